@@ -11,17 +11,18 @@ import (
 	uuid "github.com/google/uuid"
 	flag "github.com/namsral/flag"
 	natsgo "github.com/nats-io/nats.go"
+	promhttp "github.com/prometheus/client_golang/prometheus/promhttp"
 	aws "github.com/threeport/threeport/internal/aws"
 	notif "github.com/threeport/threeport/internal/aws/notif"
 	version "github.com/threeport/threeport/internal/version"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	event "github.com/threeport/threeport/pkg/event/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
 	zap "go.uber.org/zap"
 	"net/http"
 	"os"
 	"sync"
-	"time"
 )
 
 func main() {
@@ -29,17 +30,62 @@ func main() {
 	var awsEksKubernetesRuntimeInstanceConcurrentReconciles = flag.Int(
 		"aws-eks-kubernetes-runtime-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for aws eks kubernetes runtime instances",
+		"Number of concurrent reconcilers to start with for aws eks kubernetes runtime instances",
+	)
+	var awsEksKubernetesRuntimeInstanceMinConcurrentReconciles = flag.Int(
+		"aws-eks-kubernetes-runtime-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for aws eks kubernetes runtime instances",
+	)
+	var awsEksKubernetesRuntimeInstanceMaxConcurrentReconciles = flag.Int(
+		"aws-eks-kubernetes-runtime-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for aws eks kubernetes runtime instances",
+	)
+	var awsEksKubernetesRuntimeInstanceResyncInterval = flag.Duration(
+		"aws-eks-kubernetes-runtime-instance-resync-interval",
+		controller.DefaultResyncInterval,
+		"Interval at which reconciled aws eks kubernetes runtime instances are resynced to check for drift (0 disables resync)",
 	)
 	var awsRelationalDatabaseInstanceConcurrentReconciles = flag.Int(
 		"aws-relational-database-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for aws relational database instances",
+		"Number of concurrent reconcilers to start with for aws relational database instances",
+	)
+	var awsRelationalDatabaseInstanceMinConcurrentReconciles = flag.Int(
+		"aws-relational-database-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for aws relational database instances",
+	)
+	var awsRelationalDatabaseInstanceMaxConcurrentReconciles = flag.Int(
+		"aws-relational-database-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for aws relational database instances",
+	)
+	var awsRelationalDatabaseInstanceResyncInterval = flag.Duration(
+		"aws-relational-database-instance-resync-interval",
+		controller.DefaultResyncInterval,
+		"Interval at which reconciled aws relational database instances are resynced to check for drift (0 disables resync)",
 	)
 	var awsObjectStorageBucketInstanceConcurrentReconciles = flag.Int(
 		"aws-object-storage-bucket-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for aws object storage bucket instances",
+		"Number of concurrent reconcilers to start with for aws object storage bucket instances",
+	)
+	var awsObjectStorageBucketInstanceMinConcurrentReconciles = flag.Int(
+		"aws-object-storage-bucket-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for aws object storage bucket instances",
+	)
+	var awsObjectStorageBucketInstanceMaxConcurrentReconciles = flag.Int(
+		"aws-object-storage-bucket-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for aws object storage bucket instances",
+	)
+	var awsObjectStorageBucketInstanceResyncInterval = flag.Duration(
+		"aws-object-storage-bucket-instance-resync-interval",
+		controller.DefaultResyncInterval,
+		"Interval at which reconciled aws object storage bucket instances are resynced to check for drift (0 disables resync)",
 	)
 
 	var apiServer = flag.String("api-server", "threeport-api-server.threeport-control-plane.svc.cluster.local", "Threepoort REST API server endpoint")
@@ -51,6 +97,9 @@ func main() {
 	var verbose = flag.Bool("verbose", false, "Write logs with v(1).InfoLevel and above")
 	var help = flag.Bool("help", false, "Show help info")
 	var authEnabled = flag.Bool("auth-enabled", true, "Enable client certificate authentication (default is true)")
+	var autoscaleInterval = flag.Duration("autoscale-interval", controller.DefaultAutoscaleInterval, "Interval at which the number of concurrent reconcilers is adjusted")
+	var cpuBudget = flag.Float64("cpu-budget", controller.DefaultCPUBudget, "CPU cores the controller may consume before concurrent reconcilers are reduced")
+	var sharding = flag.Bool("sharding", true, "Shard objects across controller replicas so that each object is reconciled by one replica")
 	flag.Parse()
 
 	var log logr.Logger
@@ -82,6 +131,13 @@ func main() {
 		log = zapr.NewLogger(zapLog).WithValues("controllerID", controllerID)
 	}
 
+	// tracing setup - spans are exported if an OTLP collector is configured
+	shutdownTracing, err := tracing.Init(context.Background(), "threeport-aws-controller")
+	if err != nil {
+		log.Error(err, "failed to initialize tracing")
+		os.Exit(1)
+	}
+
 	// connect to NATS server
 	natsConn := fmt.Sprintf(
 		"nats://%s:%s@%s:%s",
@@ -107,7 +163,7 @@ func main() {
 	kvConfig := natsgo.KeyValueConfig{
 		Bucket:      aws.LockBucketName,
 		Description: aws.LockBucketDescr,
-		TTL:         time.Minute * 20,
+		TTL:         controller.LockBucketTTL,
 	}
 	kv, err := controller.CreateLockBucketIfNotExists(js, &kvConfig)
 	if err != nil {
@@ -127,8 +183,24 @@ func main() {
 		os.Exit(1)
 	}
 
-	// create a channel and wait group used for graceful shut downs
-	var shutdownChans []chan bool
+	// shard objects across controller replicas
+	var sharder *controller.Sharder
+	if *sharding {
+		sharder = &controller.Sharder{
+			ControllerID:     controllerID,
+			JetStreamContext: js,
+			Log:              &log,
+			MemberBucketName: aws.MemberBucketName,
+			NotifStreamName:  notif.AwsStreamName,
+			ShardStreamName:  aws.ShardStreamName,
+		}
+		if err := sharder.Start(); err != nil {
+			log.Error(err, "failed to start sharding objects across controller replicas")
+			os.Exit(1)
+		}
+	}
+
+	// create a wait group used for graceful shut downs
 	var shutdownWait sync.WaitGroup
 
 	// configure http client for calls to threeport API
@@ -141,24 +213,59 @@ func main() {
 	// configure and start reconcilers
 	var reconcilerConfigs []controller.ReconcilerConfig
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *awsEksKubernetesRuntimeInstanceConcurrentReconciles,
-		Name:                 "AwsEksKubernetesRuntimeInstanceReconciler",
-		NotifSubject:         notif.AwsEksKubernetesRuntimeInstanceSubject,
-		ReconcileFunc:        aws.AwsEksKubernetesRuntimeInstanceReconciler,
+		ConcurrentReconciles:    *awsEksKubernetesRuntimeInstanceConcurrentReconciles,
+		ListReconciledFunc:      aws.ListReconciledAwsEksKubernetesRuntimeInstances,
+		MaxConcurrentReconciles: *awsEksKubernetesRuntimeInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *awsEksKubernetesRuntimeInstanceMinConcurrentReconciles,
+		Name:                    "AwsEksKubernetesRuntimeInstanceReconciler",
+		NotifSubject:            notif.AwsEksKubernetesRuntimeInstanceSubject,
+		ReconcileFunc:           aws.AwsEksKubernetesRuntimeInstanceReconciler,
+		ResyncInterval:          *awsEksKubernetesRuntimeInstanceResyncInterval,
+		ResyncSubject:           notif.AwsEksKubernetesRuntimeInstanceUpdateSubject,
+		RetryPolicy: controller.RetryPolicy{
+			InitialDelay: 5,
+			Jitter:       0.2,
+			MaxAttempts:  10,
+			MaxDelay:     600,
+		},
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *awsRelationalDatabaseInstanceConcurrentReconciles,
-		Name:                 "AwsRelationalDatabaseInstanceReconciler",
-		NotifSubject:         notif.AwsRelationalDatabaseInstanceSubject,
-		ReconcileFunc:        aws.AwsRelationalDatabaseInstanceReconciler,
+		ConcurrentReconciles:    *awsRelationalDatabaseInstanceConcurrentReconciles,
+		ListReconciledFunc:      aws.ListReconciledAwsRelationalDatabaseInstances,
+		MaxConcurrentReconciles: *awsRelationalDatabaseInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *awsRelationalDatabaseInstanceMinConcurrentReconciles,
+		Name:                    "AwsRelationalDatabaseInstanceReconciler",
+		NotifSubject:            notif.AwsRelationalDatabaseInstanceSubject,
+		ReconcileFunc:           aws.AwsRelationalDatabaseInstanceReconciler,
+		ResyncInterval:          *awsRelationalDatabaseInstanceResyncInterval,
+		ResyncSubject:           notif.AwsRelationalDatabaseInstanceUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *awsObjectStorageBucketInstanceConcurrentReconciles,
-		Name:                 "AwsObjectStorageBucketInstanceReconciler",
-		NotifSubject:         notif.AwsObjectStorageBucketInstanceSubject,
-		ReconcileFunc:        aws.AwsObjectStorageBucketInstanceReconciler,
+		ConcurrentReconciles:    *awsObjectStorageBucketInstanceConcurrentReconciles,
+		ListReconciledFunc:      aws.ListReconciledAwsObjectStorageBucketInstances,
+		MaxConcurrentReconciles: *awsObjectStorageBucketInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *awsObjectStorageBucketInstanceMinConcurrentReconciles,
+		Name:                    "AwsObjectStorageBucketInstanceReconciler",
+		NotifSubject:            notif.AwsObjectStorageBucketInstanceSubject,
+		ReconcileFunc:           aws.AwsObjectStorageBucketInstanceReconciler,
+		ResyncInterval:          *awsObjectStorageBucketInstanceResyncInterval,
+		ResyncSubject:           notif.AwsObjectStorageBucketInstanceUpdateSubject,
 	})
 
+	// scale concurrent reconcilers with the number of pending notifications
+	autoscaler := controller.Autoscaler{
+		CPUBudget: *cpuBudget,
+		Interval:  *autoscaleInterval,
+		Log:       &log,
+	}
+
+	// periodically resync reconciled objects so that drift is detected
+	resyncer := controller.Resyncer{
+		APIClient:        apiClient,
+		APIServer:        *apiServer,
+		JetStreamContext: js,
+		Log:              &log,
+	}
 	for _, r := range reconcilerConfigs {
 
 		// create JetStream consumer
@@ -169,40 +276,54 @@ func main() {
 			FilterSubject: r.NotifSubject,
 		})
 
-		// create durable pull subscription
-		sub, err := js.PullSubscribe(r.NotifSubject, consumer, natsgo.BindStream(notif.AwsStreamName))
-		if err != nil {
-			log.Error(err, "failed to create pull subscription for reconciler notifications", "reconcilerName", r.Name)
-			os.Exit(1)
+		// route notifications to the replicas that own the objects
+		if sharder != nil {
+			if err := sharder.AddReconciler(r, consumer); err != nil {
+				log.Error(err, "failed to start routing notifications", "reconcilerName", r.Name)
+				os.Exit(1)
+			}
 		}
 
-		// create exit channel
-		shutdownChan := make(chan bool, 1)
-		shutdownChans = append(shutdownChans, shutdownChan)
+		// start reconciler - each concurrent reconciler has its own subscription
+		if err := autoscaler.AddReconciler(r, func() (*controller.Reconciler, error) {
+			// create pull subscription
+			var sub *natsgo.Subscription
+			var err error
+			if sharder != nil {
+				sub, err = sharder.Subscribe(r)
+			} else {
+				sub, err = js.PullSubscribe(r.NotifSubject, consumer, natsgo.BindStream(notif.AwsStreamName))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to create pull subscription for reconciler notifications: %w", err)
+			}
 
-		// create reconciler
-		reconciler := controller.Reconciler{
-			APIClient:     apiClient,
-			APIServer:     *apiServer,
-			ControllerID:  controllerID,
-			EncryptionKey: encryptionKey,
-			EventsRecorder: &event.EventRecorder{
-				APIClient:           apiClient,
-				APIServer:           *apiServer,
-				ReportingController: "AwsController",
-			},
-			JetStreamContext: js,
-			KeyValue:         kv,
-			Log:              &log,
-			Name:             r.Name,
-			Shutdown:         shutdownChan,
-			ShutdownWait:     &shutdownWait,
-			Sub:              sub,
+			return &controller.Reconciler{
+				APIClient:     apiClient,
+				APIServer:     *apiServer,
+				ControllerID:  controllerID,
+				EncryptionKey: encryptionKey,
+				EventsRecorder: &event.EventRecorder{
+					APIClient:           apiClient,
+					APIServer:           *apiServer,
+					ReportingController: "AwsController",
+				},
+				JetStreamContext: js,
+				KeyValue:         kv,
+				Log:              &log,
+				Name:             r.Name,
+				RetryPolicy:      r.RetryPolicy,
+				Sharder:          sharder,
+				ShutdownWait:     &shutdownWait,
+				Sub:              sub,
+			}, nil
+		}); err != nil {
+			log.Error(err, "failed to start reconciler", "reconcilerName", r.Name)
+			os.Exit(1)
 		}
-
-		// start reconciler
-		go r.ReconcileFunc(&reconciler)
+		resyncer.AddReconciler(r)
 	}
+	go autoscaler.Run()
 
 	log.Info(
 		"aws controller started",
@@ -219,9 +340,9 @@ func main() {
 		Handler: mux,
 	}
 	mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
-		for _, c := range shutdownChans {
-			c <- true
-		}
+		autoscaler.Shutdown()
+		resyncer.Shutdown()
+		sharder.Shutdown()
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "shutting down\n")
 		shutdownWait.Add(1)
@@ -236,6 +357,9 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+
+	// set up metrics endpoint
+	http.Handle("/metrics", promhttp.Handler())
 	go http.ListenAndServe(":8081", nil)
 
 	// run shutdown endpoint server
@@ -246,6 +370,11 @@ func main() {
 	// wait for reconcilers to finish
 	shutdownWait.Wait()
 
+	// export any remaining spans
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Error(err, "failed to shut down tracing")
+	}
+
 	log.Info("aws controller shutting down")
 	os.Exit(0)
 }
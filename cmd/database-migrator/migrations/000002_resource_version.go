@@ -0,0 +1,83 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	gorm "gorm.io/gorm"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000002, Down000002)
+}
+
+// Up000002 adds the resource_version column used for optimistic concurrency
+// control to every table for an object that includes the Common fields.
+// Existing rows are set to resource version 1 by the column default.
+func Up000002(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	models, err := modelsWithField(gormDb, dbInterfaces000001(), "ResourceVersion")
+	if err != nil {
+		return err
+	}
+
+	for _, model := range models {
+		// tables created by the initial migration on a new install already
+		// have the column
+		if gormDb.Migrator().HasColumn(model, "ResourceVersion") {
+			continue
+		}
+		if err := gormDb.Migrator().AddColumn(model, "ResourceVersion"); err != nil {
+			return fmt.Errorf("could not add resource_version column: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Down000002 removes the resource_version column.
+func Down000002(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	models, err := modelsWithField(gormDb, dbInterfaces000001(), "ResourceVersion")
+	if err != nil {
+		return err
+	}
+
+	for _, model := range models {
+		if !gormDb.Migrator().HasColumn(model, "ResourceVersion") {
+			continue
+		}
+		if err := gormDb.Migrator().DropColumn(model, "ResourceVersion"); err != nil {
+			return fmt.Errorf("could not drop resource_version column: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// modelsWithField returns the models that have the named field.  Not every
+// model in the database includes all the fields from the Common struct.
+func modelsWithField(gormDb *gorm.DB, models []interface{}, field string) ([]interface{}, error) {
+	var withField []interface{}
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: gormDb}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("could not parse model schema: %w", err)
+		}
+		if stmt.Schema.LookUpField(field) != nil {
+			withField = append(withField, model)
+		}
+	}
+
+	return withField, nil
+}
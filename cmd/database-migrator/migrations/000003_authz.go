@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000003, Down000003)
+}
+
+// Up000003 creates the tables for roles and role bindings used to authorize
+// API requests.
+func Up000003(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := gormDb.AutoMigrate(dbInterfaces000003()...); err != nil {
+		return fmt.Errorf("could not run gorm AutoMigrate: %w", err)
+	}
+
+	return nil
+}
+
+// Down000003 drops the tables for roles and role bindings.
+func Down000003(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range dbInterfaces000003() {
+		if err := gormDb.Migrator().DropTable(table); err != nil {
+			return fmt.Errorf("could not drop table with gorm db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func dbInterfaces000003() []interface{} {
+	return []interface{}{
+		&v0.Role{},
+		&v0.RoleBinding{},
+	}
+}
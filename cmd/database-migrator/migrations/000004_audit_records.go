@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000004, Down000004)
+}
+
+// Up000004 creates the table for audit records of API requests.
+func Up000004(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := gormDb.AutoMigrate(dbInterfaces000004()...); err != nil {
+		return fmt.Errorf("could not run gorm AutoMigrate: %w", err)
+	}
+
+	return nil
+}
+
+// Down000004 drops the table for audit records.
+func Down000004(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range dbInterfaces000004() {
+		if err := gormDb.Migrator().DropTable(table); err != nil {
+			return fmt.Errorf("could not drop table with gorm db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func dbInterfaces000004() []interface{} {
+	return []interface{}{
+		&v0.AuditRecord{},
+	}
+}
@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000005, Down000005)
+}
+
+// labelFields are the columns added to every object that includes the Common
+// fields so objects can be organized and selected by labels.
+var labelFields = []string{"Labels", "Annotations"}
+
+// Up000005 adds the labels and annotations columns.
+func Up000005(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range labelFields {
+		models, err := modelsWithField(gormDb, labelModels(), field)
+		if err != nil {
+			return err
+		}
+
+		for _, model := range models {
+			// tables created by earlier migrations on a new install already
+			// have the column
+			if gormDb.Migrator().HasColumn(model, field) {
+				continue
+			}
+			if err := gormDb.Migrator().AddColumn(model, field); err != nil {
+				return fmt.Errorf("could not add %s column: %w", field, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Down000005 removes the labels and annotations columns.
+func Down000005(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range labelFields {
+		models, err := modelsWithField(gormDb, labelModels(), field)
+		if err != nil {
+			return err
+		}
+
+		for _, model := range models {
+			if !gormDb.Migrator().HasColumn(model, field) {
+				continue
+			}
+			if err := gormDb.Migrator().DropColumn(model, field); err != nil {
+				return fmt.Errorf("could not drop %s column: %w", field, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// labelModels returns all models created by prior migrations.
+func labelModels() []interface{} {
+	models := dbInterfaces000001()
+	models = append(models, dbInterfaces000003()...)
+	models = append(models, dbInterfaces000004()...)
+
+	return models
+}
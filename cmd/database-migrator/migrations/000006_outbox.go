@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000006, Down000006)
+}
+
+// Up000006 creates the table for the outbox of controller notifications.
+func Up000006(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := gormDb.AutoMigrate(dbInterfaces000006()...); err != nil {
+		return fmt.Errorf("could not run gorm AutoMigrate: %w", err)
+	}
+
+	return nil
+}
+
+// Down000006 drops the table for the outbox of controller notifications.
+func Down000006(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range dbInterfaces000006() {
+		if err := gormDb.Migrator().DropTable(table); err != nil {
+			return fmt.Errorf("could not drop table with gorm db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func dbInterfaces000006() []interface{} {
+	return []interface{}{
+		&v0.OutboxNotification{},
+	}
+}
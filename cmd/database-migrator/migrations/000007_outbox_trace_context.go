@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000007, Down000007)
+}
+
+// Up000007 adds the trace_context column to the outbox of controller
+// notifications so that traces continue from API requests to controllers.
+func Up000007(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the outbox table created on a new install already has the column
+	if gormDb.Migrator().HasColumn(&v0.OutboxNotification{}, "TraceContext") {
+		return nil
+	}
+	if err := gormDb.Migrator().AddColumn(&v0.OutboxNotification{}, "TraceContext"); err != nil {
+		return fmt.Errorf("could not add trace_context column: %w", err)
+	}
+
+	return nil
+}
+
+// Down000007 removes the trace_context column.
+func Down000007(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !gormDb.Migrator().HasColumn(&v0.OutboxNotification{}, "TraceContext") {
+		return nil
+	}
+	if err := gormDb.Migrator().DropColumn(&v0.OutboxNotification{}, "TraceContext"); err != nil {
+		return fmt.Errorf("could not drop trace_context column: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000008, Down000008)
+}
+
+// Up000008 adds the drifted column to every table for an object that includes
+// the Reconciliation fields so controllers can record drift found when
+// objects are resynced.  Existing rows are set to false by the column default.
+func Up000008(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	models, err := modelsWithField(gormDb, dbInterfaces000001(), "Drifted")
+	if err != nil {
+		return err
+	}
+
+	for _, model := range models {
+		// tables created by the initial migration on a new install already
+		// have the column
+		if gormDb.Migrator().HasColumn(model, "Drifted") {
+			continue
+		}
+		if err := gormDb.Migrator().AddColumn(model, "Drifted"); err != nil {
+			return fmt.Errorf("could not add drifted column: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Down000008 removes the drifted column.
+func Down000008(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	models, err := modelsWithField(gormDb, dbInterfaces000001(), "Drifted")
+	if err != nil {
+		return err
+	}
+
+	for _, model := range models {
+		if !gormDb.Migrator().HasColumn(model, "Drifted") {
+			continue
+		}
+		if err := gormDb.Migrator().DropColumn(model, "Drifted"); err != nil {
+			return fmt.Errorf("could not drop drifted column: %w", err)
+		}
+	}
+
+	return nil
+}
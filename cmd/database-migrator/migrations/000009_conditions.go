@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000009, Down000009)
+}
+
+// Up000009 adds the conditions column to every table for an object that
+// includes the Reconciliation fields so controllers can record structured
+// status conditions.  Existing rows have no conditions until their objects
+// are next reconciled.
+func Up000009(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	models, err := modelsWithField(gormDb, dbInterfaces000001(), "Conditions")
+	if err != nil {
+		return err
+	}
+
+	for _, model := range models {
+		// tables created by the initial migration on a new install already
+		// have the column
+		if gormDb.Migrator().HasColumn(model, "Conditions") {
+			continue
+		}
+		if err := gormDb.Migrator().AddColumn(model, "Conditions"); err != nil {
+			return fmt.Errorf("could not add conditions column: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Down000009 removes the conditions column.
+func Down000009(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	models, err := modelsWithField(gormDb, dbInterfaces000001(), "Conditions")
+	if err != nil {
+		return err
+	}
+
+	for _, model := range models {
+		if !gormDb.Migrator().HasColumn(model, "Conditions") {
+			continue
+		}
+		if err := gormDb.Migrator().DropColumn(model, "Conditions"); err != nil {
+			return fmt.Errorf("could not drop conditions column: %w", err)
+		}
+	}
+
+	return nil
+}
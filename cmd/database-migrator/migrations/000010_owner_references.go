@@ -0,0 +1,138 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000010, Down000010)
+}
+
+// the columns added for owner references and finalizers - owner references
+// are included in every object with the Common fields while finalizers are
+// only included in reconciled objects
+var ownerReferenceFields000010 = []string{"OwnerReferences", "Finalizers"}
+
+// Up000010 adds the owner references and finalizers columns to every table
+// for an object that includes them so that deleting an object cascades to
+// its dependents.  The helm workload instances created by the observability
+// controllers are given owner references so they continue to be deleted with
+// the objects they were created for.
+func Up000010(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range ownerReferenceFields000010 {
+		models, err := modelsWithField(gormDb, dbInterfaces000001(), field)
+		if err != nil {
+			return err
+		}
+
+		for _, model := range models {
+			// tables created by the initial migration on a new install
+			// already have the column
+			if gormDb.Migrator().HasColumn(model, field) {
+				continue
+			}
+			if err := gormDb.Migrator().AddColumn(model, field); err != nil {
+				return fmt.Errorf("could not add %s column: %w", field, err)
+			}
+		}
+	}
+
+	var metricsInstances []v0.MetricsInstance
+	if result := gormDb.Find(&metricsInstances); result.Error != nil {
+		return fmt.Errorf("could not get metrics instances: %w", result.Error)
+	}
+	for _, metricsInstance := range metricsInstances {
+		if err := addHelmWorkloadInstanceOwner000010(
+			gormDb,
+			metricsInstance.KubePrometheusStackHelmWorkloadInstanceID,
+			v0.ObjectTypeMetricsInstance,
+			*metricsInstance.ID,
+		); err != nil {
+			return err
+		}
+	}
+
+	var dashboardInstances []v0.ObservabilityDashboardInstance
+	if result := gormDb.Find(&dashboardInstances); result.Error != nil {
+		return fmt.Errorf("could not get observability dashboard instances: %w", result.Error)
+	}
+	for _, dashboardInstance := range dashboardInstances {
+		if err := addHelmWorkloadInstanceOwner000010(
+			gormDb,
+			dashboardInstance.GrafanaHelmWorkloadInstanceID,
+			v0.ObjectTypeObservabilityDashboardInstance,
+			*dashboardInstance.ID,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addHelmWorkloadInstanceOwner000010 sets the owner reference on a helm
+// workload instance created by an observability controller.
+func addHelmWorkloadInstanceOwner000010(
+	gormDb *gorm.DB,
+	helmWorkloadInstanceID *uint,
+	ownerType string,
+	ownerID uint,
+) error {
+	if helmWorkloadInstanceID == nil {
+		return nil
+	}
+
+	ownerReferences := datatypes.JSONSlice[v0.OwnerReference]{
+		{
+			ObjectType: ownerType,
+			Version:    "v0",
+			ID:         ownerID,
+		},
+	}
+	if result := gormDb.Model(&v0.HelmWorkloadInstance{}).Where(
+		"id = ?", *helmWorkloadInstanceID,
+	).Update("OwnerReferences", ownerReferences); result.Error != nil {
+		return fmt.Errorf("could not add owner reference to helm workload instance: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Down000010 removes the owner references and finalizers columns.
+func Down000010(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range ownerReferenceFields000010 {
+		models, err := modelsWithField(gormDb, dbInterfaces000001(), field)
+		if err != nil {
+			return err
+		}
+
+		for _, model := range models {
+			if !gormDb.Migrator().HasColumn(model, field) {
+				continue
+			}
+			if err := gormDb.Migrator().DropColumn(model, field); err != nil {
+				return fmt.Errorf("could not drop %s column: %w", field, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000011, Down000011)
+}
+
+// the role bindings that grant the admin role to the threeport control plane
+// components and the admin client created at install time, as well as to the
+// single subject used by all of them on control planes installed before they
+// were issued certificates with their own subjects
+var authzBootstrapBindings000011 = map[string]string{
+	"threeport-system": v0.AuthzSystemSubjectPrefix + v0.AuthzAll,
+	"threeport-admin":  v0.AuthzAdminSubject,
+	"threeport-legacy": v0.AuthzLegacySubject,
+}
+
+// Up000011 adds unique indexes to the names of roles and role bindings that
+// exclude deleted objects so their names may be reused, and creates the admin
+// role along with the role bindings for the control plane components and the
+// admin client so that they are authorized by the same roles as other API
+// clients.  The role and role bindings are only created if they have never
+// existed so that an admin may remove them, e.g. the legacy role binding once
+// the certificates of an existing control plane have been re-issued.
+func Up000011(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, model := range dbInterfaces000003() {
+		// tables created by the authz migration on a new install already have
+		// the index
+		if gormDb.Migrator().HasIndex(model, "Name") {
+			continue
+		}
+		if err := gormDb.Migrator().CreateIndex(model, "Name"); err != nil {
+			return fmt.Errorf("could not create unique name index: %w", err)
+		}
+	}
+
+	var role v0.Role
+	result := gormDb.Unscoped().Where("name = ?", v0.AuthzAdminRoleName).First(&role)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("could not query admin role: %w", result.Error)
+	}
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		rules := datatypes.JSONSlice[v0.RoleRule]{{
+			Verbs:       []string{v0.AuthzAll},
+			ObjectTypes: []string{v0.AuthzAll},
+		}}
+		role = v0.Role{
+			Name:  util.Ptr(v0.AuthzAdminRoleName),
+			Rules: &rules,
+		}
+		if result := gormDb.Create(&role); result.Error != nil {
+			return fmt.Errorf("could not create admin role: %w", result.Error)
+		}
+	}
+
+	for name, subject := range authzBootstrapBindings000011 {
+		var roleBinding v0.RoleBinding
+		result := gormDb.Unscoped().Where("name = ?", name).First(&roleBinding)
+		if result.Error == nil {
+			continue
+		}
+		if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("could not query role binding %s: %w", name, result.Error)
+		}
+		roleBinding = v0.RoleBinding{
+			Name:    util.Ptr(name),
+			Subject: util.Ptr(subject),
+			RoleID:  role.ID,
+		}
+		if result := gormDb.Create(&roleBinding); result.Error != nil {
+			return fmt.Errorf("could not create role binding %s: %w", name, result.Error)
+		}
+	}
+
+	return nil
+}
+
+// Down000011 removes the bootstrap role bindings and admin role and drops the
+// unique name indexes.
+func Down000011(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for name := range authzBootstrapBindings000011 {
+		if result := gormDb.Unscoped().Where("name = ?", name).Delete(&v0.RoleBinding{}); result.Error != nil {
+			return fmt.Errorf("could not delete role binding %s: %w", name, result.Error)
+		}
+	}
+	if result := gormDb.Unscoped().Where("name = ?", v0.AuthzAdminRoleName).Delete(&v0.Role{}); result.Error != nil {
+		return fmt.Errorf("could not delete admin role: %w", result.Error)
+	}
+
+	for _, model := range dbInterfaces000003() {
+		if !gormDb.Migrator().HasIndex(model, "Name") {
+			continue
+		}
+		if err := gormDb.Migrator().DropIndex(model, "Name"); err != nil {
+			return fmt.Errorf("could not drop unique name index: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	goose "github.com/pressly/goose/v3"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(Up000012, Down000012)
+}
+
+// the index the outbox relay uses to find notifications that are due to be
+// published and the single column index it replaces
+const (
+	outboxDueIndex000012       = "idx_v0_outbox_notifications_due"
+	outboxDeliveredIndex000012 = "idx_v0_outbox_notifications_delivered"
+)
+
+// Up000012 replaces the index on the delivered column of the outbox with an
+// index on the delivered and next_attempt_at columns so the outbox relay's
+// query for notifications that are due remains efficient as the outbox grows.
+func Up000012(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the outbox table created on a new install already has the index
+	if !gormDb.Migrator().HasIndex(&v0.OutboxNotification{}, outboxDueIndex000012) {
+		if err := gormDb.Migrator().CreateIndex(&v0.OutboxNotification{}, outboxDueIndex000012); err != nil {
+			return fmt.Errorf("could not create outbox due index: %w", err)
+		}
+	}
+
+	if gormDb.Migrator().HasIndex(&v0.OutboxNotification{}, outboxDeliveredIndex000012) {
+		if err := gormDb.Migrator().DropIndex(&v0.OutboxNotification{}, outboxDeliveredIndex000012); err != nil {
+			return fmt.Errorf("could not drop outbox delivered index: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Down000012 restores the index on the delivered column of the outbox and
+// drops the index on the delivered and next_attempt_at columns.
+func Down000012(ctx context.Context, db *sql.DB) error {
+	gormDb, err := getGormDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !gormDb.Migrator().HasIndex(&v0.OutboxNotification{}, outboxDeliveredIndex000012) {
+		if result := gormDb.Exec(fmt.Sprintf(
+			"CREATE INDEX %s ON %s (delivered)",
+			outboxDeliveredIndex000012,
+			v0.OutboxNotification{}.TableName(),
+		)); result.Error != nil {
+			return fmt.Errorf("could not create outbox delivered index: %w", result.Error)
+		}
+	}
+
+	if gormDb.Migrator().HasIndex(&v0.OutboxNotification{}, outboxDueIndex000012) {
+		if err := gormDb.Migrator().DropIndex(&v0.OutboxNotification{}, outboxDueIndex000012); err != nil {
+			return fmt.Errorf("could not drop outbox due index: %w", err)
+		}
+	}
+
+	return nil
+}
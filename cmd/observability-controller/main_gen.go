@@ -11,17 +11,18 @@ import (
 	uuid "github.com/google/uuid"
 	flag "github.com/namsral/flag"
 	natsgo "github.com/nats-io/nats.go"
+	promhttp "github.com/prometheus/client_golang/prometheus/promhttp"
 	observability "github.com/threeport/threeport/internal/observability"
 	notif "github.com/threeport/threeport/internal/observability/notif"
 	version "github.com/threeport/threeport/internal/version"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	event "github.com/threeport/threeport/pkg/event/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
 	zap "go.uber.org/zap"
 	"net/http"
 	"os"
 	"sync"
-	"time"
 )
 
 func main() {
@@ -29,42 +30,162 @@ func main() {
 	var observabilityStackDefinitionConcurrentReconciles = flag.Int(
 		"observability-stack-definition-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for observability stack definitions",
+		"Number of concurrent reconcilers to start with for observability stack definitions",
+	)
+	var observabilityStackDefinitionMinConcurrentReconciles = flag.Int(
+		"observability-stack-definition-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for observability stack definitions",
+	)
+	var observabilityStackDefinitionMaxConcurrentReconciles = flag.Int(
+		"observability-stack-definition-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for observability stack definitions",
+	)
+	var observabilityStackDefinitionResyncInterval = flag.Duration(
+		"observability-stack-definition-resync-interval",
+		0,
+		"Interval at which reconciled observability stack definitions are resynced (0 disables resync)",
 	)
 	var observabilityStackInstanceConcurrentReconciles = flag.Int(
 		"observability-stack-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for observability stack instances",
+		"Number of concurrent reconcilers to start with for observability stack instances",
+	)
+	var observabilityStackInstanceMinConcurrentReconciles = flag.Int(
+		"observability-stack-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for observability stack instances",
+	)
+	var observabilityStackInstanceMaxConcurrentReconciles = flag.Int(
+		"observability-stack-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for observability stack instances",
+	)
+	var observabilityStackInstanceResyncInterval = flag.Duration(
+		"observability-stack-instance-resync-interval",
+		0,
+		"Interval at which reconciled observability stack instances are resynced (0 disables resync)",
 	)
 	var observabilityDashboardDefinitionConcurrentReconciles = flag.Int(
 		"observability-dashboard-definition-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for observability dashboard definitions",
+		"Number of concurrent reconcilers to start with for observability dashboard definitions",
+	)
+	var observabilityDashboardDefinitionMinConcurrentReconciles = flag.Int(
+		"observability-dashboard-definition-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for observability dashboard definitions",
+	)
+	var observabilityDashboardDefinitionMaxConcurrentReconciles = flag.Int(
+		"observability-dashboard-definition-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for observability dashboard definitions",
+	)
+	var observabilityDashboardDefinitionResyncInterval = flag.Duration(
+		"observability-dashboard-definition-resync-interval",
+		0,
+		"Interval at which reconciled observability dashboard definitions are resynced (0 disables resync)",
 	)
 	var observabilityDashboardInstanceConcurrentReconciles = flag.Int(
 		"observability-dashboard-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for observability dashboard instances",
+		"Number of concurrent reconcilers to start with for observability dashboard instances",
+	)
+	var observabilityDashboardInstanceMinConcurrentReconciles = flag.Int(
+		"observability-dashboard-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for observability dashboard instances",
+	)
+	var observabilityDashboardInstanceMaxConcurrentReconciles = flag.Int(
+		"observability-dashboard-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for observability dashboard instances",
+	)
+	var observabilityDashboardInstanceResyncInterval = flag.Duration(
+		"observability-dashboard-instance-resync-interval",
+		0,
+		"Interval at which reconciled observability dashboard instances are resynced (0 disables resync)",
 	)
 	var metricsDefinitionConcurrentReconciles = flag.Int(
 		"metrics-definition-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for metrics definitions",
+		"Number of concurrent reconcilers to start with for metrics definitions",
+	)
+	var metricsDefinitionMinConcurrentReconciles = flag.Int(
+		"metrics-definition-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for metrics definitions",
+	)
+	var metricsDefinitionMaxConcurrentReconciles = flag.Int(
+		"metrics-definition-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for metrics definitions",
+	)
+	var metricsDefinitionResyncInterval = flag.Duration(
+		"metrics-definition-resync-interval",
+		0,
+		"Interval at which reconciled metrics definitions are resynced (0 disables resync)",
 	)
 	var metricsInstanceConcurrentReconciles = flag.Int(
 		"metrics-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for metrics instances",
+		"Number of concurrent reconcilers to start with for metrics instances",
+	)
+	var metricsInstanceMinConcurrentReconciles = flag.Int(
+		"metrics-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for metrics instances",
+	)
+	var metricsInstanceMaxConcurrentReconciles = flag.Int(
+		"metrics-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for metrics instances",
+	)
+	var metricsInstanceResyncInterval = flag.Duration(
+		"metrics-instance-resync-interval",
+		0,
+		"Interval at which reconciled metrics instances are resynced (0 disables resync)",
 	)
 	var loggingDefinitionConcurrentReconciles = flag.Int(
 		"logging-definition-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for logging definitions",
+		"Number of concurrent reconcilers to start with for logging definitions",
+	)
+	var loggingDefinitionMinConcurrentReconciles = flag.Int(
+		"logging-definition-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for logging definitions",
+	)
+	var loggingDefinitionMaxConcurrentReconciles = flag.Int(
+		"logging-definition-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for logging definitions",
+	)
+	var loggingDefinitionResyncInterval = flag.Duration(
+		"logging-definition-resync-interval",
+		0,
+		"Interval at which reconciled logging definitions are resynced (0 disables resync)",
 	)
 	var loggingInstanceConcurrentReconciles = flag.Int(
 		"logging-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for logging instances",
+		"Number of concurrent reconcilers to start with for logging instances",
+	)
+	var loggingInstanceMinConcurrentReconciles = flag.Int(
+		"logging-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for logging instances",
+	)
+	var loggingInstanceMaxConcurrentReconciles = flag.Int(
+		"logging-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for logging instances",
+	)
+	var loggingInstanceResyncInterval = flag.Duration(
+		"logging-instance-resync-interval",
+		0,
+		"Interval at which reconciled logging instances are resynced (0 disables resync)",
 	)
 
 	var apiServer = flag.String("api-server", "threeport-api-server.threeport-control-plane.svc.cluster.local", "Threepoort REST API server endpoint")
@@ -76,6 +197,9 @@ func main() {
 	var verbose = flag.Bool("verbose", false, "Write logs with v(1).InfoLevel and above")
 	var help = flag.Bool("help", false, "Show help info")
 	var authEnabled = flag.Bool("auth-enabled", true, "Enable client certificate authentication (default is true)")
+	var autoscaleInterval = flag.Duration("autoscale-interval", controller.DefaultAutoscaleInterval, "Interval at which the number of concurrent reconcilers is adjusted")
+	var cpuBudget = flag.Float64("cpu-budget", controller.DefaultCPUBudget, "CPU cores the controller may consume before concurrent reconcilers are reduced")
+	var sharding = flag.Bool("sharding", true, "Shard objects across controller replicas so that each object is reconciled by one replica")
 	flag.Parse()
 
 	var log logr.Logger
@@ -107,6 +231,13 @@ func main() {
 		log = zapr.NewLogger(zapLog).WithValues("controllerID", controllerID)
 	}
 
+	// tracing setup - spans are exported if an OTLP collector is configured
+	shutdownTracing, err := tracing.Init(context.Background(), "threeport-observability-controller")
+	if err != nil {
+		log.Error(err, "failed to initialize tracing")
+		os.Exit(1)
+	}
+
 	// connect to NATS server
 	natsConn := fmt.Sprintf(
 		"nats://%s:%s@%s:%s",
@@ -132,7 +263,7 @@ func main() {
 	kvConfig := natsgo.KeyValueConfig{
 		Bucket:      observability.LockBucketName,
 		Description: observability.LockBucketDescr,
-		TTL:         time.Minute * 20,
+		TTL:         controller.LockBucketTTL,
 	}
 	kv, err := controller.CreateLockBucketIfNotExists(js, &kvConfig)
 	if err != nil {
@@ -152,8 +283,24 @@ func main() {
 		os.Exit(1)
 	}
 
-	// create a channel and wait group used for graceful shut downs
-	var shutdownChans []chan bool
+	// shard objects across controller replicas
+	var sharder *controller.Sharder
+	if *sharding {
+		sharder = &controller.Sharder{
+			ControllerID:     controllerID,
+			JetStreamContext: js,
+			Log:              &log,
+			MemberBucketName: observability.MemberBucketName,
+			NotifStreamName:  notif.ObservabilityStreamName,
+			ShardStreamName:  observability.ShardStreamName,
+		}
+		if err := sharder.Start(); err != nil {
+			log.Error(err, "failed to start sharding objects across controller replicas")
+			os.Exit(1)
+		}
+	}
+
+	// create a wait group used for graceful shut downs
 	var shutdownWait sync.WaitGroup
 
 	// configure http client for calls to threeport API
@@ -166,54 +313,108 @@ func main() {
 	// configure and start reconcilers
 	var reconcilerConfigs []controller.ReconcilerConfig
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *observabilityStackDefinitionConcurrentReconciles,
-		Name:                 "ObservabilityStackDefinitionReconciler",
-		NotifSubject:         notif.ObservabilityStackDefinitionSubject,
-		ReconcileFunc:        observability.ObservabilityStackDefinitionReconciler,
+		ConcurrentReconciles:    *observabilityStackDefinitionConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledObservabilityStackDefinitions,
+		MaxConcurrentReconciles: *observabilityStackDefinitionMaxConcurrentReconciles,
+		MinConcurrentReconciles: *observabilityStackDefinitionMinConcurrentReconciles,
+		Name:                    "ObservabilityStackDefinitionReconciler",
+		NotifSubject:            notif.ObservabilityStackDefinitionSubject,
+		ReconcileFunc:           observability.ObservabilityStackDefinitionReconciler,
+		ResyncInterval:          *observabilityStackDefinitionResyncInterval,
+		ResyncSubject:           notif.ObservabilityStackDefinitionUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *observabilityStackInstanceConcurrentReconciles,
-		Name:                 "ObservabilityStackInstanceReconciler",
-		NotifSubject:         notif.ObservabilityStackInstanceSubject,
-		ReconcileFunc:        observability.ObservabilityStackInstanceReconciler,
+		ConcurrentReconciles:    *observabilityStackInstanceConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledObservabilityStackInstances,
+		MaxConcurrentReconciles: *observabilityStackInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *observabilityStackInstanceMinConcurrentReconciles,
+		Name:                    "ObservabilityStackInstanceReconciler",
+		NotifSubject:            notif.ObservabilityStackInstanceSubject,
+		ReconcileFunc:           observability.ObservabilityStackInstanceReconciler,
+		ResyncInterval:          *observabilityStackInstanceResyncInterval,
+		ResyncSubject:           notif.ObservabilityStackInstanceUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *observabilityDashboardDefinitionConcurrentReconciles,
-		Name:                 "ObservabilityDashboardDefinitionReconciler",
-		NotifSubject:         notif.ObservabilityDashboardDefinitionSubject,
-		ReconcileFunc:        observability.ObservabilityDashboardDefinitionReconciler,
+		ConcurrentReconciles:    *observabilityDashboardDefinitionConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledObservabilityDashboardDefinitions,
+		MaxConcurrentReconciles: *observabilityDashboardDefinitionMaxConcurrentReconciles,
+		MinConcurrentReconciles: *observabilityDashboardDefinitionMinConcurrentReconciles,
+		Name:                    "ObservabilityDashboardDefinitionReconciler",
+		NotifSubject:            notif.ObservabilityDashboardDefinitionSubject,
+		ReconcileFunc:           observability.ObservabilityDashboardDefinitionReconciler,
+		ResyncInterval:          *observabilityDashboardDefinitionResyncInterval,
+		ResyncSubject:           notif.ObservabilityDashboardDefinitionUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *observabilityDashboardInstanceConcurrentReconciles,
-		Name:                 "ObservabilityDashboardInstanceReconciler",
-		NotifSubject:         notif.ObservabilityDashboardInstanceSubject,
-		ReconcileFunc:        observability.ObservabilityDashboardInstanceReconciler,
+		ConcurrentReconciles:    *observabilityDashboardInstanceConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledObservabilityDashboardInstances,
+		MaxConcurrentReconciles: *observabilityDashboardInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *observabilityDashboardInstanceMinConcurrentReconciles,
+		Name:                    "ObservabilityDashboardInstanceReconciler",
+		NotifSubject:            notif.ObservabilityDashboardInstanceSubject,
+		ReconcileFunc:           observability.ObservabilityDashboardInstanceReconciler,
+		ResyncInterval:          *observabilityDashboardInstanceResyncInterval,
+		ResyncSubject:           notif.ObservabilityDashboardInstanceUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *metricsDefinitionConcurrentReconciles,
-		Name:                 "MetricsDefinitionReconciler",
-		NotifSubject:         notif.MetricsDefinitionSubject,
-		ReconcileFunc:        observability.MetricsDefinitionReconciler,
+		ConcurrentReconciles:    *metricsDefinitionConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledMetricsDefinitions,
+		MaxConcurrentReconciles: *metricsDefinitionMaxConcurrentReconciles,
+		MinConcurrentReconciles: *metricsDefinitionMinConcurrentReconciles,
+		Name:                    "MetricsDefinitionReconciler",
+		NotifSubject:            notif.MetricsDefinitionSubject,
+		ReconcileFunc:           observability.MetricsDefinitionReconciler,
+		ResyncInterval:          *metricsDefinitionResyncInterval,
+		ResyncSubject:           notif.MetricsDefinitionUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *metricsInstanceConcurrentReconciles,
-		Name:                 "MetricsInstanceReconciler",
-		NotifSubject:         notif.MetricsInstanceSubject,
-		ReconcileFunc:        observability.MetricsInstanceReconciler,
+		ConcurrentReconciles:    *metricsInstanceConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledMetricsInstances,
+		MaxConcurrentReconciles: *metricsInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *metricsInstanceMinConcurrentReconciles,
+		Name:                    "MetricsInstanceReconciler",
+		NotifSubject:            notif.MetricsInstanceSubject,
+		ReconcileFunc:           observability.MetricsInstanceReconciler,
+		ResyncInterval:          *metricsInstanceResyncInterval,
+		ResyncSubject:           notif.MetricsInstanceUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *loggingDefinitionConcurrentReconciles,
-		Name:                 "LoggingDefinitionReconciler",
-		NotifSubject:         notif.LoggingDefinitionSubject,
-		ReconcileFunc:        observability.LoggingDefinitionReconciler,
+		ConcurrentReconciles:    *loggingDefinitionConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledLoggingDefinitions,
+		MaxConcurrentReconciles: *loggingDefinitionMaxConcurrentReconciles,
+		MinConcurrentReconciles: *loggingDefinitionMinConcurrentReconciles,
+		Name:                    "LoggingDefinitionReconciler",
+		NotifSubject:            notif.LoggingDefinitionSubject,
+		ReconcileFunc:           observability.LoggingDefinitionReconciler,
+		ResyncInterval:          *loggingDefinitionResyncInterval,
+		ResyncSubject:           notif.LoggingDefinitionUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *loggingInstanceConcurrentReconciles,
-		Name:                 "LoggingInstanceReconciler",
-		NotifSubject:         notif.LoggingInstanceSubject,
-		ReconcileFunc:        observability.LoggingInstanceReconciler,
+		ConcurrentReconciles:    *loggingInstanceConcurrentReconciles,
+		ListReconciledFunc:      observability.ListReconciledLoggingInstances,
+		MaxConcurrentReconciles: *loggingInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *loggingInstanceMinConcurrentReconciles,
+		Name:                    "LoggingInstanceReconciler",
+		NotifSubject:            notif.LoggingInstanceSubject,
+		ReconcileFunc:           observability.LoggingInstanceReconciler,
+		ResyncInterval:          *loggingInstanceResyncInterval,
+		ResyncSubject:           notif.LoggingInstanceUpdateSubject,
 	})
 
+	// scale concurrent reconcilers with the number of pending notifications
+	autoscaler := controller.Autoscaler{
+		CPUBudget: *cpuBudget,
+		Interval:  *autoscaleInterval,
+		Log:       &log,
+	}
+
+	// periodically resync reconciled objects so that drift is detected
+	resyncer := controller.Resyncer{
+		APIClient:        apiClient,
+		APIServer:        *apiServer,
+		JetStreamContext: js,
+		Log:              &log,
+	}
 	for _, r := range reconcilerConfigs {
 
 		// create JetStream consumer
@@ -224,40 +425,54 @@ func main() {
 			FilterSubject: r.NotifSubject,
 		})
 
-		// create durable pull subscription
-		sub, err := js.PullSubscribe(r.NotifSubject, consumer, natsgo.BindStream(notif.ObservabilityStreamName))
-		if err != nil {
-			log.Error(err, "failed to create pull subscription for reconciler notifications", "reconcilerName", r.Name)
-			os.Exit(1)
+		// route notifications to the replicas that own the objects
+		if sharder != nil {
+			if err := sharder.AddReconciler(r, consumer); err != nil {
+				log.Error(err, "failed to start routing notifications", "reconcilerName", r.Name)
+				os.Exit(1)
+			}
 		}
 
-		// create exit channel
-		shutdownChan := make(chan bool, 1)
-		shutdownChans = append(shutdownChans, shutdownChan)
+		// start reconciler - each concurrent reconciler has its own subscription
+		if err := autoscaler.AddReconciler(r, func() (*controller.Reconciler, error) {
+			// create pull subscription
+			var sub *natsgo.Subscription
+			var err error
+			if sharder != nil {
+				sub, err = sharder.Subscribe(r)
+			} else {
+				sub, err = js.PullSubscribe(r.NotifSubject, consumer, natsgo.BindStream(notif.ObservabilityStreamName))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to create pull subscription for reconciler notifications: %w", err)
+			}
 
-		// create reconciler
-		reconciler := controller.Reconciler{
-			APIClient:     apiClient,
-			APIServer:     *apiServer,
-			ControllerID:  controllerID,
-			EncryptionKey: encryptionKey,
-			EventsRecorder: &event.EventRecorder{
-				APIClient:           apiClient,
-				APIServer:           *apiServer,
-				ReportingController: "ObservabilityController",
-			},
-			JetStreamContext: js,
-			KeyValue:         kv,
-			Log:              &log,
-			Name:             r.Name,
-			Shutdown:         shutdownChan,
-			ShutdownWait:     &shutdownWait,
-			Sub:              sub,
+			return &controller.Reconciler{
+				APIClient:     apiClient,
+				APIServer:     *apiServer,
+				ControllerID:  controllerID,
+				EncryptionKey: encryptionKey,
+				EventsRecorder: &event.EventRecorder{
+					APIClient:           apiClient,
+					APIServer:           *apiServer,
+					ReportingController: "ObservabilityController",
+				},
+				JetStreamContext: js,
+				KeyValue:         kv,
+				Log:              &log,
+				Name:             r.Name,
+				RetryPolicy:      r.RetryPolicy,
+				Sharder:          sharder,
+				ShutdownWait:     &shutdownWait,
+				Sub:              sub,
+			}, nil
+		}); err != nil {
+			log.Error(err, "failed to start reconciler", "reconcilerName", r.Name)
+			os.Exit(1)
 		}
-
-		// start reconciler
-		go r.ReconcileFunc(&reconciler)
+		resyncer.AddReconciler(r)
 	}
+	go autoscaler.Run()
 
 	log.Info(
 		"observability controller started",
@@ -274,9 +489,9 @@ func main() {
 		Handler: mux,
 	}
 	mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
-		for _, c := range shutdownChans {
-			c <- true
-		}
+		autoscaler.Shutdown()
+		resyncer.Shutdown()
+		sharder.Shutdown()
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "shutting down\n")
 		shutdownWait.Add(1)
@@ -291,6 +506,9 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+
+	// set up metrics endpoint
+	http.Handle("/metrics", promhttp.Handler())
 	go http.ListenAndServe(":8081", nil)
 
 	// run shutdown endpoint server
@@ -301,6 +519,11 @@ func main() {
 	// wait for reconcilers to finish
 	shutdownWait.Wait()
 
+	// export any remaining spans
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Error(err, "failed to shut down tracing")
+	}
+
 	log.Info("observability controller shutting down")
 	os.Exit(0)
 }
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"flag"
@@ -12,6 +13,7 @@ import (
 	echo "github.com/labstack/echo/v4"
 	middleware "github.com/labstack/echo/v4/middleware"
 	natsgo "github.com/nats-io/nats.go"
+	promhttp "github.com/prometheus/client_golang/prometheus/promhttp"
 	util "github.com/threeport/threeport/cmd/rest-api/util"
 	version "github.com/threeport/threeport/internal/version"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
@@ -22,10 +24,12 @@ import (
 	versions_v0 "github.com/threeport/threeport/pkg/api-server/v0/versions"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	log "github.com/threeport/threeport/pkg/log/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
 	zap "go.uber.org/zap"
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 // @title Threeport RESTful API
@@ -39,10 +43,14 @@ func main() {
 	var autoMigrate bool
 	var verbose bool
 	var authEnabled bool
+	var deletedObjectRetention time.Duration
+	var outboxRetention time.Duration
 	flag.StringVar(&envFile, "env-file", "/etc/threeport/env", "File from which to load environment")
 	flag.BoolVar(&autoMigrate, "auto-migrate", false, "If true API server will auto migrate DB schema")
 	flag.BoolVar(&verbose, "verbose", false, "Write logs with v(1).InfoLevel and above")
 	flag.BoolVar(&authEnabled, "auth-enabled", true, "Enable client certificate authentication")
+	flag.DurationVar(&deletedObjectRetention, "deleted-object-retention", apiserver_lib.DefaultDeletedObjectRetention, "Length of time deleted objects can be restored before they are purged")
+	flag.DurationVar(&outboxRetention, "outbox-retention", apiserver_lib.DefaultOutboxRetention, "Length of time delivered controller notifications are kept in the outbox before they are purged")
 	flag.Parse()
 
 	// set up echo
@@ -91,6 +99,14 @@ func main() {
 	}))
 	e.Use(middleware.Recover())
 
+	// trace requests and export spans if an OTLP collector is configured
+	shutdownTracing, err := tracing.Init(context.Background(), "threeport-rest-api")
+	if err != nil {
+		e.Logger.Fatalf("failed to initialize tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+	e.Use(apiserver_lib.TracingMiddleware())
+
 	e.HTTPErrorHandler = func(err error, c echo.Context) {
 		// call the default handler to return the HTTP response
 		e.DefaultHTTPErrorHandler(err, c)
@@ -107,6 +123,14 @@ func main() {
 		e.Logger.Fatalf("failed to initialize database: %v", err)
 	}
 
+	// record an audit trail of requests that change objects
+	e.Use(apiserver_lib.AuditMiddleware(db))
+
+	// authorize requests using roles bound to the client certificate subject
+	if authEnabled {
+		e.Use(apiserver_lib.AuthorizationMiddleware(db))
+	}
+
 	// add module router middleware
 	if err := api_v0.InitModuleRouter(db, e); err != nil {
 		e.Logger.Fatalf("failed to initialize extension proxy router: %v", err)
@@ -132,6 +156,30 @@ func main() {
 		e.Logger.Fatalf("failed to initialize nats jet stream: %v", err)
 	}
 
+	// publish controller notifications from the outbox
+	outboxRelay := &apiserver_lib.OutboxRelay{
+		DB:     db,
+		JS:     *js,
+		Logger: &logger,
+	}
+	go outboxRelay.Run(context.Background())
+
+	// purge delivered notifications from the outbox once the retention window has passed
+	apiserver_lib.OutboxRetention = outboxRetention
+	outboxPurger := &apiserver_lib.OutboxPurger{
+		DB:     db,
+		Logger: &logger,
+	}
+	go outboxPurger.Run(context.Background())
+
+	// purge deleted objects once the retention window has passed
+	apiserver_lib.DeletedObjectRetention = deletedObjectRetention
+	deletedObjectPurger := &apiserver_lib.DeletedObjectPurger{
+		DB:     db,
+		Logger: &logger,
+	}
+	go deletedObjectPurger.Run(context.Background())
+
 	// handlers
 	// v0
 	h_v0 := handlers_v0.New(db, nc, *js)
@@ -199,7 +247,8 @@ func main() {
 	}
 }
 
-// configureHealthCheckEndpoint sets up a health check endpoint for the API server
+// configureHealthCheckEndpoint sets up a health check endpoint and a metrics
+// endpoint for the API server
 func configureHealthCheckEndpoint() {
 	// set up health check endpoint
 	http.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
@@ -207,5 +256,8 @@ func configureHealthCheckEndpoint() {
 		w.Write([]byte("OK"))
 	})
 
+	// set up metrics endpoint
+	http.Handle("/metrics", promhttp.Handler())
+
 	go http.ListenAndServe(":8081", nil)
 }
@@ -14,6 +14,8 @@ import (
 	secret_notif "github.com/threeport/threeport/internal/secret/notif"
 	terraform_notif "github.com/threeport/threeport/internal/terraform/notif"
 	workload_notif "github.com/threeport/threeport/internal/workload/notif"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 )
 
 // Initialize the NATS Jet stream context with controller streams
@@ -96,5 +98,25 @@ func InitJetStream(nc *nats.Conn) (*nats.JetStreamContext, error) {
 		return nil, fmt.Errorf("could not add stream %s: %w", workload_notif.WorkloadStreamName, err)
 	}
 
+	// add stream for watch events that are sent to API clients
+	_, err = js.AddStream(&nats.StreamConfig{
+		MaxAge:   apiserver_lib.WatchStreamMaxAge,
+		Name:     apiserver_lib.WatchStreamName,
+		Subjects: []string{apiserver_lib.WatchSubjects},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not add stream %s: %w", apiserver_lib.WatchStreamName, err)
+	}
+
+	// add stream for notifications that reconcilers have given up on
+	_, err = js.AddStream(&nats.StreamConfig{
+		MaxAge:   notifications.DeadLetterStreamMaxAge,
+		Name:     notifications.DeadLetterStreamName,
+		Subjects: []string{notifications.DeadLetterSubjects},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not add stream %s: %w", notifications.DeadLetterStreamName, err)
+	}
+
 	return &js, nil
 }
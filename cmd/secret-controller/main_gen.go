@@ -11,17 +11,18 @@ import (
 	uuid "github.com/google/uuid"
 	flag "github.com/namsral/flag"
 	natsgo "github.com/nats-io/nats.go"
+	promhttp "github.com/prometheus/client_golang/prometheus/promhttp"
 	secret "github.com/threeport/threeport/internal/secret"
 	notif "github.com/threeport/threeport/internal/secret/notif"
 	version "github.com/threeport/threeport/internal/version"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	event "github.com/threeport/threeport/pkg/event/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
 	zap "go.uber.org/zap"
 	"net/http"
 	"os"
 	"sync"
-	"time"
 )
 
 func main() {
@@ -29,12 +30,27 @@ func main() {
 	var secretDefinitionConcurrentReconciles = flag.Int(
 		"secret-definition-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for secret definitions",
+		"Number of concurrent reconcilers to start with for secret definitions",
+	)
+	var secretDefinitionMinConcurrentReconciles = flag.Int(
+		"secret-definition-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for secret definitions",
 	)
 	var secretInstanceConcurrentReconciles = flag.Int(
 		"secret-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for secret instances",
+		"Number of concurrent reconcilers to start with for secret instances",
+	)
+	var secretInstanceMinConcurrentReconciles = flag.Int(
+		"secret-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for secret instances",
+	)
+	var secretInstanceResyncInterval = flag.Duration(
+		"secret-instance-resync-interval",
+		0,
+		"Interval at which reconciled secret instances are resynced (0 disables resync)",
 	)
 
 	var apiServer = flag.String("api-server", "threeport-api-server.threeport-control-plane.svc.cluster.local", "Threepoort REST API server endpoint")
@@ -46,6 +62,8 @@ func main() {
 	var verbose = flag.Bool("verbose", false, "Write logs with v(1).InfoLevel and above")
 	var help = flag.Bool("help", false, "Show help info")
 	var authEnabled = flag.Bool("auth-enabled", true, "Enable client certificate authentication (default is true)")
+	var autoscaleInterval = flag.Duration("autoscale-interval", controller.DefaultAutoscaleInterval, "Interval at which the number of concurrent reconcilers is adjusted")
+	var cpuBudget = flag.Float64("cpu-budget", controller.DefaultCPUBudget, "CPU cores the controller may consume before concurrent reconcilers are reduced")
 	flag.Parse()
 
 	var log logr.Logger
@@ -77,6 +95,13 @@ func main() {
 		log = zapr.NewLogger(zapLog).WithValues("controllerID", controllerID)
 	}
 
+	// tracing setup - spans are exported if an OTLP collector is configured
+	shutdownTracing, err := tracing.Init(context.Background(), "threeport-secret-controller")
+	if err != nil {
+		log.Error(err, "failed to initialize tracing")
+		os.Exit(1)
+	}
+
 	// connect to NATS server
 	natsConn := fmt.Sprintf(
 		"nats://%s:%s@%s:%s",
@@ -102,7 +127,7 @@ func main() {
 	kvConfig := natsgo.KeyValueConfig{
 		Bucket:      secret.LockBucketName,
 		Description: secret.LockBucketDescr,
-		TTL:         time.Minute * 20,
+		TTL:         controller.LockBucketTTL,
 	}
 	kv, err := controller.CreateLockBucketIfNotExists(js, &kvConfig)
 	if err != nil {
@@ -122,8 +147,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	// create a channel and wait group used for graceful shut downs
-	var shutdownChans []chan bool
+	// create a wait group used for graceful shut downs
 	var shutdownWait sync.WaitGroup
 
 	// configure http client for calls to threeport API
@@ -136,54 +160,74 @@ func main() {
 	// configure and start reconcilers
 	var reconcilerConfigs []controller.ReconcilerConfig
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *secretDefinitionConcurrentReconciles,
-		Name:                 "SecretDefinitionReconciler",
-		NotifSubject:         notif.SecretDefinitionSubject,
-		ReconcileFunc:        secret.SecretDefinitionReconciler,
+		ConcurrentReconciles:    *secretDefinitionConcurrentReconciles,
+		MaxConcurrentReconciles: 1,
+		MinConcurrentReconciles: *secretDefinitionMinConcurrentReconciles,
+		Name:                    "SecretDefinitionReconciler",
+		NotifSubject:            notif.SecretDefinitionSubject,
+		ReconcileFunc:           secret.SecretDefinitionReconciler,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *secretInstanceConcurrentReconciles,
-		Name:                 "SecretInstanceReconciler",
-		NotifSubject:         notif.SecretInstanceSubject,
-		ReconcileFunc:        secret.SecretInstanceReconciler,
+		ConcurrentReconciles:    *secretInstanceConcurrentReconciles,
+		ListReconciledFunc:      secret.ListReconciledSecretInstances,
+		MaxConcurrentReconciles: 1,
+		MinConcurrentReconciles: *secretInstanceMinConcurrentReconciles,
+		Name:                    "SecretInstanceReconciler",
+		NotifSubject:            notif.SecretInstanceSubject,
+		ReconcileFunc:           secret.SecretInstanceReconciler,
+		ResyncInterval:          *secretInstanceResyncInterval,
+		ResyncSubject:           notif.SecretInstanceUpdateSubject,
 	})
 
+	// scale concurrent reconcilers with the number of pending notifications
+	autoscaler := controller.Autoscaler{
+		CPUBudget: *cpuBudget,
+		Interval:  *autoscaleInterval,
+		Log:       &log,
+	}
+
+	// periodically resync reconciled objects so that drift is detected
+	resyncer := controller.Resyncer{
+		APIClient:        apiClient,
+		APIServer:        *apiServer,
+		JetStreamContext: js,
+		Log:              &log,
+	}
 	for _, r := range reconcilerConfigs {
 
-		// create durable pull subscription
-		sub, err := js.PullSubscribe(r.NotifSubject, "", natsgo.BindStream(notif.SecretStreamName))
-		if err != nil {
-			log.Error(err, "failed to create pull subscription for reconciler notifications", "reconcilerName", r.Name)
-			os.Exit(1)
-		}
+		// start reconciler - each concurrent reconciler has its own subscription
+		if err := autoscaler.AddReconciler(r, func() (*controller.Reconciler, error) {
+			// create pull subscription
+			sub, err := js.PullSubscribe(r.NotifSubject, "", natsgo.BindStream(notif.SecretStreamName))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create pull subscription for reconciler notifications: %w", err)
+			}
 
-		// create exit channel
-		shutdownChan := make(chan bool, 1)
-		shutdownChans = append(shutdownChans, shutdownChan)
-
-		// create reconciler
-		reconciler := controller.Reconciler{
-			APIClient:     apiClient,
-			APIServer:     *apiServer,
-			ControllerID:  controllerID,
-			EncryptionKey: encryptionKey,
-			EventsRecorder: &event.EventRecorder{
-				APIClient:           apiClient,
-				APIServer:           *apiServer,
-				ReportingController: "SecretController",
-			},
-			JetStreamContext: js,
-			KeyValue:         kv,
-			Log:              &log,
-			Name:             r.Name,
-			Shutdown:         shutdownChan,
-			ShutdownWait:     &shutdownWait,
-			Sub:              sub,
+			return &controller.Reconciler{
+				APIClient:     apiClient,
+				APIServer:     *apiServer,
+				ControllerID:  controllerID,
+				EncryptionKey: encryptionKey,
+				EventsRecorder: &event.EventRecorder{
+					APIClient:           apiClient,
+					APIServer:           *apiServer,
+					ReportingController: "SecretController",
+				},
+				JetStreamContext: js,
+				KeyValue:         kv,
+				Log:              &log,
+				Name:             r.Name,
+				RetryPolicy:      r.RetryPolicy,
+				ShutdownWait:     &shutdownWait,
+				Sub:              sub,
+			}, nil
+		}); err != nil {
+			log.Error(err, "failed to start reconciler", "reconcilerName", r.Name)
+			os.Exit(1)
 		}
-
-		// start reconciler
-		go r.ReconcileFunc(&reconciler)
+		resyncer.AddReconciler(r)
 	}
+	go autoscaler.Run()
 
 	log.Info(
 		"secret controller started",
@@ -200,9 +244,8 @@ func main() {
 		Handler: mux,
 	}
 	mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
-		for _, c := range shutdownChans {
-			c <- true
-		}
+		autoscaler.Shutdown()
+		resyncer.Shutdown()
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "shutting down\n")
 		shutdownWait.Add(1)
@@ -217,6 +260,9 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+
+	// set up metrics endpoint
+	http.Handle("/metrics", promhttp.Handler())
 	go http.ListenAndServe(":8081", nil)
 
 	// run shutdown endpoint server
@@ -227,6 +273,11 @@ func main() {
 	// wait for reconcilers to finish
 	shutdownWait.Wait()
 
+	// export any remaining spans
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Error(err, "failed to shut down tracing")
+	}
+
 	log.Info("secret controller shutting down")
 	os.Exit(0)
 }
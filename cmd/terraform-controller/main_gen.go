@@ -11,17 +11,18 @@ import (
 	uuid "github.com/google/uuid"
 	flag "github.com/namsral/flag"
 	natsgo "github.com/nats-io/nats.go"
+	promhttp "github.com/prometheus/client_golang/prometheus/promhttp"
 	terraform "github.com/threeport/threeport/internal/terraform"
 	notif "github.com/threeport/threeport/internal/terraform/notif"
 	version "github.com/threeport/threeport/internal/version"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	event "github.com/threeport/threeport/pkg/event/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
 	zap "go.uber.org/zap"
 	"net/http"
 	"os"
 	"sync"
-	"time"
 )
 
 func main() {
@@ -29,12 +30,42 @@ func main() {
 	var terraformDefinitionConcurrentReconciles = flag.Int(
 		"terraform-definition-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for terraform definitions",
+		"Number of concurrent reconcilers to start with for terraform definitions",
+	)
+	var terraformDefinitionMinConcurrentReconciles = flag.Int(
+		"terraform-definition-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for terraform definitions",
+	)
+	var terraformDefinitionMaxConcurrentReconciles = flag.Int(
+		"terraform-definition-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for terraform definitions",
+	)
+	var terraformDefinitionResyncInterval = flag.Duration(
+		"terraform-definition-resync-interval",
+		0,
+		"Interval at which reconciled terraform definitions are resynced (0 disables resync)",
 	)
 	var terraformInstanceConcurrentReconciles = flag.Int(
 		"terraform-instance-concurrent-reconciles",
 		1,
-		"Number of concurrent reconcilers to run for terraform instances",
+		"Number of concurrent reconcilers to start with for terraform instances",
+	)
+	var terraformInstanceMinConcurrentReconciles = flag.Int(
+		"terraform-instance-min-concurrent-reconciles",
+		controller.DefaultMinConcurrentReconciles,
+		"Minimum number of concurrent reconcilers to run for terraform instances",
+	)
+	var terraformInstanceMaxConcurrentReconciles = flag.Int(
+		"terraform-instance-max-concurrent-reconciles",
+		controller.DefaultMaxConcurrentReconciles,
+		"Maximum number of concurrent reconcilers to run for terraform instances",
+	)
+	var terraformInstanceResyncInterval = flag.Duration(
+		"terraform-instance-resync-interval",
+		0,
+		"Interval at which reconciled terraform instances are resynced (0 disables resync)",
 	)
 
 	var apiServer = flag.String("api-server", "threeport-api-server.threeport-control-plane.svc.cluster.local", "Threepoort REST API server endpoint")
@@ -46,6 +77,9 @@ func main() {
 	var verbose = flag.Bool("verbose", false, "Write logs with v(1).InfoLevel and above")
 	var help = flag.Bool("help", false, "Show help info")
 	var authEnabled = flag.Bool("auth-enabled", true, "Enable client certificate authentication (default is true)")
+	var autoscaleInterval = flag.Duration("autoscale-interval", controller.DefaultAutoscaleInterval, "Interval at which the number of concurrent reconcilers is adjusted")
+	var cpuBudget = flag.Float64("cpu-budget", controller.DefaultCPUBudget, "CPU cores the controller may consume before concurrent reconcilers are reduced")
+	var sharding = flag.Bool("sharding", true, "Shard objects across controller replicas so that each object is reconciled by one replica")
 	flag.Parse()
 
 	var log logr.Logger
@@ -77,6 +111,13 @@ func main() {
 		log = zapr.NewLogger(zapLog).WithValues("controllerID", controllerID)
 	}
 
+	// tracing setup - spans are exported if an OTLP collector is configured
+	shutdownTracing, err := tracing.Init(context.Background(), "threeport-terraform-controller")
+	if err != nil {
+		log.Error(err, "failed to initialize tracing")
+		os.Exit(1)
+	}
+
 	// connect to NATS server
 	natsConn := fmt.Sprintf(
 		"nats://%s:%s@%s:%s",
@@ -102,7 +143,7 @@ func main() {
 	kvConfig := natsgo.KeyValueConfig{
 		Bucket:      terraform.LockBucketName,
 		Description: terraform.LockBucketDescr,
-		TTL:         time.Minute * 20,
+		TTL:         controller.LockBucketTTL,
 	}
 	kv, err := controller.CreateLockBucketIfNotExists(js, &kvConfig)
 	if err != nil {
@@ -122,8 +163,24 @@ func main() {
 		os.Exit(1)
 	}
 
-	// create a channel and wait group used for graceful shut downs
-	var shutdownChans []chan bool
+	// shard objects across controller replicas
+	var sharder *controller.Sharder
+	if *sharding {
+		sharder = &controller.Sharder{
+			ControllerID:     controllerID,
+			JetStreamContext: js,
+			Log:              &log,
+			MemberBucketName: terraform.MemberBucketName,
+			NotifStreamName:  notif.TerraformStreamName,
+			ShardStreamName:  terraform.ShardStreamName,
+		}
+		if err := sharder.Start(); err != nil {
+			log.Error(err, "failed to start sharding objects across controller replicas")
+			os.Exit(1)
+		}
+	}
+
+	// create a wait group used for graceful shut downs
 	var shutdownWait sync.WaitGroup
 
 	// configure http client for calls to threeport API
@@ -136,18 +193,42 @@ func main() {
 	// configure and start reconcilers
 	var reconcilerConfigs []controller.ReconcilerConfig
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *terraformDefinitionConcurrentReconciles,
-		Name:                 "TerraformDefinitionReconciler",
-		NotifSubject:         notif.TerraformDefinitionSubject,
-		ReconcileFunc:        terraform.TerraformDefinitionReconciler,
+		ConcurrentReconciles:    *terraformDefinitionConcurrentReconciles,
+		ListReconciledFunc:      terraform.ListReconciledTerraformDefinitions,
+		MaxConcurrentReconciles: *terraformDefinitionMaxConcurrentReconciles,
+		MinConcurrentReconciles: *terraformDefinitionMinConcurrentReconciles,
+		Name:                    "TerraformDefinitionReconciler",
+		NotifSubject:            notif.TerraformDefinitionSubject,
+		ReconcileFunc:           terraform.TerraformDefinitionReconciler,
+		ResyncInterval:          *terraformDefinitionResyncInterval,
+		ResyncSubject:           notif.TerraformDefinitionUpdateSubject,
 	})
 	reconcilerConfigs = append(reconcilerConfigs, controller.ReconcilerConfig{
-		ConcurrentReconciles: *terraformInstanceConcurrentReconciles,
-		Name:                 "TerraformInstanceReconciler",
-		NotifSubject:         notif.TerraformInstanceSubject,
-		ReconcileFunc:        terraform.TerraformInstanceReconciler,
+		ConcurrentReconciles:    *terraformInstanceConcurrentReconciles,
+		ListReconciledFunc:      terraform.ListReconciledTerraformInstances,
+		MaxConcurrentReconciles: *terraformInstanceMaxConcurrentReconciles,
+		MinConcurrentReconciles: *terraformInstanceMinConcurrentReconciles,
+		Name:                    "TerraformInstanceReconciler",
+		NotifSubject:            notif.TerraformInstanceSubject,
+		ReconcileFunc:           terraform.TerraformInstanceReconciler,
+		ResyncInterval:          *terraformInstanceResyncInterval,
+		ResyncSubject:           notif.TerraformInstanceUpdateSubject,
 	})
 
+	// scale concurrent reconcilers with the number of pending notifications
+	autoscaler := controller.Autoscaler{
+		CPUBudget: *cpuBudget,
+		Interval:  *autoscaleInterval,
+		Log:       &log,
+	}
+
+	// periodically resync reconciled objects so that drift is detected
+	resyncer := controller.Resyncer{
+		APIClient:        apiClient,
+		APIServer:        *apiServer,
+		JetStreamContext: js,
+		Log:              &log,
+	}
 	for _, r := range reconcilerConfigs {
 
 		// create JetStream consumer
@@ -158,40 +239,54 @@ func main() {
 			FilterSubject: r.NotifSubject,
 		})
 
-		// create durable pull subscription
-		sub, err := js.PullSubscribe(r.NotifSubject, consumer, natsgo.BindStream(notif.TerraformStreamName))
-		if err != nil {
-			log.Error(err, "failed to create pull subscription for reconciler notifications", "reconcilerName", r.Name)
-			os.Exit(1)
+		// route notifications to the replicas that own the objects
+		if sharder != nil {
+			if err := sharder.AddReconciler(r, consumer); err != nil {
+				log.Error(err, "failed to start routing notifications", "reconcilerName", r.Name)
+				os.Exit(1)
+			}
 		}
 
-		// create exit channel
-		shutdownChan := make(chan bool, 1)
-		shutdownChans = append(shutdownChans, shutdownChan)
-
-		// create reconciler
-		reconciler := controller.Reconciler{
-			APIClient:     apiClient,
-			APIServer:     *apiServer,
-			ControllerID:  controllerID,
-			EncryptionKey: encryptionKey,
-			EventsRecorder: &event.EventRecorder{
-				APIClient:           apiClient,
-				APIServer:           *apiServer,
-				ReportingController: "TerraformController",
-			},
-			JetStreamContext: js,
-			KeyValue:         kv,
-			Log:              &log,
-			Name:             r.Name,
-			Shutdown:         shutdownChan,
-			ShutdownWait:     &shutdownWait,
-			Sub:              sub,
-		}
+		// start reconciler - each concurrent reconciler has its own subscription
+		if err := autoscaler.AddReconciler(r, func() (*controller.Reconciler, error) {
+			// create pull subscription
+			var sub *natsgo.Subscription
+			var err error
+			if sharder != nil {
+				sub, err = sharder.Subscribe(r)
+			} else {
+				sub, err = js.PullSubscribe(r.NotifSubject, consumer, natsgo.BindStream(notif.TerraformStreamName))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to create pull subscription for reconciler notifications: %w", err)
+			}
 
-		// start reconciler
-		go r.ReconcileFunc(&reconciler)
+			return &controller.Reconciler{
+				APIClient:     apiClient,
+				APIServer:     *apiServer,
+				ControllerID:  controllerID,
+				EncryptionKey: encryptionKey,
+				EventsRecorder: &event.EventRecorder{
+					APIClient:           apiClient,
+					APIServer:           *apiServer,
+					ReportingController: "TerraformController",
+				},
+				JetStreamContext: js,
+				KeyValue:         kv,
+				Log:              &log,
+				Name:             r.Name,
+				RetryPolicy:      r.RetryPolicy,
+				Sharder:          sharder,
+				ShutdownWait:     &shutdownWait,
+				Sub:              sub,
+			}, nil
+		}); err != nil {
+			log.Error(err, "failed to start reconciler", "reconcilerName", r.Name)
+			os.Exit(1)
+		}
+		resyncer.AddReconciler(r)
 	}
+	go autoscaler.Run()
 
 	log.Info(
 		"terraform controller started",
@@ -208,9 +303,9 @@ func main() {
 		Handler: mux,
 	}
 	mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
-		for _, c := range shutdownChans {
-			c <- true
-		}
+		autoscaler.Shutdown()
+		resyncer.Shutdown()
+		sharder.Shutdown()
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "shutting down\n")
 		shutdownWait.Add(1)
@@ -225,6 +320,9 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+
+	// set up metrics endpoint
+	http.Handle("/metrics", promhttp.Handler())
 	go http.ListenAndServe(":8081", nil)
 
 	// run shutdown endpoint server
@@ -235,6 +333,11 @@ func main() {
 	// wait for reconcilers to finish
 	shutdownWait.Wait()
 
+	// export any remaining spans
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Error(err, "failed to shut down tracing")
+	}
+
 	log.Info("terraform controller shutting down")
 	os.Exit(0)
 }
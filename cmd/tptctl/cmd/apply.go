@@ -0,0 +1,191 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	config_v0 "github.com/threeport/threeport/pkg/config/v0"
+)
+
+var (
+	applyFilenames []string
+	applySetName   string
+	applyPrune     bool
+	applyDryRun    bool
+	applyForce     bool
+)
+
+// characters that are not permitted in an apply set name
+var applySetInvalidChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// the past tense of each apply operation used in command output
+var applyOperationResults = map[config_v0.ApplyOperation]string{
+	config_v0.ApplyOperationCreate:    "created",
+	config_v0.ApplyOperationUpdate:    "updated",
+	config_v0.ApplyOperationReplace:   "replaced",
+	config_v0.ApplyOperationUnchanged: "unchanged",
+	config_v0.ApplyOperationPrune:     "pruned",
+}
+
+// ApplyCmd represents the apply command
+var ApplyCmd = &cobra.Command{
+	Example: `  tptctl apply -f path/to/configs/
+  tptctl apply -f workload.yaml -f gateway.yaml --apply-set web-app --prune
+  tptctl apply -f path/to/configs/ --dry-run
+  tptctl apply -f path/to/configs/ --force-replace`,
+	Long: `Apply config documents of any kind to the system.
+
+Config files may contain multiple YAML documents separated by '---' and
+directories are read for all .yaml and .yml files.  Each document is a config
+of the kind given by its top-level key, e.g. Workload, GatewayInstance or
+Secret.  Objects are created in dependency order so that the objects they
+refer to are created first.
+
+Objects created by apply are labeled with the apply set they belong to.  Objects
+in the apply set that were previously applied from a document that has changed
+are updated.  Sensitive values such as secret data, AWS access keys and inline
+helm values are not stored with the objects so documents that set them are
+updated each time they are applied.  Changes that cannot be made in place
+require the objects to be deleted and created again, which is only done with
+--force-replace.  With --prune, objects in the apply set that were applied from
+a document in one of the provided files or directories that is no longer
+present are deleted.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// the apply set defaults to the name of the first file or directory
+		if applySetName == "" {
+			base := filepath.Base(filepath.Clean(applyFilenames[0]))
+			base = strings.TrimSuffix(base, filepath.Ext(base))
+			applySetName = strings.Trim(applySetInvalidChars.ReplaceAllString(base, "-"), "-_.")
+		}
+		if _, err := apiserver_lib.ParseLabelSelector(
+			fmt.Sprintf("%s=%s", config_v0.ApplySetLabel, applySetName),
+		); err != nil || applySetName == "" {
+			cli.Error("invalid apply set name", fmt.Errorf("%q must consist of alphanumeric characters, '-', '_' or '.'", applySetName))
+			os.Exit(1)
+		}
+
+		// read config documents
+		var documents []config_v0.ApplyDocument
+		for _, filename := range applyFilenames {
+			fileDocuments, err := config_v0.ReadApplyDocuments(filename)
+			if err != nil {
+				cli.Error("failed to read config documents", err)
+				os.Exit(1)
+			}
+			documents = append(documents, fileDocuments...)
+		}
+
+		// determine the operations needed to apply the documents
+		applySet := config_v0.ApplySet{
+			Name:         applySetName,
+			APIClient:    apiClient,
+			APIEndpoint:  apiEndpoint,
+			Sources:      applyFilenames,
+			ForceReplace: applyForce,
+		}
+		actions, err := applySet.Plan(documents, applyPrune)
+		if err != nil {
+			cli.Error("failed to determine changes to apply", err)
+			os.Exit(1)
+		}
+
+		// objects are only deleted and created again when explicitly permitted
+		if !applyDryRun && !applyForce {
+			var replaced []string
+			for _, action := range actions {
+				if action.Operation == config_v0.ApplyOperationReplace {
+					replaced = append(replaced, fmt.Sprintf("%s %s", action.Document.Kind, action.Document.Name))
+				}
+			}
+			if len(replaced) > 0 {
+				cli.Error(
+					"changes require objects to be deleted and created again - use --force-replace to apply them",
+					errors.New(strings.Join(replaced, ", ")),
+				)
+				os.Exit(1)
+			}
+		}
+
+		// apply each document in order
+		results := make(map[config_v0.ApplyOperation]int)
+		for _, action := range actions {
+			if !applyDryRun {
+				if err := applySet.Apply(action); err != nil {
+					cli.Error(fmt.Sprintf("failed to apply %s %s", action.Document.Kind, action.Document.Name), err)
+					if errors.Is(err, config_v0.ErrReplaceRequired) {
+						cli.Info("use --force-replace to delete and create the objects again")
+					}
+					os.Exit(1)
+				}
+			}
+			results[action.Operation]++
+			cli.Info(fmt.Sprintf(
+				"%s %s %s",
+				action.Document.Kind,
+				action.Document.Name,
+				applyOperationResults[action.Operation],
+			))
+		}
+
+		summary := fmt.Sprintf(
+			"apply set %s: %d created, %d updated, %d replaced, %d unchanged, %d pruned",
+			applySetName,
+			results[config_v0.ApplyOperationCreate],
+			results[config_v0.ApplyOperationUpdate],
+			results[config_v0.ApplyOperationReplace],
+			results[config_v0.ApplyOperationUnchanged],
+			results[config_v0.ApplyOperationPrune],
+		)
+		if applyDryRun {
+			summary += " (dry run)"
+		}
+		cli.Complete(summary)
+	},
+	Short:        "Apply config documents to the system",
+	SilenceUsage: true,
+	Use:          "apply",
+}
+
+func init() {
+	rootCmd.AddCommand(ApplyCmd)
+
+	ApplyCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	ApplyCmd.Flags().StringSliceVarP(
+		&applyFilenames,
+		"filename", "f", []string{}, "Required. Config file or directory of config files to apply.  May be repeated.",
+	)
+	ApplyCmd.Flags().StringVar(
+		&applySetName,
+		"apply-set", "", "Optional. Name of the apply set the objects belong to.  Defaults to the name of the first file or directory.",
+	)
+	ApplyCmd.Flags().BoolVar(
+		&applyPrune,
+		"prune", false, "Delete objects in the apply set that were applied from documents in the provided files or directories that are no longer present.",
+	)
+	ApplyCmd.Flags().BoolVar(
+		&applyDryRun,
+		"dry-run", false, "Show the changes that would be applied without making them.",
+	)
+	ApplyCmd.Flags().BoolVar(
+		&applyForce,
+		"force-replace", false, "Delete and create again objects with changes that cannot be made in place.",
+	)
+	ApplyCmd.MarkFlagRequired("filename")
+}
@@ -0,0 +1,154 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_v0 "github.com/threeport/threeport/pkg/client/v0"
+)
+
+var (
+	getAuditRecordsStart      string
+	getAuditRecordsEnd        string
+	getAuditRecordsSince      time.Duration
+	getAuditRecordsSubject    string
+	getAuditRecordsObjectType string
+	getAuditRecordsObjectID   uint
+	getAuditRecordsMethod     string
+	getAuditRecordsSelector   string
+)
+
+// GetAuditRecordsCmd represents the audit-records command
+var GetAuditRecordsCmd = &cobra.Command{
+	Example: `  tptctl get audit-records --since 24h
+  tptctl get audit-records --object-type WorkloadInstance --object-id 5
+  tptctl get audit-records --start 2024-01-01T00:00:00Z --end 2024-01-02T00:00:00Z`,
+	Long:   "Get audit records for requests that created, updated or deleted objects.",
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		queryString, err := auditRecordsQueryString()
+		if err != nil {
+			cli.Error("invalid audit record filters", err)
+			os.Exit(1)
+		}
+
+		// get audit records
+		auditRecords, err := client_v0.GetAuditRecordsSearch(apiClient, apiEndpoint, queryString)
+		if err != nil {
+			cli.Error("failed to retrieve audit records", err)
+			os.Exit(1)
+		}
+
+		// write the output
+		if len(*auditRecords) == 0 {
+			cli.Info(fmt.Sprintf(
+				"No audit records matching filters found on %s threeport control plane",
+				requestedControlPlane,
+			))
+			os.Exit(0)
+		}
+		if err := outputGetAuditRecordsCmd(auditRecords); err != nil {
+			cli.Error("failed to produce output", err)
+			os.Exit(0)
+		}
+	},
+	Short:        "Get audit records from the system",
+	SilenceUsage: true,
+	Use:          "audit-records",
+}
+
+func init() {
+	GetCmd.AddCommand(GetAuditRecordsCmd)
+
+	GetAuditRecordsCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	GetAuditRecordsCmd.Flags().StringVar(
+		&getAuditRecordsStart,
+		"start", "", "Optional. Only get records from this time onward as an RFC3339 timestamp, e.g. 2024-01-01T00:00:00Z.",
+	)
+	GetAuditRecordsCmd.Flags().StringVar(
+		&getAuditRecordsEnd,
+		"end", "", "Optional. Only get records up to this time as an RFC3339 timestamp.",
+	)
+	GetAuditRecordsCmd.Flags().DurationVar(
+		&getAuditRecordsSince,
+		"since", 0, "Optional. Only get records from this long ago onward, e.g. 24h.  Cannot be used with --start.",
+	)
+	GetAuditRecordsCmd.Flags().StringVar(
+		&getAuditRecordsSubject,
+		"subject", "", "Optional. Only get records for requests made by this client certificate subject.",
+	)
+	GetAuditRecordsCmd.Flags().StringVar(
+		&getAuditRecordsObjectType,
+		"object-type", "", "Optional. Only get records for this object type, e.g. WorkloadInstance.",
+	)
+	GetAuditRecordsCmd.Flags().UintVar(
+		&getAuditRecordsObjectID,
+		"object-id", 0, "Optional. Only get records for the object with this ID.",
+	)
+	GetAuditRecordsCmd.Flags().StringVar(
+		&getAuditRecordsMethod,
+		"method", "", "Optional. Only get records for this HTTP method. One of: [POST, PATCH, PUT, DELETE]",
+	)
+	GetAuditRecordsCmd.Flags().StringVarP(
+		&getAuditRecordsSelector,
+		"selector", "l", "", "Optional. Label selector to filter audit records by, e.g. team=payments.",
+	)
+	GetAuditRecordsCmd.MarkFlagsMutuallyExclusive("start", "since")
+}
+
+// auditRecordsQueryString returns the query string for the audit record
+// search based on the flags provided.
+func auditRecordsQueryString() (string, error) {
+	query := url.Values{}
+
+	start := getAuditRecordsStart
+	if getAuditRecordsSince != 0 {
+		start = time.Now().Add(-getAuditRecordsSince).UTC().Format(time.RFC3339)
+	}
+	for param, value := range map[string]string{
+		v0.AuditRecordQueryParamStart: start,
+		v0.AuditRecordQueryParamEnd:   getAuditRecordsEnd,
+	} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return "", fmt.Errorf("%s time %s is not a valid RFC3339 timestamp: %w", param, value, err)
+		}
+		query.Set(param, value)
+	}
+
+	if getAuditRecordsSubject != "" {
+		query.Set("subject", getAuditRecordsSubject)
+	}
+	if getAuditRecordsObjectType != "" {
+		query.Set("objecttype", getAuditRecordsObjectType)
+	}
+	if getAuditRecordsObjectID != 0 {
+		query.Set("objectid", strconv.FormatUint(uint64(getAuditRecordsObjectID), 10))
+	}
+	if getAuditRecordsMethod != "" {
+		query.Set("method", getAuditRecordsMethod)
+	}
+	if getAuditRecordsSelector != "" {
+		query.Set(apiserver_lib.QueryParamLabelSelector, getAuditRecordsSelector)
+	}
+
+	return query.Encode(), nil
+}
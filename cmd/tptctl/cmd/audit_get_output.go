@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// outputGetAuditRecordsCmd produces the tabular output for the
+// 'tptctl get audit-records' command.
+func outputGetAuditRecordsCmd(auditRecords *[]v0.AuditRecord) error {
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, "TIME\t SUBJECT\t METHOD\t ROUTE\t OBJECT TYPE\t OBJECT ID\t RESPONSE CODE")
+	for _, auditRecord := range *auditRecords {
+		recordTime := ""
+		if auditRecord.CreatedAt != nil {
+			recordTime = auditRecord.CreatedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintln(
+			writer,
+			recordTime, "\t",
+			stringOrDash(auditRecord.Subject), "\t",
+			stringOrDash(auditRecord.Method), "\t",
+			stringOrDash(auditRecord.Route), "\t",
+			stringOrDash(auditRecord.ObjectType), "\t",
+			uintOrDash(auditRecord.ObjectID), "\t",
+			intOrDash(auditRecord.ResponseCode),
+		)
+	}
+	writer.Flush()
+
+	return nil
+}
+
+// stringOrDash returns the value of a string pointer or a dash if nil or
+// empty.
+func stringOrDash(value *string) string {
+	if value == nil || *value == "" {
+		return "-"
+	}
+	return *value
+}
+
+// uintOrDash returns the value of a uint pointer or a dash if nil.
+func uintOrDash(value *uint) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *value)
+}
+
+// intOrDash returns the value of an int pointer or a dash if nil.
+func intOrDash(value *int) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *value)
+}
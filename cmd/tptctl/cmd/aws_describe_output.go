@@ -148,6 +148,8 @@ func outputDescribev0AwsEksKubernetesRuntimeInstanceCmd(
 		*awsEksKubernetesRuntimeStatus.KubernetesRuntimeInstance.Name,
 	)
 
+	outputDescribeConditions(awsEksKubernetesRuntimeInstance.GetConditions())
+
 	return nil
 }
 
@@ -198,6 +200,8 @@ func outputDescribev0AwsRelationalDatabaseInstanceCmd(
 		*awsRelationalDatabaseInstance.UpdatedAt,
 	)
 
+	outputDescribeConditions(awsRelationalDatabaseInstance.GetConditions())
+
 	return nil
 }
 
@@ -265,5 +269,7 @@ func outputDescribev0AwsObjectStorageBucketInstanceCmd(
 		*awsObjectStorageBucketInstance.UpdatedAt,
 	)
 
+	outputDescribeConditions(awsObjectStorageBucketInstance.GetConditions())
+
 	return nil
 }
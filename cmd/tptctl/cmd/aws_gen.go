@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // AwsAccount
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsAccountVersion string
+var (
+	getAwsAccountVersion       string
+	getAwsAccountLabelSelector string
+	getAwsAccountOutput        string
+)
 
 // GetAwsAccountsCmd represents the aws-account command
 var GetAwsAccountsCmd = &cobra.Command{
-	Example: "  tptctl get aws-accounts",
+	Example: "  # Get all aws accounts\n  tptctl get aws-accounts\n\n  # Get JSON output for all aws accounts\n  tptctl get aws-accounts -o json\n\n  # Get the ID and name of each aws account\n  tptctl get aws-accounts -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws accounts from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsAccountOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsAccountVersion {
 		case "v0":
 			// get aws accounts
-			awsAccounts, err := client_v0.GetAwsAccounts(apiClient, apiEndpoint)
+			awsAccounts, err := client_v0.GetAwsAccountsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsAccountLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws accounts", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsAccountOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsAccountOutput,
+					"aws-account",
+					awsAccounts,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsAccounts) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws accounts currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getAwsAccountVersion,
 		"version", "v", "v0", "Version of aws accounts object to retrieve. One of: [v0]",
 	)
+	GetAwsAccountsCmd.Flags().StringVarP(
+		&getAwsAccountLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws accounts by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsAccountsCmd.Flags().StringVarP(
+		&getAwsAccountOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -145,6 +178,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsAccountConfigPath string
+	updateAwsAccountVersion    string
+)
+
+// UpdateAwsAccountCmd represents the aws-account command
+var UpdateAwsAccountCmd = &cobra.Command{
+	Example: "  tptctl update aws-account --config path/to/config.yaml",
+	Long:    "Update an existing aws account in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws account config
+		configContent, err := os.ReadFile(updateAwsAccountConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws account based on version
+		switch updateAwsAccountVersion {
+		case "v0":
+			var awsAccountConfig config_v0.AwsAccountConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsAccountConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws account
+			awsAccount := awsAccountConfig.AwsAccount
+			updatedAwsAccount, err := awsAccount.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws account", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws account %s updated", *updatedAwsAccount.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws account",
+	SilenceUsage: true,
+	Use:          "aws-account",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsAccountCmd)
+
+	UpdateAwsAccountCmd.Flags().StringVarP(
+		&updateAwsAccountConfigPath,
+		"config", "c", "", "Path to file with aws account config.",
+	)
+	UpdateAwsAccountCmd.MarkFlagRequired("config")
+	UpdateAwsAccountCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsAccountCmd.Flags().StringVarP(
+		&updateAwsAccountVersion,
+		"version", "v", "v0", "Version of aws accounts object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsAccountConfigPath string
 	deleteAwsAccountName       string
@@ -433,26 +531,50 @@ func init() {
 // AwsEksKubernetesRuntimeDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsEksKubernetesRuntimeDefinitionVersion string
+var (
+	getAwsEksKubernetesRuntimeDefinitionVersion       string
+	getAwsEksKubernetesRuntimeDefinitionLabelSelector string
+	getAwsEksKubernetesRuntimeDefinitionOutput        string
+)
 
 // GetAwsEksKubernetesRuntimeDefinitionsCmd represents the aws-eks-kubernetes-runtime-definition command
 var GetAwsEksKubernetesRuntimeDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get aws-eks-kubernetes-runtime-definitions",
+	Example: "  # Get all aws eks kubernetes runtime definitions\n  tptctl get aws-eks-kubernetes-runtime-definitions\n\n  # Get JSON output for all aws eks kubernetes runtime definitions\n  tptctl get aws-eks-kubernetes-runtime-definitions -o json\n\n  # Get the ID and name of each aws eks kubernetes runtime definition\n  tptctl get aws-eks-kubernetes-runtime-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws eks kubernetes runtime definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsEksKubernetesRuntimeDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsEksKubernetesRuntimeDefinitionVersion {
 		case "v0":
 			// get aws eks kubernetes runtime definitions
-			awsEksKubernetesRuntimeDefinitions, err := client_v0.GetAwsEksKubernetesRuntimeDefinitions(apiClient, apiEndpoint)
+			awsEksKubernetesRuntimeDefinitions, err := client_v0.GetAwsEksKubernetesRuntimeDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsEksKubernetesRuntimeDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws eks kubernetes runtime definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsEksKubernetesRuntimeDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsEksKubernetesRuntimeDefinitionOutput,
+					"aws-eks-kubernetes-runtime-definition",
+					awsEksKubernetesRuntimeDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsEksKubernetesRuntimeDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws eks kubernetes runtime definitions currently managed by %s threeport control plane",
@@ -489,6 +611,14 @@ func init() {
 		&getAwsEksKubernetesRuntimeDefinitionVersion,
 		"version", "v", "v0", "Version of aws eks kubernetes runtime definitions object to retrieve. One of: [v0]",
 	)
+	GetAwsEksKubernetesRuntimeDefinitionsCmd.Flags().StringVarP(
+		&getAwsEksKubernetesRuntimeDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws eks kubernetes runtime definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsEksKubernetesRuntimeDefinitionsCmd.Flags().StringVarP(
+		&getAwsEksKubernetesRuntimeDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -556,6 +686,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsEksKubernetesRuntimeDefinitionConfigPath string
+	updateAwsEksKubernetesRuntimeDefinitionVersion    string
+)
+
+// UpdateAwsEksKubernetesRuntimeDefinitionCmd represents the aws-eks-kubernetes-runtime-definition command
+var UpdateAwsEksKubernetesRuntimeDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update aws-eks-kubernetes-runtime-definition --config path/to/config.yaml",
+	Long:    "Update an existing aws eks kubernetes runtime definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws eks kubernetes runtime definition config
+		configContent, err := os.ReadFile(updateAwsEksKubernetesRuntimeDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws eks kubernetes runtime definition based on version
+		switch updateAwsEksKubernetesRuntimeDefinitionVersion {
+		case "v0":
+			var awsEksKubernetesRuntimeDefinitionConfig config_v0.AwsEksKubernetesRuntimeDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsEksKubernetesRuntimeDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws eks kubernetes runtime definition
+			awsEksKubernetesRuntimeDefinition := awsEksKubernetesRuntimeDefinitionConfig.AwsEksKubernetesRuntimeDefinition
+			updatedAwsEksKubernetesRuntimeDefinition, err := awsEksKubernetesRuntimeDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws eks kubernetes runtime definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws eks kubernetes runtime definition %s updated", *updatedAwsEksKubernetesRuntimeDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws eks kubernetes runtime definition",
+	SilenceUsage: true,
+	Use:          "aws-eks-kubernetes-runtime-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsEksKubernetesRuntimeDefinitionCmd)
+
+	UpdateAwsEksKubernetesRuntimeDefinitionCmd.Flags().StringVarP(
+		&updateAwsEksKubernetesRuntimeDefinitionConfigPath,
+		"config", "c", "", "Path to file with aws eks kubernetes runtime definition config.",
+	)
+	UpdateAwsEksKubernetesRuntimeDefinitionCmd.MarkFlagRequired("config")
+	UpdateAwsEksKubernetesRuntimeDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsEksKubernetesRuntimeDefinitionCmd.Flags().StringVarP(
+		&updateAwsEksKubernetesRuntimeDefinitionVersion,
+		"version", "v", "v0", "Version of aws eks kubernetes runtime definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsEksKubernetesRuntimeDefinitionConfigPath string
 	deleteAwsEksKubernetesRuntimeDefinitionName       string
@@ -844,22 +1039,47 @@ func init() {
 // AwsEksKubernetesRuntime
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getAwsEksKubernetesRuntimeLabelSelector string
+	getAwsEksKubernetesRuntimeOutput        string
+)
+
 // GetAwsEksKubernetesRuntimesCmd represents the aws-eks-kubernetes-runtime command
 var GetAwsEksKubernetesRuntimesCmd = &cobra.Command{
-	Example: "  tptctl get aws-eks-kubernetes-runtimes",
+	Example: "  # Get all aws eks kubernetes runtimes\n  tptctl get aws-eks-kubernetes-runtimes\n\n  # Get JSON output for all aws eks kubernetes runtimes\n  tptctl get aws-eks-kubernetes-runtimes -o json\n\n  # Get the name of each aws eks kubernetes runtime instance\n  tptctl get aws-eks-kubernetes-runtimes -o jsonpath='{.items[*].Name}'",
 	Long:    "Get aws eks kubernetes runtimes from the system.\n\nA aws eks kubernetes runtime is a simple abstraction of aws eks kubernetes runtime definitions and aws eks kubernetes runtime instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsEksKubernetesRuntimeOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get aws eks kubernetes runtimes
-		v0awsEksKubernetesRuntimeInstances, err := client_v0.GetAwsEksKubernetesRuntimeInstances(apiClient, apiEndpoint)
+		v0awsEksKubernetesRuntimeInstances, err := client_v0.GetAwsEksKubernetesRuntimeInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getAwsEksKubernetesRuntimeLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve aws eks kubernetes runtime instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getAwsEksKubernetesRuntimeOutput != "" {
+			if err := cli.OutputGetObjects(
+				getAwsEksKubernetesRuntimeOutput,
+				"aws-eks-kubernetes-runtime-instance",
+				v0awsEksKubernetesRuntimeInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0awsEksKubernetesRuntimeInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No aws eks kubernetes runtime instances currently managed by %s threeport control plane",
@@ -888,6 +1108,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetAwsEksKubernetesRuntimesCmd.Flags().StringVarP(
+		&getAwsEksKubernetesRuntimeLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws eks kubernetes runtime instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsEksKubernetesRuntimesCmd.Flags().StringVarP(
+		&getAwsEksKubernetesRuntimeOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -961,6 +1189,77 @@ func init() {
 	)
 }
 
+var (
+	updateAwsEksKubernetesRuntimeConfigPath string
+	updateAwsEksKubernetesRuntimeVersion    string
+)
+
+// UpdateAwsEksKubernetesRuntimeCmd represents the aws-eks-kubernetes-runtime command
+var UpdateAwsEksKubernetesRuntimeCmd = &cobra.Command{
+	Example: "  tptctl update aws-eks-kubernetes-runtime --config path/to/config.yaml",
+	Long:    "Update an existing aws eks kubernetes runtime. This command updates the aws eks kubernetes runtime definition and aws eks kubernetes runtime instance in place based on the aws eks kubernetes runtime config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws eks kubernetes runtime config
+		configContent, err := os.ReadFile(updateAwsEksKubernetesRuntimeConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update aws eks kubernetes runtime based on version
+		switch updateAwsEksKubernetesRuntimeVersion {
+		case "v0":
+			var awsEksKubernetesRuntimeConfig config_v0.AwsEksKubernetesRuntimeConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsEksKubernetesRuntimeConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws eks kubernetes runtime
+			awsEksKubernetesRuntime := awsEksKubernetesRuntimeConfig.AwsEksKubernetesRuntime
+			updatedAwsEksKubernetesRuntimeDefinition, updatedAwsEksKubernetesRuntimeInstance, err := awsEksKubernetesRuntime.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update aws eks kubernetes runtime", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("aws eks kubernetes runtime definition %s updated", *updatedAwsEksKubernetesRuntimeDefinition.Name))
+			cli.Info(fmt.Sprintf("aws eks kubernetes runtime instance %s updated", *updatedAwsEksKubernetesRuntimeInstance.Name))
+			cli.Complete(fmt.Sprintf("aws eks kubernetes runtime %s updated", *awsEksKubernetesRuntimeConfig.AwsEksKubernetesRuntime.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws eks kubernetes runtime",
+	SilenceUsage: true,
+	Use:          "aws-eks-kubernetes-runtime",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsEksKubernetesRuntimeCmd)
+
+	UpdateAwsEksKubernetesRuntimeCmd.Flags().StringVarP(
+		&updateAwsEksKubernetesRuntimeConfigPath,
+		"config", "c", "", "Path to file with aws eks kubernetes runtime config.",
+	)
+	UpdateAwsEksKubernetesRuntimeCmd.MarkFlagRequired("config")
+	UpdateAwsEksKubernetesRuntimeCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsEksKubernetesRuntimeCmd.Flags().StringVarP(
+		&updateAwsEksKubernetesRuntimeVersion,
+		"version", "v", "v0", "Version of aws eks kubernetes runtimes object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsEksKubernetesRuntimeConfigPath string
 	deleteAwsEksKubernetesRuntimeName       string
@@ -1038,26 +1337,50 @@ func init() {
 // AwsEksKubernetesRuntimeInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsEksKubernetesRuntimeInstanceVersion string
+var (
+	getAwsEksKubernetesRuntimeInstanceVersion       string
+	getAwsEksKubernetesRuntimeInstanceLabelSelector string
+	getAwsEksKubernetesRuntimeInstanceOutput        string
+)
 
 // GetAwsEksKubernetesRuntimeInstancesCmd represents the aws-eks-kubernetes-runtime-instance command
 var GetAwsEksKubernetesRuntimeInstancesCmd = &cobra.Command{
-	Example: "  tptctl get aws-eks-kubernetes-runtime-instances",
+	Example: "  # Get all aws eks kubernetes runtime instances\n  tptctl get aws-eks-kubernetes-runtime-instances\n\n  # Get JSON output for all aws eks kubernetes runtime instances\n  tptctl get aws-eks-kubernetes-runtime-instances -o json\n\n  # Get the ID and name of each aws eks kubernetes runtime instance\n  tptctl get aws-eks-kubernetes-runtime-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws eks kubernetes runtime instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsEksKubernetesRuntimeInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsEksKubernetesRuntimeInstanceVersion {
 		case "v0":
 			// get aws eks kubernetes runtime instances
-			awsEksKubernetesRuntimeInstances, err := client_v0.GetAwsEksKubernetesRuntimeInstances(apiClient, apiEndpoint)
+			awsEksKubernetesRuntimeInstances, err := client_v0.GetAwsEksKubernetesRuntimeInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsEksKubernetesRuntimeInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws eks kubernetes runtime instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsEksKubernetesRuntimeInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsEksKubernetesRuntimeInstanceOutput,
+					"aws-eks-kubernetes-runtime-instance",
+					awsEksKubernetesRuntimeInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsEksKubernetesRuntimeInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws eks kubernetes runtime instances currently managed by %s threeport control plane",
@@ -1094,6 +1417,14 @@ func init() {
 		&getAwsEksKubernetesRuntimeInstanceVersion,
 		"version", "v", "v0", "Version of aws eks kubernetes runtime instances object to retrieve. One of: [v0]",
 	)
+	GetAwsEksKubernetesRuntimeInstancesCmd.Flags().StringVarP(
+		&getAwsEksKubernetesRuntimeInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws eks kubernetes runtime instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsEksKubernetesRuntimeInstancesCmd.Flags().StringVarP(
+		&getAwsEksKubernetesRuntimeInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -1161,6 +1492,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsEksKubernetesRuntimeInstanceConfigPath string
+	updateAwsEksKubernetesRuntimeInstanceVersion    string
+)
+
+// UpdateAwsEksKubernetesRuntimeInstanceCmd represents the aws-eks-kubernetes-runtime-instance command
+var UpdateAwsEksKubernetesRuntimeInstanceCmd = &cobra.Command{
+	Example: "  tptctl update aws-eks-kubernetes-runtime-instance --config path/to/config.yaml",
+	Long:    "Update an existing aws eks kubernetes runtime instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws eks kubernetes runtime instance config
+		configContent, err := os.ReadFile(updateAwsEksKubernetesRuntimeInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws eks kubernetes runtime instance based on version
+		switch updateAwsEksKubernetesRuntimeInstanceVersion {
+		case "v0":
+			var awsEksKubernetesRuntimeInstanceConfig config_v0.AwsEksKubernetesRuntimeInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsEksKubernetesRuntimeInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws eks kubernetes runtime instance
+			awsEksKubernetesRuntimeInstance := awsEksKubernetesRuntimeInstanceConfig.AwsEksKubernetesRuntimeInstance
+			updatedAwsEksKubernetesRuntimeInstance, err := awsEksKubernetesRuntimeInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws eks kubernetes runtime instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws eks kubernetes runtime instance %s updated", *updatedAwsEksKubernetesRuntimeInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws eks kubernetes runtime instance",
+	SilenceUsage: true,
+	Use:          "aws-eks-kubernetes-runtime-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsEksKubernetesRuntimeInstanceCmd)
+
+	UpdateAwsEksKubernetesRuntimeInstanceCmd.Flags().StringVarP(
+		&updateAwsEksKubernetesRuntimeInstanceConfigPath,
+		"config", "c", "", "Path to file with aws eks kubernetes runtime instance config.",
+	)
+	UpdateAwsEksKubernetesRuntimeInstanceCmd.MarkFlagRequired("config")
+	UpdateAwsEksKubernetesRuntimeInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsEksKubernetesRuntimeInstanceCmd.Flags().StringVarP(
+		&updateAwsEksKubernetesRuntimeInstanceVersion,
+		"version", "v", "v0", "Version of aws eks kubernetes runtime instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsEksKubernetesRuntimeInstanceConfigPath string
 	deleteAwsEksKubernetesRuntimeInstanceName       string
@@ -1449,26 +1845,50 @@ func init() {
 // AwsObjectStorageBucketDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsObjectStorageBucketDefinitionVersion string
+var (
+	getAwsObjectStorageBucketDefinitionVersion       string
+	getAwsObjectStorageBucketDefinitionLabelSelector string
+	getAwsObjectStorageBucketDefinitionOutput        string
+)
 
 // GetAwsObjectStorageBucketDefinitionsCmd represents the aws-object-storage-bucket-definition command
 var GetAwsObjectStorageBucketDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get aws-object-storage-bucket-definitions",
+	Example: "  # Get all aws object storage bucket definitions\n  tptctl get aws-object-storage-bucket-definitions\n\n  # Get JSON output for all aws object storage bucket definitions\n  tptctl get aws-object-storage-bucket-definitions -o json\n\n  # Get the ID and name of each aws object storage bucket definition\n  tptctl get aws-object-storage-bucket-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws object storage bucket definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsObjectStorageBucketDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsObjectStorageBucketDefinitionVersion {
 		case "v0":
 			// get aws object storage bucket definitions
-			awsObjectStorageBucketDefinitions, err := client_v0.GetAwsObjectStorageBucketDefinitions(apiClient, apiEndpoint)
+			awsObjectStorageBucketDefinitions, err := client_v0.GetAwsObjectStorageBucketDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsObjectStorageBucketDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws object storage bucket definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsObjectStorageBucketDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsObjectStorageBucketDefinitionOutput,
+					"aws-object-storage-bucket-definition",
+					awsObjectStorageBucketDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsObjectStorageBucketDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws object storage bucket definitions currently managed by %s threeport control plane",
@@ -1505,9 +1925,17 @@ func init() {
 		&getAwsObjectStorageBucketDefinitionVersion,
 		"version", "v", "v0", "Version of aws object storage bucket definitions object to retrieve. One of: [v0]",
 	)
-}
-
-var (
+	GetAwsObjectStorageBucketDefinitionsCmd.Flags().StringVarP(
+		&getAwsObjectStorageBucketDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws object storage bucket definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsObjectStorageBucketDefinitionsCmd.Flags().StringVarP(
+		&getAwsObjectStorageBucketDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
+}
+
+var (
 	createAwsObjectStorageBucketDefinitionConfigPath string
 	createAwsObjectStorageBucketDefinitionVersion    string
 )
@@ -1572,6 +2000,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsObjectStorageBucketDefinitionConfigPath string
+	updateAwsObjectStorageBucketDefinitionVersion    string
+)
+
+// UpdateAwsObjectStorageBucketDefinitionCmd represents the aws-object-storage-bucket-definition command
+var UpdateAwsObjectStorageBucketDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update aws-object-storage-bucket-definition --config path/to/config.yaml",
+	Long:    "Update an existing aws object storage bucket definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws object storage bucket definition config
+		configContent, err := os.ReadFile(updateAwsObjectStorageBucketDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws object storage bucket definition based on version
+		switch updateAwsObjectStorageBucketDefinitionVersion {
+		case "v0":
+			var awsObjectStorageBucketDefinitionConfig config_v0.AwsObjectStorageBucketDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsObjectStorageBucketDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws object storage bucket definition
+			awsObjectStorageBucketDefinition := awsObjectStorageBucketDefinitionConfig.AwsObjectStorageBucketDefinition
+			updatedAwsObjectStorageBucketDefinition, err := awsObjectStorageBucketDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws object storage bucket definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws object storage bucket definition %s updated", *updatedAwsObjectStorageBucketDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws object storage bucket definition",
+	SilenceUsage: true,
+	Use:          "aws-object-storage-bucket-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsObjectStorageBucketDefinitionCmd)
+
+	UpdateAwsObjectStorageBucketDefinitionCmd.Flags().StringVarP(
+		&updateAwsObjectStorageBucketDefinitionConfigPath,
+		"config", "c", "", "Path to file with aws object storage bucket definition config.",
+	)
+	UpdateAwsObjectStorageBucketDefinitionCmd.MarkFlagRequired("config")
+	UpdateAwsObjectStorageBucketDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsObjectStorageBucketDefinitionCmd.Flags().StringVarP(
+		&updateAwsObjectStorageBucketDefinitionVersion,
+		"version", "v", "v0", "Version of aws object storage bucket definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsObjectStorageBucketDefinitionConfigPath string
 	deleteAwsObjectStorageBucketDefinitionName       string
@@ -1860,22 +2353,47 @@ func init() {
 // AwsObjectStorageBucket
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getAwsObjectStorageBucketLabelSelector string
+	getAwsObjectStorageBucketOutput        string
+)
+
 // GetAwsObjectStorageBucketsCmd represents the aws-object-storage-bucket command
 var GetAwsObjectStorageBucketsCmd = &cobra.Command{
-	Example: "  tptctl get aws-object-storage-buckets",
+	Example: "  # Get all aws object storage buckets\n  tptctl get aws-object-storage-buckets\n\n  # Get JSON output for all aws object storage buckets\n  tptctl get aws-object-storage-buckets -o json\n\n  # Get the name of each aws object storage bucket instance\n  tptctl get aws-object-storage-buckets -o jsonpath='{.items[*].Name}'",
 	Long:    "Get aws object storage buckets from the system.\n\nA aws object storage bucket is a simple abstraction of aws object storage bucket definitions and aws object storage bucket instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsObjectStorageBucketOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get aws object storage buckets
-		v0awsObjectStorageBucketInstances, err := client_v0.GetAwsObjectStorageBucketInstances(apiClient, apiEndpoint)
+		v0awsObjectStorageBucketInstances, err := client_v0.GetAwsObjectStorageBucketInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getAwsObjectStorageBucketLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve aws object storage bucket instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getAwsObjectStorageBucketOutput != "" {
+			if err := cli.OutputGetObjects(
+				getAwsObjectStorageBucketOutput,
+				"aws-object-storage-bucket-instance",
+				v0awsObjectStorageBucketInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0awsObjectStorageBucketInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No aws object storage bucket instances currently managed by %s threeport control plane",
@@ -1904,6 +2422,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetAwsObjectStorageBucketsCmd.Flags().StringVarP(
+		&getAwsObjectStorageBucketLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws object storage bucket instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsObjectStorageBucketsCmd.Flags().StringVarP(
+		&getAwsObjectStorageBucketOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -1977,6 +2503,77 @@ func init() {
 	)
 }
 
+var (
+	updateAwsObjectStorageBucketConfigPath string
+	updateAwsObjectStorageBucketVersion    string
+)
+
+// UpdateAwsObjectStorageBucketCmd represents the aws-object-storage-bucket command
+var UpdateAwsObjectStorageBucketCmd = &cobra.Command{
+	Example: "  tptctl update aws-object-storage-bucket --config path/to/config.yaml",
+	Long:    "Update an existing aws object storage bucket. This command updates the aws object storage bucket definition and aws object storage bucket instance in place based on the aws object storage bucket config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws object storage bucket config
+		configContent, err := os.ReadFile(updateAwsObjectStorageBucketConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update aws object storage bucket based on version
+		switch updateAwsObjectStorageBucketVersion {
+		case "v0":
+			var awsObjectStorageBucketConfig config_v0.AwsObjectStorageBucketConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsObjectStorageBucketConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws object storage bucket
+			awsObjectStorageBucket := awsObjectStorageBucketConfig.AwsObjectStorageBucket
+			updatedAwsObjectStorageBucketDefinition, updatedAwsObjectStorageBucketInstance, err := awsObjectStorageBucket.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update aws object storage bucket", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("aws object storage bucket definition %s updated", *updatedAwsObjectStorageBucketDefinition.Name))
+			cli.Info(fmt.Sprintf("aws object storage bucket instance %s updated", *updatedAwsObjectStorageBucketInstance.Name))
+			cli.Complete(fmt.Sprintf("aws object storage bucket %s updated", *awsObjectStorageBucketConfig.AwsObjectStorageBucket.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws object storage bucket",
+	SilenceUsage: true,
+	Use:          "aws-object-storage-bucket",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsObjectStorageBucketCmd)
+
+	UpdateAwsObjectStorageBucketCmd.Flags().StringVarP(
+		&updateAwsObjectStorageBucketConfigPath,
+		"config", "c", "", "Path to file with aws object storage bucket config.",
+	)
+	UpdateAwsObjectStorageBucketCmd.MarkFlagRequired("config")
+	UpdateAwsObjectStorageBucketCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsObjectStorageBucketCmd.Flags().StringVarP(
+		&updateAwsObjectStorageBucketVersion,
+		"version", "v", "v0", "Version of aws object storage buckets object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsObjectStorageBucketConfigPath string
 	deleteAwsObjectStorageBucketName       string
@@ -2054,26 +2651,50 @@ func init() {
 // AwsObjectStorageBucketInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsObjectStorageBucketInstanceVersion string
+var (
+	getAwsObjectStorageBucketInstanceVersion       string
+	getAwsObjectStorageBucketInstanceLabelSelector string
+	getAwsObjectStorageBucketInstanceOutput        string
+)
 
 // GetAwsObjectStorageBucketInstancesCmd represents the aws-object-storage-bucket-instance command
 var GetAwsObjectStorageBucketInstancesCmd = &cobra.Command{
-	Example: "  tptctl get aws-object-storage-bucket-instances",
+	Example: "  # Get all aws object storage bucket instances\n  tptctl get aws-object-storage-bucket-instances\n\n  # Get JSON output for all aws object storage bucket instances\n  tptctl get aws-object-storage-bucket-instances -o json\n\n  # Get the ID and name of each aws object storage bucket instance\n  tptctl get aws-object-storage-bucket-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws object storage bucket instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsObjectStorageBucketInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsObjectStorageBucketInstanceVersion {
 		case "v0":
 			// get aws object storage bucket instances
-			awsObjectStorageBucketInstances, err := client_v0.GetAwsObjectStorageBucketInstances(apiClient, apiEndpoint)
+			awsObjectStorageBucketInstances, err := client_v0.GetAwsObjectStorageBucketInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsObjectStorageBucketInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws object storage bucket instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsObjectStorageBucketInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsObjectStorageBucketInstanceOutput,
+					"aws-object-storage-bucket-instance",
+					awsObjectStorageBucketInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsObjectStorageBucketInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws object storage bucket instances currently managed by %s threeport control plane",
@@ -2110,6 +2731,14 @@ func init() {
 		&getAwsObjectStorageBucketInstanceVersion,
 		"version", "v", "v0", "Version of aws object storage bucket instances object to retrieve. One of: [v0]",
 	)
+	GetAwsObjectStorageBucketInstancesCmd.Flags().StringVarP(
+		&getAwsObjectStorageBucketInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws object storage bucket instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsObjectStorageBucketInstancesCmd.Flags().StringVarP(
+		&getAwsObjectStorageBucketInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -2177,6 +2806,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsObjectStorageBucketInstanceConfigPath string
+	updateAwsObjectStorageBucketInstanceVersion    string
+)
+
+// UpdateAwsObjectStorageBucketInstanceCmd represents the aws-object-storage-bucket-instance command
+var UpdateAwsObjectStorageBucketInstanceCmd = &cobra.Command{
+	Example: "  tptctl update aws-object-storage-bucket-instance --config path/to/config.yaml",
+	Long:    "Update an existing aws object storage bucket instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws object storage bucket instance config
+		configContent, err := os.ReadFile(updateAwsObjectStorageBucketInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws object storage bucket instance based on version
+		switch updateAwsObjectStorageBucketInstanceVersion {
+		case "v0":
+			var awsObjectStorageBucketInstanceConfig config_v0.AwsObjectStorageBucketInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsObjectStorageBucketInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws object storage bucket instance
+			awsObjectStorageBucketInstance := awsObjectStorageBucketInstanceConfig.AwsObjectStorageBucketInstance
+			updatedAwsObjectStorageBucketInstance, err := awsObjectStorageBucketInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws object storage bucket instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws object storage bucket instance %s updated", *updatedAwsObjectStorageBucketInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws object storage bucket instance",
+	SilenceUsage: true,
+	Use:          "aws-object-storage-bucket-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsObjectStorageBucketInstanceCmd)
+
+	UpdateAwsObjectStorageBucketInstanceCmd.Flags().StringVarP(
+		&updateAwsObjectStorageBucketInstanceConfigPath,
+		"config", "c", "", "Path to file with aws object storage bucket instance config.",
+	)
+	UpdateAwsObjectStorageBucketInstanceCmd.MarkFlagRequired("config")
+	UpdateAwsObjectStorageBucketInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsObjectStorageBucketInstanceCmd.Flags().StringVarP(
+		&updateAwsObjectStorageBucketInstanceVersion,
+		"version", "v", "v0", "Version of aws object storage bucket instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsObjectStorageBucketInstanceConfigPath string
 	deleteAwsObjectStorageBucketInstanceName       string
@@ -2465,26 +3159,50 @@ func init() {
 // AwsRelationalDatabaseDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsRelationalDatabaseDefinitionVersion string
+var (
+	getAwsRelationalDatabaseDefinitionVersion       string
+	getAwsRelationalDatabaseDefinitionLabelSelector string
+	getAwsRelationalDatabaseDefinitionOutput        string
+)
 
 // GetAwsRelationalDatabaseDefinitionsCmd represents the aws-relational-database-definition command
 var GetAwsRelationalDatabaseDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get aws-relational-database-definitions",
+	Example: "  # Get all aws relational database definitions\n  tptctl get aws-relational-database-definitions\n\n  # Get JSON output for all aws relational database definitions\n  tptctl get aws-relational-database-definitions -o json\n\n  # Get the ID and name of each aws relational database definition\n  tptctl get aws-relational-database-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws relational database definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsRelationalDatabaseDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsRelationalDatabaseDefinitionVersion {
 		case "v0":
 			// get aws relational database definitions
-			awsRelationalDatabaseDefinitions, err := client_v0.GetAwsRelationalDatabaseDefinitions(apiClient, apiEndpoint)
+			awsRelationalDatabaseDefinitions, err := client_v0.GetAwsRelationalDatabaseDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsRelationalDatabaseDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws relational database definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsRelationalDatabaseDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsRelationalDatabaseDefinitionOutput,
+					"aws-relational-database-definition",
+					awsRelationalDatabaseDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsRelationalDatabaseDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws relational database definitions currently managed by %s threeport control plane",
@@ -2521,6 +3239,14 @@ func init() {
 		&getAwsRelationalDatabaseDefinitionVersion,
 		"version", "v", "v0", "Version of aws relational database definitions object to retrieve. One of: [v0]",
 	)
+	GetAwsRelationalDatabaseDefinitionsCmd.Flags().StringVarP(
+		&getAwsRelationalDatabaseDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws relational database definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsRelationalDatabaseDefinitionsCmd.Flags().StringVarP(
+		&getAwsRelationalDatabaseDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -2588,6 +3314,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsRelationalDatabaseDefinitionConfigPath string
+	updateAwsRelationalDatabaseDefinitionVersion    string
+)
+
+// UpdateAwsRelationalDatabaseDefinitionCmd represents the aws-relational-database-definition command
+var UpdateAwsRelationalDatabaseDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update aws-relational-database-definition --config path/to/config.yaml",
+	Long:    "Update an existing aws relational database definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws relational database definition config
+		configContent, err := os.ReadFile(updateAwsRelationalDatabaseDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws relational database definition based on version
+		switch updateAwsRelationalDatabaseDefinitionVersion {
+		case "v0":
+			var awsRelationalDatabaseDefinitionConfig config_v0.AwsRelationalDatabaseDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsRelationalDatabaseDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws relational database definition
+			awsRelationalDatabaseDefinition := awsRelationalDatabaseDefinitionConfig.AwsRelationalDatabaseDefinition
+			updatedAwsRelationalDatabaseDefinition, err := awsRelationalDatabaseDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws relational database definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws relational database definition %s updated", *updatedAwsRelationalDatabaseDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws relational database definition",
+	SilenceUsage: true,
+	Use:          "aws-relational-database-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsRelationalDatabaseDefinitionCmd)
+
+	UpdateAwsRelationalDatabaseDefinitionCmd.Flags().StringVarP(
+		&updateAwsRelationalDatabaseDefinitionConfigPath,
+		"config", "c", "", "Path to file with aws relational database definition config.",
+	)
+	UpdateAwsRelationalDatabaseDefinitionCmd.MarkFlagRequired("config")
+	UpdateAwsRelationalDatabaseDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsRelationalDatabaseDefinitionCmd.Flags().StringVarP(
+		&updateAwsRelationalDatabaseDefinitionVersion,
+		"version", "v", "v0", "Version of aws relational database definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsRelationalDatabaseDefinitionConfigPath string
 	deleteAwsRelationalDatabaseDefinitionName       string
@@ -2876,22 +3667,47 @@ func init() {
 // AwsRelationalDatabase
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getAwsRelationalDatabaseLabelSelector string
+	getAwsRelationalDatabaseOutput        string
+)
+
 // GetAwsRelationalDatabasesCmd represents the aws-relational-database command
 var GetAwsRelationalDatabasesCmd = &cobra.Command{
-	Example: "  tptctl get aws-relational-databases",
+	Example: "  # Get all aws relational databases\n  tptctl get aws-relational-databases\n\n  # Get JSON output for all aws relational databases\n  tptctl get aws-relational-databases -o json\n\n  # Get the name of each aws relational database instance\n  tptctl get aws-relational-databases -o jsonpath='{.items[*].Name}'",
 	Long:    "Get aws relational databases from the system.\n\nA aws relational database is a simple abstraction of aws relational database definitions and aws relational database instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsRelationalDatabaseOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get aws relational databases
-		v0awsRelationalDatabaseInstances, err := client_v0.GetAwsRelationalDatabaseInstances(apiClient, apiEndpoint)
+		v0awsRelationalDatabaseInstances, err := client_v0.GetAwsRelationalDatabaseInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getAwsRelationalDatabaseLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve aws relational database instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getAwsRelationalDatabaseOutput != "" {
+			if err := cli.OutputGetObjects(
+				getAwsRelationalDatabaseOutput,
+				"aws-relational-database-instance",
+				v0awsRelationalDatabaseInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0awsRelationalDatabaseInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No aws relational database instances currently managed by %s threeport control plane",
@@ -2920,6 +3736,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetAwsRelationalDatabasesCmd.Flags().StringVarP(
+		&getAwsRelationalDatabaseLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws relational database instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsRelationalDatabasesCmd.Flags().StringVarP(
+		&getAwsRelationalDatabaseOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -2993,6 +3817,77 @@ func init() {
 	)
 }
 
+var (
+	updateAwsRelationalDatabaseConfigPath string
+	updateAwsRelationalDatabaseVersion    string
+)
+
+// UpdateAwsRelationalDatabaseCmd represents the aws-relational-database command
+var UpdateAwsRelationalDatabaseCmd = &cobra.Command{
+	Example: "  tptctl update aws-relational-database --config path/to/config.yaml",
+	Long:    "Update an existing aws relational database. This command updates the aws relational database definition and aws relational database instance in place based on the aws relational database config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws relational database config
+		configContent, err := os.ReadFile(updateAwsRelationalDatabaseConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update aws relational database based on version
+		switch updateAwsRelationalDatabaseVersion {
+		case "v0":
+			var awsRelationalDatabaseConfig config_v0.AwsRelationalDatabaseConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsRelationalDatabaseConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws relational database
+			awsRelationalDatabase := awsRelationalDatabaseConfig.AwsRelationalDatabase
+			updatedAwsRelationalDatabaseDefinition, updatedAwsRelationalDatabaseInstance, err := awsRelationalDatabase.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update aws relational database", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("aws relational database definition %s updated", *updatedAwsRelationalDatabaseDefinition.Name))
+			cli.Info(fmt.Sprintf("aws relational database instance %s updated", *updatedAwsRelationalDatabaseInstance.Name))
+			cli.Complete(fmt.Sprintf("aws relational database %s updated", *awsRelationalDatabaseConfig.AwsRelationalDatabase.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws relational database",
+	SilenceUsage: true,
+	Use:          "aws-relational-database",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsRelationalDatabaseCmd)
+
+	UpdateAwsRelationalDatabaseCmd.Flags().StringVarP(
+		&updateAwsRelationalDatabaseConfigPath,
+		"config", "c", "", "Path to file with aws relational database config.",
+	)
+	UpdateAwsRelationalDatabaseCmd.MarkFlagRequired("config")
+	UpdateAwsRelationalDatabaseCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsRelationalDatabaseCmd.Flags().StringVarP(
+		&updateAwsRelationalDatabaseVersion,
+		"version", "v", "v0", "Version of aws relational databases object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsRelationalDatabaseConfigPath string
 	deleteAwsRelationalDatabaseName       string
@@ -3070,26 +3965,50 @@ func init() {
 // AwsRelationalDatabaseInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getAwsRelationalDatabaseInstanceVersion string
+var (
+	getAwsRelationalDatabaseInstanceVersion       string
+	getAwsRelationalDatabaseInstanceLabelSelector string
+	getAwsRelationalDatabaseInstanceOutput        string
+)
 
 // GetAwsRelationalDatabaseInstancesCmd represents the aws-relational-database-instance command
 var GetAwsRelationalDatabaseInstancesCmd = &cobra.Command{
-	Example: "  tptctl get aws-relational-database-instances",
+	Example: "  # Get all aws relational database instances\n  tptctl get aws-relational-database-instances\n\n  # Get JSON output for all aws relational database instances\n  tptctl get aws-relational-database-instances -o json\n\n  # Get the ID and name of each aws relational database instance\n  tptctl get aws-relational-database-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get aws relational database instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getAwsRelationalDatabaseInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getAwsRelationalDatabaseInstanceVersion {
 		case "v0":
 			// get aws relational database instances
-			awsRelationalDatabaseInstances, err := client_v0.GetAwsRelationalDatabaseInstances(apiClient, apiEndpoint)
+			awsRelationalDatabaseInstances, err := client_v0.GetAwsRelationalDatabaseInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getAwsRelationalDatabaseInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve aws relational database instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getAwsRelationalDatabaseInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getAwsRelationalDatabaseInstanceOutput,
+					"aws-relational-database-instance",
+					awsRelationalDatabaseInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*awsRelationalDatabaseInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No aws relational database instances currently managed by %s threeport control plane",
@@ -3126,6 +4045,14 @@ func init() {
 		&getAwsRelationalDatabaseInstanceVersion,
 		"version", "v", "v0", "Version of aws relational database instances object to retrieve. One of: [v0]",
 	)
+	GetAwsRelationalDatabaseInstancesCmd.Flags().StringVarP(
+		&getAwsRelationalDatabaseInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter aws relational database instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetAwsRelationalDatabaseInstancesCmd.Flags().StringVarP(
+		&getAwsRelationalDatabaseInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -3193,6 +4120,71 @@ func init() {
 	)
 }
 
+var (
+	updateAwsRelationalDatabaseInstanceConfigPath string
+	updateAwsRelationalDatabaseInstanceVersion    string
+)
+
+// UpdateAwsRelationalDatabaseInstanceCmd represents the aws-relational-database-instance command
+var UpdateAwsRelationalDatabaseInstanceCmd = &cobra.Command{
+	Example: "  tptctl update aws-relational-database-instance --config path/to/config.yaml",
+	Long:    "Update an existing aws relational database instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read aws relational database instance config
+		configContent, err := os.ReadFile(updateAwsRelationalDatabaseInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update aws relational database instance based on version
+		switch updateAwsRelationalDatabaseInstanceVersion {
+		case "v0":
+			var awsRelationalDatabaseInstanceConfig config_v0.AwsRelationalDatabaseInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &awsRelationalDatabaseInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update aws relational database instance
+			awsRelationalDatabaseInstance := awsRelationalDatabaseInstanceConfig.AwsRelationalDatabaseInstance
+			updatedAwsRelationalDatabaseInstance, err := awsRelationalDatabaseInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update aws relational database instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("aws relational database instance %s updated", *updatedAwsRelationalDatabaseInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing aws relational database instance",
+	SilenceUsage: true,
+	Use:          "aws-relational-database-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateAwsRelationalDatabaseInstanceCmd)
+
+	UpdateAwsRelationalDatabaseInstanceCmd.Flags().StringVarP(
+		&updateAwsRelationalDatabaseInstanceConfigPath,
+		"config", "c", "", "Path to file with aws relational database instance config.",
+	)
+	UpdateAwsRelationalDatabaseInstanceCmd.MarkFlagRequired("config")
+	UpdateAwsRelationalDatabaseInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateAwsRelationalDatabaseInstanceCmd.Flags().StringVarP(
+		&updateAwsRelationalDatabaseInstanceVersion,
+		"version", "v", "v0", "Version of aws relational database instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteAwsRelationalDatabaseInstanceConfigPath string
 	deleteAwsRelationalDatabaseInstanceName       string
@@ -32,6 +32,8 @@ func outputDescribev0ControlPlaneDefinitionCmd(
 		*controlPlaneDefinition.UpdatedAt,
 	)
 
+	outputDescribeConditions(controlPlaneDefinition.GetConditions())
+
 	return nil
 }
 
@@ -57,5 +59,7 @@ func outputDescribev0ControlPlaneInstanceCmd(
 		*controlPlaneInstance.UpdatedAt,
 	)
 
+	outputDescribeConditions(controlPlaneInstance.GetConditions())
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // ControlPlaneDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getControlPlaneDefinitionVersion string
+var (
+	getControlPlaneDefinitionVersion       string
+	getControlPlaneDefinitionLabelSelector string
+	getControlPlaneDefinitionOutput        string
+)
 
 // GetControlPlaneDefinitionsCmd represents the control-plane-definition command
 var GetControlPlaneDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get control-plane-definitions",
+	Example: "  # Get all control plane definitions\n  tptctl get control-plane-definitions\n\n  # Get JSON output for all control plane definitions\n  tptctl get control-plane-definitions -o json\n\n  # Get the ID and name of each control plane definition\n  tptctl get control-plane-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get control plane definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getControlPlaneDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getControlPlaneDefinitionVersion {
 		case "v0":
 			// get control plane definitions
-			controlPlaneDefinitions, err := client_v0.GetControlPlaneDefinitions(apiClient, apiEndpoint)
+			controlPlaneDefinitions, err := client_v0.GetControlPlaneDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getControlPlaneDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve control plane definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getControlPlaneDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getControlPlaneDefinitionOutput,
+					"control-plane-definition",
+					controlPlaneDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*controlPlaneDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No control plane definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getControlPlaneDefinitionVersion,
 		"version", "v", "v0", "Version of control plane definitions object to retrieve. One of: [v0]",
 	)
+	GetControlPlaneDefinitionsCmd.Flags().StringVarP(
+		&getControlPlaneDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter control plane definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetControlPlaneDefinitionsCmd.Flags().StringVarP(
+		&getControlPlaneDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -145,6 +178,71 @@ func init() {
 	)
 }
 
+var (
+	updateControlPlaneDefinitionConfigPath string
+	updateControlPlaneDefinitionVersion    string
+)
+
+// UpdateControlPlaneDefinitionCmd represents the control-plane-definition command
+var UpdateControlPlaneDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update control-plane-definition --config path/to/config.yaml",
+	Long:    "Update an existing control plane definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read control plane definition config
+		configContent, err := os.ReadFile(updateControlPlaneDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update control plane definition based on version
+		switch updateControlPlaneDefinitionVersion {
+		case "v0":
+			var controlPlaneDefinitionConfig config_v0.ControlPlaneDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &controlPlaneDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update control plane definition
+			controlPlaneDefinition := controlPlaneDefinitionConfig.ControlPlaneDefinition
+			updatedControlPlaneDefinition, err := controlPlaneDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update control plane definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("control plane definition %s updated", *updatedControlPlaneDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing control plane definition",
+	SilenceUsage: true,
+	Use:          "control-plane-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateControlPlaneDefinitionCmd)
+
+	UpdateControlPlaneDefinitionCmd.Flags().StringVarP(
+		&updateControlPlaneDefinitionConfigPath,
+		"config", "c", "", "Path to file with control plane definition config.",
+	)
+	UpdateControlPlaneDefinitionCmd.MarkFlagRequired("config")
+	UpdateControlPlaneDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateControlPlaneDefinitionCmd.Flags().StringVarP(
+		&updateControlPlaneDefinitionVersion,
+		"version", "v", "v0", "Version of control plane definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteControlPlaneDefinitionConfigPath string
 	deleteControlPlaneDefinitionName       string
@@ -433,22 +531,47 @@ func init() {
 // ControlPlane
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getControlPlaneLabelSelector string
+	getControlPlaneOutput        string
+)
+
 // GetControlPlanesCmd represents the control-plane command
 var GetControlPlanesCmd = &cobra.Command{
-	Example: "  tptctl get control-planes",
+	Example: "  # Get all control planes\n  tptctl get control-planes\n\n  # Get JSON output for all control planes\n  tptctl get control-planes -o json\n\n  # Get the name of each control plane instance\n  tptctl get control-planes -o jsonpath='{.items[*].Name}'",
 	Long:    "Get control planes from the system.\n\nA control plane is a simple abstraction of control plane definitions and control plane instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getControlPlaneOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get control planes
-		v0controlPlaneInstances, err := client_v0.GetControlPlaneInstances(apiClient, apiEndpoint)
+		v0controlPlaneInstances, err := client_v0.GetControlPlaneInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getControlPlaneLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve control plane instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getControlPlaneOutput != "" {
+			if err := cli.OutputGetObjects(
+				getControlPlaneOutput,
+				"control-plane-instance",
+				v0controlPlaneInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0controlPlaneInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No control plane instances currently managed by %s threeport control plane",
@@ -477,6 +600,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetControlPlanesCmd.Flags().StringVarP(
+		&getControlPlaneLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter control plane instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetControlPlanesCmd.Flags().StringVarP(
+		&getControlPlaneOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -550,6 +681,77 @@ func init() {
 	)
 }
 
+var (
+	updateControlPlaneConfigPath string
+	updateControlPlaneVersion    string
+)
+
+// UpdateControlPlaneCmd represents the control-plane command
+var UpdateControlPlaneCmd = &cobra.Command{
+	Example: "  tptctl update control-plane --config path/to/config.yaml",
+	Long:    "Update an existing control plane. This command updates the control plane definition and control plane instance in place based on the control plane config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read control plane config
+		configContent, err := os.ReadFile(updateControlPlaneConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update control plane based on version
+		switch updateControlPlaneVersion {
+		case "v0":
+			var controlPlaneConfig config_v0.ControlPlaneConfig
+			if err := yaml.UnmarshalStrict(configContent, &controlPlaneConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update control plane
+			controlPlane := controlPlaneConfig.ControlPlane
+			updatedControlPlaneDefinition, updatedControlPlaneInstance, err := controlPlane.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update control plane", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("control plane definition %s updated", *updatedControlPlaneDefinition.Name))
+			cli.Info(fmt.Sprintf("control plane instance %s updated", *updatedControlPlaneInstance.Name))
+			cli.Complete(fmt.Sprintf("control plane %s updated", *controlPlaneConfig.ControlPlane.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing control plane",
+	SilenceUsage: true,
+	Use:          "control-plane",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateControlPlaneCmd)
+
+	UpdateControlPlaneCmd.Flags().StringVarP(
+		&updateControlPlaneConfigPath,
+		"config", "c", "", "Path to file with control plane config.",
+	)
+	UpdateControlPlaneCmd.MarkFlagRequired("config")
+	UpdateControlPlaneCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateControlPlaneCmd.Flags().StringVarP(
+		&updateControlPlaneVersion,
+		"version", "v", "v0", "Version of control planes object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteControlPlaneConfigPath string
 	deleteControlPlaneName       string
@@ -627,26 +829,50 @@ func init() {
 // ControlPlaneInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getControlPlaneInstanceVersion string
+var (
+	getControlPlaneInstanceVersion       string
+	getControlPlaneInstanceLabelSelector string
+	getControlPlaneInstanceOutput        string
+)
 
 // GetControlPlaneInstancesCmd represents the control-plane-instance command
 var GetControlPlaneInstancesCmd = &cobra.Command{
-	Example: "  tptctl get control-plane-instances",
+	Example: "  # Get all control plane instances\n  tptctl get control-plane-instances\n\n  # Get JSON output for all control plane instances\n  tptctl get control-plane-instances -o json\n\n  # Get the ID and name of each control plane instance\n  tptctl get control-plane-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get control plane instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getControlPlaneInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getControlPlaneInstanceVersion {
 		case "v0":
 			// get control plane instances
-			controlPlaneInstances, err := client_v0.GetControlPlaneInstances(apiClient, apiEndpoint)
+			controlPlaneInstances, err := client_v0.GetControlPlaneInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getControlPlaneInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve control plane instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getControlPlaneInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getControlPlaneInstanceOutput,
+					"control-plane-instance",
+					controlPlaneInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*controlPlaneInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No control plane instances currently managed by %s threeport control plane",
@@ -683,6 +909,14 @@ func init() {
 		&getControlPlaneInstanceVersion,
 		"version", "v", "v0", "Version of control plane instances object to retrieve. One of: [v0]",
 	)
+	GetControlPlaneInstancesCmd.Flags().StringVarP(
+		&getControlPlaneInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter control plane instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetControlPlaneInstancesCmd.Flags().StringVarP(
+		&getControlPlaneInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -750,6 +984,71 @@ func init() {
 	)
 }
 
+var (
+	updateControlPlaneInstanceConfigPath string
+	updateControlPlaneInstanceVersion    string
+)
+
+// UpdateControlPlaneInstanceCmd represents the control-plane-instance command
+var UpdateControlPlaneInstanceCmd = &cobra.Command{
+	Example: "  tptctl update control-plane-instance --config path/to/config.yaml",
+	Long:    "Update an existing control plane instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read control plane instance config
+		configContent, err := os.ReadFile(updateControlPlaneInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update control plane instance based on version
+		switch updateControlPlaneInstanceVersion {
+		case "v0":
+			var controlPlaneInstanceConfig config_v0.ControlPlaneInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &controlPlaneInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update control plane instance
+			controlPlaneInstance := controlPlaneInstanceConfig.ControlPlaneInstance
+			updatedControlPlaneInstance, err := controlPlaneInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update control plane instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("control plane instance %s updated", *updatedControlPlaneInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing control plane instance",
+	SilenceUsage: true,
+	Use:          "control-plane-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateControlPlaneInstanceCmd)
+
+	UpdateControlPlaneInstanceCmd.Flags().StringVarP(
+		&updateControlPlaneInstanceConfigPath,
+		"config", "c", "", "Path to file with control plane instance config.",
+	)
+	UpdateControlPlaneInstanceCmd.MarkFlagRequired("config")
+	UpdateControlPlaneInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateControlPlaneInstanceCmd.Flags().StringVarP(
+		&updateControlPlaneInstanceVersion,
+		"version", "v", "v0", "Version of control plane instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteControlPlaneInstanceConfigPath string
 	deleteControlPlaneInstanceName       string
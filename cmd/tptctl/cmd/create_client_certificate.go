@@ -0,0 +1,132 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
+	kube "github.com/threeport/threeport/pkg/kube/v0"
+	installer "github.com/threeport/threeport/pkg/threeport-installer/v0"
+)
+
+var (
+	clientCertificateSubject   string
+	clientCertificateOutputDir string
+)
+
+// CreateClientCertificateCmd represents the create client-certificate command
+var CreateClientCertificateCmd = &cobra.Command{
+	Use: "client-certificate",
+	Example: `  # issue a client certificate for a user and write it to the current directory
+  tptctl create client-certificate --subject alice
+
+  # issue a client certificate for a user and write it to a directory
+  tptctl create client-certificate --subject alice --output-dir /tmp/alice`,
+	Short: "Issue a client certificate for a Threeport API user",
+	Long: `Issue a client certificate for a Threeport API user.  The certificate is signed
+by the control plane's API CA and its common name is the subject that role
+bindings grant roles to.  The certificate, private key and CA certificate are
+written to the output directory as <subject>.crt, <subject>.key and ca.crt.
+The subject has no permissions until a role binding is created for it.`,
+	SilenceUsage: true,
+	PreRun:       CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, config, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		encryptionKey, err := config.GetEncryptionKey(requestedControlPlane)
+		if err != nil {
+			cli.Error("failed to retrieve encryption key for control plane:", err)
+			os.Exit(1)
+		}
+
+		// get the control plane's kubernetes runtime instance and namespace
+		kubernetesRuntimeInstance, err := client.GetThreeportControlPlaneKubernetesRuntimeInstance(
+			apiClient,
+			apiEndpoint,
+		)
+		if err != nil {
+			cli.Error("failed to retrieve kubernetes runtime instance from threeport API:", err)
+			os.Exit(1)
+		}
+		controlPlaneInstance, err := client.GetSelfControlPlaneInstance(
+			apiClient,
+			apiEndpoint,
+		)
+		if err != nil {
+			cli.Error("failed to retrieve self control plane instance from threeport API:", err)
+			os.Exit(1)
+		}
+
+		dynamicKubeClient, mapper, err := kube.GetClient(
+			kubernetesRuntimeInstance,
+			false,
+			apiClient,
+			apiEndpoint,
+			encryptionKey,
+		)
+		if err != nil {
+			cli.Error("failed to get kube client:", err)
+			os.Exit(1)
+		}
+
+		certificate, privateKey, caCertificate, err := installer.IssueClientCertificate(
+			dynamicKubeClient,
+			mapper,
+			*controlPlaneInstance.Namespace,
+			clientCertificateSubject,
+		)
+		if err != nil {
+			cli.Error("failed to issue client certificate:", err)
+			os.Exit(1)
+		}
+
+		// write the certificate, key and CA
+		files := []struct {
+			name    string
+			content string
+			mode    os.FileMode
+		}{
+			{name: fmt.Sprintf("%s.crt", clientCertificateSubject), content: certificate, mode: 0644},
+			{name: fmt.Sprintf("%s.key", clientCertificateSubject), content: privateKey, mode: 0600},
+			{name: "ca.crt", content: caCertificate, mode: 0644},
+		}
+		for _, file := range files {
+			path := filepath.Join(clientCertificateOutputDir, file.name)
+			if err := os.WriteFile(path, []byte(file.content), file.mode); err != nil {
+				cli.Error(fmt.Sprintf("failed to write %s", path), err)
+				os.Exit(1)
+			}
+		}
+
+		cli.Complete(fmt.Sprintf(
+			"Client certificate for subject %s written to %s",
+			clientCertificateSubject,
+			clientCertificateOutputDir,
+		))
+	},
+}
+
+func init() {
+	CreateCmd.AddCommand(CreateClientCertificateCmd)
+
+	CreateClientCertificateCmd.Flags().StringVarP(
+		&clientCertificateSubject,
+		"subject", "s", "", "The subject for the client certificate that role bindings grant roles to.",
+	)
+	CreateClientCertificateCmd.MarkFlagRequired("subject")
+	CreateClientCertificateCmd.Flags().StringVarP(
+		&clientCertificateOutputDir,
+		"output-dir", "o", ".", "Optional. The directory to write the certificate, private key and CA certificate to.",
+	)
+	CreateClientCertificateCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+}
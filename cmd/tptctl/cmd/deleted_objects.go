@@ -0,0 +1,151 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_v0 "github.com/threeport/threeport/pkg/client/v0"
+)
+
+var (
+	deletedObjectType string
+	restoreObjectID   uint
+	restoreObjectName string
+)
+
+// GetDeletedObjectsCmd represents the deleted-objects command
+var GetDeletedObjectsCmd = &cobra.Command{
+	Example: "  tptctl get deleted-objects --object-type WorkloadInstance",
+	Long: `Get objects of an object type that have been deleted and can be restored.
+
+Deleted objects are retained for the API server's retention window after which
+they are permanently removed.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		// get deleted objects
+		deletedObjects, err := client_v0.GetDeletedObjects(apiClient, apiEndpoint, deletedObjectType)
+		if err != nil {
+			cli.Error("failed to retrieve deleted objects", err)
+			os.Exit(1)
+		}
+
+		// write the output
+		if len(*deletedObjects) == 0 {
+			cli.Info(fmt.Sprintf(
+				"No deleted %s objects found on %s threeport control plane",
+				deletedObjectType,
+				requestedControlPlane,
+			))
+			os.Exit(0)
+		}
+		if err := outputGetDeletedObjectsCmd(deletedObjects); err != nil {
+			cli.Error("failed to produce output", err)
+			os.Exit(0)
+		}
+	},
+	Short:        "Get deleted objects from the system",
+	SilenceUsage: true,
+	Use:          "deleted-objects",
+}
+
+// RestoreCmd represents the restore command
+var RestoreCmd = &cobra.Command{
+	Example: `  tptctl restore --object-type WorkloadInstance --id 5
+  tptctl restore --object-type WorkloadDefinition --name web-app`,
+	Long: `Restore a deleted object.
+
+Objects can be restored within the API server's retention window.  Restored
+objects that are reconciled by a controller are reconciled again so that their
+resources are recreated.  Dependents that were deleted along with the object are
+restored with it.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// find the deleted object by name if an ID wasn't provided
+		id := restoreObjectID
+		if restoreObjectName != "" {
+			deletedObjects, err := client_v0.GetDeletedObjects(apiClient, apiEndpoint, deletedObjectType)
+			if err != nil {
+				cli.Error("failed to retrieve deleted objects", err)
+				os.Exit(1)
+			}
+			var matches []uint
+			for _, deletedObject := range *deletedObjects {
+				if deletedObject.Name != nil && *deletedObject.Name == restoreObjectName {
+					matches = append(matches, *deletedObject.ID)
+				}
+			}
+			switch len(matches) {
+			case 0:
+				cli.Error("failed to restore object", fmt.Errorf(
+					"no deleted %s object with name %s found", deletedObjectType, restoreObjectName,
+				))
+				os.Exit(1)
+			case 1:
+				id = matches[0]
+			default:
+				cli.Error("failed to restore object", fmt.Errorf(
+					"multiple deleted %s objects with name %s found with IDs %v - use --id to choose one",
+					deletedObjectType, restoreObjectName, matches,
+				))
+				os.Exit(1)
+			}
+		}
+
+		// restore the object
+		restoredObject, err := client_v0.RestoreDeletedObject(apiClient, apiEndpoint, deletedObjectType, id)
+		if err != nil {
+			cli.Error("failed to restore object", err)
+			os.Exit(1)
+		}
+
+		cli.Complete(fmt.Sprintf("%s object with ID %d restored", deletedObjectType, *restoredObject.ID))
+	},
+	Short:        "Restore a deleted object",
+	SilenceUsage: true,
+	Use:          "restore",
+}
+
+func init() {
+	GetCmd.AddCommand(GetDeletedObjectsCmd)
+	rootCmd.AddCommand(RestoreCmd)
+
+	GetDeletedObjectsCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	GetDeletedObjectsCmd.Flags().StringVar(
+		&deletedObjectType,
+		"object-type", "", "Required. Object type to get deleted objects for, e.g. WorkloadInstance.",
+	)
+	GetDeletedObjectsCmd.MarkFlagRequired("object-type")
+
+	RestoreCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	RestoreCmd.Flags().StringVar(
+		&deletedObjectType,
+		"object-type", "", "Required. Object type of the deleted object, e.g. WorkloadInstance.",
+	)
+	RestoreCmd.Flags().UintVar(
+		&restoreObjectID,
+		"id", 0, "ID of the deleted object to restore.  Either --id or --name is required.",
+	)
+	RestoreCmd.Flags().StringVarP(
+		&restoreObjectName,
+		"name", "n", "", "Name of the deleted object to restore.  Either --id or --name is required.",
+	)
+	RestoreCmd.MarkFlagRequired("object-type")
+	RestoreCmd.MarkFlagsOneRequired("id", "name")
+	RestoreCmd.MarkFlagsMutuallyExclusive("id", "name")
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// outputGetDeletedObjectsCmd produces the tabular output for the
+// 'tptctl get deleted-objects' command.
+func outputGetDeletedObjectsCmd(deletedObjects *[]v0.DeletedObject) error {
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, "ID\t NAME\t DELETED")
+	for _, deletedObject := range *deletedObjects {
+		deletedTime := "-"
+		if deletedObject.DeletedAt != nil && deletedObject.DeletedAt.Valid {
+			deletedTime = deletedObject.DeletedAt.Time.Format(time.RFC3339)
+		}
+		fmt.Fprintln(
+			writer,
+			uintOrDash(deletedObject.ID), "\t",
+			stringOrDash(deletedObject.Name), "\t",
+			deletedTime,
+		)
+	}
+	writer.Flush()
+
+	return nil
+}
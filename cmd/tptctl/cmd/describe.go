@@ -4,9 +4,14 @@ Copyright © 2023 Threeport admin@threeport.io
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
 )
 
 // DescribeCmd represents the describe command
@@ -32,3 +37,24 @@ to describe different objects from the system.`,
 func init() {
 	rootCmd.AddCommand(DescribeCmd)
 }
+
+// outputDescribeConditions prints the status conditions of a reconciled
+// object in the plain description output so users can see why an object has
+// not been reconciled.
+func outputDescribeConditions(conditions []v0.Condition) {
+	if len(conditions) == 0 {
+		return
+	}
+
+	fmt.Println("* Conditions:")
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, "TYPE\t STATUS\t REASON\t MESSAGE\t OBSERVED GENERATION\t AGE")
+	for _, condition := range conditions {
+		fmt.Fprintln(
+			writer, condition.Type, "\t", condition.Status, "\t", condition.Reason, "\t",
+			condition.Message, "\t", condition.ObservedGeneration, "\t",
+			util.GetAge(&condition.LastTransitionTime),
+		)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,144 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	config_v0 "github.com/threeport/threeport/pkg/config/v0"
+)
+
+var diffFilenames []string
+
+// DiffCmd represents the diff command
+var DiffCmd = &cobra.Command{
+	Example: `  tptctl diff -f workload.yaml
+  tptctl diff -f path/to/configs/`,
+	Long: `Show the differences between config documents and the objects in the system.
+
+Each config document is resolved to the objects it would create and compared
+with the live objects of the same name.  Workload manifests are compared as the
+Kubernetes resources they render and helm workload instances are also compared
+using the values merged from their definition and instance.  Config files may
+contain multiple documents of any kind as accepted by 'tptctl apply'.
+
+Sensitive values such as secret data and AWS access keys can't be read from
+the system and are reported as unknown when they are set in a config document.
+
+The exit status is 0 if there are no differences, 1 if there are differences
+and 2 if the differences could not be determined, including when any
+sensitive value is unknown.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read config documents
+		var documents []config_v0.ApplyDocument
+		for _, filename := range diffFilenames {
+			fileDocuments, err := config_v0.ReadApplyDocuments(filename)
+			if err != nil {
+				cli.Error("failed to read config documents", err)
+				os.Exit(2)
+			}
+			documents = append(documents, fileDocuments...)
+		}
+
+		// compare with live objects
+		objectDiffs, err := config_v0.Diff(apiClient, apiEndpoint, documents)
+		if err != nil {
+			cli.Error("failed to compare config documents with the system", err)
+			os.Exit(2)
+		}
+
+		// write the output
+		changed := false
+		unknown := false
+		for _, objectDiff := range objectDiffs {
+			if !objectDiff.Changed() && !objectDiff.Unknown() {
+				continue
+			}
+			changed = changed || objectDiff.Changed()
+			unknown = unknown || objectDiff.Unknown()
+			if err := outputObjectDiff(objectDiff); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(2)
+			}
+		}
+
+		if unknown {
+			cli.Warning("sensitive values could not be compared with the system")
+			os.Exit(2)
+		}
+		if changed {
+			os.Exit(1)
+		}
+		cli.Complete("no differences found")
+	},
+	Short:        "Show differences between config documents and the system",
+	SilenceUsage: true,
+	Use:          "diff",
+}
+
+func init() {
+	rootCmd.AddCommand(DiffCmd)
+
+	DiffCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	DiffCmd.Flags().StringSliceVarP(
+		&diffFilenames,
+		"filename", "f", []string{}, "Required. Config file or directory of config files to compare.  May be repeated.",
+	)
+	DiffCmd.MarkFlagRequired("filename")
+}
+
+// outputObjectDiff writes the differences for an object.  Multi-line fields
+// are written as a unified diff and unknown fields are marked as such.
+func outputObjectDiff(objectDiff config_v0.ObjectDiff) error {
+	if !objectDiff.Exists {
+		fmt.Printf("+ %s %s (not found, will be created)\n", objectDiff.ObjectType, objectDiff.Name)
+		return nil
+	}
+
+	// objects that only have unknown fields may or may not change
+	marker := "~"
+	if !objectDiff.Changed() {
+		marker = "?"
+	}
+	fmt.Printf("%s %s %s\n", marker, objectDiff.ObjectType, objectDiff.Name)
+	for _, fieldDiff := range objectDiff.Fields {
+		if fieldDiff.Unknown {
+			fmt.Printf("    %s: unknown (sensitive value can't be compared)\n", fieldDiff.Field)
+			continue
+		}
+		if !strings.Contains(fieldDiff.Live, "\n") && !strings.Contains(fieldDiff.Desired, "\n") {
+			fmt.Printf("    %s: %q -> %q\n", fieldDiff.Field, fieldDiff.Live, fieldDiff.Desired)
+			continue
+		}
+
+		unifiedDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(fieldDiff.Live),
+			B:        difflib.SplitLines(fieldDiff.Desired),
+			FromFile: "live",
+			ToFile:   "desired",
+			Context:  3,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to produce diff for field %s: %w", fieldDiff.Field, err)
+		}
+		fmt.Printf("    %s:\n", fieldDiff.Field)
+		for _, line := range difflib.SplitLines(unifiedDiff) {
+			fmt.Printf("      %s", line)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_v0 "github.com/threeport/threeport/pkg/client/v0"
+)
+
+var retryFailedReconciliationID uint64
+
+// GetFailedReconciliationsCmd represents the failed-reconciliations command
+var GetFailedReconciliationsCmd = &cobra.Command{
+	Example: "  tptctl get failed-reconciliations",
+	Long: `Get reconciliations that controllers have given up on.
+
+A reconciliation is given up on when it fails more times than its reconciler's
+retry policy allows.  Failed reconciliations are retained for 7 days and can be
+retried with 'tptctl retry'.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		// get failed reconciliations
+		failedReconciliations, err := client_v0.GetFailedReconciliations(apiClient, apiEndpoint)
+		if err != nil {
+			cli.Error("failed to retrieve failed reconciliations", err)
+			os.Exit(1)
+		}
+
+		// write the output
+		if len(*failedReconciliations) == 0 {
+			cli.Info(fmt.Sprintf(
+				"No failed reconciliations found on %s threeport control plane",
+				requestedControlPlane,
+			))
+			os.Exit(0)
+		}
+		if err := outputGetFailedReconciliationsCmd(failedReconciliations); err != nil {
+			cli.Error("failed to produce output", err)
+			os.Exit(0)
+		}
+	},
+	Short:        "Get failed reconciliations from the system",
+	SilenceUsage: true,
+	Use:          "failed-reconciliations",
+}
+
+// RetryCmd represents the retry command
+var RetryCmd = &cobra.Command{
+	Example: "  tptctl retry --id 12",
+	Long: `Retry a failed reconciliation.
+
+The notification for the failed reconciliation is sent to its reconciler again
+and the reconciler's retry policy starts over.  Get the IDs of failed
+reconciliations with 'tptctl get failed-reconciliations'.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// retry the failed reconciliation
+		failedReconciliation, err := client_v0.RetryFailedReconciliation(apiClient, apiEndpoint, retryFailedReconciliationID)
+		if err != nil {
+			cli.Error("failed to retry failed reconciliation", err)
+			os.Exit(1)
+		}
+
+		cli.Complete(fmt.Sprintf(
+			"reconciliation of %s object with ID %s by %s retried",
+			stringOrDash(failedReconciliation.ObjectType),
+			uintOrDash(failedReconciliation.ObjectID),
+			stringOrDash(failedReconciliation.ReconcilerName),
+		))
+	},
+	Short:        "Retry a failed reconciliation",
+	SilenceUsage: true,
+	Use:          "retry",
+}
+
+func init() {
+	GetCmd.AddCommand(GetFailedReconciliationsCmd)
+	rootCmd.AddCommand(RetryCmd)
+
+	GetFailedReconciliationsCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+
+	RetryCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	RetryCmd.Flags().Uint64Var(
+		&retryFailedReconciliationID,
+		"id", 0, "Required. ID of the failed reconciliation to retry.",
+	)
+	RetryCmd.MarkFlagRequired("id")
+}
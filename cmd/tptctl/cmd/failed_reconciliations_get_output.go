@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// outputGetFailedReconciliationsCmd produces the tabular output for the
+// 'tptctl get failed-reconciliations' command.
+func outputGetFailedReconciliationsCmd(failedReconciliations *[]v0.FailedReconciliation) error {
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, "ID\t RECONCILER\t OBJECT TYPE\t OBJECT ID\t ATTEMPTS\t FAILED\t LAST ERROR")
+	for _, failedReconciliation := range *failedReconciliations {
+		id := "-"
+		if failedReconciliation.ID != nil {
+			id = fmt.Sprintf("%d", *failedReconciliation.ID)
+		}
+		failedTime := "-"
+		if failedReconciliation.FailedAt != nil {
+			failedTime = failedReconciliation.FailedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintln(
+			writer,
+			id, "\t",
+			stringOrDash(failedReconciliation.ReconcilerName), "\t",
+			stringOrDash(failedReconciliation.ObjectType), "\t",
+			uintOrDash(failedReconciliation.ObjectID), "\t",
+			intOrDash(failedReconciliation.Attempts), "\t",
+			failedTime, "\t",
+			stringOrDash(failedReconciliation.LastError),
+		)
+	}
+	writer.Flush()
+
+	return nil
+}
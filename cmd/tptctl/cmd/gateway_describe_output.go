@@ -46,6 +46,8 @@ func outputDescribev0GatewayDefinitionCmd(
 		}
 	}
 
+	outputDescribeConditions(gatewayDefinition.GetConditions())
+
 	return nil
 }
 
@@ -84,6 +86,8 @@ func outputDescribev0GatewayInstanceCmd(
 		*gatewayStatus.GatewayDefinition.Name,
 	)
 
+	outputDescribeConditions(gatewayInstance.GetConditions())
+
 	return nil
 }
 
@@ -123,6 +127,8 @@ func outputDescribev0DomainNameDefinitionCmd(
 		}
 	}
 
+	outputDescribeConditions(domainNameDefinition.GetConditions())
+
 	return nil
 }
 
@@ -161,5 +167,7 @@ func outputDescribev0DomainNameInstanceCmd(
 		*domainNameStatus.DomainNameDefinition.Name,
 	)
 
+	outputDescribeConditions(domainNameInstance.GetConditions())
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // DomainNameDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getDomainNameDefinitionVersion string
+var (
+	getDomainNameDefinitionVersion       string
+	getDomainNameDefinitionLabelSelector string
+	getDomainNameDefinitionOutput        string
+)
 
 // GetDomainNameDefinitionsCmd represents the domain-name-definition command
 var GetDomainNameDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get domain-name-definitions",
+	Example: "  # Get all domain name definitions\n  tptctl get domain-name-definitions\n\n  # Get JSON output for all domain name definitions\n  tptctl get domain-name-definitions -o json\n\n  # Get the ID and name of each domain name definition\n  tptctl get domain-name-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get domain name definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getDomainNameDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getDomainNameDefinitionVersion {
 		case "v0":
 			// get domain name definitions
-			domainNameDefinitions, err := client_v0.GetDomainNameDefinitions(apiClient, apiEndpoint)
+			domainNameDefinitions, err := client_v0.GetDomainNameDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getDomainNameDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve domain name definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getDomainNameDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getDomainNameDefinitionOutput,
+					"domain-name-definition",
+					domainNameDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*domainNameDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No domain name definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getDomainNameDefinitionVersion,
 		"version", "v", "v0", "Version of domain name definitions object to retrieve. One of: [v0]",
 	)
+	GetDomainNameDefinitionsCmd.Flags().StringVarP(
+		&getDomainNameDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter domain name definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetDomainNameDefinitionsCmd.Flags().StringVarP(
+		&getDomainNameDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -145,6 +178,71 @@ func init() {
 	)
 }
 
+var (
+	updateDomainNameDefinitionConfigPath string
+	updateDomainNameDefinitionVersion    string
+)
+
+// UpdateDomainNameDefinitionCmd represents the domain-name-definition command
+var UpdateDomainNameDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update domain-name-definition --config path/to/config.yaml",
+	Long:    "Update an existing domain name definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read domain name definition config
+		configContent, err := os.ReadFile(updateDomainNameDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update domain name definition based on version
+		switch updateDomainNameDefinitionVersion {
+		case "v0":
+			var domainNameDefinitionConfig config_v0.DomainNameDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &domainNameDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update domain name definition
+			domainNameDefinition := domainNameDefinitionConfig.DomainNameDefinition
+			updatedDomainNameDefinition, err := domainNameDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update domain name definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("domain name definition %s updated", *updatedDomainNameDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing domain name definition",
+	SilenceUsage: true,
+	Use:          "domain-name-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateDomainNameDefinitionCmd)
+
+	UpdateDomainNameDefinitionCmd.Flags().StringVarP(
+		&updateDomainNameDefinitionConfigPath,
+		"config", "c", "", "Path to file with domain name definition config.",
+	)
+	UpdateDomainNameDefinitionCmd.MarkFlagRequired("config")
+	UpdateDomainNameDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateDomainNameDefinitionCmd.Flags().StringVarP(
+		&updateDomainNameDefinitionVersion,
+		"version", "v", "v0", "Version of domain name definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteDomainNameDefinitionConfigPath string
 	deleteDomainNameDefinitionName       string
@@ -433,22 +531,47 @@ func init() {
 // DomainName
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getDomainNameLabelSelector string
+	getDomainNameOutput        string
+)
+
 // GetDomainNamesCmd represents the domain-name command
 var GetDomainNamesCmd = &cobra.Command{
-	Example: "  tptctl get domain-names",
+	Example: "  # Get all domain names\n  tptctl get domain-names\n\n  # Get JSON output for all domain names\n  tptctl get domain-names -o json\n\n  # Get the name of each domain name instance\n  tptctl get domain-names -o jsonpath='{.items[*].Name}'",
 	Long:    "Get domain names from the system.\n\nA domain name is a simple abstraction of domain name definitions and domain name instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getDomainNameOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get domain names
-		v0domainNameInstances, err := client_v0.GetDomainNameInstances(apiClient, apiEndpoint)
+		v0domainNameInstances, err := client_v0.GetDomainNameInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getDomainNameLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve domain name instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getDomainNameOutput != "" {
+			if err := cli.OutputGetObjects(
+				getDomainNameOutput,
+				"domain-name-instance",
+				v0domainNameInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0domainNameInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No domain name instances currently managed by %s threeport control plane",
@@ -477,6 +600,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetDomainNamesCmd.Flags().StringVarP(
+		&getDomainNameLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter domain name instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetDomainNamesCmd.Flags().StringVarP(
+		&getDomainNameOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -550,6 +681,77 @@ func init() {
 	)
 }
 
+var (
+	updateDomainNameConfigPath string
+	updateDomainNameVersion    string
+)
+
+// UpdateDomainNameCmd represents the domain-name command
+var UpdateDomainNameCmd = &cobra.Command{
+	Example: "  tptctl update domain-name --config path/to/config.yaml",
+	Long:    "Update an existing domain name. This command updates the domain name definition and domain name instance in place based on the domain name config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read domain name config
+		configContent, err := os.ReadFile(updateDomainNameConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update domain name based on version
+		switch updateDomainNameVersion {
+		case "v0":
+			var domainNameConfig config_v0.DomainNameConfig
+			if err := yaml.UnmarshalStrict(configContent, &domainNameConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update domain name
+			domainName := domainNameConfig.DomainName
+			updatedDomainNameDefinition, updatedDomainNameInstance, err := domainName.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update domain name", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("domain name definition %s updated", *updatedDomainNameDefinition.Name))
+			cli.Info(fmt.Sprintf("domain name instance %s updated", *updatedDomainNameInstance.Name))
+			cli.Complete(fmt.Sprintf("domain name %s updated", *domainNameConfig.DomainName.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing domain name",
+	SilenceUsage: true,
+	Use:          "domain-name",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateDomainNameCmd)
+
+	UpdateDomainNameCmd.Flags().StringVarP(
+		&updateDomainNameConfigPath,
+		"config", "c", "", "Path to file with domain name config.",
+	)
+	UpdateDomainNameCmd.MarkFlagRequired("config")
+	UpdateDomainNameCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateDomainNameCmd.Flags().StringVarP(
+		&updateDomainNameVersion,
+		"version", "v", "v0", "Version of domain names object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteDomainNameConfigPath string
 	deleteDomainNameName       string
@@ -627,26 +829,50 @@ func init() {
 // DomainNameInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getDomainNameInstanceVersion string
+var (
+	getDomainNameInstanceVersion       string
+	getDomainNameInstanceLabelSelector string
+	getDomainNameInstanceOutput        string
+)
 
 // GetDomainNameInstancesCmd represents the domain-name-instance command
 var GetDomainNameInstancesCmd = &cobra.Command{
-	Example: "  tptctl get domain-name-instances",
+	Example: "  # Get all domain name instances\n  tptctl get domain-name-instances\n\n  # Get JSON output for all domain name instances\n  tptctl get domain-name-instances -o json\n\n  # Get the ID and name of each domain name instance\n  tptctl get domain-name-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get domain name instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getDomainNameInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getDomainNameInstanceVersion {
 		case "v0":
 			// get domain name instances
-			domainNameInstances, err := client_v0.GetDomainNameInstances(apiClient, apiEndpoint)
+			domainNameInstances, err := client_v0.GetDomainNameInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getDomainNameInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve domain name instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getDomainNameInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getDomainNameInstanceOutput,
+					"domain-name-instance",
+					domainNameInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*domainNameInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No domain name instances currently managed by %s threeport control plane",
@@ -683,6 +909,14 @@ func init() {
 		&getDomainNameInstanceVersion,
 		"version", "v", "v0", "Version of domain name instances object to retrieve. One of: [v0]",
 	)
+	GetDomainNameInstancesCmd.Flags().StringVarP(
+		&getDomainNameInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter domain name instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetDomainNameInstancesCmd.Flags().StringVarP(
+		&getDomainNameInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -750,6 +984,71 @@ func init() {
 	)
 }
 
+var (
+	updateDomainNameInstanceConfigPath string
+	updateDomainNameInstanceVersion    string
+)
+
+// UpdateDomainNameInstanceCmd represents the domain-name-instance command
+var UpdateDomainNameInstanceCmd = &cobra.Command{
+	Example: "  tptctl update domain-name-instance --config path/to/config.yaml",
+	Long:    "Update an existing domain name instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read domain name instance config
+		configContent, err := os.ReadFile(updateDomainNameInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update domain name instance based on version
+		switch updateDomainNameInstanceVersion {
+		case "v0":
+			var domainNameInstanceConfig config_v0.DomainNameInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &domainNameInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update domain name instance
+			domainNameInstance := domainNameInstanceConfig.DomainNameInstance
+			updatedDomainNameInstance, err := domainNameInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update domain name instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("domain name instance %s updated", *updatedDomainNameInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing domain name instance",
+	SilenceUsage: true,
+	Use:          "domain-name-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateDomainNameInstanceCmd)
+
+	UpdateDomainNameInstanceCmd.Flags().StringVarP(
+		&updateDomainNameInstanceConfigPath,
+		"config", "c", "", "Path to file with domain name instance config.",
+	)
+	UpdateDomainNameInstanceCmd.MarkFlagRequired("config")
+	UpdateDomainNameInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateDomainNameInstanceCmd.Flags().StringVarP(
+		&updateDomainNameInstanceVersion,
+		"version", "v", "v0", "Version of domain name instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteDomainNameInstanceConfigPath string
 	deleteDomainNameInstanceName       string
@@ -1038,26 +1337,50 @@ func init() {
 // GatewayDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getGatewayDefinitionVersion string
+var (
+	getGatewayDefinitionVersion       string
+	getGatewayDefinitionLabelSelector string
+	getGatewayDefinitionOutput        string
+)
 
 // GetGatewayDefinitionsCmd represents the gateway-definition command
 var GetGatewayDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get gateway-definitions",
+	Example: "  # Get all gateway definitions\n  tptctl get gateway-definitions\n\n  # Get JSON output for all gateway definitions\n  tptctl get gateway-definitions -o json\n\n  # Get the ID and name of each gateway definition\n  tptctl get gateway-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get gateway definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getGatewayDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getGatewayDefinitionVersion {
 		case "v0":
 			// get gateway definitions
-			gatewayDefinitions, err := client_v0.GetGatewayDefinitions(apiClient, apiEndpoint)
+			gatewayDefinitions, err := client_v0.GetGatewayDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getGatewayDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve gateway definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getGatewayDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getGatewayDefinitionOutput,
+					"gateway-definition",
+					gatewayDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*gatewayDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No gateway definitions currently managed by %s threeport control plane",
@@ -1094,6 +1417,14 @@ func init() {
 		&getGatewayDefinitionVersion,
 		"version", "v", "v0", "Version of gateway definitions object to retrieve. One of: [v0]",
 	)
+	GetGatewayDefinitionsCmd.Flags().StringVarP(
+		&getGatewayDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter gateway definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetGatewayDefinitionsCmd.Flags().StringVarP(
+		&getGatewayDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -1161,6 +1492,71 @@ func init() {
 	)
 }
 
+var (
+	updateGatewayDefinitionConfigPath string
+	updateGatewayDefinitionVersion    string
+)
+
+// UpdateGatewayDefinitionCmd represents the gateway-definition command
+var UpdateGatewayDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update gateway-definition --config path/to/config.yaml",
+	Long:    "Update an existing gateway definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read gateway definition config
+		configContent, err := os.ReadFile(updateGatewayDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update gateway definition based on version
+		switch updateGatewayDefinitionVersion {
+		case "v0":
+			var gatewayDefinitionConfig config_v0.GatewayDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &gatewayDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update gateway definition
+			gatewayDefinition := gatewayDefinitionConfig.GatewayDefinition
+			updatedGatewayDefinition, err := gatewayDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update gateway definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("gateway definition %s updated", *updatedGatewayDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing gateway definition",
+	SilenceUsage: true,
+	Use:          "gateway-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateGatewayDefinitionCmd)
+
+	UpdateGatewayDefinitionCmd.Flags().StringVarP(
+		&updateGatewayDefinitionConfigPath,
+		"config", "c", "", "Path to file with gateway definition config.",
+	)
+	UpdateGatewayDefinitionCmd.MarkFlagRequired("config")
+	UpdateGatewayDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateGatewayDefinitionCmd.Flags().StringVarP(
+		&updateGatewayDefinitionVersion,
+		"version", "v", "v0", "Version of gateway definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteGatewayDefinitionConfigPath string
 	deleteGatewayDefinitionName       string
@@ -1449,22 +1845,47 @@ func init() {
 // Gateway
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getGatewayLabelSelector string
+	getGatewayOutput        string
+)
+
 // GetGatewaysCmd represents the gateway command
 var GetGatewaysCmd = &cobra.Command{
-	Example: "  tptctl get gateways",
+	Example: "  # Get all gateways\n  tptctl get gateways\n\n  # Get JSON output for all gateways\n  tptctl get gateways -o json\n\n  # Get the name of each gateway instance\n  tptctl get gateways -o jsonpath='{.items[*].Name}'",
 	Long:    "Get gateways from the system.\n\nA gateway is a simple abstraction of gateway definitions and gateway instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getGatewayOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get gateways
-		v0gatewayInstances, err := client_v0.GetGatewayInstances(apiClient, apiEndpoint)
+		v0gatewayInstances, err := client_v0.GetGatewayInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getGatewayLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve gateway instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getGatewayOutput != "" {
+			if err := cli.OutputGetObjects(
+				getGatewayOutput,
+				"gateway-instance",
+				v0gatewayInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0gatewayInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No gateway instances currently managed by %s threeport control plane",
@@ -1493,6 +1914,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetGatewaysCmd.Flags().StringVarP(
+		&getGatewayLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter gateway instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetGatewaysCmd.Flags().StringVarP(
+		&getGatewayOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -1566,6 +1995,77 @@ func init() {
 	)
 }
 
+var (
+	updateGatewayConfigPath string
+	updateGatewayVersion    string
+)
+
+// UpdateGatewayCmd represents the gateway command
+var UpdateGatewayCmd = &cobra.Command{
+	Example: "  tptctl update gateway --config path/to/config.yaml",
+	Long:    "Update an existing gateway. This command updates the gateway definition and gateway instance in place based on the gateway config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read gateway config
+		configContent, err := os.ReadFile(updateGatewayConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update gateway based on version
+		switch updateGatewayVersion {
+		case "v0":
+			var gatewayConfig config_v0.GatewayConfig
+			if err := yaml.UnmarshalStrict(configContent, &gatewayConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update gateway
+			gateway := gatewayConfig.Gateway
+			updatedGatewayDefinition, updatedGatewayInstance, err := gateway.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update gateway", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("gateway definition %s updated", *updatedGatewayDefinition.Name))
+			cli.Info(fmt.Sprintf("gateway instance %s updated", *updatedGatewayInstance.Name))
+			cli.Complete(fmt.Sprintf("gateway %s updated", *gatewayConfig.Gateway.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing gateway",
+	SilenceUsage: true,
+	Use:          "gateway",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateGatewayCmd)
+
+	UpdateGatewayCmd.Flags().StringVarP(
+		&updateGatewayConfigPath,
+		"config", "c", "", "Path to file with gateway config.",
+	)
+	UpdateGatewayCmd.MarkFlagRequired("config")
+	UpdateGatewayCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateGatewayCmd.Flags().StringVarP(
+		&updateGatewayVersion,
+		"version", "v", "v0", "Version of gateways object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteGatewayConfigPath string
 	deleteGatewayName       string
@@ -1643,26 +2143,50 @@ func init() {
 // GatewayInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getGatewayInstanceVersion string
+var (
+	getGatewayInstanceVersion       string
+	getGatewayInstanceLabelSelector string
+	getGatewayInstanceOutput        string
+)
 
 // GetGatewayInstancesCmd represents the gateway-instance command
 var GetGatewayInstancesCmd = &cobra.Command{
-	Example: "  tptctl get gateway-instances",
+	Example: "  # Get all gateway instances\n  tptctl get gateway-instances\n\n  # Get JSON output for all gateway instances\n  tptctl get gateway-instances -o json\n\n  # Get the ID and name of each gateway instance\n  tptctl get gateway-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get gateway instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getGatewayInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getGatewayInstanceVersion {
 		case "v0":
 			// get gateway instances
-			gatewayInstances, err := client_v0.GetGatewayInstances(apiClient, apiEndpoint)
+			gatewayInstances, err := client_v0.GetGatewayInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getGatewayInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve gateway instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getGatewayInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getGatewayInstanceOutput,
+					"gateway-instance",
+					gatewayInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*gatewayInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No gateway instances currently managed by %s threeport control plane",
@@ -1699,6 +2223,14 @@ func init() {
 		&getGatewayInstanceVersion,
 		"version", "v", "v0", "Version of gateway instances object to retrieve. One of: [v0]",
 	)
+	GetGatewayInstancesCmd.Flags().StringVarP(
+		&getGatewayInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter gateway instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetGatewayInstancesCmd.Flags().StringVarP(
+		&getGatewayInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -1766,6 +2298,71 @@ func init() {
 	)
 }
 
+var (
+	updateGatewayInstanceConfigPath string
+	updateGatewayInstanceVersion    string
+)
+
+// UpdateGatewayInstanceCmd represents the gateway-instance command
+var UpdateGatewayInstanceCmd = &cobra.Command{
+	Example: "  tptctl update gateway-instance --config path/to/config.yaml",
+	Long:    "Update an existing gateway instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read gateway instance config
+		configContent, err := os.ReadFile(updateGatewayInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update gateway instance based on version
+		switch updateGatewayInstanceVersion {
+		case "v0":
+			var gatewayInstanceConfig config_v0.GatewayInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &gatewayInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update gateway instance
+			gatewayInstance := gatewayInstanceConfig.GatewayInstance
+			updatedGatewayInstance, err := gatewayInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update gateway instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("gateway instance %s updated", *updatedGatewayInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing gateway instance",
+	SilenceUsage: true,
+	Use:          "gateway-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateGatewayInstanceCmd)
+
+	UpdateGatewayInstanceCmd.Flags().StringVarP(
+		&updateGatewayInstanceConfigPath,
+		"config", "c", "", "Path to file with gateway instance config.",
+	)
+	UpdateGatewayInstanceCmd.MarkFlagRequired("config")
+	UpdateGatewayInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateGatewayInstanceCmd.Flags().StringVarP(
+		&updateGatewayInstanceVersion,
+		"version", "v", "v0", "Version of gateway instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteGatewayInstanceConfigPath string
 	deleteGatewayInstanceName       string
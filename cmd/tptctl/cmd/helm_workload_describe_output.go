@@ -51,6 +51,8 @@ func outputDescribev0HelmWorkloadDefinitionCmd(
 		}
 	}
 
+	outputDescribeConditions(helmWorkloadDefinition.GetConditions())
+
 	return nil
 }
 
@@ -107,5 +109,7 @@ func outputDescribev0HelmWorkloadInstanceCmd(
 		writer.Flush()
 	}
 
+	outputDescribeConditions(helmWorkloadInstance.GetConditions())
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // HelmWorkloadDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getHelmWorkloadDefinitionVersion string
+var (
+	getHelmWorkloadDefinitionVersion       string
+	getHelmWorkloadDefinitionLabelSelector string
+	getHelmWorkloadDefinitionOutput        string
+)
 
 // GetHelmWorkloadDefinitionsCmd represents the helm-workload-definition command
 var GetHelmWorkloadDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get helm-workload-definitions",
+	Example: "  # Get all helm workload definitions\n  tptctl get helm-workload-definitions\n\n  # Get JSON output for all helm workload definitions\n  tptctl get helm-workload-definitions -o json\n\n  # Get the ID and name of each helm workload definition\n  tptctl get helm-workload-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get helm workload definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getHelmWorkloadDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getHelmWorkloadDefinitionVersion {
 		case "v0":
 			// get helm workload definitions
-			helmWorkloadDefinitions, err := client_v0.GetHelmWorkloadDefinitions(apiClient, apiEndpoint)
+			helmWorkloadDefinitions, err := client_v0.GetHelmWorkloadDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getHelmWorkloadDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve helm workload definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getHelmWorkloadDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getHelmWorkloadDefinitionOutput,
+					"helm-workload-definition",
+					helmWorkloadDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*helmWorkloadDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No helm workload definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getHelmWorkloadDefinitionVersion,
 		"version", "v", "v0", "Version of helm workload definitions object to retrieve. One of: [v0]",
 	)
+	GetHelmWorkloadDefinitionsCmd.Flags().StringVarP(
+		&getHelmWorkloadDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter helm workload definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetHelmWorkloadDefinitionsCmd.Flags().StringVarP(
+		&getHelmWorkloadDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -146,6 +179,72 @@ func init() {
 	)
 }
 
+var (
+	updateHelmWorkloadDefinitionConfigPath string
+	updateHelmWorkloadDefinitionVersion    string
+)
+
+// UpdateHelmWorkloadDefinitionCmd represents the helm-workload-definition command
+var UpdateHelmWorkloadDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update helm-workload-definition --config path/to/config.yaml",
+	Long:    "Update an existing helm workload definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read helm workload definition config
+		configContent, err := os.ReadFile(updateHelmWorkloadDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update helm workload definition based on version
+		switch updateHelmWorkloadDefinitionVersion {
+		case "v0":
+			var helmWorkloadDefinitionConfig config_v0.HelmWorkloadDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &helmWorkloadDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update helm workload definition
+			helmWorkloadDefinition := helmWorkloadDefinitionConfig.HelmWorkloadDefinition
+			helmWorkloadDefinition.HelmWorkloadConfigPath = &updateHelmWorkloadDefinitionConfigPath
+			updatedHelmWorkloadDefinition, err := helmWorkloadDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update helm workload definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("helm workload definition %s updated", *updatedHelmWorkloadDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing helm workload definition",
+	SilenceUsage: true,
+	Use:          "helm-workload-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateHelmWorkloadDefinitionCmd)
+
+	UpdateHelmWorkloadDefinitionCmd.Flags().StringVarP(
+		&updateHelmWorkloadDefinitionConfigPath,
+		"config", "c", "", "Path to file with helm workload definition config.",
+	)
+	UpdateHelmWorkloadDefinitionCmd.MarkFlagRequired("config")
+	UpdateHelmWorkloadDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateHelmWorkloadDefinitionCmd.Flags().StringVarP(
+		&updateHelmWorkloadDefinitionVersion,
+		"version", "v", "v0", "Version of helm workload definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteHelmWorkloadDefinitionConfigPath string
 	deleteHelmWorkloadDefinitionName       string
@@ -435,22 +534,47 @@ func init() {
 // HelmWorkload
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getHelmWorkloadLabelSelector string
+	getHelmWorkloadOutput        string
+)
+
 // GetHelmWorkloadsCmd represents the helm-workload command
 var GetHelmWorkloadsCmd = &cobra.Command{
-	Example: "  tptctl get helm-workloads",
+	Example: "  # Get all helm workloads\n  tptctl get helm-workloads\n\n  # Get JSON output for all helm workloads\n  tptctl get helm-workloads -o json\n\n  # Get the name of each helm workload instance\n  tptctl get helm-workloads -o jsonpath='{.items[*].Name}'",
 	Long:    "Get helm workloads from the system.\n\nA helm workload is a simple abstraction of helm workload definitions and helm workload instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getHelmWorkloadOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get helm workloads
-		v0helmWorkloadInstances, err := client_v0.GetHelmWorkloadInstances(apiClient, apiEndpoint)
+		v0helmWorkloadInstances, err := client_v0.GetHelmWorkloadInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getHelmWorkloadLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve helm workload instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getHelmWorkloadOutput != "" {
+			if err := cli.OutputGetObjects(
+				getHelmWorkloadOutput,
+				"helm-workload-instance",
+				v0helmWorkloadInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0helmWorkloadInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No helm workload instances currently managed by %s threeport control plane",
@@ -479,6 +603,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetHelmWorkloadsCmd.Flags().StringVarP(
+		&getHelmWorkloadLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter helm workload instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetHelmWorkloadsCmd.Flags().StringVarP(
+		&getHelmWorkloadOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -553,6 +685,78 @@ func init() {
 	)
 }
 
+var (
+	updateHelmWorkloadConfigPath string
+	updateHelmWorkloadVersion    string
+)
+
+// UpdateHelmWorkloadCmd represents the helm-workload command
+var UpdateHelmWorkloadCmd = &cobra.Command{
+	Example: "  tptctl update helm-workload --config path/to/config.yaml",
+	Long:    "Update an existing helm workload. This command updates the helm workload definition and helm workload instance in place based on the helm workload config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read helm workload config
+		configContent, err := os.ReadFile(updateHelmWorkloadConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update helm workload based on version
+		switch updateHelmWorkloadVersion {
+		case "v0":
+			var helmWorkloadConfig config_v0.HelmWorkloadConfig
+			if err := yaml.UnmarshalStrict(configContent, &helmWorkloadConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update helm workload
+			helmWorkload := helmWorkloadConfig.HelmWorkload
+			helmWorkload.HelmWorkloadConfigPath = &updateHelmWorkloadConfigPath
+			updatedHelmWorkloadDefinition, updatedHelmWorkloadInstance, err := helmWorkload.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update helm workload", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("helm workload definition %s updated", *updatedHelmWorkloadDefinition.Name))
+			cli.Info(fmt.Sprintf("helm workload instance %s updated", *updatedHelmWorkloadInstance.Name))
+			cli.Complete(fmt.Sprintf("helm workload %s updated", *helmWorkloadConfig.HelmWorkload.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing helm workload",
+	SilenceUsage: true,
+	Use:          "helm-workload",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateHelmWorkloadCmd)
+
+	UpdateHelmWorkloadCmd.Flags().StringVarP(
+		&updateHelmWorkloadConfigPath,
+		"config", "c", "", "Path to file with helm workload config.",
+	)
+	UpdateHelmWorkloadCmd.MarkFlagRequired("config")
+	UpdateHelmWorkloadCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateHelmWorkloadCmd.Flags().StringVarP(
+		&updateHelmWorkloadVersion,
+		"version", "v", "v0", "Version of helm workloads object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteHelmWorkloadConfigPath string
 	deleteHelmWorkloadName       string
@@ -631,26 +835,50 @@ func init() {
 // HelmWorkloadInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getHelmWorkloadInstanceVersion string
+var (
+	getHelmWorkloadInstanceVersion       string
+	getHelmWorkloadInstanceLabelSelector string
+	getHelmWorkloadInstanceOutput        string
+)
 
 // GetHelmWorkloadInstancesCmd represents the helm-workload-instance command
 var GetHelmWorkloadInstancesCmd = &cobra.Command{
-	Example: "  tptctl get helm-workload-instances",
+	Example: "  # Get all helm workload instances\n  tptctl get helm-workload-instances\n\n  # Get JSON output for all helm workload instances\n  tptctl get helm-workload-instances -o json\n\n  # Get the ID and name of each helm workload instance\n  tptctl get helm-workload-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get helm workload instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getHelmWorkloadInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getHelmWorkloadInstanceVersion {
 		case "v0":
 			// get helm workload instances
-			helmWorkloadInstances, err := client_v0.GetHelmWorkloadInstances(apiClient, apiEndpoint)
+			helmWorkloadInstances, err := client_v0.GetHelmWorkloadInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getHelmWorkloadInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve helm workload instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getHelmWorkloadInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getHelmWorkloadInstanceOutput,
+					"helm-workload-instance",
+					helmWorkloadInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*helmWorkloadInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No helm workload instances currently managed by %s threeport control plane",
@@ -687,6 +915,14 @@ func init() {
 		&getHelmWorkloadInstanceVersion,
 		"version", "v", "v0", "Version of helm workload instances object to retrieve. One of: [v0]",
 	)
+	GetHelmWorkloadInstancesCmd.Flags().StringVarP(
+		&getHelmWorkloadInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter helm workload instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetHelmWorkloadInstancesCmd.Flags().StringVarP(
+		&getHelmWorkloadInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -755,6 +991,72 @@ func init() {
 	)
 }
 
+var (
+	updateHelmWorkloadInstanceConfigPath string
+	updateHelmWorkloadInstanceVersion    string
+)
+
+// UpdateHelmWorkloadInstanceCmd represents the helm-workload-instance command
+var UpdateHelmWorkloadInstanceCmd = &cobra.Command{
+	Example: "  tptctl update helm-workload-instance --config path/to/config.yaml",
+	Long:    "Update an existing helm workload instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read helm workload instance config
+		configContent, err := os.ReadFile(updateHelmWorkloadInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update helm workload instance based on version
+		switch updateHelmWorkloadInstanceVersion {
+		case "v0":
+			var helmWorkloadInstanceConfig config_v0.HelmWorkloadInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &helmWorkloadInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update helm workload instance
+			helmWorkloadInstance := helmWorkloadInstanceConfig.HelmWorkloadInstance
+			helmWorkloadInstance.HelmWorkloadConfigPath = &updateHelmWorkloadInstanceConfigPath
+			updatedHelmWorkloadInstance, err := helmWorkloadInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update helm workload instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("helm workload instance %s updated", *updatedHelmWorkloadInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing helm workload instance",
+	SilenceUsage: true,
+	Use:          "helm-workload-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateHelmWorkloadInstanceCmd)
+
+	UpdateHelmWorkloadInstanceCmd.Flags().StringVarP(
+		&updateHelmWorkloadInstanceConfigPath,
+		"config", "c", "", "Path to file with helm workload instance config.",
+	)
+	UpdateHelmWorkloadInstanceCmd.MarkFlagRequired("config")
+	UpdateHelmWorkloadInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateHelmWorkloadInstanceCmd.Flags().StringVarP(
+		&updateHelmWorkloadInstanceVersion,
+		"version", "v", "v0", "Version of helm workload instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteHelmWorkloadInstanceConfigPath string
 	deleteHelmWorkloadInstanceName       string
@@ -49,6 +49,8 @@ func outputDescribev0KubernetesRuntimeDefinitionCmd(
 		}
 	}
 
+	outputDescribeConditions(kubernetesRuntimeDefinition.GetConditions())
+
 	return nil
 }
 
@@ -87,5 +89,7 @@ func outputDescribev0KubernetesRuntimeInstanceCmd(
 		*kubernetesRuntimeStatus.KubernetesRuntimeDefinition.Name,
 	)
 
+	outputDescribeConditions(kubernetesRuntimeInstance.GetConditions())
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // KubernetesRuntimeDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getKubernetesRuntimeDefinitionVersion string
+var (
+	getKubernetesRuntimeDefinitionVersion       string
+	getKubernetesRuntimeDefinitionLabelSelector string
+	getKubernetesRuntimeDefinitionOutput        string
+)
 
 // GetKubernetesRuntimeDefinitionsCmd represents the kubernetes-runtime-definition command
 var GetKubernetesRuntimeDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get kubernetes-runtime-definitions",
+	Example: "  # Get all kubernetes runtime definitions\n  tptctl get kubernetes-runtime-definitions\n\n  # Get JSON output for all kubernetes runtime definitions\n  tptctl get kubernetes-runtime-definitions -o json\n\n  # Get the ID and name of each kubernetes runtime definition\n  tptctl get kubernetes-runtime-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get kubernetes runtime definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getKubernetesRuntimeDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getKubernetesRuntimeDefinitionVersion {
 		case "v0":
 			// get kubernetes runtime definitions
-			kubernetesRuntimeDefinitions, err := client_v0.GetKubernetesRuntimeDefinitions(apiClient, apiEndpoint)
+			kubernetesRuntimeDefinitions, err := client_v0.GetKubernetesRuntimeDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getKubernetesRuntimeDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve kubernetes runtime definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getKubernetesRuntimeDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getKubernetesRuntimeDefinitionOutput,
+					"kubernetes-runtime-definition",
+					kubernetesRuntimeDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*kubernetesRuntimeDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No kubernetes runtime definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getKubernetesRuntimeDefinitionVersion,
 		"version", "v", "v0", "Version of kubernetes runtime definitions object to retrieve. One of: [v0]",
 	)
+	GetKubernetesRuntimeDefinitionsCmd.Flags().StringVarP(
+		&getKubernetesRuntimeDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter kubernetes runtime definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetKubernetesRuntimeDefinitionsCmd.Flags().StringVarP(
+		&getKubernetesRuntimeDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -145,6 +178,71 @@ func init() {
 	)
 }
 
+var (
+	updateKubernetesRuntimeDefinitionConfigPath string
+	updateKubernetesRuntimeDefinitionVersion    string
+)
+
+// UpdateKubernetesRuntimeDefinitionCmd represents the kubernetes-runtime-definition command
+var UpdateKubernetesRuntimeDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update kubernetes-runtime-definition --config path/to/config.yaml",
+	Long:    "Update an existing kubernetes runtime definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read kubernetes runtime definition config
+		configContent, err := os.ReadFile(updateKubernetesRuntimeDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update kubernetes runtime definition based on version
+		switch updateKubernetesRuntimeDefinitionVersion {
+		case "v0":
+			var kubernetesRuntimeDefinitionConfig config_v0.KubernetesRuntimeDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &kubernetesRuntimeDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update kubernetes runtime definition
+			kubernetesRuntimeDefinition := kubernetesRuntimeDefinitionConfig.KubernetesRuntimeDefinition
+			updatedKubernetesRuntimeDefinition, err := kubernetesRuntimeDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update kubernetes runtime definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("kubernetes runtime definition %s updated", *updatedKubernetesRuntimeDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing kubernetes runtime definition",
+	SilenceUsage: true,
+	Use:          "kubernetes-runtime-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateKubernetesRuntimeDefinitionCmd)
+
+	UpdateKubernetesRuntimeDefinitionCmd.Flags().StringVarP(
+		&updateKubernetesRuntimeDefinitionConfigPath,
+		"config", "c", "", "Path to file with kubernetes runtime definition config.",
+	)
+	UpdateKubernetesRuntimeDefinitionCmd.MarkFlagRequired("config")
+	UpdateKubernetesRuntimeDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateKubernetesRuntimeDefinitionCmd.Flags().StringVarP(
+		&updateKubernetesRuntimeDefinitionVersion,
+		"version", "v", "v0", "Version of kubernetes runtime definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteKubernetesRuntimeDefinitionConfigPath string
 	deleteKubernetesRuntimeDefinitionName       string
@@ -433,22 +531,47 @@ func init() {
 // KubernetesRuntime
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getKubernetesRuntimeLabelSelector string
+	getKubernetesRuntimeOutput        string
+)
+
 // GetKubernetesRuntimesCmd represents the kubernetes-runtime command
 var GetKubernetesRuntimesCmd = &cobra.Command{
-	Example: "  tptctl get kubernetes-runtimes",
+	Example: "  # Get all kubernetes runtimes\n  tptctl get kubernetes-runtimes\n\n  # Get JSON output for all kubernetes runtimes\n  tptctl get kubernetes-runtimes -o json\n\n  # Get the name of each kubernetes runtime instance\n  tptctl get kubernetes-runtimes -o jsonpath='{.items[*].Name}'",
 	Long:    "Get kubernetes runtimes from the system.\n\nA kubernetes runtime is a simple abstraction of kubernetes runtime definitions and kubernetes runtime instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getKubernetesRuntimeOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get kubernetes runtimes
-		v0kubernetesRuntimeInstances, err := client_v0.GetKubernetesRuntimeInstances(apiClient, apiEndpoint)
+		v0kubernetesRuntimeInstances, err := client_v0.GetKubernetesRuntimeInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getKubernetesRuntimeLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve kubernetes runtime instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getKubernetesRuntimeOutput != "" {
+			if err := cli.OutputGetObjects(
+				getKubernetesRuntimeOutput,
+				"kubernetes-runtime-instance",
+				v0kubernetesRuntimeInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0kubernetesRuntimeInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No kubernetes runtime instances currently managed by %s threeport control plane",
@@ -477,6 +600,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetKubernetesRuntimesCmd.Flags().StringVarP(
+		&getKubernetesRuntimeLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter kubernetes runtime instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetKubernetesRuntimesCmd.Flags().StringVarP(
+		&getKubernetesRuntimeOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -550,6 +681,77 @@ func init() {
 	)
 }
 
+var (
+	updateKubernetesRuntimeConfigPath string
+	updateKubernetesRuntimeVersion    string
+)
+
+// UpdateKubernetesRuntimeCmd represents the kubernetes-runtime command
+var UpdateKubernetesRuntimeCmd = &cobra.Command{
+	Example: "  tptctl update kubernetes-runtime --config path/to/config.yaml",
+	Long:    "Update an existing kubernetes runtime. This command updates the kubernetes runtime definition and kubernetes runtime instance in place based on the kubernetes runtime config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read kubernetes runtime config
+		configContent, err := os.ReadFile(updateKubernetesRuntimeConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update kubernetes runtime based on version
+		switch updateKubernetesRuntimeVersion {
+		case "v0":
+			var kubernetesRuntimeConfig config_v0.KubernetesRuntimeConfig
+			if err := yaml.UnmarshalStrict(configContent, &kubernetesRuntimeConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update kubernetes runtime
+			kubernetesRuntime := kubernetesRuntimeConfig.KubernetesRuntime
+			updatedKubernetesRuntimeDefinition, updatedKubernetesRuntimeInstance, err := kubernetesRuntime.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update kubernetes runtime", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("kubernetes runtime definition %s updated", *updatedKubernetesRuntimeDefinition.Name))
+			cli.Info(fmt.Sprintf("kubernetes runtime instance %s updated", *updatedKubernetesRuntimeInstance.Name))
+			cli.Complete(fmt.Sprintf("kubernetes runtime %s updated", *kubernetesRuntimeConfig.KubernetesRuntime.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing kubernetes runtime",
+	SilenceUsage: true,
+	Use:          "kubernetes-runtime",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateKubernetesRuntimeCmd)
+
+	UpdateKubernetesRuntimeCmd.Flags().StringVarP(
+		&updateKubernetesRuntimeConfigPath,
+		"config", "c", "", "Path to file with kubernetes runtime config.",
+	)
+	UpdateKubernetesRuntimeCmd.MarkFlagRequired("config")
+	UpdateKubernetesRuntimeCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateKubernetesRuntimeCmd.Flags().StringVarP(
+		&updateKubernetesRuntimeVersion,
+		"version", "v", "v0", "Version of kubernetes runtimes object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteKubernetesRuntimeConfigPath string
 	deleteKubernetesRuntimeName       string
@@ -627,26 +829,50 @@ func init() {
 // KubernetesRuntimeInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getKubernetesRuntimeInstanceVersion string
+var (
+	getKubernetesRuntimeInstanceVersion       string
+	getKubernetesRuntimeInstanceLabelSelector string
+	getKubernetesRuntimeInstanceOutput        string
+)
 
 // GetKubernetesRuntimeInstancesCmd represents the kubernetes-runtime-instance command
 var GetKubernetesRuntimeInstancesCmd = &cobra.Command{
-	Example: "  tptctl get kubernetes-runtime-instances",
+	Example: "  # Get all kubernetes runtime instances\n  tptctl get kubernetes-runtime-instances\n\n  # Get JSON output for all kubernetes runtime instances\n  tptctl get kubernetes-runtime-instances -o json\n\n  # Get the ID and name of each kubernetes runtime instance\n  tptctl get kubernetes-runtime-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get kubernetes runtime instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getKubernetesRuntimeInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getKubernetesRuntimeInstanceVersion {
 		case "v0":
 			// get kubernetes runtime instances
-			kubernetesRuntimeInstances, err := client_v0.GetKubernetesRuntimeInstances(apiClient, apiEndpoint)
+			kubernetesRuntimeInstances, err := client_v0.GetKubernetesRuntimeInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getKubernetesRuntimeInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve kubernetes runtime instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getKubernetesRuntimeInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getKubernetesRuntimeInstanceOutput,
+					"kubernetes-runtime-instance",
+					kubernetesRuntimeInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*kubernetesRuntimeInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No kubernetes runtime instances currently managed by %s threeport control plane",
@@ -683,6 +909,14 @@ func init() {
 		&getKubernetesRuntimeInstanceVersion,
 		"version", "v", "v0", "Version of kubernetes runtime instances object to retrieve. One of: [v0]",
 	)
+	GetKubernetesRuntimeInstancesCmd.Flags().StringVarP(
+		&getKubernetesRuntimeInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter kubernetes runtime instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetKubernetesRuntimeInstancesCmd.Flags().StringVarP(
+		&getKubernetesRuntimeInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -750,6 +984,71 @@ func init() {
 	)
 }
 
+var (
+	updateKubernetesRuntimeInstanceConfigPath string
+	updateKubernetesRuntimeInstanceVersion    string
+)
+
+// UpdateKubernetesRuntimeInstanceCmd represents the kubernetes-runtime-instance command
+var UpdateKubernetesRuntimeInstanceCmd = &cobra.Command{
+	Example: "  tptctl update kubernetes-runtime-instance --config path/to/config.yaml",
+	Long:    "Update an existing kubernetes runtime instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read kubernetes runtime instance config
+		configContent, err := os.ReadFile(updateKubernetesRuntimeInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update kubernetes runtime instance based on version
+		switch updateKubernetesRuntimeInstanceVersion {
+		case "v0":
+			var kubernetesRuntimeInstanceConfig config_v0.KubernetesRuntimeInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &kubernetesRuntimeInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update kubernetes runtime instance
+			kubernetesRuntimeInstance := kubernetesRuntimeInstanceConfig.KubernetesRuntimeInstance
+			updatedKubernetesRuntimeInstance, err := kubernetesRuntimeInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update kubernetes runtime instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("kubernetes runtime instance %s updated", *updatedKubernetesRuntimeInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing kubernetes runtime instance",
+	SilenceUsage: true,
+	Use:          "kubernetes-runtime-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateKubernetesRuntimeInstanceCmd)
+
+	UpdateKubernetesRuntimeInstanceCmd.Flags().StringVarP(
+		&updateKubernetesRuntimeInstanceConfigPath,
+		"config", "c", "", "Path to file with kubernetes runtime instance config.",
+	)
+	UpdateKubernetesRuntimeInstanceCmd.MarkFlagRequired("config")
+	UpdateKubernetesRuntimeInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateKubernetesRuntimeInstanceCmd.Flags().StringVarP(
+		&updateKubernetesRuntimeInstanceVersion,
+		"version", "v", "v0", "Version of kubernetes runtime instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteKubernetesRuntimeInstanceConfigPath string
 	deleteKubernetesRuntimeInstanceName       string
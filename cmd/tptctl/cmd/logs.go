@@ -0,0 +1,264 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/threeport/threeport/internal/agent"
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_v0 "github.com/threeport/threeport/pkg/client/v0"
+	config "github.com/threeport/threeport/pkg/config/v0"
+	kube "github.com/threeport/threeport/pkg/kube/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+var (
+	logsFollow    bool
+	logsContainer string
+	logsSince     time.Duration
+)
+
+// LogsCmd represents the logs command
+var LogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Print the logs for Threeport workloads",
+	Long: `Print the logs for Threeport workloads.
+
+The logs command does nothing by itself.  Use one of the avilable subcommands
+to print the logs for different objects in the system.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		switch len(args) {
+		case 0:
+			missingErr("logs")
+			os.Exit(1)
+		default:
+			unknownErr("logs", args[0])
+			os.Exit(1)
+		}
+	},
+}
+
+// LogsWorkloadInstanceCmd represents the logs workload-instance command
+var LogsWorkloadInstanceCmd = &cobra.Command{
+	Use: "workload-instance NAME",
+	Example: `  # print the logs for all containers in a workload instance
+  tptctl logs workload-instance some-workload-instance
+
+  # stream the logs for the web container from the last 10 minutes
+  tptctl logs workload-instance some-workload-instance --container web --since 10m --follow`,
+	Short:        "Print the logs for a workload instance",
+	Long:         "Print the logs for the containers in each pod of a workload instance.  Each log line is prefixed with the pod and container name it came from.",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRun:       CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, threeportConfig, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		// get workload instance
+		workloadInstance, err := client_v0.GetWorkloadInstanceByName(apiClient, apiEndpoint, args[0])
+		if err != nil {
+			cli.Error("failed to retrieve workload instance", err)
+			os.Exit(1)
+		}
+		if workloadInstance.KubernetesRuntimeInstanceID == nil {
+			cli.Error("", errors.New("workload instance has not been assigned a kubernetes runtime instance yet"))
+			os.Exit(1)
+		}
+
+		// get the managed namespaces from the workload resource instances
+		workloadResourceInstances, err := client_v0.GetWorkloadResourceInstancesByWorkloadInstanceID(
+			apiClient,
+			apiEndpoint,
+			*workloadInstance.ID,
+		)
+		if err != nil {
+			cli.Error("failed to retrieve workload resource instances", err)
+			os.Exit(1)
+		}
+		var namespaces []string
+		for _, wri := range *workloadResourceInstances {
+			if wri.JSONDefinition == nil {
+				continue
+			}
+			var kubeObject unstructured.Unstructured
+			if err := kubeObject.UnmarshalJSON(*wri.JSONDefinition); err != nil {
+				cli.Error("failed to unmarshal workload resource instance JSON definition", err)
+				os.Exit(1)
+			}
+			namespace := kubeObject.GetNamespace()
+			if kubeObject.GetKind() == "Namespace" {
+				namespace = kubeObject.GetName()
+			}
+			if namespace != "" && !util.StringSliceContains(namespaces, namespace, true) {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+
+		if err := outputWorkloadLogs(
+			apiClient,
+			apiEndpoint,
+			threeportConfig,
+			requestedControlPlane,
+			*workloadInstance.KubernetesRuntimeInstanceID,
+			namespaces,
+			fmt.Sprintf("%s=%d", agent.WorkloadInstanceLabelKey, *workloadInstance.ID),
+		); err != nil {
+			cli.Error("failed to get workload instance logs", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// LogsHelmWorkloadInstanceCmd represents the logs helm-workload-instance command
+var LogsHelmWorkloadInstanceCmd = &cobra.Command{
+	Use: "helm-workload-instance NAME",
+	Example: `  # print the logs for all containers in a helm workload instance
+  tptctl logs helm-workload-instance some-helm-workload-instance
+
+  # stream the logs for all containers from the last hour
+  tptctl logs helm-workload-instance some-helm-workload-instance --since 1h --follow`,
+	Short:        "Print the logs for a helm workload instance",
+	Long:         "Print the logs for the containers in each pod of a helm workload instance.  Each log line is prefixed with the pod and container name it came from.",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRun:       CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, threeportConfig, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		// get helm workload instance
+		helmWorkloadInstance, err := client_v0.GetHelmWorkloadInstanceByName(apiClient, apiEndpoint, args[0])
+		if err != nil {
+			cli.Error("failed to retrieve helm workload instance", err)
+			os.Exit(1)
+		}
+		if helmWorkloadInstance.KubernetesRuntimeInstanceID == nil {
+			cli.Error("", errors.New("helm workload instance has not been assigned a kubernetes runtime instance yet"))
+			os.Exit(1)
+		}
+		if helmWorkloadInstance.ReleaseNamespace == nil || *helmWorkloadInstance.ReleaseNamespace == "" {
+			cli.Error("", errors.New("helm workload instance has not been assigned a release namespace"))
+			os.Exit(1)
+		}
+
+		if err := outputWorkloadLogs(
+			apiClient,
+			apiEndpoint,
+			threeportConfig,
+			requestedControlPlane,
+			*helmWorkloadInstance.KubernetesRuntimeInstanceID,
+			[]string{*helmWorkloadInstance.ReleaseNamespace},
+			fmt.Sprintf("%s=%d", agent.HelmWorkloadInstanceLabelKey, *helmWorkloadInstance.ID),
+		); err != nil {
+			cli.Error("failed to get helm workload instance logs", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(LogsCmd)
+	LogsCmd.AddCommand(LogsWorkloadInstanceCmd)
+	LogsCmd.AddCommand(LogsHelmWorkloadInstanceCmd)
+
+	for _, logsCmd := range []*cobra.Command{LogsWorkloadInstanceCmd, LogsHelmWorkloadInstanceCmd} {
+		logsCmd.Flags().StringVarP(
+			&cliArgs.ControlPlaneName,
+			"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+		)
+		logsCmd.Flags().BoolVarP(
+			&logsFollow,
+			"follow", "f", false, "Optional. Stream the logs until interrupted.",
+		)
+		logsCmd.Flags().StringVarP(
+			&logsContainer,
+			"container", "c", "", "Optional. Only print the logs for containers with this name.",
+		)
+		logsCmd.Flags().DurationVar(
+			&logsSince,
+			"since", 0, "Optional. Only print logs newer than this duration, e.g. 10m.",
+		)
+	}
+}
+
+// outputWorkloadLogs connects to the kubernetes runtime instance for a
+// workload and writes the logs for the labelled pods in the provided
+// namespaces to stdout.
+func outputWorkloadLogs(
+	apiClient *http.Client,
+	apiEndpoint string,
+	threeportConfig *config.ThreeportConfig,
+	requestedControlPlane string,
+	kubernetesRuntimeInstanceID uint,
+	namespaces []string,
+	labelSelector string,
+) error {
+	encryptionKey, err := threeportConfig.GetThreeportEncryptionKey(requestedControlPlane)
+	if err != nil {
+		return fmt.Errorf("failed to get encryption key from threeport config: %w", err)
+	}
+
+	// get kubernetes runtime instance connection
+	kubernetesRuntimeInstance, err := client_v0.GetKubernetesRuntimeInstanceByID(
+		apiClient,
+		apiEndpoint,
+		kubernetesRuntimeInstanceID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve kubernetes runtime instance: %w", err)
+	}
+	dynamicKubeClient, _, err := kube.GetClient(
+		kubernetesRuntimeInstance,
+		false,
+		apiClient,
+		apiEndpoint,
+		encryptionKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get kube client: %w", err)
+	}
+	clientset, err := kube.GetClientset(
+		kubernetesRuntimeInstance,
+		false,
+		apiClient,
+		apiEndpoint,
+		encryptionKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get kube clientset: %w", err)
+	}
+
+	// find the pod containers to get logs for
+	podContainers, err := kube.GetPodContainers(
+		dynamicKubeClient,
+		namespaces,
+		labelSelector,
+		logsContainer,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to find pods: %w", err)
+	}
+	if len(podContainers) == 0 {
+		cli.Info("No pods found for the workload")
+		return nil
+	}
+
+	logOptions := corev1.PodLogOptions{
+		Follow: logsFollow,
+	}
+	if logsSince > 0 {
+		sinceSeconds := int64(logsSince.Seconds())
+		logOptions.SinceSeconds = &sinceSeconds
+	}
+
+	return kube.StreamPodLogs(clientset, podContainers, logOptions, os.Stdout)
+}
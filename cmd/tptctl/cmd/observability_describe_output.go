@@ -169,6 +169,8 @@ func outputDescribev0ObservabilityStackDefinitionCmd(
 		)
 	}
 
+	outputDescribeConditions(observabilityStackDefinition.GetConditions())
+
 	return nil
 }
 
@@ -326,5 +328,7 @@ func outputDescribev0ObservabilityStackInstanceCmd(
 		)
 	}
 
+	outputDescribeConditions(observabilityStackInstance.GetConditions())
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // ObservabilityStackDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getObservabilityStackDefinitionVersion string
+var (
+	getObservabilityStackDefinitionVersion       string
+	getObservabilityStackDefinitionLabelSelector string
+	getObservabilityStackDefinitionOutput        string
+)
 
 // GetObservabilityStackDefinitionsCmd represents the observability-stack-definition command
 var GetObservabilityStackDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get observability-stack-definitions",
+	Example: "  # Get all observability stack definitions\n  tptctl get observability-stack-definitions\n\n  # Get JSON output for all observability stack definitions\n  tptctl get observability-stack-definitions -o json\n\n  # Get the ID and name of each observability stack definition\n  tptctl get observability-stack-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get observability stack definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getObservabilityStackDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getObservabilityStackDefinitionVersion {
 		case "v0":
 			// get observability stack definitions
-			observabilityStackDefinitions, err := client_v0.GetObservabilityStackDefinitions(apiClient, apiEndpoint)
+			observabilityStackDefinitions, err := client_v0.GetObservabilityStackDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getObservabilityStackDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve observability stack definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getObservabilityStackDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getObservabilityStackDefinitionOutput,
+					"observability-stack-definition",
+					observabilityStackDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*observabilityStackDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No observability stack definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getObservabilityStackDefinitionVersion,
 		"version", "v", "v0", "Version of observability stack definitions object to retrieve. One of: [v0]",
 	)
+	GetObservabilityStackDefinitionsCmd.Flags().StringVarP(
+		&getObservabilityStackDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter observability stack definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetObservabilityStackDefinitionsCmd.Flags().StringVarP(
+		&getObservabilityStackDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -146,6 +179,72 @@ func init() {
 	)
 }
 
+var (
+	updateObservabilityStackDefinitionConfigPath string
+	updateObservabilityStackDefinitionVersion    string
+)
+
+// UpdateObservabilityStackDefinitionCmd represents the observability-stack-definition command
+var UpdateObservabilityStackDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update observability-stack-definition --config path/to/config.yaml",
+	Long:    "Update an existing observability stack definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read observability stack definition config
+		configContent, err := os.ReadFile(updateObservabilityStackDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update observability stack definition based on version
+		switch updateObservabilityStackDefinitionVersion {
+		case "v0":
+			var observabilityStackDefinitionConfig config_v0.ObservabilityStackDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &observabilityStackDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update observability stack definition
+			observabilityStackDefinition := observabilityStackDefinitionConfig.ObservabilityStackDefinition
+			observabilityStackDefinition.ObservabilityConfigPath = &updateObservabilityStackDefinitionConfigPath
+			updatedObservabilityStackDefinition, err := observabilityStackDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update observability stack definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("observability stack definition %s updated", *updatedObservabilityStackDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing observability stack definition",
+	SilenceUsage: true,
+	Use:          "observability-stack-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateObservabilityStackDefinitionCmd)
+
+	UpdateObservabilityStackDefinitionCmd.Flags().StringVarP(
+		&updateObservabilityStackDefinitionConfigPath,
+		"config", "c", "", "Path to file with observability stack definition config.",
+	)
+	UpdateObservabilityStackDefinitionCmd.MarkFlagRequired("config")
+	UpdateObservabilityStackDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateObservabilityStackDefinitionCmd.Flags().StringVarP(
+		&updateObservabilityStackDefinitionVersion,
+		"version", "v", "v0", "Version of observability stack definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteObservabilityStackDefinitionConfigPath string
 	deleteObservabilityStackDefinitionName       string
@@ -435,22 +534,47 @@ func init() {
 // ObservabilityStack
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getObservabilityStackLabelSelector string
+	getObservabilityStackOutput        string
+)
+
 // GetObservabilityStacksCmd represents the observability-stack command
 var GetObservabilityStacksCmd = &cobra.Command{
-	Example: "  tptctl get observability-stacks",
+	Example: "  # Get all observability stacks\n  tptctl get observability-stacks\n\n  # Get JSON output for all observability stacks\n  tptctl get observability-stacks -o json\n\n  # Get the name of each observability stack instance\n  tptctl get observability-stacks -o jsonpath='{.items[*].Name}'",
 	Long:    "Get observability stacks from the system.\n\nA observability stack is a simple abstraction of observability stack definitions and observability stack instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getObservabilityStackOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get observability stacks
-		v0observabilityStackInstances, err := client_v0.GetObservabilityStackInstances(apiClient, apiEndpoint)
+		v0observabilityStackInstances, err := client_v0.GetObservabilityStackInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getObservabilityStackLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve observability stack instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getObservabilityStackOutput != "" {
+			if err := cli.OutputGetObjects(
+				getObservabilityStackOutput,
+				"observability-stack-instance",
+				v0observabilityStackInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0observabilityStackInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No observability stack instances currently managed by %s threeport control plane",
@@ -479,6 +603,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetObservabilityStacksCmd.Flags().StringVarP(
+		&getObservabilityStackLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter observability stack instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetObservabilityStacksCmd.Flags().StringVarP(
+		&getObservabilityStackOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -553,6 +685,78 @@ func init() {
 	)
 }
 
+var (
+	updateObservabilityStackConfigPath string
+	updateObservabilityStackVersion    string
+)
+
+// UpdateObservabilityStackCmd represents the observability-stack command
+var UpdateObservabilityStackCmd = &cobra.Command{
+	Example: "  tptctl update observability-stack --config path/to/config.yaml",
+	Long:    "Update an existing observability stack. This command updates the observability stack definition and observability stack instance in place based on the observability stack config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read observability stack config
+		configContent, err := os.ReadFile(updateObservabilityStackConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update observability stack based on version
+		switch updateObservabilityStackVersion {
+		case "v0":
+			var observabilityStackConfig config_v0.ObservabilityStackConfig
+			if err := yaml.UnmarshalStrict(configContent, &observabilityStackConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update observability stack
+			observabilityStack := observabilityStackConfig.ObservabilityStack
+			observabilityStack.ObservabilityConfigPath = &updateObservabilityStackConfigPath
+			updatedObservabilityStackDefinition, updatedObservabilityStackInstance, err := observabilityStack.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update observability stack", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("observability stack definition %s updated", *updatedObservabilityStackDefinition.Name))
+			cli.Info(fmt.Sprintf("observability stack instance %s updated", *updatedObservabilityStackInstance.Name))
+			cli.Complete(fmt.Sprintf("observability stack %s updated", *observabilityStackConfig.ObservabilityStack.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing observability stack",
+	SilenceUsage: true,
+	Use:          "observability-stack",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateObservabilityStackCmd)
+
+	UpdateObservabilityStackCmd.Flags().StringVarP(
+		&updateObservabilityStackConfigPath,
+		"config", "c", "", "Path to file with observability stack config.",
+	)
+	UpdateObservabilityStackCmd.MarkFlagRequired("config")
+	UpdateObservabilityStackCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateObservabilityStackCmd.Flags().StringVarP(
+		&updateObservabilityStackVersion,
+		"version", "v", "v0", "Version of observability stacks object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteObservabilityStackConfigPath string
 	deleteObservabilityStackName       string
@@ -631,26 +835,50 @@ func init() {
 // ObservabilityStackInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getObservabilityStackInstanceVersion string
+var (
+	getObservabilityStackInstanceVersion       string
+	getObservabilityStackInstanceLabelSelector string
+	getObservabilityStackInstanceOutput        string
+)
 
 // GetObservabilityStackInstancesCmd represents the observability-stack-instance command
 var GetObservabilityStackInstancesCmd = &cobra.Command{
-	Example: "  tptctl get observability-stack-instances",
+	Example: "  # Get all observability stack instances\n  tptctl get observability-stack-instances\n\n  # Get JSON output for all observability stack instances\n  tptctl get observability-stack-instances -o json\n\n  # Get the ID and name of each observability stack instance\n  tptctl get observability-stack-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get observability stack instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getObservabilityStackInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getObservabilityStackInstanceVersion {
 		case "v0":
 			// get observability stack instances
-			observabilityStackInstances, err := client_v0.GetObservabilityStackInstances(apiClient, apiEndpoint)
+			observabilityStackInstances, err := client_v0.GetObservabilityStackInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getObservabilityStackInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve observability stack instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getObservabilityStackInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getObservabilityStackInstanceOutput,
+					"observability-stack-instance",
+					observabilityStackInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*observabilityStackInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No observability stack instances currently managed by %s threeport control plane",
@@ -687,6 +915,14 @@ func init() {
 		&getObservabilityStackInstanceVersion,
 		"version", "v", "v0", "Version of observability stack instances object to retrieve. One of: [v0]",
 	)
+	GetObservabilityStackInstancesCmd.Flags().StringVarP(
+		&getObservabilityStackInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter observability stack instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetObservabilityStackInstancesCmd.Flags().StringVarP(
+		&getObservabilityStackInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -755,6 +991,72 @@ func init() {
 	)
 }
 
+var (
+	updateObservabilityStackInstanceConfigPath string
+	updateObservabilityStackInstanceVersion    string
+)
+
+// UpdateObservabilityStackInstanceCmd represents the observability-stack-instance command
+var UpdateObservabilityStackInstanceCmd = &cobra.Command{
+	Example: "  tptctl update observability-stack-instance --config path/to/config.yaml",
+	Long:    "Update an existing observability stack instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read observability stack instance config
+		configContent, err := os.ReadFile(updateObservabilityStackInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update observability stack instance based on version
+		switch updateObservabilityStackInstanceVersion {
+		case "v0":
+			var observabilityStackInstanceConfig config_v0.ObservabilityStackInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &observabilityStackInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update observability stack instance
+			observabilityStackInstance := observabilityStackInstanceConfig.ObservabilityStackInstance
+			observabilityStackInstance.ObservabilityConfigPath = &updateObservabilityStackInstanceConfigPath
+			updatedObservabilityStackInstance, err := observabilityStackInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update observability stack instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("observability stack instance %s updated", *updatedObservabilityStackInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing observability stack instance",
+	SilenceUsage: true,
+	Use:          "observability-stack-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateObservabilityStackInstanceCmd)
+
+	UpdateObservabilityStackInstanceCmd.Flags().StringVarP(
+		&updateObservabilityStackInstanceConfigPath,
+		"config", "c", "", "Path to file with observability stack instance config.",
+	)
+	UpdateObservabilityStackInstanceCmd.MarkFlagRequired("config")
+	UpdateObservabilityStackInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateObservabilityStackInstanceCmd.Flags().StringVarP(
+		&updateObservabilityStackInstanceVersion,
+		"version", "v", "v0", "Version of observability stack instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteObservabilityStackInstanceConfigPath string
 	deleteObservabilityStackInstanceName       string
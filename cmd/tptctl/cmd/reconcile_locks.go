@@ -0,0 +1,112 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_v0 "github.com/threeport/threeport/pkg/client/v0"
+)
+
+var (
+	unlockReconcilerName string
+	unlockObjectID       uint
+)
+
+// GetReconcileLocksCmd represents the reconcile-locks command
+var GetReconcileLocksCmd = &cobra.Command{
+	Example: "  tptctl get reconcile-locks",
+	Long: `Get the locks held by controllers on the objects they are reconciling.
+
+Controllers renew their locks while reconciling.  A lock that has not been
+renewed recently is stale and will be taken over by the next controller that
+reconciles the object.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
+
+		// get reconcile locks
+		reconcileLocks, err := client_v0.GetReconcileLocks(apiClient, apiEndpoint)
+		if err != nil {
+			cli.Error("failed to retrieve reconcile locks", err)
+			os.Exit(1)
+		}
+
+		// write the output
+		if len(*reconcileLocks) == 0 {
+			cli.Info(fmt.Sprintf(
+				"No reconcile locks found on %s threeport control plane",
+				requestedControlPlane,
+			))
+			os.Exit(0)
+		}
+		if err := outputGetReconcileLocksCmd(reconcileLocks); err != nil {
+			cli.Error("failed to produce output", err)
+			os.Exit(0)
+		}
+	},
+	Short:        "Get reconcile locks from the system",
+	SilenceUsage: true,
+	Use:          "reconcile-locks",
+}
+
+// UnlockCmd represents the unlock command
+var UnlockCmd = &cobra.Command{
+	Example: "  tptctl unlock --reconciler WorkloadInstanceReconciler --object-id 5",
+	Long: `Forcibly release the lock held by a reconciler on an object.
+
+Use this to recover objects whose reconciliation is blocked by a lock.  If a
+controller is still reconciling the object, it loses its lease on the lock and
+the object may be reconciled by another controller at the same time.`,
+	PreRun: CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// release the lock
+		reconcileLock, err := client_v0.DeleteReconcileLock(apiClient, apiEndpoint, unlockReconcilerName, unlockObjectID)
+		if err != nil {
+			cli.Error("failed to release reconcile lock", err)
+			os.Exit(1)
+		}
+
+		cli.Complete(fmt.Sprintf(
+			"lock held by controller %s on object with ID %d released for %s",
+			stringOrDash(reconcileLock.ControllerID),
+			unlockObjectID,
+			unlockReconcilerName,
+		))
+	},
+	Short:        "Forcibly release a reconcile lock",
+	SilenceUsage: true,
+	Use:          "unlock",
+}
+
+func init() {
+	GetCmd.AddCommand(GetReconcileLocksCmd)
+	rootCmd.AddCommand(UnlockCmd)
+
+	GetReconcileLocksCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+
+	UnlockCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UnlockCmd.Flags().StringVar(
+		&unlockReconcilerName,
+		"reconciler", "", "Required. Name of the reconciler that holds the lock, e.g. WorkloadInstanceReconciler.",
+	)
+	UnlockCmd.Flags().UintVar(
+		&unlockObjectID,
+		"object-id", 0, "Required. ID of the locked object.",
+	)
+	UnlockCmd.MarkFlagRequired("reconciler")
+	UnlockCmd.MarkFlagRequired("object-id")
+}
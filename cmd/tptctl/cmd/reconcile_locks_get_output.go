@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// outputGetReconcileLocksCmd produces the tabular output for the
+// 'tptctl get reconcile-locks' command.
+func outputGetReconcileLocksCmd(reconcileLocks *[]v0.ReconcileLock) error {
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, "RECONCILER\t OBJECT ID\t CONTROLLER ID\t ACQUIRED\t RENEWED\t STALE")
+	for _, reconcileLock := range *reconcileLocks {
+		acquiredTime := "-"
+		if reconcileLock.AcquiredAt != nil {
+			acquiredTime = reconcileLock.AcquiredAt.Format(time.RFC3339)
+		}
+		renewedTime := "-"
+		if reconcileLock.RenewedAt != nil {
+			renewedTime = reconcileLock.RenewedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintln(
+			writer,
+			stringOrDash(reconcileLock.ReconcilerName), "\t",
+			uintOrDash(reconcileLock.ObjectID), "\t",
+			stringOrDash(reconcileLock.ControllerID), "\t",
+			acquiredTime, "\t",
+			renewedTime, "\t",
+			reconcileLock.Stale(),
+		)
+	}
+	writer.Flush()
+
+	return nil
+}
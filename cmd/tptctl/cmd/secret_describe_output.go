@@ -32,6 +32,8 @@ func outputDescribev0SecretDefinitionCmd(
 		*secretDefinition.UpdatedAt,
 	)
 
+	outputDescribeConditions(secretDefinition.GetConditions())
+
 	return nil
 }
 
@@ -57,5 +59,7 @@ func outputDescribev0SecretInstanceCmd(
 		*secretInstance.UpdatedAt,
 	)
 
+	outputDescribeConditions(secretInstance.GetConditions())
+
 	return nil
 }
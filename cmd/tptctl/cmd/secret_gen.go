@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // SecretDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getSecretDefinitionVersion string
+var (
+	getSecretDefinitionVersion       string
+	getSecretDefinitionLabelSelector string
+	getSecretDefinitionOutput        string
+)
 
 // GetSecretDefinitionsCmd represents the secret-definition command
 var GetSecretDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get secret-definitions",
+	Example: "  # Get all secret definitions\n  tptctl get secret-definitions\n\n  # Get JSON output for all secret definitions\n  tptctl get secret-definitions -o json\n\n  # Get the ID and name of each secret definition\n  tptctl get secret-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get secret definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getSecretDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getSecretDefinitionVersion {
 		case "v0":
 			// get secret definitions
-			secretDefinitions, err := client_v0.GetSecretDefinitions(apiClient, apiEndpoint)
+			secretDefinitions, err := client_v0.GetSecretDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getSecretDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve secret definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getSecretDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getSecretDefinitionOutput,
+					"secret-definition",
+					secretDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*secretDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No secret definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getSecretDefinitionVersion,
 		"version", "v", "v0", "Version of secret definitions object to retrieve. One of: [v0]",
 	)
+	GetSecretDefinitionsCmd.Flags().StringVarP(
+		&getSecretDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter secret definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetSecretDefinitionsCmd.Flags().StringVarP(
+		&getSecretDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -146,6 +179,72 @@ func init() {
 	)
 }
 
+var (
+	updateSecretDefinitionConfigPath string
+	updateSecretDefinitionVersion    string
+)
+
+// UpdateSecretDefinitionCmd represents the secret-definition command
+var UpdateSecretDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update secret-definition --config path/to/config.yaml",
+	Long:    "Update an existing secret definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read secret definition config
+		configContent, err := os.ReadFile(updateSecretDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update secret definition based on version
+		switch updateSecretDefinitionVersion {
+		case "v0":
+			var secretDefinitionConfig config_v0.SecretDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &secretDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update secret definition
+			secretDefinition := secretDefinitionConfig.SecretDefinition
+			secretDefinition.SecretConfigPath = &updateSecretDefinitionConfigPath
+			updatedSecretDefinition, err := secretDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update secret definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("secret definition %s updated", *updatedSecretDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing secret definition",
+	SilenceUsage: true,
+	Use:          "secret-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateSecretDefinitionCmd)
+
+	UpdateSecretDefinitionCmd.Flags().StringVarP(
+		&updateSecretDefinitionConfigPath,
+		"config", "c", "", "Path to file with secret definition config.",
+	)
+	UpdateSecretDefinitionCmd.MarkFlagRequired("config")
+	UpdateSecretDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateSecretDefinitionCmd.Flags().StringVarP(
+		&updateSecretDefinitionVersion,
+		"version", "v", "v0", "Version of secret definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteSecretDefinitionConfigPath string
 	deleteSecretDefinitionName       string
@@ -435,22 +534,47 @@ func init() {
 // Secret
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getSecretLabelSelector string
+	getSecretOutput        string
+)
+
 // GetSecretsCmd represents the secret command
 var GetSecretsCmd = &cobra.Command{
-	Example: "  tptctl get secrets",
+	Example: "  # Get all secrets\n  tptctl get secrets\n\n  # Get JSON output for all secrets\n  tptctl get secrets -o json\n\n  # Get the name of each secret instance\n  tptctl get secrets -o jsonpath='{.items[*].Name}'",
 	Long:    "Get secrets from the system.\n\nA secret is a simple abstraction of secret definitions and secret instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getSecretOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get secrets
-		v0secretInstances, err := client_v0.GetSecretInstances(apiClient, apiEndpoint)
+		v0secretInstances, err := client_v0.GetSecretInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getSecretLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve secret instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getSecretOutput != "" {
+			if err := cli.OutputGetObjects(
+				getSecretOutput,
+				"secret-instance",
+				v0secretInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0secretInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No secret instances currently managed by %s threeport control plane",
@@ -479,6 +603,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetSecretsCmd.Flags().StringVarP(
+		&getSecretLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter secret instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetSecretsCmd.Flags().StringVarP(
+		&getSecretOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -553,6 +685,78 @@ func init() {
 	)
 }
 
+var (
+	updateSecretConfigPath string
+	updateSecretVersion    string
+)
+
+// UpdateSecretCmd represents the secret command
+var UpdateSecretCmd = &cobra.Command{
+	Example: "  tptctl update secret --config path/to/config.yaml",
+	Long:    "Update an existing secret. This command updates the secret definition and secret instance in place based on the secret config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read secret config
+		configContent, err := os.ReadFile(updateSecretConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update secret based on version
+		switch updateSecretVersion {
+		case "v0":
+			var secretConfig config_v0.SecretConfig
+			if err := yaml.UnmarshalStrict(configContent, &secretConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update secret
+			secret := secretConfig.Secret
+			secret.SecretConfigPath = &updateSecretConfigPath
+			updatedSecretDefinition, updatedSecretInstance, err := secret.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update secret", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("secret definition %s updated", *updatedSecretDefinition.Name))
+			cli.Info(fmt.Sprintf("secret instance %s updated", *updatedSecretInstance.Name))
+			cli.Complete(fmt.Sprintf("secret %s updated", *secretConfig.Secret.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing secret",
+	SilenceUsage: true,
+	Use:          "secret",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateSecretCmd)
+
+	UpdateSecretCmd.Flags().StringVarP(
+		&updateSecretConfigPath,
+		"config", "c", "", "Path to file with secret config.",
+	)
+	UpdateSecretCmd.MarkFlagRequired("config")
+	UpdateSecretCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateSecretCmd.Flags().StringVarP(
+		&updateSecretVersion,
+		"version", "v", "v0", "Version of secrets object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteSecretConfigPath string
 	deleteSecretName       string
@@ -631,26 +835,50 @@ func init() {
 // SecretInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getSecretInstanceVersion string
+var (
+	getSecretInstanceVersion       string
+	getSecretInstanceLabelSelector string
+	getSecretInstanceOutput        string
+)
 
 // GetSecretInstancesCmd represents the secret-instance command
 var GetSecretInstancesCmd = &cobra.Command{
-	Example: "  tptctl get secret-instances",
+	Example: "  # Get all secret instances\n  tptctl get secret-instances\n\n  # Get JSON output for all secret instances\n  tptctl get secret-instances -o json\n\n  # Get the ID and name of each secret instance\n  tptctl get secret-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get secret instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getSecretInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getSecretInstanceVersion {
 		case "v0":
 			// get secret instances
-			secretInstances, err := client_v0.GetSecretInstances(apiClient, apiEndpoint)
+			secretInstances, err := client_v0.GetSecretInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getSecretInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve secret instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getSecretInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getSecretInstanceOutput,
+					"secret-instance",
+					secretInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*secretInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No secret instances currently managed by %s threeport control plane",
@@ -687,6 +915,14 @@ func init() {
 		&getSecretInstanceVersion,
 		"version", "v", "v0", "Version of secret instances object to retrieve. One of: [v0]",
 	)
+	GetSecretInstancesCmd.Flags().StringVarP(
+		&getSecretInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter secret instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetSecretInstancesCmd.Flags().StringVarP(
+		&getSecretInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -755,6 +991,72 @@ func init() {
 	)
 }
 
+var (
+	updateSecretInstanceConfigPath string
+	updateSecretInstanceVersion    string
+)
+
+// UpdateSecretInstanceCmd represents the secret-instance command
+var UpdateSecretInstanceCmd = &cobra.Command{
+	Example: "  tptctl update secret-instance --config path/to/config.yaml",
+	Long:    "Update an existing secret instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read secret instance config
+		configContent, err := os.ReadFile(updateSecretInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update secret instance based on version
+		switch updateSecretInstanceVersion {
+		case "v0":
+			var secretInstanceConfig config_v0.SecretInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &secretInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update secret instance
+			secretInstance := secretInstanceConfig.SecretInstance
+			secretInstance.SecretConfigPath = &updateSecretInstanceConfigPath
+			updatedSecretInstance, err := secretInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update secret instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("secret instance %s updated", *updatedSecretInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing secret instance",
+	SilenceUsage: true,
+	Use:          "secret-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateSecretInstanceCmd)
+
+	UpdateSecretInstanceCmd.Flags().StringVarP(
+		&updateSecretInstanceConfigPath,
+		"config", "c", "", "Path to file with secret instance config.",
+	)
+	UpdateSecretInstanceCmd.MarkFlagRequired("config")
+	UpdateSecretInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateSecretInstanceCmd.Flags().StringVarP(
+		&updateSecretInstanceVersion,
+		"version", "v", "v0", "Version of secret instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteSecretInstanceConfigPath string
 	deleteSecretInstanceName       string
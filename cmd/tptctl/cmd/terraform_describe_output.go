@@ -46,6 +46,8 @@ func outputDescribev0TerraformDefinitionCmd(
 		}
 	}
 
+	outputDescribeConditions(terraformDefinition.GetConditions())
+
 	return nil
 }
 
@@ -84,5 +86,7 @@ func outputDescribev0TerraformInstanceCmd(
 		*terraformStatus.TerraformDefinition.Name,
 	)
 
+	outputDescribeConditions(terraformInstance.GetConditions())
+
 	return nil
 }
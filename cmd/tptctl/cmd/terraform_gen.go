@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // TerraformDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getTerraformDefinitionVersion string
+var (
+	getTerraformDefinitionVersion       string
+	getTerraformDefinitionLabelSelector string
+	getTerraformDefinitionOutput        string
+)
 
 // GetTerraformDefinitionsCmd represents the terraform-definition command
 var GetTerraformDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get terraform-definitions",
+	Example: "  # Get all terraform definitions\n  tptctl get terraform-definitions\n\n  # Get JSON output for all terraform definitions\n  tptctl get terraform-definitions -o json\n\n  # Get the ID and name of each terraform definition\n  tptctl get terraform-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get terraform definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getTerraformDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getTerraformDefinitionVersion {
 		case "v0":
 			// get terraform definitions
-			terraformDefinitions, err := client_v0.GetTerraformDefinitions(apiClient, apiEndpoint)
+			terraformDefinitions, err := client_v0.GetTerraformDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getTerraformDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve terraform definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getTerraformDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getTerraformDefinitionOutput,
+					"terraform-definition",
+					terraformDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*terraformDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No terraform definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getTerraformDefinitionVersion,
 		"version", "v", "v0", "Version of terraform definitions object to retrieve. One of: [v0]",
 	)
+	GetTerraformDefinitionsCmd.Flags().StringVarP(
+		&getTerraformDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter terraform definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetTerraformDefinitionsCmd.Flags().StringVarP(
+		&getTerraformDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -146,6 +179,72 @@ func init() {
 	)
 }
 
+var (
+	updateTerraformDefinitionConfigPath string
+	updateTerraformDefinitionVersion    string
+)
+
+// UpdateTerraformDefinitionCmd represents the terraform-definition command
+var UpdateTerraformDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update terraform-definition --config path/to/config.yaml",
+	Long:    "Update an existing terraform definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read terraform definition config
+		configContent, err := os.ReadFile(updateTerraformDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update terraform definition based on version
+		switch updateTerraformDefinitionVersion {
+		case "v0":
+			var terraformDefinitionConfig config_v0.TerraformDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &terraformDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update terraform definition
+			terraformDefinition := terraformDefinitionConfig.TerraformDefinition
+			terraformDefinition.TerraformConfigPath = &updateTerraformDefinitionConfigPath
+			updatedTerraformDefinition, err := terraformDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update terraform definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("terraform definition %s updated", *updatedTerraformDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing terraform definition",
+	SilenceUsage: true,
+	Use:          "terraform-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateTerraformDefinitionCmd)
+
+	UpdateTerraformDefinitionCmd.Flags().StringVarP(
+		&updateTerraformDefinitionConfigPath,
+		"config", "c", "", "Path to file with terraform definition config.",
+	)
+	UpdateTerraformDefinitionCmd.MarkFlagRequired("config")
+	UpdateTerraformDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateTerraformDefinitionCmd.Flags().StringVarP(
+		&updateTerraformDefinitionVersion,
+		"version", "v", "v0", "Version of terraform definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteTerraformDefinitionConfigPath string
 	deleteTerraformDefinitionName       string
@@ -435,22 +534,47 @@ func init() {
 // Terraform
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getTerraformLabelSelector string
+	getTerraformOutput        string
+)
+
 // GetTerraformsCmd represents the terraform command
 var GetTerraformsCmd = &cobra.Command{
-	Example: "  tptctl get terraforms",
+	Example: "  # Get all terraforms\n  tptctl get terraforms\n\n  # Get JSON output for all terraforms\n  tptctl get terraforms -o json\n\n  # Get the name of each terraform instance\n  tptctl get terraforms -o jsonpath='{.items[*].Name}'",
 	Long:    "Get terraforms from the system.\n\nA terraform is a simple abstraction of terraform definitions and terraform instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getTerraformOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get terraforms
-		v0terraformInstances, err := client_v0.GetTerraformInstances(apiClient, apiEndpoint)
+		v0terraformInstances, err := client_v0.GetTerraformInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getTerraformLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve terraform instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getTerraformOutput != "" {
+			if err := cli.OutputGetObjects(
+				getTerraformOutput,
+				"terraform-instance",
+				v0terraformInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0terraformInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No terraform instances currently managed by %s threeport control plane",
@@ -479,6 +603,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetTerraformsCmd.Flags().StringVarP(
+		&getTerraformLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter terraform instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetTerraformsCmd.Flags().StringVarP(
+		&getTerraformOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -553,6 +685,78 @@ func init() {
 	)
 }
 
+var (
+	updateTerraformConfigPath string
+	updateTerraformVersion    string
+)
+
+// UpdateTerraformCmd represents the terraform command
+var UpdateTerraformCmd = &cobra.Command{
+	Example: "  tptctl update terraform --config path/to/config.yaml",
+	Long:    "Update an existing terraform. This command updates the terraform definition and terraform instance in place based on the terraform config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read terraform config
+		configContent, err := os.ReadFile(updateTerraformConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update terraform based on version
+		switch updateTerraformVersion {
+		case "v0":
+			var terraformConfig config_v0.TerraformConfig
+			if err := yaml.UnmarshalStrict(configContent, &terraformConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update terraform
+			terraform := terraformConfig.Terraform
+			terraform.TerraformConfigPath = &updateTerraformConfigPath
+			updatedTerraformDefinition, updatedTerraformInstance, err := terraform.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update terraform", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("terraform definition %s updated", *updatedTerraformDefinition.Name))
+			cli.Info(fmt.Sprintf("terraform instance %s updated", *updatedTerraformInstance.Name))
+			cli.Complete(fmt.Sprintf("terraform %s updated", *terraformConfig.Terraform.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing terraform",
+	SilenceUsage: true,
+	Use:          "terraform",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateTerraformCmd)
+
+	UpdateTerraformCmd.Flags().StringVarP(
+		&updateTerraformConfigPath,
+		"config", "c", "", "Path to file with terraform config.",
+	)
+	UpdateTerraformCmd.MarkFlagRequired("config")
+	UpdateTerraformCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateTerraformCmd.Flags().StringVarP(
+		&updateTerraformVersion,
+		"version", "v", "v0", "Version of terraforms object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteTerraformConfigPath string
 	deleteTerraformName       string
@@ -631,26 +835,50 @@ func init() {
 // TerraformInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getTerraformInstanceVersion string
+var (
+	getTerraformInstanceVersion       string
+	getTerraformInstanceLabelSelector string
+	getTerraformInstanceOutput        string
+)
 
 // GetTerraformInstancesCmd represents the terraform-instance command
 var GetTerraformInstancesCmd = &cobra.Command{
-	Example: "  tptctl get terraform-instances",
+	Example: "  # Get all terraform instances\n  tptctl get terraform-instances\n\n  # Get JSON output for all terraform instances\n  tptctl get terraform-instances -o json\n\n  # Get the ID and name of each terraform instance\n  tptctl get terraform-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get terraform instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getTerraformInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getTerraformInstanceVersion {
 		case "v0":
 			// get terraform instances
-			terraformInstances, err := client_v0.GetTerraformInstances(apiClient, apiEndpoint)
+			terraformInstances, err := client_v0.GetTerraformInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getTerraformInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve terraform instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getTerraformInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getTerraformInstanceOutput,
+					"terraform-instance",
+					terraformInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*terraformInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No terraform instances currently managed by %s threeport control plane",
@@ -687,6 +915,14 @@ func init() {
 		&getTerraformInstanceVersion,
 		"version", "v", "v0", "Version of terraform instances object to retrieve. One of: [v0]",
 	)
+	GetTerraformInstancesCmd.Flags().StringVarP(
+		&getTerraformInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter terraform instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetTerraformInstancesCmd.Flags().StringVarP(
+		&getTerraformInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -755,6 +991,72 @@ func init() {
 	)
 }
 
+var (
+	updateTerraformInstanceConfigPath string
+	updateTerraformInstanceVersion    string
+)
+
+// UpdateTerraformInstanceCmd represents the terraform-instance command
+var UpdateTerraformInstanceCmd = &cobra.Command{
+	Example: "  tptctl update terraform-instance --config path/to/config.yaml",
+	Long:    "Update an existing terraform instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read terraform instance config
+		configContent, err := os.ReadFile(updateTerraformInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update terraform instance based on version
+		switch updateTerraformInstanceVersion {
+		case "v0":
+			var terraformInstanceConfig config_v0.TerraformInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &terraformInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update terraform instance
+			terraformInstance := terraformInstanceConfig.TerraformInstance
+			terraformInstance.TerraformConfigPath = &updateTerraformInstanceConfigPath
+			updatedTerraformInstance, err := terraformInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update terraform instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("terraform instance %s updated", *updatedTerraformInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing terraform instance",
+	SilenceUsage: true,
+	Use:          "terraform-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateTerraformInstanceCmd)
+
+	UpdateTerraformInstanceCmd.Flags().StringVarP(
+		&updateTerraformInstanceConfigPath,
+		"config", "c", "", "Path to file with terraform instance config.",
+	)
+	UpdateTerraformInstanceCmd.MarkFlagRequired("config")
+	UpdateTerraformInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateTerraformInstanceCmd.Flags().StringVarP(
+		&updateTerraformInstanceVersion,
+		"version", "v", "v0", "Version of terraform instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteTerraformInstanceConfigPath string
 	deleteTerraformInstanceName       string
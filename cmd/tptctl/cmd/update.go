@@ -0,0 +1,34 @@
+/*
+Copyright © 2023 Threeport admin@threeport.io
+*/
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// UpdateCmd represents the update command
+var UpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update Threeport objects",
+	Long: `Update Threeport objects.
+
+The update command does nothing by itself.  Use one of the avilable subcommands
+to update different objects in the system.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		switch len(args) {
+		case 0:
+			missingErr("update")
+			os.Exit(1)
+		default:
+			unknownErr("update", args[0])
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(UpdateCmd)
+}
@@ -51,6 +51,8 @@ func outputDescribev0WorkloadDefinitionCmd(
 		}
 	}
 
+	outputDescribeConditions(workloadDefinition.GetConditions())
+
 	return nil
 }
 
@@ -108,6 +110,8 @@ func outputDescribev0WorkloadInstanceCmd(
 		writer.Flush()
 	}
 
+	outputDescribeConditions(workloadInstance.GetConditions())
+
 	return nil
 }
 
@@ -165,5 +169,7 @@ func outputDescribev1WorkloadInstanceCmd(
 		writer.Flush()
 	}
 
+	outputDescribeConditions(workloadInstance.GetConditions())
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	config_v0 "github.com/threeport/threeport/pkg/config/v0"
 	encryption "github.com/threeport/threeport/pkg/encryption/v0"
@@ -22,26 +23,50 @@ import (
 // WorkloadDefinition
 ///////////////////////////////////////////////////////////////////////////////
 
-var getWorkloadDefinitionVersion string
+var (
+	getWorkloadDefinitionVersion       string
+	getWorkloadDefinitionLabelSelector string
+	getWorkloadDefinitionOutput        string
+)
 
 // GetWorkloadDefinitionsCmd represents the workload-definition command
 var GetWorkloadDefinitionsCmd = &cobra.Command{
-	Example: "  tptctl get workload-definitions",
+	Example: "  # Get all workload definitions\n  tptctl get workload-definitions\n\n  # Get JSON output for all workload definitions\n  tptctl get workload-definitions -o json\n\n  # Get the ID and name of each workload definition\n  tptctl get workload-definitions -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get workload definitions from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getWorkloadDefinitionOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getWorkloadDefinitionVersion {
 		case "v0":
 			// get workload definitions
-			workloadDefinitions, err := client_v0.GetWorkloadDefinitions(apiClient, apiEndpoint)
+			workloadDefinitions, err := client_v0.GetWorkloadDefinitionsWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getWorkloadDefinitionLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve workload definitions", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getWorkloadDefinitionOutput != "" {
+				if err := cli.OutputGetObjects(
+					getWorkloadDefinitionOutput,
+					"workload-definition",
+					workloadDefinitions,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*workloadDefinitions) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No workload definitions currently managed by %s threeport control plane",
@@ -78,6 +103,14 @@ func init() {
 		&getWorkloadDefinitionVersion,
 		"version", "v", "v0", "Version of workload definitions object to retrieve. One of: [v0]",
 	)
+	GetWorkloadDefinitionsCmd.Flags().StringVarP(
+		&getWorkloadDefinitionLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter workload definitions by, e.g. team=payments,tier!=dev.",
+	)
+	GetWorkloadDefinitionsCmd.Flags().StringVarP(
+		&getWorkloadDefinitionOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -146,6 +179,72 @@ func init() {
 	)
 }
 
+var (
+	updateWorkloadDefinitionConfigPath string
+	updateWorkloadDefinitionVersion    string
+)
+
+// UpdateWorkloadDefinitionCmd represents the workload-definition command
+var UpdateWorkloadDefinitionCmd = &cobra.Command{
+	Example: "  tptctl update workload-definition --config path/to/config.yaml",
+	Long:    "Update an existing workload definition in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read workload definition config
+		configContent, err := os.ReadFile(updateWorkloadDefinitionConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update workload definition based on version
+		switch updateWorkloadDefinitionVersion {
+		case "v0":
+			var workloadDefinitionConfig config_v0.WorkloadDefinitionConfig
+			if err := yaml.UnmarshalStrict(configContent, &workloadDefinitionConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update workload definition
+			workloadDefinition := workloadDefinitionConfig.WorkloadDefinition
+			workloadDefinition.WorkloadConfigPath = &updateWorkloadDefinitionConfigPath
+			updatedWorkloadDefinition, err := workloadDefinition.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update workload definition", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("workload definition %s updated", *updatedWorkloadDefinition.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing workload definition",
+	SilenceUsage: true,
+	Use:          "workload-definition",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateWorkloadDefinitionCmd)
+
+	UpdateWorkloadDefinitionCmd.Flags().StringVarP(
+		&updateWorkloadDefinitionConfigPath,
+		"config", "c", "", "Path to file with workload definition config.",
+	)
+	UpdateWorkloadDefinitionCmd.MarkFlagRequired("config")
+	UpdateWorkloadDefinitionCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateWorkloadDefinitionCmd.Flags().StringVarP(
+		&updateWorkloadDefinitionVersion,
+		"version", "v", "v0", "Version of workload definitions object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteWorkloadDefinitionConfigPath string
 	deleteWorkloadDefinitionName       string
@@ -435,22 +534,47 @@ func init() {
 // Workload
 ///////////////////////////////////////////////////////////////////////////////
 
+var (
+	getWorkloadLabelSelector string
+	getWorkloadOutput        string
+)
+
 // GetWorkloadsCmd represents the workload command
 var GetWorkloadsCmd = &cobra.Command{
-	Example: "  tptctl get workloads",
+	Example: "  # Get all workloads\n  tptctl get workloads\n\n  # Get JSON output for all workloads\n  tptctl get workloads -o json\n\n  # Get the name of each workload instance\n  tptctl get workloads -o jsonpath='{.items[*].Name}'",
 	Long:    "Get workloads from the system.\n\nA workload is a simple abstraction of workload definitions and workload instances.\nThis command displays all instances and the definitions used to configure them.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getWorkloadOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		// get workloads
-		v0workloadInstances, err := client_v0.GetWorkloadInstances(apiClient, apiEndpoint)
+		v0workloadInstances, err := client_v0.GetWorkloadInstancesWithOptions(
+			apiClient,
+			apiEndpoint,
+			client_lib.WithLabelSelector(getWorkloadLabelSelector),
+		)
 		if err != nil {
 			cli.Error("failed to retrieve workload instances", err)
 			os.Exit(1)
 		}
 
 		// write the output
+		if getWorkloadOutput != "" {
+			if err := cli.OutputGetObjects(
+				getWorkloadOutput,
+				"workload-instance",
+				v0workloadInstances,
+			); err != nil {
+				cli.Error("failed to produce output", err)
+				os.Exit(1)
+			}
+			return
+		}
 		if len(*v0workloadInstances) == 0 {
 			cli.Info(fmt.Sprintf(
 				"No workload instances currently managed by %s threeport control plane",
@@ -479,6 +603,14 @@ func init() {
 		&cliArgs.ControlPlaneName,
 		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
 	)
+	GetWorkloadsCmd.Flags().StringVarP(
+		&getWorkloadLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter workload instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetWorkloadsCmd.Flags().StringVarP(
+		&getWorkloadOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -553,6 +685,78 @@ func init() {
 	)
 }
 
+var (
+	updateWorkloadConfigPath string
+	updateWorkloadVersion    string
+)
+
+// UpdateWorkloadCmd represents the workload command
+var UpdateWorkloadCmd = &cobra.Command{
+	Example: "  tptctl update workload --config path/to/config.yaml",
+	Long:    "Update an existing workload. This command updates the workload definition and workload instance in place based on the workload config.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read workload config
+		configContent, err := os.ReadFile(updateWorkloadConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+
+		// update workload based on version
+		switch updateWorkloadVersion {
+		case "v0":
+			var workloadConfig config_v0.WorkloadConfig
+			if err := yaml.UnmarshalStrict(configContent, &workloadConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update workload
+			workload := workloadConfig.Workload
+			workload.WorkloadConfigPath = &updateWorkloadConfigPath
+			updatedWorkloadDefinition, updatedWorkloadInstance, err := workload.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				cli.Error("failed to update workload", err)
+				os.Exit(1)
+			}
+
+			cli.Info(fmt.Sprintf("workload definition %s updated", *updatedWorkloadDefinition.Name))
+			cli.Info(fmt.Sprintf("workload instance %s updated", *updatedWorkloadInstance.Name))
+			cli.Complete(fmt.Sprintf("workload %s updated", *workloadConfig.Workload.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing workload",
+	SilenceUsage: true,
+	Use:          "workload",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateWorkloadCmd)
+
+	UpdateWorkloadCmd.Flags().StringVarP(
+		&updateWorkloadConfigPath,
+		"config", "c", "", "Path to file with workload config.",
+	)
+	UpdateWorkloadCmd.MarkFlagRequired("config")
+	UpdateWorkloadCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateWorkloadCmd.Flags().StringVarP(
+		&updateWorkloadVersion,
+		"version", "v", "v0", "Version of workloads object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteWorkloadConfigPath string
 	deleteWorkloadName       string
@@ -630,26 +834,50 @@ func init() {
 // WorkloadInstance
 ///////////////////////////////////////////////////////////////////////////////
 
-var getWorkloadInstanceVersion string
+var (
+	getWorkloadInstanceVersion       string
+	getWorkloadInstanceLabelSelector string
+	getWorkloadInstanceOutput        string
+)
 
 // GetWorkloadInstancesCmd represents the workload-instance command
 var GetWorkloadInstancesCmd = &cobra.Command{
-	Example: "  tptctl get workload-instances",
+	Example: "  # Get all workload instances\n  tptctl get workload-instances\n\n  # Get JSON output for all workload instances\n  tptctl get workload-instances -o json\n\n  # Get the ID and name of each workload instance\n  tptctl get workload-instances -o custom-columns=ID:.ID,NAME:.Name",
 	Long:    "Get workload instances from the system.",
 	PreRun:  CommandPreRunFunc,
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := cli.ValidateGetOutput(getWorkloadInstanceOutput); err != nil {
+			cli.Error("invalid output format", err)
+			os.Exit(1)
+		}
+
 		apiClient, _, apiEndpoint, requestedControlPlane := GetClientContext(cmd)
 
 		switch getWorkloadInstanceVersion {
 		case "v0":
 			// get workload instances
-			workloadInstances, err := client_v0.GetWorkloadInstances(apiClient, apiEndpoint)
+			workloadInstances, err := client_v0.GetWorkloadInstancesWithOptions(
+				apiClient,
+				apiEndpoint,
+				client_lib.WithLabelSelector(getWorkloadInstanceLabelSelector),
+			)
 			if err != nil {
 				cli.Error("failed to retrieve workload instances", err)
 				os.Exit(1)
 			}
 
 			// write the output
+			if getWorkloadInstanceOutput != "" {
+				if err := cli.OutputGetObjects(
+					getWorkloadInstanceOutput,
+					"workload-instance",
+					workloadInstances,
+				); err != nil {
+					cli.Error("failed to produce output", err)
+					os.Exit(1)
+				}
+				return
+			}
 			if len(*workloadInstances) == 0 {
 				cli.Info(fmt.Sprintf(
 					"No workload instances currently managed by %s threeport control plane",
@@ -686,6 +914,14 @@ func init() {
 		&getWorkloadInstanceVersion,
 		"version", "v", "v0", "Version of workload instances object to retrieve. One of: [v0]",
 	)
+	GetWorkloadInstancesCmd.Flags().StringVarP(
+		&getWorkloadInstanceLabelSelector,
+		"selector", "l", "", "Optional. Label selector to filter workload instances by, e.g. team=payments,tier!=dev.",
+	)
+	GetWorkloadInstancesCmd.Flags().StringVarP(
+		&getWorkloadInstanceOutput,
+		"output", "o", "", cli.GetOutputUsage,
+	)
 }
 
 var (
@@ -753,6 +989,71 @@ func init() {
 	)
 }
 
+var (
+	updateWorkloadInstanceConfigPath string
+	updateWorkloadInstanceVersion    string
+)
+
+// UpdateWorkloadInstanceCmd represents the workload-instance command
+var UpdateWorkloadInstanceCmd = &cobra.Command{
+	Example: "  tptctl update workload-instance --config path/to/config.yaml",
+	Long:    "Update an existing workload instance in place.",
+	PreRun:  CommandPreRunFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, _, apiEndpoint, _ := GetClientContext(cmd)
+
+		// read workload instance config
+		configContent, err := os.ReadFile(updateWorkloadInstanceConfigPath)
+		if err != nil {
+			cli.Error("failed to read config file", err)
+			os.Exit(1)
+		}
+		// update workload instance based on version
+		switch updateWorkloadInstanceVersion {
+		case "v0":
+			var workloadInstanceConfig config_v0.WorkloadInstanceConfig
+			if err := yaml.UnmarshalStrict(configContent, &workloadInstanceConfig); err != nil {
+				cli.Error("failed to unmarshal config file yaml content", err)
+				os.Exit(1)
+			}
+
+			// update workload instance
+			workloadInstance := workloadInstanceConfig.WorkloadInstance
+			updatedWorkloadInstance, err := workloadInstance.Update(apiClient, apiEndpoint)
+			if err != nil {
+				cli.Error("failed to update workload instance", err)
+				os.Exit(1)
+			}
+
+			cli.Complete(fmt.Sprintf("workload instance %s updated", *updatedWorkloadInstance.Name))
+		default:
+			cli.Error("", errors.New("unrecognized object version"))
+			os.Exit(1)
+		}
+	},
+	Short:        "Update an existing workload instance",
+	SilenceUsage: true,
+	Use:          "workload-instance",
+}
+
+func init() {
+	UpdateCmd.AddCommand(UpdateWorkloadInstanceCmd)
+
+	UpdateWorkloadInstanceCmd.Flags().StringVarP(
+		&updateWorkloadInstanceConfigPath,
+		"config", "c", "", "Path to file with workload instance config.",
+	)
+	UpdateWorkloadInstanceCmd.MarkFlagRequired("config")
+	UpdateWorkloadInstanceCmd.Flags().StringVarP(
+		&cliArgs.ControlPlaneName,
+		"control-plane-name", "i", "", "Optional. Name of control plane. Will default to current control plane if not provided.",
+	)
+	UpdateWorkloadInstanceCmd.Flags().StringVarP(
+		&updateWorkloadInstanceVersion,
+		"version", "v", "v0", "Version of workload instances object to update. One of: [v0]",
+	)
+}
+
 var (
 	deleteWorkloadInstanceConfigPath string
 	deleteWorkloadInstanceName       string
@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "awsLock"
 	LockBucketDescr = "contains locks on aws objects"
 )
+
+// The names of the NATS bucket used to track aws controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "awsMembers"
+	ShardStreamName  = "awsShard"
+)
@@ -5,6 +5,7 @@ package aws
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func AwsRelationalDatabaseInstanceReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,484 @@ func AwsRelationalDatabaseInstanceReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("aws relational database instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var awsRelationalDatabaseInstance tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				awsRelationalDatabaseInstance = &api_v0.AwsRelationalDatabaseInstance{}
-			default:
-				log.Error(errors.New("received unrecognized version of aws relational database instance object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("aws relational database instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := awsRelationalDatabaseInstance.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("aws relational database instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("awsRelationalDatabaseInstanceID", awsRelationalDatabaseInstance.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("aws relational database instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(awsRelationalDatabaseInstance)
-			if locked || ok == false {
-				r.Requeue(awsRelationalDatabaseInstance, requeueDelay, msg)
-				log.V(1).Info("aws relational database instance reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var awsRelationalDatabaseInstance tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					awsRelationalDatabaseInstance = &api_v0.AwsRelationalDatabaseInstance{}
+				default:
+					log.Error(errors.New("received unrecognized version of aws relational database instance object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("aws relational database instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of aws relational database instance")
-					r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for aws relational database instance, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := awsRelationalDatabaseInstance.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("aws relational database instance reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("awsRelationalDatabaseInstanceID", awsRelationalDatabaseInstance.GetId())
+				r.TraceObject(msg, awsRelationalDatabaseInstance, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(awsRelationalDatabaseInstance); !ok {
-				r.Requeue(awsRelationalDatabaseInstance, requeueDelay, msg)
-				log.V(1).Info("aws relational database instance reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(awsRelationalDatabaseInstance)
 
-			// retrieve latest version of object
-			var latestAwsRelationalDatabaseInstance tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetAwsRelationalDatabaseInstanceByID(
-					r.APIClient,
-					r.APIServer,
-					awsRelationalDatabaseInstance.GetId(),
-				)
-				latestAwsRelationalDatabaseInstance = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of aws relational database instance object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(awsRelationalDatabaseInstance)
+				if locked || ok == false {
+					r.Requeue(awsRelationalDatabaseInstance, requeueDelay, msg)
+					log.V(1).Info("aws relational database instance reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(awsRelationalDatabaseInstance, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get aws relational database instance by ID from API")
-				r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
-				continue
-			}
-			awsRelationalDatabaseInstance = latestAwsRelationalDatabaseInstance
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of aws relational database instance")
+						r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for aws relational database instance, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if awsRelationalDatabaseInstance.ScheduledForDeletion() != nil {
-					log.Info("aws relational database instance scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(awsRelationalDatabaseInstance); !ok {
+					r.Requeue(awsRelationalDatabaseInstance, requeueDelay, msg)
+					log.V(1).Info("aws relational database instance reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch awsRelationalDatabaseInstance.GetVersion() {
+
+				// retrieve latest version of object
+				var latestAwsRelationalDatabaseInstance tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0AwsRelationalDatabaseInstanceCreated(
-						r,
-						awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of aws relational database instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created aws relational database instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetAwsRelationalDatabaseInstanceByID(
+						r.APIClient,
+						r.APIServer,
 						awsRelationalDatabaseInstance.GetId(),
-						awsRelationalDatabaseInstance.GetVersion(),
-						awsRelationalDatabaseInstance.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						awsRelationalDatabaseInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestAwsRelationalDatabaseInstance = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of aws relational database instance object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						awsRelationalDatabaseInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(awsRelationalDatabaseInstance, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch awsRelationalDatabaseInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0AwsRelationalDatabaseInstanceUpdated(
-						r,
-						awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of aws relational database instance encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get aws relational database instance by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated aws relational database instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				awsRelationalDatabaseInstance = latestAwsRelationalDatabaseInstance
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if awsRelationalDatabaseInstance.ScheduledForDeletion() != nil {
+						log.Info("aws relational database instance scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch awsRelationalDatabaseInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0AwsRelationalDatabaseInstanceCreated(
+							r,
+							awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of aws relational database instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created aws relational database instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							awsRelationalDatabaseInstance.GetId(),
+							awsRelationalDatabaseInstance.GetVersion(),
+							awsRelationalDatabaseInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							awsRelationalDatabaseInstance,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+								Common:         api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateAwsRelationalDatabaseInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedAwsRelationalDatabaseInstance,
+							); err != nil {
+								log.Error(err, "failed to update aws relational database instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							awsRelationalDatabaseInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							awsRelationalDatabaseInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated:
+					var operationErr error
+					var customRequeueDelay int64
+					switch awsRelationalDatabaseInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0AwsRelationalDatabaseInstanceUpdated(
+							r,
+							awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of aws relational database instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated aws relational database instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							awsRelationalDatabaseInstance.GetId(),
+							awsRelationalDatabaseInstance.GetVersion(),
+							awsRelationalDatabaseInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							awsRelationalDatabaseInstance,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+								Common:         api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateAwsRelationalDatabaseInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedAwsRelationalDatabaseInstance,
+							); err != nil {
+								log.Error(err, "failed to update aws relational database instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							awsRelationalDatabaseInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							awsRelationalDatabaseInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch awsRelationalDatabaseInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0AwsRelationalDatabaseInstanceDeleted(
+							r,
+							awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of aws relational database instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted aws relational database instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							awsRelationalDatabaseInstance.GetId(),
+							awsRelationalDatabaseInstance.GetVersion(),
+							awsRelationalDatabaseInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							awsRelationalDatabaseInstance,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+								Common:         api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateAwsRelationalDatabaseInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedAwsRelationalDatabaseInstance,
+							); err != nil {
+								log.Error(err, "failed to update aws relational database instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							awsRelationalDatabaseInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							awsRelationalDatabaseInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+						Common: api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateAwsRelationalDatabaseInstance(
+						r.APIClient,
+						r.APIServer,
+						&deletedAwsRelationalDatabaseInstance,
+					)
+					if err != nil {
+						log.Error(err, "failed to update aws relational database instance to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteAwsRelationalDatabaseInstance(
+						r.APIClient,
+						r.APIServer,
 						awsRelationalDatabaseInstance.GetId(),
-						awsRelationalDatabaseInstance.GetVersion(),
-						awsRelationalDatabaseInstance.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete aws relational database instance")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+				case notifications.NotificationOperationResync:
+					if awsRelationalDatabaseInstance.ScheduledForDeletion() != nil {
+						log.Info("aws relational database instance scheduled for deletion - skipping resync")
+						break
+					}
+					var drift *controller.Drift
+					var previouslyDrifted bool
+					var operationErr error
+					switch awsRelationalDatabaseInstance.GetVersion() {
+					case "v0":
+						objectDrift, err := v0AwsRelationalDatabaseInstanceDriftCheck(
+							r,
+							awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
+							&log,
+						)
+						drift = objectDrift
+						previouslyDrifted = util.DerefBool(awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance).Drifted)
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of aws relational database instance encountered for resync")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to check resynced aws relational database instance object for drift"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedResync),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							awsRelationalDatabaseInstance.GetId(),
+							awsRelationalDatabaseInstance.GetVersion(),
+							awsRelationalDatabaseInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						r.RecordReconcileError(msg, operationErr)
+						r.ReleaseLock(awsRelationalDatabaseInstance, lockReleased, msg, true)
+						return
+					}
+					r.RecordDrift(awsRelationalDatabaseInstance, drift, &log)
+					driftConditions, conditionsChanged := controller.DriftConditions(awsRelationalDatabaseInstance, drift)
+					if drift.Uncorrected() != previouslyDrifted || conditionsChanged {
+						driftedAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+							Common: api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
+							Reconciliation: api_v0.Reconciliation{
+								Conditions: driftConditions,
+								Drifted:    util.Ptr(drift.Uncorrected()),
+							},
+						}
+						_, err = client_v0.UpdateAwsRelationalDatabaseInstance(
+							r.APIClient,
+							r.APIServer,
+							&driftedAwsRelationalDatabaseInstance,
+						)
+						if err != nil {
+							log.Error(err, "failed to update aws relational database instance to record drift")
+							r.RecordReconcileError(msg, err)
+							r.ReleaseLock(awsRelationalDatabaseInstance, lockReleased, msg, true)
+							return
+						}
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						awsRelationalDatabaseInstance,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						awsRelationalDatabaseInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch awsRelationalDatabaseInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0AwsRelationalDatabaseInstanceDeleted(
-						r,
-						awsRelationalDatabaseInstance.(*api_v0.AwsRelationalDatabaseInstance),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of aws relational database instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted aws relational database instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+						Common: api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						awsRelationalDatabaseInstance.GetId(),
-						awsRelationalDatabaseInstance.GetVersion(),
-						awsRelationalDatabaseInstance.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						awsRelationalDatabaseInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedAwsRelationalDatabaseInstance, err := client_v0.UpdateAwsRelationalDatabaseInstance(
+						r.APIClient,
+						r.APIServer,
+						&reconciledAwsRelationalDatabaseInstance,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						awsRelationalDatabaseInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update aws relational database instance to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"aws relational database instance marked as reconciled in API",
+						"aws relational database instanceName", updatedAwsRelationalDatabaseInstance.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
-					Common: api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateAwsRelationalDatabaseInstance(
-					r.APIClient,
-					r.APIServer,
-					&deletedAwsRelationalDatabaseInstance,
-				)
-				if err != nil {
-					log.Error(err, "failed to update aws relational database instance to mark as deleted")
-					r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteAwsRelationalDatabaseInstance(
-					r.APIClient,
-					r.APIServer,
-					awsRelationalDatabaseInstance.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete aws relational database instance")
-					r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(awsRelationalDatabaseInstance, lockReleased, msg, true); !ok {
+					log.Error(errors.New("aws relational database instance remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("aws relational database instance unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					awsRelationalDatabaseInstance,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
-					Common:         api_v0.Common{ID: util.Ptr(awsRelationalDatabaseInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("aws relational database instance resynced")
+					return
 				}
-				updatedAwsRelationalDatabaseInstance, err := client_v0.UpdateAwsRelationalDatabaseInstance(
-					r.APIClient,
-					r.APIServer,
-					&reconciledAwsRelationalDatabaseInstance,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"aws relational database instance successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update aws relational database instance to mark as reconciled")
-					r.UnlockAndRequeue(awsRelationalDatabaseInstance, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					awsRelationalDatabaseInstance.GetId(),
+					awsRelationalDatabaseInstance.GetVersion(),
+					awsRelationalDatabaseInstance.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful aws relational database instance reconciliation")
 				}
-				log.V(1).Info(
-					"aws relational database instance marked as reconciled in API",
-					"aws relational database instanceName", updatedAwsRelationalDatabaseInstance.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(awsRelationalDatabaseInstance, lockReleased, msg, true); !ok {
-				log.Error(errors.New("aws relational database instance remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("aws relational database instance unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"aws relational database instance successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				awsRelationalDatabaseInstance.GetId(),
-				awsRelationalDatabaseInstance.GetVersion(),
-				awsRelationalDatabaseInstance.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful aws relational database instance reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +546,22 @@ func AwsRelationalDatabaseInstanceReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledAwsRelationalDatabaseInstances returns the aws relational database instances that have been
+// reconciled so they may be resynced.
+func ListReconciledAwsRelationalDatabaseInstances(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0AwsRelationalDatabaseInstances, err := client_v0.GetAwsRelationalDatabaseInstancesWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 aws relational database instances: %w", err)
+	}
+	for i := range *v0AwsRelationalDatabaseInstances {
+		reconciled = append(reconciled, &(*v0AwsRelationalDatabaseInstances)[i])
+	}
+
+	return reconciled, nil
+}
@@ -3,10 +3,14 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	logr "github.com/go-logr/logr"
 	aws_client "github.com/nukleros/aws-builder/pkg/client"
 	"github.com/nukleros/aws-builder/pkg/eks"
@@ -416,6 +420,99 @@ func v0AwsEksKubernetesRuntimeInstanceDeleted(
 	return 300, nil
 }
 
+// v0AwsEksKubernetesRuntimeInstanceDriftCheck checks the AWS resources for a
+// v0 AwsEksKubernetesRuntimeInstance for drift from the desired state when it
+// is resynced.  An EKS cluster or node group that no longer exists or is not
+// active is reported but not corrected.
+func v0AwsEksKubernetesRuntimeInstanceDriftCheck(
+	r *controller.Reconciler,
+	awsEksKubernetesRuntimeInstance *v0.AwsEksKubernetesRuntimeInstance,
+	log *logr.Logger,
+) (*controller.Drift, error) {
+	// get the inventory of resources that were created
+	inventory, err := getInventory(r, awsEksKubernetesRuntimeInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EKS cluster's AWS resource inventory for drift check: %w", err)
+	}
+	if inventory.Cluster.ClusterName == "" {
+		return nil, nil
+	}
+
+	// get cluster definition and aws account info
+	awsEksKubernetesRuntimeDefinition, err := client.GetAwsEksKubernetesRuntimeDefinitionByID(
+		r.APIClient,
+		r.APIServer,
+		*awsEksKubernetesRuntimeInstance.AwsEksKubernetesRuntimeDefinitionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retreive cluster definition by ID: %w", err)
+	}
+	awsAccount, err := client.GetAwsAccountByID(
+		r.APIClient,
+		r.APIServer,
+		*awsEksKubernetesRuntimeDefinition.AwsAccountID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS account by ID: %w", err)
+	}
+
+	awsConfig, err := kube.GetAwsConfigFromAwsAccount(r.EncryptionKey, *awsEksKubernetesRuntimeInstance.Region, awsAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS config: %w", err)
+	}
+	eksSvc := awseks.NewFromConfig(*awsConfig)
+
+	// check the cluster
+	drift := controller.Drift{}
+	clusterResource := fmt.Sprintf("EKSCluster %s", inventory.Cluster.ClusterName)
+	cluster, err := eksSvc.DescribeCluster(context.Background(), &awseks.DescribeClusterInput{
+		Name: aws.String(inventory.Cluster.ClusterName),
+	})
+	if err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			drift.Add(clusterResource)
+			return &drift, nil
+		}
+		return nil, fmt.Errorf("failed to describe EKS cluster: %w", err)
+	}
+	if cluster.Cluster.Status != ekstypes.ClusterStatusActive {
+		log.V(1).Info(
+			"EKS cluster is not active",
+			"clusterName", inventory.Cluster.ClusterName,
+			"status", cluster.Cluster.Status,
+		)
+		drift.Add(clusterResource)
+	}
+
+	// check the node groups
+	for _, nodeGroupName := range inventory.NodeGroupNames {
+		nodeGroupResource := fmt.Sprintf("EKSNodeGroup %s", nodeGroupName)
+		nodeGroup, err := eksSvc.DescribeNodegroup(context.Background(), &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String(inventory.Cluster.ClusterName),
+			NodegroupName: aws.String(nodeGroupName),
+		})
+		if err != nil {
+			var notFoundErr *ekstypes.ResourceNotFoundException
+			if errors.As(err, &notFoundErr) {
+				drift.Add(nodeGroupResource)
+				continue
+			}
+			return nil, fmt.Errorf("failed to describe EKS node group: %w", err)
+		}
+		if nodeGroup.Nodegroup.Status != ekstypes.NodegroupStatusActive {
+			log.V(1).Info(
+				"EKS node group is not active",
+				"nodeGroupName", nodeGroupName,
+				"status", nodeGroup.Nodegroup.Status,
+			)
+			drift.Add(nodeGroupResource)
+		}
+	}
+
+	return &drift, nil
+}
+
 // getInventory takes an aws eks kubernetes runtime instance and retrieves the
 // latest resource inventory from the threeport API then returns the inventory.
 func getInventory(
@@ -505,13 +602,22 @@ func refreshAcknowledgement(
 		case <-quitChan:
 			return
 		default:
-			// refresh the acknowledgement timestamp
+			// refresh the acknowledgement timestamp - only the timestamp is
+			// updated so that changes made to the object by other updates
+			// while the infra is being created don't cause a conflict
 			refreshAckTimestamp := time.Now().UTC()
-			awsEksKubernetesRuntimeInstance.CreationAcknowledged = &refreshAckTimestamp
+			refreshedAwsEksKubernetesRuntimeInstance := v0.AwsEksKubernetesRuntimeInstance{
+				Common: v0.Common{
+					ID: awsEksKubernetesRuntimeInstance.ID,
+				},
+				Reconciliation: v0.Reconciliation{
+					CreationAcknowledged: &refreshAckTimestamp,
+				},
+			}
 			_, err := client.UpdateAwsEksKubernetesRuntimeInstance(
 				r.APIClient,
 				r.APIServer,
-				awsEksKubernetesRuntimeInstance,
+				&refreshedAwsEksKubernetesRuntimeInstance,
 			)
 			if err != nil {
 				log.Error(err, "failed to refresh creation acknowledged timestamp")
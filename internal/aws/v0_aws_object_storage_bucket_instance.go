@@ -3,10 +3,14 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-logr/logr"
 	awsclient "github.com/nukleros/aws-builder/pkg/client"
 	"github.com/nukleros/aws-builder/pkg/eks"
@@ -419,6 +423,55 @@ func deleteS3Bucket(
 	return nil
 }
 
+// v0AwsObjectStorageBucketInstanceDriftCheck checks the AWS resources for a v0
+// AwsObjectStorageBucketInstance for drift from the desired state when it is
+// resynced.  An S3 bucket that no longer exists is reported but not
+// re-created.
+func v0AwsObjectStorageBucketInstanceDriftCheck(
+	r *controller.Reconciler,
+	awsObjectStorageBucketInstance *v0.AwsObjectStorageBucketInstance,
+	log *logr.Logger,
+) (*controller.Drift, error) {
+	// get the inventory of resources that were created
+	s3Inventory, err := getS3Inventory(r, awsObjectStorageBucketInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve S3 bucket inventory for drift check: %w", err)
+	}
+	if s3Inventory.BucketName == "" {
+		return nil, nil
+	}
+
+	// get required objects from the threeport API
+	requiredObjects, err := getRequiredS3Objects(r, awsObjectStorageBucketInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get required objects for AWS object storage bucket instance drift check: %w", err)
+	}
+
+	awsConfig, err := kube.GetAwsConfigFromAwsAccount(r.EncryptionKey, *requiredObjects.AwsEksKubernetesRuntimeInstance.Region, &requiredObjects.AwsAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS config from API keys: %w", err)
+	}
+	s3Svc := awss3.NewFromConfig(*awsConfig)
+
+	drift := controller.Drift{}
+	if _, err := s3Svc.HeadBucket(context.Background(), &awss3.HeadBucketInput{
+		Bucket: aws.String(s3Inventory.BucketName),
+	}); err != nil {
+		var notFoundErr *s3types.NotFound
+		if errors.As(err, &notFoundErr) {
+			log.V(1).Info(
+				"S3 bucket not found",
+				"bucketName", s3Inventory.BucketName,
+			)
+			drift.Add(fmt.Sprintf("S3Bucket %s", s3Inventory.BucketName))
+			return &drift, nil
+		}
+		return nil, fmt.Errorf("failed to get S3 bucket: %w", err)
+	}
+
+	return &drift, nil
+}
+
 // getS3Inventory retrieves the inventory from the threeport API for an AWS
 // S3 bucket.
 func getS3Inventory(
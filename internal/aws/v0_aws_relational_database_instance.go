@@ -3,11 +3,15 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/go-logr/logr"
 	awsclient "github.com/nukleros/aws-builder/pkg/client"
 	"github.com/nukleros/aws-builder/pkg/eks"
@@ -401,6 +405,55 @@ func deleteRdsInstance(
 	}
 }
 
+// v0AwsRelationalDatabaseInstanceDriftCheck checks the AWS resources for a v0
+// AwsRelationalDatabaseInstance for drift from the desired state when it is
+// resynced.  An RDS instance that no longer exists is reported but not
+// re-created.
+func v0AwsRelationalDatabaseInstanceDriftCheck(
+	r *controller.Reconciler,
+	awsRelationalDatabaseInstance *v0.AwsRelationalDatabaseInstance,
+	log *logr.Logger,
+) (*controller.Drift, error) {
+	// get the inventory of resources that were created
+	rdsInventory, err := getRdsInventory(r, awsRelationalDatabaseInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS relational database inventory for drift check: %w", err)
+	}
+	if rdsInventory.RdsInstanceId == "" {
+		return nil, nil
+	}
+
+	// get required objects from the threeport API
+	_, awsAccount, _, awsEksKubernetesRuntimeInstance, err := getRequiredRdsObjects(r, awsRelationalDatabaseInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get required objects for AWS relational database instance drift check: %w", err)
+	}
+
+	awsConfig, err := kube.GetAwsConfigFromAwsAccount(r.EncryptionKey, *awsEksKubernetesRuntimeInstance.Region, awsAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS config from API keys: %w", err)
+	}
+	rdsSvc := awsrds.NewFromConfig(*awsConfig)
+
+	drift := controller.Drift{}
+	if _, err := rdsSvc.DescribeDBInstances(context.Background(), &awsrds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(rdsInventory.RdsInstanceId),
+	}); err != nil {
+		var notFoundErr *rdstypes.DBInstanceNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			log.V(1).Info(
+				"RDS instance not found",
+				"rdsInstanceID", rdsInventory.RdsInstanceId,
+			)
+			drift.Add(fmt.Sprintf("RDSInstance %s", rdsInventory.RdsInstanceId))
+			return &drift, nil
+		}
+		return nil, fmt.Errorf("failed to describe RDS instance: %w", err)
+	}
+
+	return &drift, nil
+}
+
 // getRdsInventory retrieves the inventory from the threeport API for an AWS
 // relational database.
 func getRdsInventory(
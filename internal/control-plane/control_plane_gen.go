@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "controlPlaneLock"
 	LockBucketDescr = "contains locks on control plane objects"
 )
+
+// The names of the NATS bucket used to track control plane controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "controlPlaneMembers"
+	ShardStreamName  = "controlPlaneShard"
+)
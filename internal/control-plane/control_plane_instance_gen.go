@@ -5,6 +5,7 @@ package controlplane
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func ControlPlaneInstanceReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func ControlPlaneInstanceReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("control plane instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var controlPlaneInstance tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				controlPlaneInstance = &api_v0.ControlPlaneInstance{}
-			default:
-				log.Error(errors.New("received unrecognized version of control plane instance object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("control plane instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := controlPlaneInstance.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("control plane instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("controlPlaneInstanceID", controlPlaneInstance.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("control plane instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(controlPlaneInstance)
-			if locked || ok == false {
-				r.Requeue(controlPlaneInstance, requeueDelay, msg)
-				log.V(1).Info("control plane instance reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var controlPlaneInstance tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					controlPlaneInstance = &api_v0.ControlPlaneInstance{}
+				default:
+					log.Error(errors.New("received unrecognized version of control plane instance object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("control plane instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of control plane instance")
-					r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for control plane instance, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := controlPlaneInstance.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("control plane instance reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("controlPlaneInstanceID", controlPlaneInstance.GetId())
+				r.TraceObject(msg, controlPlaneInstance, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(controlPlaneInstance); !ok {
-				r.Requeue(controlPlaneInstance, requeueDelay, msg)
-				log.V(1).Info("control plane instance reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(controlPlaneInstance)
 
-			// retrieve latest version of object
-			var latestControlPlaneInstance tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetControlPlaneInstanceByID(
-					r.APIClient,
-					r.APIServer,
-					controlPlaneInstance.GetId(),
-				)
-				latestControlPlaneInstance = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of control plane instance object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(controlPlaneInstance)
+				if locked || ok == false {
+					r.Requeue(controlPlaneInstance, requeueDelay, msg)
+					log.V(1).Info("control plane instance reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(controlPlaneInstance, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get control plane instance by ID from API")
-				r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
-				continue
-			}
-			controlPlaneInstance = latestControlPlaneInstance
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of control plane instance")
+						r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for control plane instance, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if controlPlaneInstance.ScheduledForDeletion() != nil {
-					log.Info("control plane instance scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(controlPlaneInstance); !ok {
+					r.Requeue(controlPlaneInstance, requeueDelay, msg)
+					log.V(1).Info("control plane instance reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch controlPlaneInstance.GetVersion() {
+
+				// retrieve latest version of object
+				var latestControlPlaneInstance tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0ControlPlaneInstanceCreated(
-						r,
-						controlPlaneInstance.(*api_v0.ControlPlaneInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of control plane instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created control plane instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetControlPlaneInstanceByID(
+						r.APIClient,
+						r.APIServer,
 						controlPlaneInstance.GetId(),
-						controlPlaneInstance.GetVersion(),
-						controlPlaneInstance.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						controlPlaneInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestControlPlaneInstance = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of control plane instance object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						controlPlaneInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(controlPlaneInstance, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch controlPlaneInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0ControlPlaneInstanceUpdated(
-						r,
-						controlPlaneInstance.(*api_v0.ControlPlaneInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of control plane instance encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get control plane instance by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated control plane instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				controlPlaneInstance = latestControlPlaneInstance
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if controlPlaneInstance.ScheduledForDeletion() != nil {
+						log.Info("control plane instance scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch controlPlaneInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0ControlPlaneInstanceCreated(
+							r,
+							controlPlaneInstance.(*api_v0.ControlPlaneInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of control plane instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created control plane instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							controlPlaneInstance.GetId(),
+							controlPlaneInstance.GetVersion(),
+							controlPlaneInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							controlPlaneInstance,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedControlPlaneInstance := api_v0.ControlPlaneInstance{
+								Common:         api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateControlPlaneInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedControlPlaneInstance,
+							); err != nil {
+								log.Error(err, "failed to update control plane instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							controlPlaneInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							controlPlaneInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch controlPlaneInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0ControlPlaneInstanceUpdated(
+							r,
+							controlPlaneInstance.(*api_v0.ControlPlaneInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of control plane instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated control plane instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							controlPlaneInstance.GetId(),
+							controlPlaneInstance.GetVersion(),
+							controlPlaneInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							controlPlaneInstance,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedControlPlaneInstance := api_v0.ControlPlaneInstance{
+								Common:         api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateControlPlaneInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedControlPlaneInstance,
+							); err != nil {
+								log.Error(err, "failed to update control plane instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							controlPlaneInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							controlPlaneInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch controlPlaneInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0ControlPlaneInstanceDeleted(
+							r,
+							controlPlaneInstance.(*api_v0.ControlPlaneInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of control plane instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted control plane instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							controlPlaneInstance.GetId(),
+							controlPlaneInstance.GetVersion(),
+							controlPlaneInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							controlPlaneInstance,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedControlPlaneInstance := api_v0.ControlPlaneInstance{
+								Common:         api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateControlPlaneInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedControlPlaneInstance,
+							); err != nil {
+								log.Error(err, "failed to update control plane instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							controlPlaneInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							controlPlaneInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedControlPlaneInstance := api_v0.ControlPlaneInstance{
+						Common: api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateControlPlaneInstance(
+						r.APIClient,
+						r.APIServer,
+						&deletedControlPlaneInstance,
+					)
+					if err != nil {
+						log.Error(err, "failed to update control plane instance to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteControlPlaneInstance(
+						r.APIClient,
+						r.APIServer,
 						controlPlaneInstance.GetId(),
-						controlPlaneInstance.GetVersion(),
-						controlPlaneInstance.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete control plane instance")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						controlPlaneInstance,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						controlPlaneInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch controlPlaneInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0ControlPlaneInstanceDeleted(
-						r,
-						controlPlaneInstance.(*api_v0.ControlPlaneInstance),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of control plane instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted control plane instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledControlPlaneInstance := api_v0.ControlPlaneInstance{
+						Common: api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						controlPlaneInstance.GetId(),
-						controlPlaneInstance.GetVersion(),
-						controlPlaneInstance.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						controlPlaneInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedControlPlaneInstance, err := client_v0.UpdateControlPlaneInstance(
+						r.APIClient,
+						r.APIServer,
+						&reconciledControlPlaneInstance,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						controlPlaneInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update control plane instance to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"control plane instance marked as reconciled in API",
+						"control plane instanceName", updatedControlPlaneInstance.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedControlPlaneInstance := api_v0.ControlPlaneInstance{
-					Common: api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateControlPlaneInstance(
-					r.APIClient,
-					r.APIServer,
-					&deletedControlPlaneInstance,
-				)
-				if err != nil {
-					log.Error(err, "failed to update control plane instance to mark as deleted")
-					r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteControlPlaneInstance(
-					r.APIClient,
-					r.APIServer,
-					controlPlaneInstance.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete control plane instance")
-					r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(controlPlaneInstance, lockReleased, msg, true); !ok {
+					log.Error(errors.New("control plane instance remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("control plane instance unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					controlPlaneInstance,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledControlPlaneInstance := api_v0.ControlPlaneInstance{
-					Common:         api_v0.Common{ID: util.Ptr(controlPlaneInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("control plane instance resynced")
+					return
 				}
-				updatedControlPlaneInstance, err := client_v0.UpdateControlPlaneInstance(
-					r.APIClient,
-					r.APIServer,
-					&reconciledControlPlaneInstance,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"control plane instance successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update control plane instance to mark as reconciled")
-					r.UnlockAndRequeue(controlPlaneInstance, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					controlPlaneInstance.GetId(),
+					controlPlaneInstance.GetVersion(),
+					controlPlaneInstance.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful control plane instance reconciliation")
 				}
-				log.V(1).Info(
-					"control plane instance marked as reconciled in API",
-					"control plane instanceName", updatedControlPlaneInstance.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(controlPlaneInstance, lockReleased, msg, true); !ok {
-				log.Error(errors.New("control plane instance remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("control plane instance unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"control plane instance successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				controlPlaneInstance.GetId(),
-				controlPlaneInstance.GetVersion(),
-				controlPlaneInstance.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful control plane instance reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func ControlPlaneInstanceReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledControlPlaneInstances returns the control plane instances that have been
+// reconciled so they may be resynced.
+func ListReconciledControlPlaneInstances(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0ControlPlaneInstances, err := client_v0.GetControlPlaneInstancesWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 control plane instances: %w", err)
+	}
+	for i := range *v0ControlPlaneInstances {
+		reconciled = append(reconciled, &(*v0ControlPlaneInstances)[i])
+	}
+
+	return reconciled, nil
+}
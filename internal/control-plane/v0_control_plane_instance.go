@@ -305,7 +305,7 @@ func v0ControlPlaneInstanceCreated(
 		clientCertificate, clientPrivateKey, err := auth.GenerateCertificate(
 			authConfig.CAConfig,
 			&authConfig.CAPrivateKey,
-			"localhost",
+			v0.AuthzAdminSubject,
 		)
 		if err != nil {
 			return 0, fmt.Errorf("failed to generate client certificate and private key: %w", err)
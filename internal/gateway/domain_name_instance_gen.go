@@ -5,6 +5,7 @@ package gateway
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func DomainNameInstanceReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func DomainNameInstanceReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("domain name instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var domainNameInstance tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				domainNameInstance = &api_v0.DomainNameInstance{}
-			default:
-				log.Error(errors.New("received unrecognized version of domain name instance object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("domain name instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := domainNameInstance.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("domain name instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("domainNameInstanceID", domainNameInstance.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("domain name instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(domainNameInstance)
-			if locked || ok == false {
-				r.Requeue(domainNameInstance, requeueDelay, msg)
-				log.V(1).Info("domain name instance reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var domainNameInstance tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					domainNameInstance = &api_v0.DomainNameInstance{}
+				default:
+					log.Error(errors.New("received unrecognized version of domain name instance object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("domain name instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of domain name instance")
-					r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for domain name instance, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := domainNameInstance.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("domain name instance reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("domainNameInstanceID", domainNameInstance.GetId())
+				r.TraceObject(msg, domainNameInstance, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(domainNameInstance); !ok {
-				r.Requeue(domainNameInstance, requeueDelay, msg)
-				log.V(1).Info("domain name instance reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(domainNameInstance)
 
-			// retrieve latest version of object
-			var latestDomainNameInstance tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetDomainNameInstanceByID(
-					r.APIClient,
-					r.APIServer,
-					domainNameInstance.GetId(),
-				)
-				latestDomainNameInstance = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of domain name instance object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(domainNameInstance)
+				if locked || ok == false {
+					r.Requeue(domainNameInstance, requeueDelay, msg)
+					log.V(1).Info("domain name instance reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(domainNameInstance, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get domain name instance by ID from API")
-				r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
-				continue
-			}
-			domainNameInstance = latestDomainNameInstance
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of domain name instance")
+						r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for domain name instance, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if domainNameInstance.ScheduledForDeletion() != nil {
-					log.Info("domain name instance scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(domainNameInstance); !ok {
+					r.Requeue(domainNameInstance, requeueDelay, msg)
+					log.V(1).Info("domain name instance reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch domainNameInstance.GetVersion() {
+
+				// retrieve latest version of object
+				var latestDomainNameInstance tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0DomainNameInstanceCreated(
-						r,
-						domainNameInstance.(*api_v0.DomainNameInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of domain name instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created domain name instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetDomainNameInstanceByID(
+						r.APIClient,
+						r.APIServer,
 						domainNameInstance.GetId(),
-						domainNameInstance.GetVersion(),
-						domainNameInstance.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						domainNameInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestDomainNameInstance = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of domain name instance object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						domainNameInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(domainNameInstance, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch domainNameInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0DomainNameInstanceUpdated(
-						r,
-						domainNameInstance.(*api_v0.DomainNameInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of domain name instance encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get domain name instance by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated domain name instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				domainNameInstance = latestDomainNameInstance
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if domainNameInstance.ScheduledForDeletion() != nil {
+						log.Info("domain name instance scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch domainNameInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0DomainNameInstanceCreated(
+							r,
+							domainNameInstance.(*api_v0.DomainNameInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of domain name instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created domain name instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							domainNameInstance.GetId(),
+							domainNameInstance.GetVersion(),
+							domainNameInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							domainNameInstance,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedDomainNameInstance := api_v0.DomainNameInstance{
+								Common:         api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateDomainNameInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedDomainNameInstance,
+							); err != nil {
+								log.Error(err, "failed to update domain name instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							domainNameInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							domainNameInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch domainNameInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0DomainNameInstanceUpdated(
+							r,
+							domainNameInstance.(*api_v0.DomainNameInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of domain name instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated domain name instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							domainNameInstance.GetId(),
+							domainNameInstance.GetVersion(),
+							domainNameInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							domainNameInstance,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedDomainNameInstance := api_v0.DomainNameInstance{
+								Common:         api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateDomainNameInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedDomainNameInstance,
+							); err != nil {
+								log.Error(err, "failed to update domain name instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							domainNameInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							domainNameInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch domainNameInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0DomainNameInstanceDeleted(
+							r,
+							domainNameInstance.(*api_v0.DomainNameInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of domain name instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted domain name instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							domainNameInstance.GetId(),
+							domainNameInstance.GetVersion(),
+							domainNameInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							domainNameInstance,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedDomainNameInstance := api_v0.DomainNameInstance{
+								Common:         api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateDomainNameInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedDomainNameInstance,
+							); err != nil {
+								log.Error(err, "failed to update domain name instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							domainNameInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							domainNameInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedDomainNameInstance := api_v0.DomainNameInstance{
+						Common: api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateDomainNameInstance(
+						r.APIClient,
+						r.APIServer,
+						&deletedDomainNameInstance,
+					)
+					if err != nil {
+						log.Error(err, "failed to update domain name instance to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteDomainNameInstance(
+						r.APIClient,
+						r.APIServer,
 						domainNameInstance.GetId(),
-						domainNameInstance.GetVersion(),
-						domainNameInstance.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete domain name instance")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						domainNameInstance,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						domainNameInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch domainNameInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0DomainNameInstanceDeleted(
-						r,
-						domainNameInstance.(*api_v0.DomainNameInstance),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of domain name instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted domain name instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledDomainNameInstance := api_v0.DomainNameInstance{
+						Common: api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						domainNameInstance.GetId(),
-						domainNameInstance.GetVersion(),
-						domainNameInstance.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						domainNameInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedDomainNameInstance, err := client_v0.UpdateDomainNameInstance(
+						r.APIClient,
+						r.APIServer,
+						&reconciledDomainNameInstance,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						domainNameInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update domain name instance to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"domain name instance marked as reconciled in API",
+						"domain name instanceName", updatedDomainNameInstance.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedDomainNameInstance := api_v0.DomainNameInstance{
-					Common: api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateDomainNameInstance(
-					r.APIClient,
-					r.APIServer,
-					&deletedDomainNameInstance,
-				)
-				if err != nil {
-					log.Error(err, "failed to update domain name instance to mark as deleted")
-					r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteDomainNameInstance(
-					r.APIClient,
-					r.APIServer,
-					domainNameInstance.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete domain name instance")
-					r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(domainNameInstance, lockReleased, msg, true); !ok {
+					log.Error(errors.New("domain name instance remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("domain name instance unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					domainNameInstance,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledDomainNameInstance := api_v0.DomainNameInstance{
-					Common:         api_v0.Common{ID: util.Ptr(domainNameInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("domain name instance resynced")
+					return
 				}
-				updatedDomainNameInstance, err := client_v0.UpdateDomainNameInstance(
-					r.APIClient,
-					r.APIServer,
-					&reconciledDomainNameInstance,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"domain name instance successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update domain name instance to mark as reconciled")
-					r.UnlockAndRequeue(domainNameInstance, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					domainNameInstance.GetId(),
+					domainNameInstance.GetVersion(),
+					domainNameInstance.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful domain name instance reconciliation")
 				}
-				log.V(1).Info(
-					"domain name instance marked as reconciled in API",
-					"domain name instanceName", updatedDomainNameInstance.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(domainNameInstance, lockReleased, msg, true); !ok {
-				log.Error(errors.New("domain name instance remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("domain name instance unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"domain name instance successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				domainNameInstance.GetId(),
-				domainNameInstance.GetVersion(),
-				domainNameInstance.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful domain name instance reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func DomainNameInstanceReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledDomainNameInstances returns the domain name instances that have been
+// reconciled so they may be resynced.
+func ListReconciledDomainNameInstances(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0DomainNameInstances, err := client_v0.GetDomainNameInstancesWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 domain name instances: %w", err)
+	}
+	for i := range *v0DomainNameInstances {
+		reconciled = append(reconciled, &(*v0DomainNameInstances)[i])
+	}
+
+	return reconciled, nil
+}
@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "gatewayLock"
 	LockBucketDescr = "contains locks on gateway objects"
 )
+
+// The names of the NATS bucket used to track gateway controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "gatewayMembers"
+	ShardStreamName  = "gatewayShard"
+)
@@ -5,6 +5,7 @@ package helmworkload
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func HelmWorkloadDefinitionReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func HelmWorkloadDefinitionReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("helm workload definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var helmWorkloadDefinition tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				helmWorkloadDefinition = &api_v0.HelmWorkloadDefinition{}
-			default:
-				log.Error(errors.New("received unrecognized version of helm workload definition object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("helm workload definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := helmWorkloadDefinition.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("helm workload definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("helmWorkloadDefinitionID", helmWorkloadDefinition.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("helm workload definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(helmWorkloadDefinition)
-			if locked || ok == false {
-				r.Requeue(helmWorkloadDefinition, requeueDelay, msg)
-				log.V(1).Info("helm workload definition reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var helmWorkloadDefinition tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					helmWorkloadDefinition = &api_v0.HelmWorkloadDefinition{}
+				default:
+					log.Error(errors.New("received unrecognized version of helm workload definition object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("helm workload definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of helm workload definition")
-					r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for helm workload definition, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := helmWorkloadDefinition.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("helm workload definition reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("helmWorkloadDefinitionID", helmWorkloadDefinition.GetId())
+				r.TraceObject(msg, helmWorkloadDefinition, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(helmWorkloadDefinition); !ok {
-				r.Requeue(helmWorkloadDefinition, requeueDelay, msg)
-				log.V(1).Info("helm workload definition reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(helmWorkloadDefinition)
 
-			// retrieve latest version of object
-			var latestHelmWorkloadDefinition tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetHelmWorkloadDefinitionByID(
-					r.APIClient,
-					r.APIServer,
-					helmWorkloadDefinition.GetId(),
-				)
-				latestHelmWorkloadDefinition = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of helm workload definition object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(helmWorkloadDefinition)
+				if locked || ok == false {
+					r.Requeue(helmWorkloadDefinition, requeueDelay, msg)
+					log.V(1).Info("helm workload definition reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(helmWorkloadDefinition, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get helm workload definition by ID from API")
-				r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
-				continue
-			}
-			helmWorkloadDefinition = latestHelmWorkloadDefinition
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of helm workload definition")
+						r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for helm workload definition, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if helmWorkloadDefinition.ScheduledForDeletion() != nil {
-					log.Info("helm workload definition scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(helmWorkloadDefinition); !ok {
+					r.Requeue(helmWorkloadDefinition, requeueDelay, msg)
+					log.V(1).Info("helm workload definition reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch helmWorkloadDefinition.GetVersion() {
+
+				// retrieve latest version of object
+				var latestHelmWorkloadDefinition tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0HelmWorkloadDefinitionCreated(
-						r,
-						helmWorkloadDefinition.(*api_v0.HelmWorkloadDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of helm workload definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created helm workload definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetHelmWorkloadDefinitionByID(
+						r.APIClient,
+						r.APIServer,
 						helmWorkloadDefinition.GetId(),
-						helmWorkloadDefinition.GetVersion(),
-						helmWorkloadDefinition.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						helmWorkloadDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestHelmWorkloadDefinition = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of helm workload definition object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						helmWorkloadDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(helmWorkloadDefinition, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch helmWorkloadDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0HelmWorkloadDefinitionUpdated(
-						r,
-						helmWorkloadDefinition.(*api_v0.HelmWorkloadDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of helm workload definition encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get helm workload definition by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated helm workload definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				helmWorkloadDefinition = latestHelmWorkloadDefinition
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if helmWorkloadDefinition.ScheduledForDeletion() != nil {
+						log.Info("helm workload definition scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch helmWorkloadDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0HelmWorkloadDefinitionCreated(
+							r,
+							helmWorkloadDefinition.(*api_v0.HelmWorkloadDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of helm workload definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created helm workload definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							helmWorkloadDefinition.GetId(),
+							helmWorkloadDefinition.GetVersion(),
+							helmWorkloadDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							helmWorkloadDefinition,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateHelmWorkloadDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedHelmWorkloadDefinition,
+							); err != nil {
+								log.Error(err, "failed to update helm workload definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							helmWorkloadDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							helmWorkloadDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch helmWorkloadDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0HelmWorkloadDefinitionUpdated(
+							r,
+							helmWorkloadDefinition.(*api_v0.HelmWorkloadDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of helm workload definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated helm workload definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							helmWorkloadDefinition.GetId(),
+							helmWorkloadDefinition.GetVersion(),
+							helmWorkloadDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							helmWorkloadDefinition,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateHelmWorkloadDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedHelmWorkloadDefinition,
+							); err != nil {
+								log.Error(err, "failed to update helm workload definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							helmWorkloadDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							helmWorkloadDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch helmWorkloadDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0HelmWorkloadDefinitionDeleted(
+							r,
+							helmWorkloadDefinition.(*api_v0.HelmWorkloadDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of helm workload definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted helm workload definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							helmWorkloadDefinition.GetId(),
+							helmWorkloadDefinition.GetVersion(),
+							helmWorkloadDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							helmWorkloadDefinition,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateHelmWorkloadDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedHelmWorkloadDefinition,
+							); err != nil {
+								log.Error(err, "failed to update helm workload definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							helmWorkloadDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							helmWorkloadDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
+						Common: api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateHelmWorkloadDefinition(
+						r.APIClient,
+						r.APIServer,
+						&deletedHelmWorkloadDefinition,
+					)
+					if err != nil {
+						log.Error(err, "failed to update helm workload definition to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteHelmWorkloadDefinition(
+						r.APIClient,
+						r.APIServer,
 						helmWorkloadDefinition.GetId(),
-						helmWorkloadDefinition.GetVersion(),
-						helmWorkloadDefinition.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete helm workload definition")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						helmWorkloadDefinition,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						helmWorkloadDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch helmWorkloadDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0HelmWorkloadDefinitionDeleted(
-						r,
-						helmWorkloadDefinition.(*api_v0.HelmWorkloadDefinition),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of helm workload definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted helm workload definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
+						Common: api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						helmWorkloadDefinition.GetId(),
-						helmWorkloadDefinition.GetVersion(),
-						helmWorkloadDefinition.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						helmWorkloadDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedHelmWorkloadDefinition, err := client_v0.UpdateHelmWorkloadDefinition(
+						r.APIClient,
+						r.APIServer,
+						&reconciledHelmWorkloadDefinition,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						helmWorkloadDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update helm workload definition to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"helm workload definition marked as reconciled in API",
+						"helm workload definitionName", updatedHelmWorkloadDefinition.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
-					Common: api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateHelmWorkloadDefinition(
-					r.APIClient,
-					r.APIServer,
-					&deletedHelmWorkloadDefinition,
-				)
-				if err != nil {
-					log.Error(err, "failed to update helm workload definition to mark as deleted")
-					r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteHelmWorkloadDefinition(
-					r.APIClient,
-					r.APIServer,
-					helmWorkloadDefinition.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete helm workload definition")
-					r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(helmWorkloadDefinition, lockReleased, msg, true); !ok {
+					log.Error(errors.New("helm workload definition remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("helm workload definition unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					helmWorkloadDefinition,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
-					Common:         api_v0.Common{ID: util.Ptr(helmWorkloadDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("helm workload definition resynced")
+					return
 				}
-				updatedHelmWorkloadDefinition, err := client_v0.UpdateHelmWorkloadDefinition(
-					r.APIClient,
-					r.APIServer,
-					&reconciledHelmWorkloadDefinition,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"helm workload definition successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update helm workload definition to mark as reconciled")
-					r.UnlockAndRequeue(helmWorkloadDefinition, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					helmWorkloadDefinition.GetId(),
+					helmWorkloadDefinition.GetVersion(),
+					helmWorkloadDefinition.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful helm workload definition reconciliation")
 				}
-				log.V(1).Info(
-					"helm workload definition marked as reconciled in API",
-					"helm workload definitionName", updatedHelmWorkloadDefinition.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(helmWorkloadDefinition, lockReleased, msg, true); !ok {
-				log.Error(errors.New("helm workload definition remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("helm workload definition unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"helm workload definition successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				helmWorkloadDefinition.GetId(),
-				helmWorkloadDefinition.GetVersion(),
-				helmWorkloadDefinition.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful helm workload definition reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func HelmWorkloadDefinitionReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledHelmWorkloadDefinitions returns the helm workload definitions that have been
+// reconciled so they may be resynced.
+func ListReconciledHelmWorkloadDefinitions(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0HelmWorkloadDefinitions, err := client_v0.GetHelmWorkloadDefinitionsWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 helm workload definitions: %w", err)
+	}
+	for i := range *v0HelmWorkloadDefinitions {
+		reconciled = append(reconciled, &(*v0HelmWorkloadDefinitions)[i])
+	}
+
+	return reconciled, nil
+}
@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "helmWorkloadLock"
 	LockBucketDescr = "contains locks on helm workload objects"
 )
+
+// The names of the NATS bucket used to track helm workload controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "helmWorkloadMembers"
+	ShardStreamName  = "helmWorkloadShard"
+)
@@ -3,8 +3,11 @@
 package helmworkload
 
 import (
+	"fmt"
+
 	logr "github.com/go-logr/logr"
 	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 )
 
@@ -25,6 +28,43 @@ func v0HelmWorkloadDefinitionUpdated(
 	helmWorkloadDefinition *v0.HelmWorkloadDefinition,
 	log *logr.Logger,
 ) (int64, error) {
+	// a definition that is already reconciled has no changes to apply
+	if helmWorkloadDefinition.Reconciled != nil && *helmWorkloadDefinition.Reconciled {
+		return 0, nil
+	}
+
+	// get helm workload instances derived from this definition
+	helmWorkloadInstances, err := client.GetHelmWorkloadInstancesByQueryString(
+		r.APIClient,
+		r.APIServer,
+		fmt.Sprintf("helmworkloaddefinitionid=%d", *helmWorkloadDefinition.ID),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get helm workload instances by helm workload definition ID: %w", err)
+	}
+
+	// trigger a reconciliation of each helm workload instance so the helm
+	// release is upgraded with the updated definition
+	for _, helmWorkloadInstance := range *helmWorkloadInstances {
+		reconciled := false
+		update := v0.HelmWorkloadInstance{
+			Common: v0.Common{
+				ID:              helmWorkloadInstance.ID,
+				ResourceVersion: helmWorkloadInstance.ResourceVersion,
+			},
+			Reconciliation: v0.Reconciliation{
+				Reconciled: &reconciled,
+			},
+		}
+		if _, err := client.UpdateHelmWorkloadInstance(r.APIClient, r.APIServer, &update); err != nil {
+			return 0, fmt.Errorf("failed to update helm workload instance with ID %d: %w", *helmWorkloadInstance.ID, err)
+		}
+		log.V(1).Info(
+			"helm workload instance marked for upgrade",
+			"helmWorkloadInstanceID", helmWorkloadInstance.ID,
+		)
+	}
+
 	return 0, nil
 }
 
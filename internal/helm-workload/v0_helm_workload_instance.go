@@ -17,6 +17,7 @@ import (
 	"helm.sh/helm/v3/pkg/registry"
 	"helm.sh/helm/v3/pkg/releaseutil"
 	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage/driver"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -360,6 +361,73 @@ func v0HelmWorkloadInstanceDeleted(
 	return 0, nil
 }
 
+// v0HelmWorkloadInstanceDriftCheck checks the Kubernetes resources in the helm
+// release for a v0 HelmWorkloadInstance for drift from the desired state when
+// it is resynced.  Resources that have been changed or removed are re-applied
+// from the release manifest.  A release that no longer exists is not
+// re-installed.
+func v0HelmWorkloadInstanceDriftCheck(
+	r *controller.Reconciler,
+	helmWorkloadInstance *v0.HelmWorkloadInstance,
+	log *logr.Logger,
+) (*controller.Drift, error) {
+	// get helm action config and kube client
+	actionConf, _, kubeClient, mapper, err := getHelmActionConfig(r, helmWorkloadInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a helm action config: %w", err)
+	}
+
+	// get the deployed release
+	drift := controller.Drift{}
+	release, err := action.NewGet(actionConf).Run(helmReleaseName(helmWorkloadInstance))
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			drift.Add(fmt.Sprintf("HelmRelease %s", helmReleaseName(helmWorkloadInstance)))
+			return &drift, nil
+		}
+		return nil, fmt.Errorf("failed to get helm release: %w", err)
+	}
+
+	splitManifests := releaseutil.SplitManifests(release.Manifest)
+	for _, manifest := range splitManifests {
+		// convert to unstructured kube object
+		serializer := yamlserailizer.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+		kubeObject := &unstructured.Unstructured{}
+		_, _, err := serializer.Decode([]byte(manifest), nil, kubeObject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode YAML manifest to unstructured object: %w", err)
+		}
+
+		missing, fields, err := kube.GetResourceDrift(
+			kubeObject,
+			*helmWorkloadInstance.ReleaseNamespace,
+			kubeClient,
+			*mapper,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check drift of Kubernetes resource in helm release: %w", err)
+		}
+		if !missing && len(fields) == 0 {
+			continue
+		}
+		log.V(1).Info(
+			"helm release resource drifted",
+			"resource", kube.DriftedResourceName(kubeObject),
+			"missing", missing,
+			"fields", fields,
+		)
+		drift.Add(kube.DriftedResourceName(kubeObject))
+
+		// restore the desired state
+		if _, err := kube.CreateOrUpdateResource(kubeObject, kubeClient, *mapper); err != nil {
+			return &drift, fmt.Errorf("failed to correct drift of Kubernetes resource in helm release: %w", err)
+		}
+	}
+	drift.Corrected = drift.Detected()
+
+	return &drift, nil
+}
+
 // uninstallHelmRelease uninstalls a named helm release.
 func uninstallHelmRelease(
 	releaseName string,
@@ -5,6 +5,7 @@ package kubernetesruntime
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func KubernetesRuntimeDefinitionReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func KubernetesRuntimeDefinitionReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("kubernetes runtime definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var kubernetesRuntimeDefinition tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				kubernetesRuntimeDefinition = &api_v0.KubernetesRuntimeDefinition{}
-			default:
-				log.Error(errors.New("received unrecognized version of kubernetes runtime definition object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("kubernetes runtime definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := kubernetesRuntimeDefinition.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("kubernetes runtime definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("kubernetesRuntimeDefinitionID", kubernetesRuntimeDefinition.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("kubernetes runtime definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(kubernetesRuntimeDefinition)
-			if locked || ok == false {
-				r.Requeue(kubernetesRuntimeDefinition, requeueDelay, msg)
-				log.V(1).Info("kubernetes runtime definition reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var kubernetesRuntimeDefinition tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					kubernetesRuntimeDefinition = &api_v0.KubernetesRuntimeDefinition{}
+				default:
+					log.Error(errors.New("received unrecognized version of kubernetes runtime definition object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("kubernetes runtime definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of kubernetes runtime definition")
-					r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for kubernetes runtime definition, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := kubernetesRuntimeDefinition.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("kubernetes runtime definition reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("kubernetesRuntimeDefinitionID", kubernetesRuntimeDefinition.GetId())
+				r.TraceObject(msg, kubernetesRuntimeDefinition, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(kubernetesRuntimeDefinition); !ok {
-				r.Requeue(kubernetesRuntimeDefinition, requeueDelay, msg)
-				log.V(1).Info("kubernetes runtime definition reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(kubernetesRuntimeDefinition)
 
-			// retrieve latest version of object
-			var latestKubernetesRuntimeDefinition tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetKubernetesRuntimeDefinitionByID(
-					r.APIClient,
-					r.APIServer,
-					kubernetesRuntimeDefinition.GetId(),
-				)
-				latestKubernetesRuntimeDefinition = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of kubernetes runtime definition object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(kubernetesRuntimeDefinition)
+				if locked || ok == false {
+					r.Requeue(kubernetesRuntimeDefinition, requeueDelay, msg)
+					log.V(1).Info("kubernetes runtime definition reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(kubernetesRuntimeDefinition, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get kubernetes runtime definition by ID from API")
-				r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
-				continue
-			}
-			kubernetesRuntimeDefinition = latestKubernetesRuntimeDefinition
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of kubernetes runtime definition")
+						r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for kubernetes runtime definition, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if kubernetesRuntimeDefinition.ScheduledForDeletion() != nil {
-					log.Info("kubernetes runtime definition scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(kubernetesRuntimeDefinition); !ok {
+					r.Requeue(kubernetesRuntimeDefinition, requeueDelay, msg)
+					log.V(1).Info("kubernetes runtime definition reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch kubernetesRuntimeDefinition.GetVersion() {
+
+				// retrieve latest version of object
+				var latestKubernetesRuntimeDefinition tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0KubernetesRuntimeDefinitionCreated(
-						r,
-						kubernetesRuntimeDefinition.(*api_v0.KubernetesRuntimeDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of kubernetes runtime definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created kubernetes runtime definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetKubernetesRuntimeDefinitionByID(
+						r.APIClient,
+						r.APIServer,
 						kubernetesRuntimeDefinition.GetId(),
-						kubernetesRuntimeDefinition.GetVersion(),
-						kubernetesRuntimeDefinition.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						kubernetesRuntimeDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestKubernetesRuntimeDefinition = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of kubernetes runtime definition object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						kubernetesRuntimeDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(kubernetesRuntimeDefinition, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch kubernetesRuntimeDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0KubernetesRuntimeDefinitionUpdated(
-						r,
-						kubernetesRuntimeDefinition.(*api_v0.KubernetesRuntimeDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of kubernetes runtime definition encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get kubernetes runtime definition by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated kubernetes runtime definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				kubernetesRuntimeDefinition = latestKubernetesRuntimeDefinition
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if kubernetesRuntimeDefinition.ScheduledForDeletion() != nil {
+						log.Info("kubernetes runtime definition scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch kubernetesRuntimeDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0KubernetesRuntimeDefinitionCreated(
+							r,
+							kubernetesRuntimeDefinition.(*api_v0.KubernetesRuntimeDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of kubernetes runtime definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created kubernetes runtime definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							kubernetesRuntimeDefinition.GetId(),
+							kubernetesRuntimeDefinition.GetVersion(),
+							kubernetesRuntimeDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							kubernetesRuntimeDefinition,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateKubernetesRuntimeDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedKubernetesRuntimeDefinition,
+							); err != nil {
+								log.Error(err, "failed to update kubernetes runtime definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							kubernetesRuntimeDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							kubernetesRuntimeDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch kubernetesRuntimeDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0KubernetesRuntimeDefinitionUpdated(
+							r,
+							kubernetesRuntimeDefinition.(*api_v0.KubernetesRuntimeDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of kubernetes runtime definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated kubernetes runtime definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							kubernetesRuntimeDefinition.GetId(),
+							kubernetesRuntimeDefinition.GetVersion(),
+							kubernetesRuntimeDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							kubernetesRuntimeDefinition,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateKubernetesRuntimeDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedKubernetesRuntimeDefinition,
+							); err != nil {
+								log.Error(err, "failed to update kubernetes runtime definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							kubernetesRuntimeDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							kubernetesRuntimeDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch kubernetesRuntimeDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0KubernetesRuntimeDefinitionDeleted(
+							r,
+							kubernetesRuntimeDefinition.(*api_v0.KubernetesRuntimeDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of kubernetes runtime definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted kubernetes runtime definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							kubernetesRuntimeDefinition.GetId(),
+							kubernetesRuntimeDefinition.GetVersion(),
+							kubernetesRuntimeDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							kubernetesRuntimeDefinition,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateKubernetesRuntimeDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedKubernetesRuntimeDefinition,
+							); err != nil {
+								log.Error(err, "failed to update kubernetes runtime definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							kubernetesRuntimeDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							kubernetesRuntimeDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
+						Common: api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateKubernetesRuntimeDefinition(
+						r.APIClient,
+						r.APIServer,
+						&deletedKubernetesRuntimeDefinition,
+					)
+					if err != nil {
+						log.Error(err, "failed to update kubernetes runtime definition to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteKubernetesRuntimeDefinition(
+						r.APIClient,
+						r.APIServer,
 						kubernetesRuntimeDefinition.GetId(),
-						kubernetesRuntimeDefinition.GetVersion(),
-						kubernetesRuntimeDefinition.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete kubernetes runtime definition")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						kubernetesRuntimeDefinition,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						kubernetesRuntimeDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch kubernetesRuntimeDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0KubernetesRuntimeDefinitionDeleted(
-						r,
-						kubernetesRuntimeDefinition.(*api_v0.KubernetesRuntimeDefinition),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of kubernetes runtime definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted kubernetes runtime definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
+						Common: api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						kubernetesRuntimeDefinition.GetId(),
-						kubernetesRuntimeDefinition.GetVersion(),
-						kubernetesRuntimeDefinition.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						kubernetesRuntimeDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedKubernetesRuntimeDefinition, err := client_v0.UpdateKubernetesRuntimeDefinition(
+						r.APIClient,
+						r.APIServer,
+						&reconciledKubernetesRuntimeDefinition,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						kubernetesRuntimeDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update kubernetes runtime definition to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"kubernetes runtime definition marked as reconciled in API",
+						"kubernetes runtime definitionName", updatedKubernetesRuntimeDefinition.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
-					Common: api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateKubernetesRuntimeDefinition(
-					r.APIClient,
-					r.APIServer,
-					&deletedKubernetesRuntimeDefinition,
-				)
-				if err != nil {
-					log.Error(err, "failed to update kubernetes runtime definition to mark as deleted")
-					r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteKubernetesRuntimeDefinition(
-					r.APIClient,
-					r.APIServer,
-					kubernetesRuntimeDefinition.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete kubernetes runtime definition")
-					r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(kubernetesRuntimeDefinition, lockReleased, msg, true); !ok {
+					log.Error(errors.New("kubernetes runtime definition remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("kubernetes runtime definition unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					kubernetesRuntimeDefinition,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
-					Common:         api_v0.Common{ID: util.Ptr(kubernetesRuntimeDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("kubernetes runtime definition resynced")
+					return
 				}
-				updatedKubernetesRuntimeDefinition, err := client_v0.UpdateKubernetesRuntimeDefinition(
-					r.APIClient,
-					r.APIServer,
-					&reconciledKubernetesRuntimeDefinition,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"kubernetes runtime definition successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update kubernetes runtime definition to mark as reconciled")
-					r.UnlockAndRequeue(kubernetesRuntimeDefinition, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					kubernetesRuntimeDefinition.GetId(),
+					kubernetesRuntimeDefinition.GetVersion(),
+					kubernetesRuntimeDefinition.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful kubernetes runtime definition reconciliation")
 				}
-				log.V(1).Info(
-					"kubernetes runtime definition marked as reconciled in API",
-					"kubernetes runtime definitionName", updatedKubernetesRuntimeDefinition.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(kubernetesRuntimeDefinition, lockReleased, msg, true); !ok {
-				log.Error(errors.New("kubernetes runtime definition remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("kubernetes runtime definition unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"kubernetes runtime definition successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				kubernetesRuntimeDefinition.GetId(),
-				kubernetesRuntimeDefinition.GetVersion(),
-				kubernetesRuntimeDefinition.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful kubernetes runtime definition reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func KubernetesRuntimeDefinitionReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledKubernetesRuntimeDefinitions returns the kubernetes runtime definitions that have been
+// reconciled so they may be resynced.
+func ListReconciledKubernetesRuntimeDefinitions(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0KubernetesRuntimeDefinitions, err := client_v0.GetKubernetesRuntimeDefinitionsWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 kubernetes runtime definitions: %w", err)
+	}
+	for i := range *v0KubernetesRuntimeDefinitions {
+		reconciled = append(reconciled, &(*v0KubernetesRuntimeDefinitions)[i])
+	}
+
+	return reconciled, nil
+}
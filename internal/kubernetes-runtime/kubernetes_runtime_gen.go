@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "kubernetesRuntimeLock"
 	LockBucketDescr = "contains locks on kubernetes runtime objects"
 )
+
+// The names of the NATS bucket used to track kubernetes runtime controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "kubernetesRuntimeMembers"
+	ShardStreamName  = "kubernetesRuntimeShard"
+)
@@ -5,6 +5,7 @@ package observability
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func MetricsDefinitionReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func MetricsDefinitionReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("metrics definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var metricsDefinition tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				metricsDefinition = &api_v0.MetricsDefinition{}
-			default:
-				log.Error(errors.New("received unrecognized version of metrics definition object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("metrics definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := metricsDefinition.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("metrics definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("metricsDefinitionID", metricsDefinition.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("metrics definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(metricsDefinition)
-			if locked || ok == false {
-				r.Requeue(metricsDefinition, requeueDelay, msg)
-				log.V(1).Info("metrics definition reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var metricsDefinition tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					metricsDefinition = &api_v0.MetricsDefinition{}
+				default:
+					log.Error(errors.New("received unrecognized version of metrics definition object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("metrics definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of metrics definition")
-					r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for metrics definition, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := metricsDefinition.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("metrics definition reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("metricsDefinitionID", metricsDefinition.GetId())
+				r.TraceObject(msg, metricsDefinition, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(metricsDefinition); !ok {
-				r.Requeue(metricsDefinition, requeueDelay, msg)
-				log.V(1).Info("metrics definition reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(metricsDefinition)
 
-			// retrieve latest version of object
-			var latestMetricsDefinition tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetMetricsDefinitionByID(
-					r.APIClient,
-					r.APIServer,
-					metricsDefinition.GetId(),
-				)
-				latestMetricsDefinition = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of metrics definition object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(metricsDefinition)
+				if locked || ok == false {
+					r.Requeue(metricsDefinition, requeueDelay, msg)
+					log.V(1).Info("metrics definition reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(metricsDefinition, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get metrics definition by ID from API")
-				r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
-				continue
-			}
-			metricsDefinition = latestMetricsDefinition
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of metrics definition")
+						r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for metrics definition, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if metricsDefinition.ScheduledForDeletion() != nil {
-					log.Info("metrics definition scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(metricsDefinition); !ok {
+					r.Requeue(metricsDefinition, requeueDelay, msg)
+					log.V(1).Info("metrics definition reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch metricsDefinition.GetVersion() {
+
+				// retrieve latest version of object
+				var latestMetricsDefinition tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0MetricsDefinitionCreated(
-						r,
-						metricsDefinition.(*api_v0.MetricsDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of metrics definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created metrics definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetMetricsDefinitionByID(
+						r.APIClient,
+						r.APIServer,
 						metricsDefinition.GetId(),
-						metricsDefinition.GetVersion(),
-						metricsDefinition.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						metricsDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestMetricsDefinition = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of metrics definition object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						metricsDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(metricsDefinition, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch metricsDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0MetricsDefinitionUpdated(
-						r,
-						metricsDefinition.(*api_v0.MetricsDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of metrics definition encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get metrics definition by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated metrics definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				metricsDefinition = latestMetricsDefinition
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if metricsDefinition.ScheduledForDeletion() != nil {
+						log.Info("metrics definition scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch metricsDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0MetricsDefinitionCreated(
+							r,
+							metricsDefinition.(*api_v0.MetricsDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of metrics definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created metrics definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							metricsDefinition.GetId(),
+							metricsDefinition.GetVersion(),
+							metricsDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							metricsDefinition,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedMetricsDefinition := api_v0.MetricsDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateMetricsDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedMetricsDefinition,
+							); err != nil {
+								log.Error(err, "failed to update metrics definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							metricsDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							metricsDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch metricsDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0MetricsDefinitionUpdated(
+							r,
+							metricsDefinition.(*api_v0.MetricsDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of metrics definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated metrics definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							metricsDefinition.GetId(),
+							metricsDefinition.GetVersion(),
+							metricsDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							metricsDefinition,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedMetricsDefinition := api_v0.MetricsDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateMetricsDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedMetricsDefinition,
+							); err != nil {
+								log.Error(err, "failed to update metrics definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							metricsDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							metricsDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch metricsDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0MetricsDefinitionDeleted(
+							r,
+							metricsDefinition.(*api_v0.MetricsDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of metrics definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted metrics definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							metricsDefinition.GetId(),
+							metricsDefinition.GetVersion(),
+							metricsDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							metricsDefinition,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedMetricsDefinition := api_v0.MetricsDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateMetricsDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedMetricsDefinition,
+							); err != nil {
+								log.Error(err, "failed to update metrics definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							metricsDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							metricsDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedMetricsDefinition := api_v0.MetricsDefinition{
+						Common: api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateMetricsDefinition(
+						r.APIClient,
+						r.APIServer,
+						&deletedMetricsDefinition,
+					)
+					if err != nil {
+						log.Error(err, "failed to update metrics definition to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteMetricsDefinition(
+						r.APIClient,
+						r.APIServer,
 						metricsDefinition.GetId(),
-						metricsDefinition.GetVersion(),
-						metricsDefinition.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete metrics definition")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						metricsDefinition,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						metricsDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch metricsDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0MetricsDefinitionDeleted(
-						r,
-						metricsDefinition.(*api_v0.MetricsDefinition),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of metrics definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted metrics definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledMetricsDefinition := api_v0.MetricsDefinition{
+						Common: api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						metricsDefinition.GetId(),
-						metricsDefinition.GetVersion(),
-						metricsDefinition.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						metricsDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedMetricsDefinition, err := client_v0.UpdateMetricsDefinition(
+						r.APIClient,
+						r.APIServer,
+						&reconciledMetricsDefinition,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						metricsDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update metrics definition to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"metrics definition marked as reconciled in API",
+						"metrics definitionName", updatedMetricsDefinition.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedMetricsDefinition := api_v0.MetricsDefinition{
-					Common: api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateMetricsDefinition(
-					r.APIClient,
-					r.APIServer,
-					&deletedMetricsDefinition,
-				)
-				if err != nil {
-					log.Error(err, "failed to update metrics definition to mark as deleted")
-					r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteMetricsDefinition(
-					r.APIClient,
-					r.APIServer,
-					metricsDefinition.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete metrics definition")
-					r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(metricsDefinition, lockReleased, msg, true); !ok {
+					log.Error(errors.New("metrics definition remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("metrics definition unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					metricsDefinition,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledMetricsDefinition := api_v0.MetricsDefinition{
-					Common:         api_v0.Common{ID: util.Ptr(metricsDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("metrics definition resynced")
+					return
 				}
-				updatedMetricsDefinition, err := client_v0.UpdateMetricsDefinition(
-					r.APIClient,
-					r.APIServer,
-					&reconciledMetricsDefinition,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"metrics definition successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update metrics definition to mark as reconciled")
-					r.UnlockAndRequeue(metricsDefinition, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					metricsDefinition.GetId(),
+					metricsDefinition.GetVersion(),
+					metricsDefinition.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful metrics definition reconciliation")
 				}
-				log.V(1).Info(
-					"metrics definition marked as reconciled in API",
-					"metrics definitionName", updatedMetricsDefinition.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(metricsDefinition, lockReleased, msg, true); !ok {
-				log.Error(errors.New("metrics definition remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("metrics definition unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"metrics definition successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				metricsDefinition.GetId(),
-				metricsDefinition.GetVersion(),
-				metricsDefinition.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful metrics definition reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func MetricsDefinitionReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledMetricsDefinitions returns the metrics definitions that have been
+// reconciled so they may be resynced.
+func ListReconciledMetricsDefinitions(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0MetricsDefinitions, err := client_v0.GetMetricsDefinitionsWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 metrics definitions: %w", err)
+	}
+	for i := range *v0MetricsDefinitions {
+		reconciled = append(reconciled, &(*v0MetricsDefinitions)[i])
+	}
+
+	return reconciled, nil
+}
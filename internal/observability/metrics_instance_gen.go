@@ -5,6 +5,7 @@ package observability
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func MetricsInstanceReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func MetricsInstanceReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("metrics instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var metricsInstance tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				metricsInstance = &api_v0.MetricsInstance{}
-			default:
-				log.Error(errors.New("received unrecognized version of metrics instance object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("metrics instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := metricsInstance.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("metrics instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("metricsInstanceID", metricsInstance.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("metrics instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(metricsInstance)
-			if locked || ok == false {
-				r.Requeue(metricsInstance, requeueDelay, msg)
-				log.V(1).Info("metrics instance reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var metricsInstance tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					metricsInstance = &api_v0.MetricsInstance{}
+				default:
+					log.Error(errors.New("received unrecognized version of metrics instance object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("metrics instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of metrics instance")
-					r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for metrics instance, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := metricsInstance.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("metrics instance reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("metricsInstanceID", metricsInstance.GetId())
+				r.TraceObject(msg, metricsInstance, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(metricsInstance); !ok {
-				r.Requeue(metricsInstance, requeueDelay, msg)
-				log.V(1).Info("metrics instance reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(metricsInstance)
 
-			// retrieve latest version of object
-			var latestMetricsInstance tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetMetricsInstanceByID(
-					r.APIClient,
-					r.APIServer,
-					metricsInstance.GetId(),
-				)
-				latestMetricsInstance = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of metrics instance object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(metricsInstance)
+				if locked || ok == false {
+					r.Requeue(metricsInstance, requeueDelay, msg)
+					log.V(1).Info("metrics instance reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(metricsInstance, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get metrics instance by ID from API")
-				r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
-				continue
-			}
-			metricsInstance = latestMetricsInstance
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of metrics instance")
+						r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for metrics instance, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if metricsInstance.ScheduledForDeletion() != nil {
-					log.Info("metrics instance scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(metricsInstance); !ok {
+					r.Requeue(metricsInstance, requeueDelay, msg)
+					log.V(1).Info("metrics instance reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch metricsInstance.GetVersion() {
+
+				// retrieve latest version of object
+				var latestMetricsInstance tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0MetricsInstanceCreated(
-						r,
-						metricsInstance.(*api_v0.MetricsInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of metrics instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created metrics instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetMetricsInstanceByID(
+						r.APIClient,
+						r.APIServer,
 						metricsInstance.GetId(),
-						metricsInstance.GetVersion(),
-						metricsInstance.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						metricsInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestMetricsInstance = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of metrics instance object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						metricsInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(metricsInstance, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch metricsInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0MetricsInstanceUpdated(
-						r,
-						metricsInstance.(*api_v0.MetricsInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of metrics instance encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get metrics instance by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated metrics instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				metricsInstance = latestMetricsInstance
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if metricsInstance.ScheduledForDeletion() != nil {
+						log.Info("metrics instance scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch metricsInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0MetricsInstanceCreated(
+							r,
+							metricsInstance.(*api_v0.MetricsInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of metrics instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created metrics instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							metricsInstance.GetId(),
+							metricsInstance.GetVersion(),
+							metricsInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							metricsInstance,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedMetricsInstance := api_v0.MetricsInstance{
+								Common:         api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateMetricsInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedMetricsInstance,
+							); err != nil {
+								log.Error(err, "failed to update metrics instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							metricsInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							metricsInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch metricsInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0MetricsInstanceUpdated(
+							r,
+							metricsInstance.(*api_v0.MetricsInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of metrics instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated metrics instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							metricsInstance.GetId(),
+							metricsInstance.GetVersion(),
+							metricsInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							metricsInstance,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedMetricsInstance := api_v0.MetricsInstance{
+								Common:         api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateMetricsInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedMetricsInstance,
+							); err != nil {
+								log.Error(err, "failed to update metrics instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							metricsInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							metricsInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch metricsInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0MetricsInstanceDeleted(
+							r,
+							metricsInstance.(*api_v0.MetricsInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of metrics instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted metrics instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							metricsInstance.GetId(),
+							metricsInstance.GetVersion(),
+							metricsInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							metricsInstance,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedMetricsInstance := api_v0.MetricsInstance{
+								Common:         api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateMetricsInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedMetricsInstance,
+							); err != nil {
+								log.Error(err, "failed to update metrics instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							metricsInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							metricsInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedMetricsInstance := api_v0.MetricsInstance{
+						Common: api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateMetricsInstance(
+						r.APIClient,
+						r.APIServer,
+						&deletedMetricsInstance,
+					)
+					if err != nil {
+						log.Error(err, "failed to update metrics instance to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteMetricsInstance(
+						r.APIClient,
+						r.APIServer,
 						metricsInstance.GetId(),
-						metricsInstance.GetVersion(),
-						metricsInstance.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete metrics instance")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						metricsInstance,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						metricsInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch metricsInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0MetricsInstanceDeleted(
-						r,
-						metricsInstance.(*api_v0.MetricsInstance),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of metrics instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted metrics instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledMetricsInstance := api_v0.MetricsInstance{
+						Common: api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						metricsInstance.GetId(),
-						metricsInstance.GetVersion(),
-						metricsInstance.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						metricsInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedMetricsInstance, err := client_v0.UpdateMetricsInstance(
+						r.APIClient,
+						r.APIServer,
+						&reconciledMetricsInstance,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						metricsInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update metrics instance to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"metrics instance marked as reconciled in API",
+						"metrics instanceName", updatedMetricsInstance.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedMetricsInstance := api_v0.MetricsInstance{
-					Common: api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateMetricsInstance(
-					r.APIClient,
-					r.APIServer,
-					&deletedMetricsInstance,
-				)
-				if err != nil {
-					log.Error(err, "failed to update metrics instance to mark as deleted")
-					r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteMetricsInstance(
-					r.APIClient,
-					r.APIServer,
-					metricsInstance.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete metrics instance")
-					r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(metricsInstance, lockReleased, msg, true); !ok {
+					log.Error(errors.New("metrics instance remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("metrics instance unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					metricsInstance,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledMetricsInstance := api_v0.MetricsInstance{
-					Common:         api_v0.Common{ID: util.Ptr(metricsInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("metrics instance resynced")
+					return
 				}
-				updatedMetricsInstance, err := client_v0.UpdateMetricsInstance(
-					r.APIClient,
-					r.APIServer,
-					&reconciledMetricsInstance,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"metrics instance successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update metrics instance to mark as reconciled")
-					r.UnlockAndRequeue(metricsInstance, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					metricsInstance.GetId(),
+					metricsInstance.GetVersion(),
+					metricsInstance.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful metrics instance reconciliation")
 				}
-				log.V(1).Info(
-					"metrics instance marked as reconciled in API",
-					"metrics instanceName", updatedMetricsInstance.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(metricsInstance, lockReleased, msg, true); !ok {
-				log.Error(errors.New("metrics instance remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("metrics instance unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"metrics instance successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				metricsInstance.GetId(),
-				metricsInstance.GetVersion(),
-				metricsInstance.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful metrics instance reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func MetricsInstanceReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledMetricsInstances returns the metrics instances that have been
+// reconciled so they may be resynced.
+func ListReconciledMetricsInstances(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0MetricsInstances, err := client_v0.GetMetricsInstancesWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 metrics instances: %w", err)
+	}
+	for i := range *v0MetricsInstances {
+		reconciled = append(reconciled, &(*v0MetricsInstances)[i])
+	}
+
+	return reconciled, nil
+}
@@ -5,6 +5,7 @@ package observability
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func ObservabilityDashboardInstanceReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,422 @@ func ObservabilityDashboardInstanceReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("observability dashboard instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var observabilityDashboardInstance tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				observabilityDashboardInstance = &api_v0.ObservabilityDashboardInstance{}
-			default:
-				log.Error(errors.New("received unrecognized version of observability dashboard instance object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("observability dashboard instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := observabilityDashboardInstance.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("observability dashboard instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("observabilityDashboardInstanceID", observabilityDashboardInstance.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("observability dashboard instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(observabilityDashboardInstance)
-			if locked || ok == false {
-				r.Requeue(observabilityDashboardInstance, requeueDelay, msg)
-				log.V(1).Info("observability dashboard instance reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var observabilityDashboardInstance tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					observabilityDashboardInstance = &api_v0.ObservabilityDashboardInstance{}
+				default:
+					log.Error(errors.New("received unrecognized version of observability dashboard instance object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("observability dashboard instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of observability dashboard instance")
-					r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for observability dashboard instance, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := observabilityDashboardInstance.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("observability dashboard instance reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("observabilityDashboardInstanceID", observabilityDashboardInstance.GetId())
+				r.TraceObject(msg, observabilityDashboardInstance, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(observabilityDashboardInstance); !ok {
-				r.Requeue(observabilityDashboardInstance, requeueDelay, msg)
-				log.V(1).Info("observability dashboard instance reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(observabilityDashboardInstance)
 
-			// retrieve latest version of object
-			var latestObservabilityDashboardInstance tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetObservabilityDashboardInstanceByID(
-					r.APIClient,
-					r.APIServer,
-					observabilityDashboardInstance.GetId(),
-				)
-				latestObservabilityDashboardInstance = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of observability dashboard instance object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(observabilityDashboardInstance)
+				if locked || ok == false {
+					r.Requeue(observabilityDashboardInstance, requeueDelay, msg)
+					log.V(1).Info("observability dashboard instance reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(observabilityDashboardInstance, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get observability dashboard instance by ID from API")
-				r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
-				continue
-			}
-			observabilityDashboardInstance = latestObservabilityDashboardInstance
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of observability dashboard instance")
+						r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for observability dashboard instance, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if observabilityDashboardInstance.ScheduledForDeletion() != nil {
-					log.Info("observability dashboard instance scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(observabilityDashboardInstance); !ok {
+					r.Requeue(observabilityDashboardInstance, requeueDelay, msg)
+					log.V(1).Info("observability dashboard instance reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch observabilityDashboardInstance.GetVersion() {
+
+				// retrieve latest version of object
+				var latestObservabilityDashboardInstance tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0ObservabilityDashboardInstanceCreated(
-						r,
-						observabilityDashboardInstance.(*api_v0.ObservabilityDashboardInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of observability dashboard instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created observability dashboard instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetObservabilityDashboardInstanceByID(
+						r.APIClient,
+						r.APIServer,
 						observabilityDashboardInstance.GetId(),
-						observabilityDashboardInstance.GetVersion(),
-						observabilityDashboardInstance.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						observabilityDashboardInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestObservabilityDashboardInstance = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of observability dashboard instance object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						observabilityDashboardInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(observabilityDashboardInstance, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch observabilityDashboardInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0ObservabilityDashboardInstanceUpdated(
-						r,
-						observabilityDashboardInstance.(*api_v0.ObservabilityDashboardInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of observability dashboard instance encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get observability dashboard instance by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated observability dashboard instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				observabilityDashboardInstance = latestObservabilityDashboardInstance
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if observabilityDashboardInstance.ScheduledForDeletion() != nil {
+						log.Info("observability dashboard instance scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch observabilityDashboardInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0ObservabilityDashboardInstanceCreated(
+							r,
+							observabilityDashboardInstance.(*api_v0.ObservabilityDashboardInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of observability dashboard instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created observability dashboard instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							observabilityDashboardInstance.GetId(),
+							observabilityDashboardInstance.GetVersion(),
+							observabilityDashboardInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							observabilityDashboardInstance,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
+								Common:         api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateObservabilityDashboardInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedObservabilityDashboardInstance,
+							); err != nil {
+								log.Error(err, "failed to update observability dashboard instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							observabilityDashboardInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							observabilityDashboardInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch observabilityDashboardInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0ObservabilityDashboardInstanceUpdated(
+							r,
+							observabilityDashboardInstance.(*api_v0.ObservabilityDashboardInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of observability dashboard instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated observability dashboard instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							observabilityDashboardInstance.GetId(),
+							observabilityDashboardInstance.GetVersion(),
+							observabilityDashboardInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							observabilityDashboardInstance,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
+								Common:         api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateObservabilityDashboardInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedObservabilityDashboardInstance,
+							); err != nil {
+								log.Error(err, "failed to update observability dashboard instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							observabilityDashboardInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							observabilityDashboardInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch observabilityDashboardInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0ObservabilityDashboardInstanceDeleted(
+							r,
+							observabilityDashboardInstance.(*api_v0.ObservabilityDashboardInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of observability dashboard instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted observability dashboard instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							observabilityDashboardInstance.GetId(),
+							observabilityDashboardInstance.GetVersion(),
+							observabilityDashboardInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							observabilityDashboardInstance,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
+								Common:         api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateObservabilityDashboardInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedObservabilityDashboardInstance,
+							); err != nil {
+								log.Error(err, "failed to update observability dashboard instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							observabilityDashboardInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							observabilityDashboardInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
+						Common: api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateObservabilityDashboardInstance(
+						r.APIClient,
+						r.APIServer,
+						&deletedObservabilityDashboardInstance,
+					)
+					if err != nil {
+						log.Error(err, "failed to update observability dashboard instance to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteObservabilityDashboardInstance(
+						r.APIClient,
+						r.APIServer,
 						observabilityDashboardInstance.GetId(),
-						observabilityDashboardInstance.GetVersion(),
-						observabilityDashboardInstance.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete observability dashboard instance")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						observabilityDashboardInstance,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						observabilityDashboardInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch observabilityDashboardInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0ObservabilityDashboardInstanceDeleted(
-						r,
-						observabilityDashboardInstance.(*api_v0.ObservabilityDashboardInstance),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of observability dashboard instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted observability dashboard instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
+						Common: api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						observabilityDashboardInstance.GetId(),
-						observabilityDashboardInstance.GetVersion(),
-						observabilityDashboardInstance.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						observabilityDashboardInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedObservabilityDashboardInstance, err := client_v0.UpdateObservabilityDashboardInstance(
+						r.APIClient,
+						r.APIServer,
+						&reconciledObservabilityDashboardInstance,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						observabilityDashboardInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update observability dashboard instance to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"observability dashboard instance marked as reconciled in API",
+						"observability dashboard instanceName", updatedObservabilityDashboardInstance.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
-					Common: api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateObservabilityDashboardInstance(
-					r.APIClient,
-					r.APIServer,
-					&deletedObservabilityDashboardInstance,
-				)
-				if err != nil {
-					log.Error(err, "failed to update observability dashboard instance to mark as deleted")
-					r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteObservabilityDashboardInstance(
-					r.APIClient,
-					r.APIServer,
-					observabilityDashboardInstance.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete observability dashboard instance")
-					r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(observabilityDashboardInstance, lockReleased, msg, true); !ok {
+					log.Error(errors.New("observability dashboard instance remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("observability dashboard instance unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					observabilityDashboardInstance,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledObservabilityDashboardInstance := api_v0.ObservabilityDashboardInstance{
-					Common:         api_v0.Common{ID: util.Ptr(observabilityDashboardInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("observability dashboard instance resynced")
+					return
 				}
-				updatedObservabilityDashboardInstance, err := client_v0.UpdateObservabilityDashboardInstance(
-					r.APIClient,
-					r.APIServer,
-					&reconciledObservabilityDashboardInstance,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"observability dashboard instance successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update observability dashboard instance to mark as reconciled")
-					r.UnlockAndRequeue(observabilityDashboardInstance, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					observabilityDashboardInstance.GetId(),
+					observabilityDashboardInstance.GetVersion(),
+					observabilityDashboardInstance.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful observability dashboard instance reconciliation")
 				}
-				log.V(1).Info(
-					"observability dashboard instance marked as reconciled in API",
-					"observability dashboard instanceName", updatedObservabilityDashboardInstance.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(observabilityDashboardInstance, lockReleased, msg, true); !ok {
-				log.Error(errors.New("observability dashboard instance remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("observability dashboard instance unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"observability dashboard instance successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				observabilityDashboardInstance.GetId(),
-				observabilityDashboardInstance.GetVersion(),
-				observabilityDashboardInstance.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful observability dashboard instance reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +484,22 @@ func ObservabilityDashboardInstanceReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledObservabilityDashboardInstances returns the observability dashboard instances that have been
+// reconciled so they may be resynced.
+func ListReconciledObservabilityDashboardInstances(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0ObservabilityDashboardInstances, err := client_v0.GetObservabilityDashboardInstancesWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 observability dashboard instances: %w", err)
+	}
+	for i := range *v0ObservabilityDashboardInstances {
+		reconciled = append(reconciled, &(*v0ObservabilityDashboardInstances)[i])
+	}
+
+	return reconciled, nil
+}
@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "observabilityLock"
 	LockBucketDescr = "contains locks on observability objects"
 )
+
+// The names of the NATS bucket used to track observability controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "observabilityMembers"
+	ShardStreamName  = "observabilityShard"
+)
@@ -3,14 +3,12 @@
 package observability
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/go-logr/logr"
 
 	helmworkload "github.com/threeport/threeport/internal/helm-workload"
 	v0 "github.com/threeport/threeport/pkg/api/v0"
-	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client "github.com/threeport/threeport/pkg/client/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
@@ -50,6 +48,9 @@ func v0ObservabilityDashboardInstanceCreated(
 		r.APIClient,
 		r.APIServer,
 		&v0.HelmWorkloadInstance{
+			Common: v0.Common{
+				OwnerReferences: controller.OwnedBy(observabilityDashboardInstance),
+			},
 			Instance: v0.Instance{
 				Name: util.Ptr(GrafanaChartName(*observabilityDashboardInstance.Name)),
 			},
@@ -95,14 +96,8 @@ func v0ObservabilityDashboardInstanceDeleted(
 	observabilityDashboardInstance *v0.ObservabilityDashboardInstance,
 	log *logr.Logger,
 ) (int64, error) {
-	// delete grafana helm workload instance
-	if _, err := client.DeleteHelmWorkloadInstance(
-		r.APIClient,
-		r.APIServer,
-		*observabilityDashboardInstance.GrafanaHelmWorkloadInstanceID,
-	); err != nil && !errors.Is(err, client_lib.ErrObjectNotFound) {
-		return 0, fmt.Errorf("failed to delete grafana helm workload instance: %w", err)
-	}
+	// the grafana helm workload instance is owned by the observability
+	// dashboard instance and is deleted along with it
 
 	return 0, nil
 }
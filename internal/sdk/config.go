@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
 	"github.com/threeport/threeport/internal/sdk/util"
+	controller "github.com/threeport/threeport/pkg/controller/v0"
 )
 
 // SdkConfig contains all the configuration options available to a user
@@ -134,6 +136,41 @@ type ApiObject struct {
 
 	// Tptctl contains sdk configurations related to tptctl
 	Tptctl *Tptctl `yaml:"Tptctl"`
+
+	// RetryPolicy determines how failed reconciliations of the object are
+	// retried.  Only applies to reconcilable objects.
+	RetryPolicy *RetryPolicy `yaml:"RetryPolicy"`
+
+	// DriftDetection indicates whether the object's reconciler checks the
+	// resources it manages for drift from the desired state when the object
+	// is periodically resynced.  If true, a DriftCheck function must be
+	// written for each version of the object, e.g. v0WorkloadInstanceDriftCheck.
+	// Only applies to reconcilable objects.
+	DriftDetection *bool `yaml:"DriftDetection"`
+}
+
+// RetryPolicy contains the attributes used to generate the retry policy for
+// an object's reconciler.  Fields that are not set use the controller
+// defaults.
+type RetryPolicy struct {
+	// The delay in seconds before the first retry.
+	InitialDelay *int64 `yaml:"InitialDelay"`
+
+	// The maximum delay in seconds between retries.
+	MaxDelay *int64 `yaml:"MaxDelay"`
+
+	// The factor the delay is multiplied by after each failed attempt.
+	Multiplier *float64 `yaml:"Multiplier"`
+
+	// The fraction of the delay, between 0 and 1, that is randomly added to
+	// or removed from each delay.
+	Jitter *float64 `yaml:"Jitter"`
+
+	// The number of failed attempts after which the notification is moved
+	// to the dead-letter stream.  If not set, reconciliation is retried
+	// indefinitely.  When set, delays must be less than 1200 seconds, the TTL
+	// of the bucket failed attempts are counted in.
+	MaxAttempts *int `yaml:"MaxAttempts"`
 }
 
 // Tptctl contains attributes used by the SDK to generate tptctl
@@ -216,6 +253,76 @@ func ValidateSdkConfig(sdkConfig *SdkConfig) error {
 		}
 	}
 
+	// check that retry policies are valid
+	for _, objectGroup := range sdkConfig.ApiObjectConfig.ApiObjectGroups {
+		for _, object := range objectGroup.Objects {
+			if object.RetryPolicy == nil {
+				continue
+			}
+			if object.Reconcilable == nil || !*object.Reconcilable {
+				return fmt.Errorf("%s has a RetryPolicy but is not reconcilable", *object.Name)
+			}
+			if err := validateRetryPolicy(object.RetryPolicy); err != nil {
+				return fmt.Errorf("%s has an invalid RetryPolicy: %w", *object.Name, err)
+			}
+		}
+	}
+
+	// check that objects with drift detection can be resynced
+	for _, objectGroup := range sdkConfig.ApiObjectConfig.ApiObjectGroups {
+		for _, object := range objectGroup.Objects {
+			if object.DriftDetection == nil || !*object.DriftDetection {
+				continue
+			}
+			if object.Reconcilable == nil || !*object.Reconcilable {
+				return fmt.Errorf("%s has DriftDetection enabled but is not reconcilable", *object.Name)
+			}
+			if object.DisableNotificationPersistence != nil && *object.DisableNotificationPersistence {
+				return fmt.Errorf("%s has DriftDetection enabled but notifications are not persisted", *object.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// retryAttemptsTTL is the TTL in seconds of the lock bucket that failed
+// reconciliation attempts are counted in by generated controllers.  A retry
+// delay that reaches the TTL lets the count expire between attempts.
+const retryAttemptsTTL = int64(controller.LockBucketTTL / time.Second)
+
+// validateRetryPolicy checks that the values of a retry policy are within
+// their bounds.
+func validateRetryPolicy(retryPolicy *RetryPolicy) error {
+	if retryPolicy.InitialDelay != nil && *retryPolicy.InitialDelay < 1 {
+		return fmt.Errorf("InitialDelay must be at least 1")
+	}
+	if retryPolicy.MaxDelay != nil && *retryPolicy.MaxDelay < 1 {
+		return fmt.Errorf("MaxDelay must be at least 1")
+	}
+	if retryPolicy.InitialDelay != nil && retryPolicy.MaxDelay != nil && *retryPolicy.MaxDelay < *retryPolicy.InitialDelay {
+		return fmt.Errorf("MaxDelay must not be less than InitialDelay")
+	}
+	if retryPolicy.Multiplier != nil && *retryPolicy.Multiplier < 1 {
+		return fmt.Errorf("Multiplier must be at least 1")
+	}
+	if retryPolicy.Jitter != nil && (*retryPolicy.Jitter < 0 || *retryPolicy.Jitter > 1) {
+		return fmt.Errorf("Jitter must be between 0 and 1")
+	}
+	if retryPolicy.MaxAttempts != nil && *retryPolicy.MaxAttempts < 1 {
+		return fmt.Errorf("MaxAttempts must be at least 1")
+	}
+	if retryPolicy.MaxAttempts != nil {
+		// the max delay is raised to the initial delay if it is not set
+		if (retryPolicy.MaxDelay != nil && *retryPolicy.MaxDelay >= retryAttemptsTTL) ||
+			(retryPolicy.InitialDelay != nil && *retryPolicy.InitialDelay >= retryAttemptsTTL) {
+			return fmt.Errorf(
+				"MaxDelay and InitialDelay must be less than %d when MaxAttempts is set because failed attempts are forgotten once the controller's lock bucket TTL expires",
+				retryAttemptsTTL,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -60,6 +60,7 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		commandCode.ImportAlias("github.com/threeport/threeport/pkg/cli/v0", "cli")
 		commandCode.ImportAlias("github.com/threeport/threeport/pkg/encryption/v0", "encryption")
 		commandCode.ImportAlias("github.com/threeport/threeport/pkg/util/v0", "util")
+		commandCode.ImportAlias("github.com/threeport/threeport/pkg/client/lib/v0", "client_lib")
 		if gen.Module {
 			commandCode.ImportAlias("github.com/threeport/threeport/cmd/tptctl/cmd", "tptctl_cmd")
 			commandCode.ImportAlias("github.com/threeport/threeport/pkg/config/v0", "tptctl_config")
@@ -152,6 +153,15 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					getCmdVar := fmt.Sprintf("Get%sCmd", pluralize.Pluralize(rootObj, 2, false))
 					getClientFunc := fmt.Sprintf("Get%s%s", rootObj, "Instances")
 					getCmdOutputFunc := fmt.Sprintf("output%s", getCmdVar)
+					getLabelSelectorVar := fmt.Sprintf("get%sLabelSelector", rootObj)
+					getOutputVar := fmt.Sprintf("get%sOutput", rootObj)
+					instanceCmdStr := strcase.ToKebab(instanceObj)
+
+					commandCode.Var().Defs(
+						Id(getLabelSelectorVar).String(),
+						Id(getOutputVar).String(),
+					)
+					commandCode.Line()
 
 					commandCode.Comment(fmt.Sprintf(
 						"%s represents the %s command",
@@ -164,9 +174,11 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					).Values(Dict{
 						Id("Use"): Lit(pluralize.Pluralize(rootCmdStr, 2, false)),
 						Id("Example"): Lit(fmt.Sprintf(
-							"  %s get %s",
+							"  # Get all %[1]s\n  %[2]s get %[3]s\n\n  # Get JSON output for all %[1]s\n  %[2]s get %[3]s -o json\n\n  # Get the name of each %[4]s\n  %[2]s get %[3]s -o jsonpath='{.items[*].Name}'",
+							pluralize.Pluralize(rootCmdStrHuman, 2, false),
 							exampleCmdStr,
 							pluralize.Pluralize(rootCmdStr, 2, false),
+							instanceHuman,
 						)),
 						Id("Short"): Lit(fmt.Sprintf(
 							"Get %s from the system",
@@ -183,6 +195,20 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							"github.com/spf13/cobra",
 							"Command",
 						), Id("args").Index().String()).BlockFunc(func(g *Group) {
+							g.If(
+								Err().Op(":=").Qual(
+									"github.com/threeport/threeport/pkg/cli/v0",
+									"ValidateGetOutput",
+								).Call(Id(getOutputVar)),
+								Err().Op("!=").Nil(),
+							).Block(
+								Qual(
+									"github.com/threeport/threeport/pkg/cli/v0",
+									"Error",
+								).Call(Lit("invalid output format"), Err()),
+								Qual("os", "Exit").Call(Lit(1)),
+							)
+							g.Line()
 							if gen.Module {
 								g.List(
 									Id("apiClient"),
@@ -211,8 +237,16 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 									fmt.Sprintf("%s%s", version, pluralize.Pluralize(instanceVar, 2, false)),
 								), Err()).Op(":=").Qual(
 									fmt.Sprintf("%s%s", clientImportPath, version),
-									getClientFunc,
-								).Call(Id("apiClient"), Id("apiEndpoint"))
+									getClientFunc+"WithOptions",
+								).Call(
+									Line().Id("apiClient"),
+									Line().Id("apiEndpoint"),
+									Line().Qual(
+										"github.com/threeport/threeport/pkg/client/lib/v0",
+										"WithLabelSelector",
+									).Call(Id(getLabelSelectorVar)),
+									Line(),
+								)
 								g.If(Err().Op("!=").Nil()).Block(
 									Qual(
 										"github.com/threeport/threeport/pkg/cli/v0",
@@ -227,6 +261,30 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							}
 							g.Line()
 							g.Comment("write the output")
+							g.If(Id(getOutputVar).Op("!=").Lit("")).Block(
+								If(
+									Err().Op(":=").Qual(
+										"github.com/threeport/threeport/pkg/cli/v0",
+										"OutputGetObjects",
+									).CustomFunc(multiLineCall, func(h *Group) {
+										h.Id(getOutputVar)
+										h.Lit(instanceCmdStr)
+										for _, version := range apiObj.Versions {
+											h.Id(
+												fmt.Sprintf("%s%s", version, pluralize.Pluralize(instanceVar, 2, false)),
+											)
+										}
+									}),
+									Err().Op("!=").Nil(),
+								).Block(
+									Qual(
+										"github.com/threeport/threeport/pkg/cli/v0",
+										"Error",
+									).Call(Lit("failed to produce output"), Err()),
+									Qual("os", "Exit").Call(Lit(1)),
+								),
+								Return(),
+							)
 							objLenCheck := &Statement{}
 							for i, version := range apiObj.Versions {
 								objLenCheck.Len(Op("*").Id(
@@ -281,6 +339,28 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							Lit("Optional. Name of control plane. Will default to current control plane if not provided."),
 							Line(),
 						),
+						Id(getCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+							Line().Op("&").Id(getLabelSelectorVar),
+							Line().Lit("selector"),
+							Lit("l"),
+							Lit(""),
+							Lit(fmt.Sprintf(
+								"Optional. Label selector to filter %s instances by, e.g. team=payments,tier!=dev.",
+								rootCmdStrHuman,
+							)),
+							Line(),
+						),
+						Id(getCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+							Line().Op("&").Id(getOutputVar),
+							Line().Lit("output"),
+							Lit("o"),
+							Lit(""),
+							Qual(
+								"github.com/threeport/threeport/pkg/cli/v0",
+								"GetOutputUsage",
+							),
+							Line(),
+						),
 					)
 
 					// defined instance create command
@@ -484,6 +564,205 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 
+					// defined instance update command
+					updateCmdVar := fmt.Sprintf("Update%sCmd", rootObj)
+					updateConfigPathVar := fmt.Sprintf("update%sConfigPath", rootObj)
+					updatedDefObjVar := fmt.Sprintf("updated%sDefinition", rootObj)
+					updatedInstObjVar := fmt.Sprintf("updated%sInstance", rootObj)
+					updateDefInstVersionVar := fmt.Sprintf("update%sVersion", rootObj)
+
+					// for models that use configs that reference other files the config
+					// path variable must be set on the config object
+					setConfigPath = &Statement{}
+					if apiObj.TptctlConfigPath || apiObj.DefinedInstanceTptctlConfigPath {
+						setConfigPath.Id(rootObjectVar).Dot(configPathField).Op("=").Op("&").Id(updateConfigPathVar)
+					}
+
+					commandCode.Var().Defs(
+						Id(updateConfigPathVar).String(),
+						Id(updateDefInstVersionVar).String(),
+					)
+
+					commandCode.Comment(fmt.Sprintf(
+						"%s represents the %s command",
+						updateCmdVar,
+						rootCmdStr,
+					))
+					commandCode.Var().Id(updateCmdVar).Op("=").Op("&").Qual(
+						"github.com/spf13/cobra",
+						"Command",
+					).Values(Dict{
+						Id("Use"): Lit(rootCmdStr),
+						Id("Example"): Lit(fmt.Sprintf(
+							"  %s update %s --config path/to/config.yaml",
+							exampleCmdStr,
+							rootCmdStr,
+						)),
+						Id("Short"): Lit(fmt.Sprintf(
+							"Update an existing %s",
+							rootCmdStrHuman,
+						)),
+						Id("Long"): Lit(fmt.Sprintf(
+							"Update an existing %[1]s. This command updates the %[1]s definition and %[1]s instance in place based on the %[1]s config.",
+							rootCmdStrHuman,
+						)),
+						Id("SilenceUsage"): True(),
+						Id("PreRun"):       Id("CommandPreRunFunc"),
+						Id("Run"): Func().Params(Id("cmd").Op("*").Qual(
+							"github.com/spf13/cobra",
+							"Command",
+						), Id("args").Index().String()).BlockFunc(func(g *Group) {
+							if gen.Module {
+								g.List(
+									Id("apiClient"),
+									Id("_"),
+									Id("apiEndpoint"),
+									Id("_"),
+								).Op(":=").Qual(
+									"github.com/threeport/threeport/cmd/tptctl/cmd",
+									"GetClientContext",
+								).Call(Id("cmd"))
+							} else {
+								g.List(
+									Id("apiClient"),
+									Id("_"),
+									Id("apiEndpoint"),
+									Id("_"),
+								).Op(":=").Id("GetClientContext").Call(Id("cmd"))
+							}
+							g.Line()
+							g.Comment(fmt.Sprintf(
+								"read %s config",
+								rootCmdStrHuman,
+							))
+							g.Id("configContent").Op(",").Err().Op(":=").Qual("os", "ReadFile").Call(
+								Id(updateConfigPathVar),
+							)
+							g.If(Err().Op("!=").Nil()).Block(
+								Qual(
+									"github.com/threeport/threeport/pkg/cli/v0",
+									"Error",
+								).Call(Lit("failed to read config file"), Err()),
+								Qual("os", "Exit").Call(Lit(1)),
+							)
+							g.Line()
+							g.Comment(fmt.Sprintf("update %s based on version", rootCmdStrHuman))
+							g.Switch(Id(updateDefInstVersionVar)).BlockFunc(func(h *Group) {
+								for _, version := range apiObj.Versions {
+									h.Case(Lit(version)).Block(
+										Var().Id(rootObjectConfigVar).Qual(
+											fmt.Sprintf("%s%s", configImportPath, version),
+											objectConfigObj,
+										),
+										If(Err().Op(":=").Qual(
+											"gopkg.in/yaml.v2",
+											"UnmarshalStrict",
+										).Call(Id("configContent"), Op("&").Id(rootObjectConfigVar)), Err().Op("!=").Nil()).Block(
+											Qual(
+												"github.com/threeport/threeport/pkg/cli/v0",
+												"Error",
+											).Call(Lit("failed to unmarshal config file yaml content"), Err()),
+											Qual("os", "Exit").Call(Lit(1)),
+										),
+										Line(),
+										Comment(fmt.Sprintf(
+											"update %s",
+											rootCmdStrHuman,
+										)),
+										Id(rootObjectVar).Op(":=").Id(rootObjectConfigVar).Dot(rootObj),
+										Add(setConfigPath),
+										Id(updatedDefObjVar).Op(",").Id(updatedInstObjVar).Op(",").Err().Op(":=").Id(rootObjectVar).Dot("Update").Call(
+											Line().Id("apiClient"),
+											Line().Id("apiEndpoint"),
+											Line(),
+										),
+										If(Err().Op("!=").Nil()).Block(
+											Qual(
+												"github.com/threeport/threeport/pkg/cli/v0",
+												"Error",
+											).Call(Lit(fmt.Sprintf(
+												"failed to update %s",
+												rootCmdStrHuman,
+											)), Err()),
+											Qual("os", "Exit").Call(Lit(1)),
+										),
+										Line(),
+										Qual(
+											"github.com/threeport/threeport/pkg/cli/v0",
+											"Info",
+										).Call(Qual("fmt", "Sprintf").Call(Lit(fmt.Sprintf(
+											"%s definition %%s updated",
+											rootCmdStrHuman,
+										)), Op("*").Id(updatedDefObjVar).Dot("Name"))),
+										Qual(
+											"github.com/threeport/threeport/pkg/cli/v0",
+											"Info",
+										).Call(Qual("fmt", "Sprintf").Call(Lit(fmt.Sprintf(
+											"%s instance %%s updated",
+											rootCmdStrHuman,
+										)), Op("*").Id(updatedInstObjVar).Dot("Name"))),
+										Qual(
+											"github.com/threeport/threeport/pkg/cli/v0",
+											"Complete",
+										).Call(Qual("fmt", "Sprintf").Call(Lit(fmt.Sprintf(
+											"%s %%s updated",
+											rootCmdStrHuman,
+										)), Op("*").Id(rootObjectConfigVar).Dot(rootObj).Dot("Name"))),
+									)
+									h.Default().Block(
+										Qual(
+											"github.com/threeport/threeport/pkg/cli/v0",
+											"Error",
+										).Call(
+											Lit(""),
+											Qual("errors", "New").Call(
+												Lit("unrecognized object version"),
+											),
+										),
+										Qual("os", "Exit").Call(Lit(1)),
+									)
+								}
+							})
+						}),
+					})
+
+					commandCode.Func().Id("init").Params().Block(
+						Id("UpdateCmd").Dot("AddCommand").Call(Id(updateCmdVar)),
+						Line(),
+						Id(updateCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+							Line().Op("&").Id(updateConfigPathVar),
+							Line().Lit("config"),
+							Lit("c"),
+							Lit(""),
+							Lit(fmt.Sprintf(
+								"Path to file with %s config.",
+								rootCmdStrHuman,
+							)),
+							Line(),
+						),
+						Id(updateCmdVar).Dot("MarkFlagRequired").Call(Lit("config")),
+						Id(updateCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+							Line().Op("&").Id("cliArgs").Dot("ControlPlaneName"),
+							Line().Lit("control-plane-name"),
+							Lit("i"),
+							Lit(""),
+							Lit("Optional. Name of control plane. Will default to current control plane if not provided."),
+							Line(),
+						),
+						Id(updateCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+							Line().Op("&").Id(updateDefInstVersionVar),
+							Line().Lit("version"),
+							Lit("v"),
+							Lit(util.GetDefaultObjectVersion(apiObj.TypeName)),
+							Lit(fmt.Sprintf(
+								"Version of %s object to update. One of: %s",
+								pluralize.Pluralize(rootCmdStrHuman, 2, false),
+								apiObj.Versions,
+							)),
+							Line(),
+						),
+					)
+
 					// defined instance delete command
 					deleteCmdVar := fmt.Sprintf("Delete%sCmd", rootObj)
 					deleteConfigPathVar := fmt.Sprintf("delete%sConfigPath", rootObj)
@@ -763,8 +1042,14 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 				getCmdVar := fmt.Sprintf("Get%sCmd", pluralize.Pluralize(apiObj.TypeName, 2, false))
 				getClientFunc := fmt.Sprintf("Get%s", pluralize.Pluralize(apiObj.TypeName, 2, false))
 				getObjectVersionVar := fmt.Sprintf("get%sVersion", apiObj.TypeName)
+				getLabelSelectorVar := fmt.Sprintf("get%sLabelSelector", apiObj.TypeName)
+				getOutputVar := fmt.Sprintf("get%sOutput", apiObj.TypeName)
 
-				commandCode.Var().Id(getObjectVersionVar).String()
+				commandCode.Var().Defs(
+					Id(getObjectVersionVar).String(),
+					Id(getLabelSelectorVar).String(),
+					Id(getOutputVar).String(),
+				)
 				commandCode.Line()
 
 				commandCode.Comment(fmt.Sprintf(
@@ -778,9 +1063,11 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 				).Values(Dict{
 					Id("Use"): Lit(pluralize.Pluralize(cmdStr, 2, false)),
 					Id("Example"): Lit(fmt.Sprintf(
-						"  %s get %s",
+						"  # Get all %[1]s\n  %[2]s get %[3]s\n\n  # Get JSON output for all %[1]s\n  %[2]s get %[3]s -o json\n\n  # Get the ID and name of each %[4]s\n  %[2]s get %[3]s -o custom-columns=ID:.ID,NAME:.Name",
+						pluralize.Pluralize(cmdStrHuman, 2, false),
 						exampleCmdStr,
 						pluralize.Pluralize(cmdStr, 2, false),
+						cmdStrHuman,
 					)),
 					Id("Short"): Lit(fmt.Sprintf(
 						"Get %s from the system",
@@ -796,6 +1083,20 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						"github.com/spf13/cobra",
 						"Command",
 					), Id("args").Index().String()).BlockFunc(func(g *Group) {
+						g.If(
+							Err().Op(":=").Qual(
+								"github.com/threeport/threeport/pkg/cli/v0",
+								"ValidateGetOutput",
+							).Call(Id(getOutputVar)),
+							Err().Op("!=").Nil(),
+						).Block(
+							Qual(
+								"github.com/threeport/threeport/pkg/cli/v0",
+								"Error",
+							).Call(Lit("invalid output format"), Err()),
+							Qual("os", "Exit").Call(Lit(1)),
+						)
+						g.Line()
 						if gen.Module {
 							g.List(
 								Id("apiClient"),
@@ -824,8 +1125,16 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 									)),
 									List(Id(pluralize.Pluralize(objectVar, 2, false)), Err()).Op(":=").Qual(
 										fmt.Sprintf("%s%s", clientImportPath, version),
-										getClientFunc,
-									).Call(Id("apiClient"), Id("apiEndpoint")),
+										getClientFunc+"WithOptions",
+									).Call(
+										Line().Id("apiClient"),
+										Line().Id("apiEndpoint"),
+										Line().Qual(
+											"github.com/threeport/threeport/pkg/client/lib/v0",
+											"WithLabelSelector",
+										).Call(Id(getLabelSelectorVar)),
+										Line(),
+									),
 									If(Err().Op("!=").Nil()).Block(
 										Qual(
 											"github.com/threeport/threeport/pkg/cli/v0",
@@ -838,6 +1147,27 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 									),
 									Line(),
 									Comment("write the output"),
+									If(Id(getOutputVar).Op("!=").Lit("")).Block(
+										If(
+											Err().Op(":=").Qual(
+												"github.com/threeport/threeport/pkg/cli/v0",
+												"OutputGetObjects",
+											).Call(
+												Line().Id(getOutputVar),
+												Line().Lit(cmdStr),
+												Line().Id(pluralize.Pluralize(objectVar, 2, false)),
+												Line(),
+											),
+											Err().Op("!=").Nil(),
+										).Block(
+											Qual(
+												"github.com/threeport/threeport/pkg/cli/v0",
+												"Error",
+											).Call(Lit("failed to produce output"), Err()),
+											Qual("os", "Exit").Call(Lit(1)),
+										),
+										Return(),
+									),
 									If(Len(Op("*").Id(pluralize.Pluralize(objectVar, 2, false))).Op("==").Lit(0)).Block(
 										Qual(
 											"github.com/threeport/threeport/pkg/cli/v0",
@@ -914,6 +1244,28 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						)),
 						Line(),
 					),
+					Id(getCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+						Line().Op("&").Id(getLabelSelectorVar),
+						Line().Lit("selector"),
+						Lit("l"),
+						Lit(""),
+						Lit(fmt.Sprintf(
+							"Optional. Label selector to filter %s by, e.g. team=payments,tier!=dev.",
+							pluralize.Pluralize(cmdStrHuman, 2, false),
+						)),
+						Line(),
+					),
+					Id(getCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+						Line().Op("&").Id(getOutputVar),
+						Line().Lit("output"),
+						Lit("o"),
+						Lit(""),
+						Qual(
+							"github.com/threeport/threeport/pkg/cli/v0",
+							"GetOutputUsage",
+						),
+						Line(),
+					),
 				)
 
 				// create command
@@ -1097,6 +1449,187 @@ func GenCliCommands(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					),
 				)
 
+				// update command
+				updateCmdVar := fmt.Sprintf("Update%sCmd", apiObj.TypeName)
+				updateConfigPathVar := fmt.Sprintf("update%sConfigPath", apiObj.TypeName)
+				updatedObjVar := fmt.Sprintf("updated%s", apiObj.TypeName)
+				updateObjectVersionVar := fmt.Sprintf("update%sVersion", apiObj.TypeName)
+
+				// for models that use configs that reference other files the config
+				// path variable must be set on the config object
+				setConfigPath = &Statement{}
+				if apiObj.TptctlConfigPath {
+					setConfigPath.Id(objectVar).Dot(configPathField).Op("=").Op("&").Id(updateConfigPathVar)
+				}
+
+				commandCode.Var().Defs(
+					Id(updateConfigPathVar).String(),
+					Id(updateObjectVersionVar).String(),
+				)
+
+				commandCode.Comment(fmt.Sprintf(
+					"%s represents the %s command",
+					updateCmdVar,
+					cmdStr,
+				))
+				commandCode.Var().Id(updateCmdVar).Op("=").Op("&").Qual(
+					"github.com/spf13/cobra",
+					"Command",
+				).Values(Dict{
+					Id("Use"): Lit(cmdStr),
+					Id("Example"): Lit(fmt.Sprintf(
+						"  %s update %s --config path/to/config.yaml",
+						exampleCmdStr,
+						cmdStr,
+					)),
+					Id("Short"): Lit(fmt.Sprintf(
+						"Update an existing %s",
+						cmdStrHuman,
+					)),
+					Id("Long"): Lit(fmt.Sprintf(
+						"Update an existing %s in place.",
+						cmdStrHuman,
+					)),
+					Id("SilenceUsage"): True(),
+					Id("PreRun"):       Id("CommandPreRunFunc"),
+					Id("Run"): Func().Params(Id("cmd").Op("*").Qual(
+						"github.com/spf13/cobra",
+						"Command",
+					), Id("args").Index().String()).BlockFunc(func(g *Group) {
+						if gen.Module {
+							g.List(
+								Id("apiClient"),
+								Id("_"),
+								Id("apiEndpoint"),
+								Id("_"),
+							).Op(":=").Qual(
+								"github.com/threeport/threeport/cmd/tptctl/cmd",
+								"GetClientContext",
+							).Call(Id("cmd"))
+						} else {
+							g.List(
+								Id("apiClient"),
+								Id("_"),
+								Id("apiEndpoint"),
+								Id("_"),
+							).Op(":=").Id("GetClientContext").Call(Id("cmd"))
+						}
+						g.Line()
+						g.Comment(fmt.Sprintf(
+							"read %s config",
+							cmdStrHuman,
+						))
+						g.Id("configContent").Op(",").Err().Op(":=").Qual("os", "ReadFile").Call(
+							Id(updateConfigPathVar),
+						)
+						g.If(Err().Op("!=").Nil()).Block(
+							Qual(
+								"github.com/threeport/threeport/pkg/cli/v0",
+								"Error",
+							).Call(Lit("failed to read config file"), Err()),
+							Qual("os", "Exit").Call(Lit(1)),
+						)
+						g.Comment(fmt.Sprintf("update %s based on version", cmdStrHuman))
+						g.Switch().Id(updateObjectVersionVar).BlockFunc(func(h *Group) {
+							for _, version := range apiObj.Versions {
+								h.Case(Lit(version)).Block(
+									Var().Id(objectConfigVar).Qual(
+										fmt.Sprintf("%s%s", configImportPath, version),
+										objectConfigObj,
+									),
+									If(Err().Op(":=").Qual(
+										"gopkg.in/yaml.v2",
+										"UnmarshalStrict",
+									).Call(Id("configContent"), Op("&").Id(objectConfigVar)), Err().Op("!=").Nil()).Block(
+										Qual(
+											"github.com/threeport/threeport/pkg/cli/v0",
+											"Error",
+										).Call(Lit("failed to unmarshal config file yaml content"), Err()),
+										Qual("os", "Exit").Call(Lit(1)),
+									),
+									Line(),
+									Comment(fmt.Sprintf(
+										"update %s",
+										cmdStrHuman,
+									)),
+									Id(objectVar).Op(":=").Id(objectConfigVar).Dot(apiObj.TypeName),
+									Add(setConfigPath),
+									Id(updatedObjVar).Op(",").Err().Op(":=").Id(objectVar).Dot("Update").Call(
+										Id("apiClient"), Id("apiEndpoint"),
+									),
+									If(Err().Op("!=").Nil()).Block(
+										Qual(
+											"github.com/threeport/threeport/pkg/cli/v0",
+											"Error",
+										).Call(Lit(fmt.Sprintf(
+											"failed to update %s",
+											cmdStrHuman,
+										)), Err()),
+										Qual("os", "Exit").Call(Lit(1)),
+									),
+									Line(),
+									Qual(
+										"github.com/threeport/threeport/pkg/cli/v0",
+										"Complete",
+									).Call(Qual("fmt", "Sprintf").Call(Lit(fmt.Sprintf(
+										"%s %%s updated",
+										cmdStrHuman,
+									)), Op("*").Id(updatedObjVar).Dot("Name"))),
+								)
+								h.Default().Block(
+									Qual(
+										"github.com/threeport/threeport/pkg/cli/v0",
+										"Error",
+									).Call(
+										Lit(""),
+										Qual("errors", "New").Call(
+											Lit("unrecognized object version"),
+										),
+									),
+									Qual("os", "Exit").Call(Lit(1)),
+								)
+							}
+						})
+					}),
+				})
+
+				commandCode.Func().Id("init").Params().Block(
+					Id("UpdateCmd").Dot("AddCommand").Call(Id(updateCmdVar)),
+					Line(),
+					Id(updateCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+						Line().Op("&").Id(updateConfigPathVar),
+						Line().Lit("config"),
+						Lit("c"),
+						Lit(""),
+						Lit(fmt.Sprintf(
+							"Path to file with %s config.",
+							cmdStrHuman,
+						)),
+						Line(),
+					),
+					Id(updateCmdVar).Dot("MarkFlagRequired").Call(Lit("config")),
+					Id(updateCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+						Line().Op("&").Id("cliArgs").Dot("ControlPlaneName"),
+						Line().Lit("control-plane-name"),
+						Lit("i"),
+						Lit(""),
+						Lit("Optional. Name of control plane. Will default to current control plane if not provided."),
+						Line(),
+					),
+					Id(updateCmdVar).Dot("Flags").Call().Dot("StringVarP").Call(
+						Line().Op("&").Id(updateObjectVersionVar),
+						Line().Lit("version"),
+						Lit("v"),
+						Lit(util.GetDefaultObjectVersion(apiObj.TypeName)),
+						Lit(fmt.Sprintf(
+							"Version of %s object to update. One of: %s",
+							pluralize.Pluralize(cmdStrHuman, 2, false),
+							apiObj.Versions,
+						)),
+						Line(),
+					),
+				)
+
 				// delete command
 				deleteCmdVar := fmt.Sprintf("Delete%sCmd", apiObj.TypeName)
 				deleteConfigPathVar := fmt.Sprintf("delete%sConfigPath", apiObj.TypeName)
@@ -9,6 +9,7 @@ import (
 	"github.com/gertd/go-pluralize"
 	"github.com/iancoleman/strcase"
 
+	"github.com/threeport/threeport/internal/sdk"
 	"github.com/threeport/threeport/internal/sdk/gen"
 	"github.com/threeport/threeport/internal/sdk/util"
 	cli "github.com/threeport/threeport/pkg/cli/v0"
@@ -26,10 +27,24 @@ func GenControllerMain(gen *gen.Generator) error {
 			f.ImportAlias("github.com/threeport/threeport/pkg/controller/v0", "controller")
 			f.ImportAlias("github.com/threeport/threeport/pkg/runtime/v0", "runtime")
 			f.ImportAlias("github.com/threeport/threeport/pkg/event/v0", "event")
+			f.ImportAlias("github.com/threeport/threeport/pkg/tracing/v0", "tracing")
 			f.ImportAlias(fmt.Sprintf("%s/pkg/config/v0", gen.ModulePath), "config")
 
+			durable := true
+			for _, obj := range objGroup.ReconciledObjects {
+				// If any of the reconciled objects has a struct tag with "persist" set to
+				// "false", then set the controller's consumer to be ephemeral. This will
+				// prevent all of the nats streams associated with this controller from
+				// being persisted to disk, and will result in nats messages being lost in the
+				// event of a nats server failure or restart.
+				if obj.DisableNotificationPersistence {
+					durable = false
+				}
+			}
+
 			concurrencyFlags := &Statement{}
 			for _, obj := range objGroup.ReconciledObjects {
+				pluralObjects := pluralize.Pluralize(strcase.ToDelimited(obj.Name, ' '), 2, false)
 				concurrencyFlags.Var().Id(fmt.Sprintf(
 					fmt.Sprintf("%sConcurrentReconciles", strcase.ToLowerCamel(obj.Name)),
 				)).Op("=").Qual(
@@ -41,29 +56,93 @@ func GenControllerMain(gen *gen.Generator) error {
 					),
 					Line().Lit(1),
 					Line().Lit(fmt.Sprintf(
-						"Number of concurrent reconcilers to run for %s",
-						pluralize.Pluralize(strcase.ToDelimited(obj.Name, ' '), 2, false),
+						"Number of concurrent reconcilers to start with for %s",
+						pluralObjects,
 					)),
 					Line(),
 				)
 				concurrencyFlags.Line()
+				concurrencyFlags.Var().Id(fmt.Sprintf(
+					fmt.Sprintf("%sMinConcurrentReconciles", strcase.ToLowerCamel(obj.Name)),
+				)).Op("=").Qual(
+					"github.com/namsral/flag",
+					"Int",
+				).Call(
+					Line().Lit(
+						fmt.Sprintf("%s-min-concurrent-reconciles", strcase.ToKebab(obj.Name)),
+					),
+					Line().Qual("github.com/threeport/threeport/pkg/controller/v0", "DefaultMinConcurrentReconciles"),
+					Line().Lit(fmt.Sprintf(
+						"Minimum number of concurrent reconcilers to run for %s",
+						pluralObjects,
+					)),
+					Line(),
+				)
+				concurrencyFlags.Line()
+
+				// ephemeral consumers deliver every notification to each
+				// subscription so reconcilers that use them are not scaled
+				if durable {
+					concurrencyFlags.Var().Id(fmt.Sprintf(
+						fmt.Sprintf("%sMaxConcurrentReconciles", strcase.ToLowerCamel(obj.Name)),
+					)).Op("=").Qual(
+						"github.com/namsral/flag",
+						"Int",
+					).Call(
+						Line().Lit(
+							fmt.Sprintf("%s-max-concurrent-reconciles", strcase.ToKebab(obj.Name)),
+						),
+						Line().Qual("github.com/threeport/threeport/pkg/controller/v0", "DefaultMaxConcurrentReconciles"),
+						Line().Lit(fmt.Sprintf(
+							"Maximum number of concurrent reconcilers to run for %s",
+							pluralObjects,
+						)),
+						Line(),
+					)
+					concurrencyFlags.Line()
+				}
+
+				// only objects with persisted notifications can be listed
+				// from the API to be resynced
+				if objGroup.Resyncable(&obj) {
+					resyncInterval := Lit(0)
+					resyncHelp := fmt.Sprintf(
+						"Interval at which reconciled %s are resynced (0 disables resync)",
+						pluralObjects,
+					)
+					if obj.DriftDetection {
+						resyncInterval = Qual("github.com/threeport/threeport/pkg/controller/v0", "DefaultResyncInterval")
+						resyncHelp = fmt.Sprintf(
+							"Interval at which reconciled %s are resynced to check for drift (0 disables resync)",
+							pluralObjects,
+						)
+					}
+					concurrencyFlags.Var().Id(
+						fmt.Sprintf("%sResyncInterval", strcase.ToLowerCamel(obj.Name)),
+					).Op("=").Qual(
+						"github.com/namsral/flag",
+						"Duration",
+					).Call(
+						Line().Lit(
+							fmt.Sprintf("%s-resync-interval", strcase.ToKebab(obj.Name)),
+						),
+						Line().Add(resyncInterval),
+						Line().Lit(resyncHelp),
+						Line(),
+					)
+					concurrencyFlags.Line()
+				}
 			}
 
 			reconcilerConfigs := &Statement{}
-			durable := true
 			for _, obj := range objGroup.ReconciledObjects {
-				// If any of the reconciled objects has a struct tag with "persist" set to
-				// "false", then set the controller's consumer to be ephemeral. This will
-				// prevent all of the nats streams associated with this controller from
-				// being persisted to disk, and will result in nats messages being lost in the
-				// event of a nats server failure or restart.
-				if obj.DisableNotificationPersistence {
-					durable = false
+				maxConcurrentReconciles := Lit(1)
+				if durable {
+					maxConcurrentReconciles = Op("*").Id(
+						fmt.Sprintf("%sMaxConcurrentReconciles", strcase.ToLowerCamel(obj.Name)),
+					)
 				}
-				reconcilerConfigs.Id("reconcilerConfigs").Op("=").Append(Id("reconcilerConfigs").Op(",").Qual(
-					"github.com/threeport/threeport/pkg/controller/v0",
-					"ReconcilerConfig",
-				).Values(Dict{
+				reconcilerConfig := Dict{
 					Id("Name"): Lit(fmt.Sprintf("%sReconciler", obj.Name)),
 					Id("ReconcileFunc"): Qual(
 						fmt.Sprintf("%s/internal/%s", gen.ModulePath, objGroup.ControllerShortName),
@@ -72,14 +151,83 @@ func GenControllerMain(gen *gen.Generator) error {
 					Id("ConcurrentReconciles"): Op("*").Id(
 						fmt.Sprintf("%sConcurrentReconciles", strcase.ToLowerCamel(obj.Name)),
 					),
+					Id("MinConcurrentReconciles"): Op("*").Id(
+						fmt.Sprintf("%sMinConcurrentReconciles", strcase.ToLowerCamel(obj.Name)),
+					),
+					Id("MaxConcurrentReconciles"): maxConcurrentReconciles,
 					Id("NotifSubject"): Qual(
 						fmt.Sprintf("%s/internal/%s/notif", gen.ModulePath, objGroup.ControllerShortName),
 						fmt.Sprintf("%sSubject", obj.Name),
 					),
-				}))
+				}
+				if obj.RetryPolicy != nil {
+					reconcilerConfig[Id("RetryPolicy")] = retryPolicy(obj.RetryPolicy)
+				}
+				if objGroup.Resyncable(&obj) {
+					reconcilerConfig[Id("ResyncInterval")] = Op("*").Id(
+						fmt.Sprintf("%sResyncInterval", strcase.ToLowerCamel(obj.Name)),
+					)
+					reconcilerConfig[Id("ResyncSubject")] = Qual(
+						fmt.Sprintf("%s/internal/%s/notif", gen.ModulePath, objGroup.ControllerShortName),
+						fmt.Sprintf("%sUpdateSubject", obj.Name),
+					)
+					reconcilerConfig[Id("ListReconciledFunc")] = Qual(
+						fmt.Sprintf("%s/internal/%s", gen.ModulePath, objGroup.ControllerShortName),
+						fmt.Sprintf("ListReconciled%s", pluralize.Pluralize(obj.Name, 2, false)),
+					)
+				}
+				reconcilerConfigs.Id("reconcilerConfigs").Op("=").Append(Id("reconcilerConfigs").Op(",").Qual(
+					"github.com/threeport/threeport/pkg/controller/v0",
+					"ReconcilerConfig",
+				).Values(reconcilerConfig))
 				reconcilerConfigs.Line()
 			}
 
+			// ephemeral consumers deliver every notification to each
+			// subscription so controllers that use them are not sharded
+			shardingFlag := Null()
+			shardingSetup := Null()
+			shardingShutdown := Null()
+			if durable {
+				shardingShutdown = Id("sharder").Dot("Shutdown").Call()
+				shardingFlag = Var().Id("sharding").Op("=").Qual(
+					"github.com/namsral/flag",
+					"Bool",
+				).Call(
+					Lit("sharding").Op(",").Lit(true).Op(",").Lit("Shard objects across controller replicas so that each object is reconciled by one replica"),
+				)
+				shardingSetup = Line().Comment("shard objects across controller replicas").Line().
+					Var().Id("sharder").Op("*").Qual(
+					"github.com/threeport/threeport/pkg/controller/v0",
+					"Sharder",
+				).Line().If(Op("*").Id("sharding")).Block(
+					Id("sharder").Op("=").Op("&").Qual(
+						"github.com/threeport/threeport/pkg/controller/v0",
+						"Sharder",
+					).Values(Dict{
+						Id("ControllerID"):     Id("controllerID"),
+						Id("JetStreamContext"): Id("js"),
+						Id("MemberBucketName"): Qual(
+							fmt.Sprintf("%s/internal/%s", gen.ModulePath, objGroup.ControllerShortName),
+							"MemberBucketName",
+						),
+						Id("ShardStreamName"): Qual(
+							fmt.Sprintf("%s/internal/%s", gen.ModulePath, objGroup.ControllerShortName),
+							"ShardStreamName",
+						),
+						Id("NotifStreamName"): Qual(
+							fmt.Sprintf("%s/internal/%s/notif", gen.ModulePath, objGroup.ControllerShortName),
+							objGroup.StreamName,
+						),
+						Id("Log"): Op("&").Id("log"),
+					}),
+					If(Err().Op(":=").Id("sharder").Dot("Start").Call(), Err().Op("!=").Nil()).Block(
+						Id("log").Dot("Error").Call(Err(), Lit("failed to start sharding objects across controller replicas")),
+						Qual("os", "Exit").Call(Lit(1)),
+					),
+				)
+			}
+
 			f.Func().Id("main").Params().Block(
 				Comment("flags"),
 				concurrencyFlags,
@@ -137,6 +285,25 @@ func GenControllerMain(gen *gen.Generator) error {
 				).Call(
 					Lit("auth-enabled").Op(",").Lit(true).Op(",").Lit("Enable client certificate authentication (default is true)"),
 				),
+				Var().Id("autoscaleInterval").Op("=").Qual(
+					"github.com/namsral/flag",
+					"Duration",
+				).Call(
+					Lit("autoscale-interval").Op(",").Qual(
+						"github.com/threeport/threeport/pkg/controller/v0",
+						"DefaultAutoscaleInterval",
+					).Op(",").Lit("Interval at which the number of concurrent reconcilers is adjusted"),
+				),
+				Var().Id("cpuBudget").Op("=").Qual(
+					"github.com/namsral/flag",
+					"Float64",
+				).Call(
+					Lit("cpu-budget").Op(",").Qual(
+						"github.com/threeport/threeport/pkg/controller/v0",
+						"DefaultCPUBudget",
+					).Op(",").Lit("CPU cores the controller may consume before concurrent reconcilers are reduced"),
+				),
+				shardingFlag,
 				Qual(
 					"github.com/namsral/flag",
 					"Parse",
@@ -187,6 +354,19 @@ func GenControllerMain(gen *gen.Generator) error {
 					),
 				),
 
+				Line().Comment("tracing setup - spans are exported if an OTLP collector is configured"),
+				List(Id("shutdownTracing"), Err()).Op(":=").Qual(
+					"github.com/threeport/threeport/pkg/tracing/v0",
+					"Init",
+				).Call(
+					Qual("context", "Background").Call(),
+					Lit(fmt.Sprintf("threeport-%s-controller", objGroup.ControllerShortName)),
+				),
+				If(Err().Op("!=").Nil()).Block(
+					Id("log").Dot("Error").Call(Err(), Lit("failed to initialize tracing")),
+					Qual("os", "Exit").Call(Lit(1)),
+				),
+
 				Line().Comment("connect to NATS server"),
 				Id("natsConn").Op(":=").Qual("fmt", "Sprintf").Call(
 					Line().Lit("nats://%s:%s@%s:%s"),
@@ -238,7 +418,7 @@ func GenControllerMain(gen *gen.Generator) error {
 						),
 						"LockBucketDescr",
 					),
-					Id("TTL"): Qual("time", "Minute").Op("*").Lit(20),
+					Id("TTL"): Qual("github.com/threeport/threeport/pkg/controller/v0", "LockBucketTTL"),
 				}),
 				List(
 					Id("kv"), Id("err"),
@@ -306,8 +486,9 @@ func GenControllerMain(gen *gen.Generator) error {
 					Qual("os", "Exit").Call(Lit(1)),
 				),
 
-				Line().Comment("create a channel and wait group used for graceful shut downs"),
-				Var().Id("shutdownChans").Index().Chan().Bool(),
+				shardingSetup,
+
+				Line().Comment("create a wait group used for graceful shut downs"),
 				Var().Id("shutdownWait").Qual("sync", "WaitGroup"),
 				Line(),
 				Comment("configure http client for calls to threeport API"),
@@ -334,42 +515,103 @@ func GenControllerMain(gen *gen.Generator) error {
 				),
 				reconcilerConfigs,
 
+				Line().Comment("scale concurrent reconcilers with the number of pending notifications"),
+				Id("autoscaler").Op(":=").Qual(
+					"github.com/threeport/threeport/pkg/controller/v0",
+					"Autoscaler",
+				).Values(Dict{
+					Id("Interval"):  Op("*").Id("autoscaleInterval"),
+					Id("CPUBudget"): Op("*").Id("cpuBudget"),
+					Id("Log"):       Op("&").Id("log"),
+				}),
+
+				Line().Comment("periodically resync reconciled objects so that drift is detected"),
+				Id("resyncer").Op(":=").Qual(
+					"github.com/threeport/threeport/pkg/controller/v0",
+					"Resyncer",
+				).Values(Dict{
+					Id("APIServer"):        Op("*").Id("apiServer"),
+					Id("APIClient"):        Id("apiClient"),
+					Id("JetStreamContext"): Id("js"),
+					Id("Log"):              Op("&").Id("log"),
+				}),
 				For(
 					Id("_").Op(",").Id("r").Op(":=").Range().Id("reconcilerConfigs"),
 				).BlockFunc(func(g *jen.Group) {
-					ConfigurePullSubscription(g, objGroup, durable, gen.ModulePath)
-					g.Line().Comment("create exit channel")
-					g.Id("shutdownChan").Op(":=").Make(Chan().Bool(), Lit(1))
-					g.Id("shutdownChans").Op("=").Append(Id("shutdownChans"), Id("shutdownChan"))
-
-					g.Line().Comment("create reconciler")
-					g.Id("reconciler").Op(":=").Id("controller").Dot("Reconciler").Values(Dict{
-						Id("Name"):             Id("r").Dot("Name"),
-						Id("APIServer"):        Op("*").Id("apiServer"),
-						Id("APIClient"):        Id("apiClient"),
-						Id("JetStreamContext"): Id("js"),
-						Id("Sub"):              Id("sub"),
-						Id("KeyValue"):         Id("kv"),
-						Id("ControllerID"):     Id("controllerID"),
-						Id("Log"):              Op("&").Id("log"),
-						Id("Shutdown"):         Id("shutdownChan"),
-						Id("ShutdownWait"):     Op("&").Id("shutdownWait"),
-						Id("EncryptionKey"):    Id("encryptionKey"),
-						Id("EventsRecorder"): Op("&").Qual(
-							"github.com/threeport/threeport/pkg/event/v0",
-							"EventRecorder",
-						).Values(Dict{
-							Id("APIClient"): Id("apiClient"),
-							Id("APIServer"): Op("*").Id("apiServer"),
-							Id("ReportingController"): Lit(
-								fmt.Sprintf("%sController", strcase.ToCamel(objGroup.ControllerShortName)),
+					ConfigureConsumer(g, objGroup, durable, gen.ModulePath)
+					if durable {
+						g.Line().Comment("route notifications to the replicas that own the objects")
+						g.If(Id("sharder").Op("!=").Nil()).Block(
+							If(
+								Err().Op(":=").Id("sharder").Dot("AddReconciler").Call(Id("r"), Id("consumer")),
+								Err().Op("!=").Nil(),
+							).Block(
+								Id("log").Dot("Error").Call(
+									Err(),
+									Lit("failed to start routing notifications"),
+									Lit("reconcilerName"),
+									Id("r").Dot("Name"),
+								),
+								Qual("os", "Exit").Call(Lit(1)),
 							),
-						}),
-					})
-
-					g.Line().Comment("start reconciler")
-					g.Id("go").Id("r").Dot("ReconcileFunc").Call(Op("&").Id("reconciler"))
+						)
+					}
+
+					g.Line().Comment("start reconciler - each concurrent reconciler has its own subscription")
+					g.If(
+						Err().Op(":=").Id("autoscaler").Dot("AddReconciler").Call(
+							Id("r"),
+							Func().Params().Params(
+								Op("*").Qual("github.com/threeport/threeport/pkg/controller/v0", "Reconciler"),
+								Error(),
+							).BlockFunc(func(h *jen.Group) {
+								ConfigurePullSubscription(h, objGroup, durable, gen.ModulePath)
+								h.Line()
+								reconciler := Dict{
+									Id("Name"):             Id("r").Dot("Name"),
+									Id("APIServer"):        Op("*").Id("apiServer"),
+									Id("APIClient"):        Id("apiClient"),
+									Id("JetStreamContext"): Id("js"),
+									Id("Sub"):              Id("sub"),
+									Id("KeyValue"):         Id("kv"),
+									Id("ControllerID"):     Id("controllerID"),
+									Id("Log"):              Op("&").Id("log"),
+									Id("ShutdownWait"):     Op("&").Id("shutdownWait"),
+									Id("EncryptionKey"):    Id("encryptionKey"),
+									Id("RetryPolicy"):      Id("r").Dot("RetryPolicy"),
+									Id("EventsRecorder"): Op("&").Qual(
+										"github.com/threeport/threeport/pkg/event/v0",
+										"EventRecorder",
+									).Values(Dict{
+										Id("APIClient"): Id("apiClient"),
+										Id("APIServer"): Op("*").Id("apiServer"),
+										Id("ReportingController"): Lit(
+											fmt.Sprintf("%sController", strcase.ToCamel(objGroup.ControllerShortName)),
+										),
+									}),
+								}
+								if durable {
+									reconciler[Id("Sharder")] = Id("sharder")
+								}
+								h.Return(Op("&").Qual(
+									"github.com/threeport/threeport/pkg/controller/v0",
+									"Reconciler",
+								).Values(reconciler), Nil())
+							}),
+						),
+						Err().Op("!=").Nil(),
+					).Block(
+						Id("log").Dot("Error").Call(
+							Err(),
+							Lit("failed to start reconciler"),
+							Lit("reconcilerName"),
+							Id("r").Dot("Name"),
+						),
+						Qual("os", "Exit").Call(Lit(1)),
+					)
+					g.Id("resyncer").Dot("AddReconciler").Call(Id("r"))
 				}),
+				Go().Id("autoscaler").Dot("Run").Call(),
 				Line(),
 
 				Id("log").Dot("Info").Call(
@@ -402,9 +644,9 @@ func GenControllerMain(gen *gen.Generator) error {
 					Lit("/shutdown").Op(",").Func().Params(
 						Id("w").Qual("net/http", "ResponseWriter").Op(",").Id("r").Op("*").Qual("net/http", "Request"),
 					).Block(
-						For(Id("_").Op(",").Id("c").Op(":=").Range().Id("shutdownChans")).Block(
-							Id("c").Op("<-").Lit(true),
-						),
+						Id("autoscaler").Dot("Shutdown").Call(),
+						Id("resyncer").Dot("Shutdown").Call(),
+						shardingShutdown,
 						Id("w").Dot("WriteHeader").Call(Qual("net/http", "StatusOK")),
 						Qual("fmt", "Fprintf").Call(Id("w").Op(",").Lit("shutting down\n")),
 						Id("shutdownWait").Dot("Add").Call(Lit(1)),
@@ -427,7 +669,12 @@ func GenControllerMain(gen *gen.Generator) error {
 						Id("w").Dot("Write").Call(Index().Byte().Call(Lit("OK"))),
 					),
 				),
-
+				Line(),
+				Comment("set up metrics endpoint"),
+				Qual("net/http", "Handle").Call(
+					Lit("/metrics"),
+					Qual("github.com/prometheus/client_golang/prometheus/promhttp", "Handler").Call(),
+				),
 				Go().Id("http.ListenAndServe").Call(Lit(":8081"), Nil()),
 				Line(),
 
@@ -444,6 +691,15 @@ func GenControllerMain(gen *gen.Generator) error {
 				Id("shutdownWait").Dot("Wait").Call(),
 				Line(),
 
+				Comment("export any remaining spans"),
+				If(
+					Err().Op(":=").Id("shutdownTracing").Call(Qual("context", "Background").Call()),
+					Err().Op("!=").Nil(),
+				).Block(
+					Id("log").Dot("Error").Call(Err(), Lit("failed to shut down tracing")),
+				),
+				Line(),
+
 				Id("log").Dot("Info").Call(Lit(fmt.Sprintf("%s controller shutting down", objGroup.ControllerShortName))),
 				Qual("os", "Exit").Call(Lit(0)),
 			)
@@ -474,7 +730,70 @@ func GenControllerMain(gen *gen.Generator) error {
 	return nil
 }
 
-// ConfigurePullSubscription adds a durable consumer to a controller's main package.
+// retryPolicy returns the controller retry policy for a reconciler from the
+// retry policy in the SDK config.  Fields that are not set are left to the
+// controller defaults.
+func retryPolicy(sdkRetryPolicy *sdk.RetryPolicy) *Statement {
+	values := Dict{}
+	if sdkRetryPolicy.InitialDelay != nil {
+		values[Id("InitialDelay")] = Lit(int(*sdkRetryPolicy.InitialDelay))
+	}
+	if sdkRetryPolicy.MaxDelay != nil {
+		values[Id("MaxDelay")] = Lit(int(*sdkRetryPolicy.MaxDelay))
+	}
+	if sdkRetryPolicy.Multiplier != nil {
+		values[Id("Multiplier")] = Lit(*sdkRetryPolicy.Multiplier)
+	}
+	if sdkRetryPolicy.Jitter != nil {
+		values[Id("Jitter")] = Lit(*sdkRetryPolicy.Jitter)
+	}
+	if sdkRetryPolicy.MaxAttempts != nil {
+		values[Id("MaxAttempts")] = Lit(*sdkRetryPolicy.MaxAttempts)
+	}
+
+	return Qual(
+		"github.com/threeport/threeport/pkg/controller/v0",
+		"RetryPolicy",
+	).Values(values)
+}
+
+// ConfigureConsumer adds a durable consumer to a controller's main package.
+func ConfigureConsumer(
+	g *jen.Group,
+	objGroup gen.ApiObjectGroup,
+	durable bool,
+	modulePath string,
+) {
+	if !durable {
+		return
+	}
+
+	g.Line().Comment("create JetStream consumer")
+	g.Id("consumer").Op(":=").Id("r").Dot("Name").Op("+").Lit("Consumer")
+	g.Id("js").Dot("AddConsumer").Call(Qual(
+		fmt.Sprintf(
+			"%s/internal/%s/notif",
+			modulePath,
+			objGroup.ControllerShortName,
+		),
+		objGroup.StreamName,
+	).Op(",").Op("&").Qual(
+		"github.com/nats-io/nats.go",
+		"ConsumerConfig",
+	).Values(Dict{
+		Id("Durable"): Id("consumer"),
+		Id("AckPolicy"): Qual(
+			"github.com/nats-io/nats.go",
+			"AckExplicitPolicy",
+		),
+		Id("FilterSubject"): Id("r").Dot("NotifSubject"),
+	}),
+	)
+}
+
+// ConfigurePullSubscription adds a pull subscription for a reconciliation
+// process to a controller's main package.  Durable subscriptions bind to the
+// consumer added by ConfigureConsumer.
 func ConfigurePullSubscription(
 	g *jen.Group,
 	objGroup gen.ApiObjectGroup,
@@ -484,31 +803,9 @@ func ConfigurePullSubscription(
 	consumer := Lit("")
 	if durable {
 		consumer = Id("consumer")
-		g.Line().Comment("create JetStream consumer")
-		g.Id("consumer").Op(":=").Id("r").Dot("Name").Op("+").Lit("Consumer")
-		g.Id("js").Dot("AddConsumer").Call(Qual(
-			fmt.Sprintf(
-				"%s/internal/%s/notif",
-				modulePath,
-				objGroup.ControllerShortName,
-			),
-			objGroup.StreamName,
-		).Op(",").Op("&").Qual(
-			"github.com/nats-io/nats.go",
-			"ConsumerConfig",
-		).Values(Dict{
-			Id("Durable"): consumer,
-			Id("AckPolicy"): Qual(
-				"github.com/nats-io/nats.go",
-				"AckExplicitPolicy",
-			),
-			Id("FilterSubject"): Id("r").Dot("NotifSubject"),
-		}),
-		)
 	}
 
-	g.Line().Comment("create durable pull subscription")
-	g.Id("sub").Op(",").Id("err").Op(":=").Id("js").Dot("PullSubscribe").Call(
+	pullSubscribe := Id("js").Dot("PullSubscribe").Call(
 		Id("r").Dot("NotifSubject"),
 		consumer,
 		Qual(
@@ -523,13 +820,25 @@ func ConfigurePullSubscription(
 			objGroup.StreamName,
 		)),
 	)
+
+	g.Comment("create pull subscription")
+	if durable {
+		// when sharding, reconciliation processes receive the notifications
+		// routed to this replica
+		g.Var().Id("sub").Op("*").Qual("github.com/nats-io/nats.go", "Subscription")
+		g.Var().Id("err").Error()
+		g.If(Id("sharder").Op("!=").Nil()).Block(
+			List(Id("sub"), Id("err")).Op("=").Id("sharder").Dot("Subscribe").Call(Id("r")),
+		).Else().Block(
+			List(Id("sub"), Id("err")).Op("=").Add(pullSubscribe),
+		)
+	} else {
+		g.List(Id("sub"), Id("err")).Op(":=").Add(pullSubscribe)
+	}
 	g.If(Id("err").Op("!=").Nil()).Block(
-		Id("log").Dot("Error").Call(
+		Return(Nil(), Qual("fmt", "Errorf").Call(
+			Lit("failed to create pull subscription for reconciler notifications: %w"),
 			Id("err"),
-			Lit("failed to create pull subscription for reconciler notifications"),
-			Lit("reconcilerName"),
-			Id("r").Dot("Name"),
-		),
-		Qual("os", "Exit").Call(Lit(1)),
+		)),
 	)
 }
@@ -95,6 +95,16 @@ func GenDbMigratorMigration(gen *gen.Generator) error {
 					)
 				}
 			}
+			// the outbox holds notifications for the module's controllers
+			// until they are published
+			if gen.Module {
+				g.List(
+					Op("&").Qual(
+						"github.com/threeport/threeport/pkg/api/v0",
+						"OutboxNotification",
+					).Values().Op(","),
+				)
+			}
 		}),
 	)
 	f.Line()
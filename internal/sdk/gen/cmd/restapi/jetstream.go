@@ -20,6 +20,12 @@ func GenUtilJetstream(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 	f.HeaderComment("generated by 'threeport-sdk gen' - do not edit")
 
 	f.ImportAlias("github.com/nats-io/nats.go", "nats")
+	f.ImportAlias(util.SetImportAlias(
+		"github.com/threeport/threeport/pkg/api-server/lib/v0",
+		"apiserver_lib",
+		"tpapiserver_lib",
+		gen.Module,
+	))
 	f.ImportAlias("github.com/threeport/threeport/internal/aws/notif", "aws_notif")
 	f.ImportAlias("github.com/threeport/threeport/internal/control-plane/notif", "controlplane_notif")
 	f.ImportAlias("github.com/threeport/threeport/internal/gateway/notif", "gateway_notif")
@@ -29,6 +35,7 @@ func GenUtilJetstream(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 	f.ImportAlias("github.com/threeport/threeport/internal/secret/notif", "secret_notif")
 	f.ImportAlias("github.com/threeport/threeport/internal/terraform/notif", "terraform_notif")
 	f.ImportAlias("github.com/threeport/threeport/internal/workload/notif", "workload_notif")
+	f.ImportAlias("github.com/threeport/threeport/pkg/notifications/v0", "notifications")
 
 	f.Comment(`Initialize the NATS Jet stream context with controller streams`)
 	f.Func().Id("InitJetStream").Params(
@@ -77,6 +84,70 @@ func GenUtilJetstream(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 				g.Line()
 			}
 		}
+		g.Comment("add stream for watch events that are sent to API clients")
+		g.Id("_").Op(",").Id("err").Op("=").Id("js").Dot("AddStream").Call(
+			Op("&").Qual("github.com/nats-io/nats.go", "StreamConfig").Values(
+				Dict{
+					Id("Name"): Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"WatchStreamName",
+					),
+					Id("Subjects"): Index().String().Values(Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"WatchSubjects",
+					)),
+					Id("MaxAge"): Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"WatchStreamMaxAge",
+					),
+				},
+			),
+		)
+		g.If(Id("err").Op("!=").Nil().Block(
+			Return(
+				Nil(),
+				Qual("fmt", "Errorf").Call(
+					Lit("could not add stream %s: %w"), Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"WatchStreamName",
+					),
+					Err(),
+				),
+			),
+		))
+		g.Line()
+		g.Comment("add stream for notifications that reconcilers have given up on")
+		g.Id("_").Op(",").Id("err").Op("=").Id("js").Dot("AddStream").Call(
+			Op("&").Qual("github.com/nats-io/nats.go", "StreamConfig").Values(
+				Dict{
+					Id("Name"): Qual(
+						"github.com/threeport/threeport/pkg/notifications/v0",
+						"DeadLetterStreamName",
+					),
+					Id("Subjects"): Index().String().Values(Qual(
+						"github.com/threeport/threeport/pkg/notifications/v0",
+						"DeadLetterSubjects",
+					)),
+					Id("MaxAge"): Qual(
+						"github.com/threeport/threeport/pkg/notifications/v0",
+						"DeadLetterStreamMaxAge",
+					),
+				},
+			),
+		)
+		g.If(Id("err").Op("!=").Nil().Block(
+			Return(
+				Nil(),
+				Qual("fmt", "Errorf").Call(
+					Lit("could not add stream %s: %w"), Qual(
+						"github.com/threeport/threeport/pkg/notifications/v0",
+						"DeadLetterStreamName",
+					),
+					Err(),
+				),
+			),
+		))
+		g.Line()
 		g.Return(Op("&").Id("js"), Nil())
 	})
 
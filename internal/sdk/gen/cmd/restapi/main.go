@@ -34,6 +34,12 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		startupMessage = "\nThreeport REST API: %s\n"
 	}
 
+	// set the service name used for traces
+	tracingServiceName := "threeport-rest-api"
+	if gen.Module {
+		tracingServiceName = fmt.Sprintf("%s-rest-api", sdkConfig.ApiNamespace)
+	}
+
 	// build handler registration source code
 	handlerRegistration := &Statement{}
 	handlerRegistration.Comment("handlers")
@@ -118,6 +124,7 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 	f.ImportAlias("github.com/labstack/echo/v4", "echo")
 	f.ImportAlias("github.com/go-playground/validator/v10", "validator")
 	f.ImportAlias("github.com/threeport/threeport/pkg/api-server/lib/v0", "apiserver_lib")
+	f.ImportAlias("github.com/threeport/threeport/pkg/tracing/v0", "tracing")
 	f.ImportAlias(util.SetImportAlias(
 		"github.com/threeport/threeport/pkg/log/v0",
 		"log",
@@ -171,6 +178,8 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		g.Var().Id("autoMigrate").Bool()
 		g.Var().Id("verbose").Bool()
 		g.Var().Id("authEnabled").Bool()
+		g.Var().Id("deletedObjectRetention").Qual("time", "Duration")
+		g.Var().Id("outboxRetention").Qual("time", "Duration")
 		g.Qual("flag", "StringVar").Call(
 			Id("&envFile"),
 			Lit("env-file"),
@@ -195,6 +204,24 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 			True(),
 			Lit("Enable client certificate authentication"),
 		)
+		g.Qual("flag", "DurationVar").Call(
+			Id("&deletedObjectRetention"),
+			Lit("deleted-object-retention"),
+			Qual(
+				"github.com/threeport/threeport/pkg/api-server/lib/v0",
+				"DefaultDeletedObjectRetention",
+			),
+			Lit("Length of time deleted objects can be restored before they are purged"),
+		)
+		g.Qual("flag", "DurationVar").Call(
+			Id("&outboxRetention"),
+			Lit("outbox-retention"),
+			Qual(
+				"github.com/threeport/threeport/pkg/api-server/lib/v0",
+				"DefaultOutboxRetention",
+			),
+			Lit("Length of time delivered controller notifications are kept in the outbox before they are purged"),
+		)
 		g.Qual("flag", "Parse").Call()
 		g.Line()
 
@@ -301,6 +328,21 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		g.Id("e").Dot("Use").Call(Qual("github.com/labstack/echo/v4/middleware", "Recover").Call())
 		g.Line()
 
+		g.Comment("trace requests and export spans if an OTLP collector is configured")
+		g.List(Id("shutdownTracing"), Err()).Op(":=").Qual(
+			"github.com/threeport/threeport/pkg/tracing/v0",
+			"Init",
+		).Call(Qual("context", "Background").Call(), Lit(tracingServiceName))
+		g.If(Err().Op("!=").Nil()).Block(
+			Id("e").Dot("Logger").Dot("Fatalf").Call(Lit("failed to initialize tracing: %v"), Err()),
+		)
+		g.Defer().Id("shutdownTracing").Call(Qual("context", "Background").Call())
+		g.Id("e").Dot("Use").Call(Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"TracingMiddleware",
+		).Call())
+		g.Line()
+
 		g.Id("e").Dot("HTTPErrorHandler").Op("=").Func().Params(
 			Id("err").Error(), Id("c").Qual("github.com/labstack/echo/v4", "Context"),
 		).Block(
@@ -331,6 +373,22 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		g.Line()
 
 		if !gen.Module {
+			g.Comment("record an audit trail of requests that change objects")
+			g.Id("e").Dot("Use").Call(Qual(
+				"github.com/threeport/threeport/pkg/api-server/lib/v0",
+				"AuditMiddleware",
+			).Call(Id("db")))
+			g.Line()
+
+			g.Comment("authorize requests using roles bound to the client certificate subject")
+			g.If(Id("authEnabled")).Block(
+				Id("e").Dot("Use").Call(Qual(
+					"github.com/threeport/threeport/pkg/api-server/lib/v0",
+					"AuthorizationMiddleware",
+				).Call(Id("db"))),
+			)
+			g.Line()
+
 			g.Comment("add module router middleware")
 			g.If(
 				Err().Op(":=").Qual(
@@ -379,6 +437,48 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		)
 		g.Line()
 
+		g.Comment("publish controller notifications from the outbox")
+		g.Id("outboxRelay").Op(":=").Op("&").Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"OutboxRelay",
+		).Values(Dict{
+			Id("DB"):     Id("db"),
+			Id("JS"):     Op("*").Id("js"),
+			Id("Logger"): Op("&").Id("logger"),
+		})
+		g.Go().Id("outboxRelay").Dot("Run").Call(Qual("context", "Background").Call())
+		g.Line()
+
+		g.Comment("purge delivered notifications from the outbox once the retention window has passed")
+		g.Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"OutboxRetention",
+		).Op("=").Id("outboxRetention")
+		g.Id("outboxPurger").Op(":=").Op("&").Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"OutboxPurger",
+		).Values(Dict{
+			Id("DB"):     Id("db"),
+			Id("Logger"): Op("&").Id("logger"),
+		})
+		g.Go().Id("outboxPurger").Dot("Run").Call(Qual("context", "Background").Call())
+		g.Line()
+
+		g.Comment("purge deleted objects once the retention window has passed")
+		g.Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"DeletedObjectRetention",
+		).Op("=").Id("deletedObjectRetention")
+		g.Id("deletedObjectPurger").Op(":=").Op("&").Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"DeletedObjectPurger",
+		).Values(Dict{
+			Id("DB"):     Id("db"),
+			Id("Logger"): Op("&").Id("logger"),
+		})
+		g.Go().Id("deletedObjectPurger").Dot("Run").Call(Qual("context", "Background").Call())
+		g.Line()
+
 		g.Add(handlerRegistration)
 
 		g.Add(routeRegistration)
@@ -476,7 +576,8 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 		}
 	})
 
-	f.Comment("configureHealthCheckEndpoint sets up a health check endpoint for the API server")
+	f.Comment("configureHealthCheckEndpoint sets up a health check endpoint and a metrics")
+	f.Comment("endpoint for the API server")
 	f.Func().Id("configureHealthCheckEndpoint").Params().Block(
 		Comment("set up health check endpoint"),
 		Qual("net/http", "HandleFunc").Call(
@@ -487,6 +588,12 @@ func GenRestApiMain(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 			),
 		),
 		Line(),
+		Comment("set up metrics endpoint"),
+		Qual("net/http", "Handle").Call(
+			Lit("/metrics"),
+			Qual("github.com/prometheus/client_golang/prometheus/promhttp", "Handler").Call(),
+		),
+		Line(),
 		Go().Qual("net/http", "ListenAndServe").Call(Lit(":8081"), Nil()),
 	)
 
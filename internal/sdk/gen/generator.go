@@ -243,6 +243,14 @@ type ReconciledObject struct {
 
 	// If true, do not persist notifications in NATS JetStream.
 	DisableNotificationPersistence bool
+
+	// The retry policy for failed reconciliations.  If nil, the controller
+	// defaults are used.
+	RetryPolicy *sdk.RetryPolicy
+
+	// If true, the reconciler checks the object for drift when it is
+	// resynced.
+	DriftDetection bool
 }
 
 // New populates a new Generator in preparation for source code generation.  It
@@ -684,6 +692,8 @@ func (g *Generator) New(sdkConfig *sdk.SdkConfig) error {
 						Name:                           *apiObject.Name,
 						Versions:                       versions,
 						DisableNotificationPersistence: disableNotificationPersistense,
+						RetryPolicy:                    apiObject.RetryPolicy,
+						DriftDetection:                 apiObject.DriftDetection != nil && *apiObject.DriftDetection,
 					},
 				)
 			}
@@ -806,6 +816,14 @@ func (a *ApiObjectGroup) CheckStructTagMap(
 	return false
 }
 
+// Resyncable returns true if a reconciled object's notifications are
+// persisted so that its reconciled objects can be listed from the API and
+// resynced.
+func (a *ApiObjectGroup) Resyncable(obj *ReconciledObject) bool {
+	return !obj.DisableNotificationPersistence &&
+		!a.CheckStructTagMap(obj.Name, "Data", "persist", "false")
+}
+
 // nameFields returns a list of struct type fields that indicate a struct
 // requires a unique name for the object.
 func nameFields() []string {
@@ -34,6 +34,22 @@ func GenController(gen *gen.Generator) error {
 				)),
 			)
 
+			f.Comment(fmt.Sprintf(
+				"The names of the NATS bucket used to track %s controller replicas and",
+				strcase.ToDelimited(objGroup.ControllerShortName, ' '),
+			))
+			f.Comment("the NATS stream used to route notifications to the replica that owns each object")
+			f.Const().Defs(
+				Id("MemberBucketName").Op("=").Lit(fmt.Sprintf(
+					"%sMembers",
+					strcase.ToLowerCamel(objGroup.ControllerShortName),
+				)),
+				Id("ShardStreamName").Op("=").Lit(fmt.Sprintf(
+					"%sShard",
+					strcase.ToLowerCamel(objGroup.ControllerShortName),
+				)),
+			)
+
 			// write code to file
 			genFilepath := filepath.Join(
 				"internal",
@@ -6,6 +6,7 @@ import (
 
 	"github.com/dave/jennifer/jen"
 	. "github.com/dave/jennifer/jen"
+	"github.com/gertd/go-pluralize"
 	"github.com/iancoleman/strcase"
 
 	"github.com/threeport/threeport/internal/sdk/gen"
@@ -27,6 +28,7 @@ func GenReconcilers(gen *gen.Generator) error {
 			f.ImportAlias("github.com/threeport/threeport/pkg/api/lib/v0", "tpapi_lib")
 			f.ImportAlias("github.com/threeport/threeport/pkg/client/v0", "tpclient")
 			f.ImportAlias("github.com/threeport/threeport/pkg/client/lib/v0", "tpclient_lib")
+			f.ImportAlias("github.com/threeport/threeport/pkg/api-server/lib/v0", "apiserver_lib")
 			f.ImportAlias("github.com/threeport/threeport/pkg/controller/v0", "controller")
 			f.ImportAlias("github.com/threeport/threeport/pkg/notifications/v0", "notifications")
 			f.ImportAlias("github.com/threeport/threeport/pkg/util/v0", "util")
@@ -76,6 +78,10 @@ func GenReconcilers(gen *gen.Generator) error {
 					Qual("syscall", "SIGTERM"),
 				),
 				Line(),
+				Comment("stop receiving signals when the reconciler exits, e.g. when it is"),
+				Comment("scaled down by the autoscaler"),
+				Defer().Qual("os/signal", "Stop").Call(Id("osSignals")),
+				Line(),
 
 				For().Block(
 					Comment("create a fresh log object per reconciliation loop so we don't"),
@@ -103,51 +109,84 @@ func GenReconcilers(gen *gen.Generator) error {
 							)
 							g.Line()
 
-							g.Comment("consume message data to capture notification from API")
-							g.Id("notif").Op(",").Id("err").Op(":=").Qual(
-								"github.com/threeport/threeport/pkg/notifications/v0",
-								"ConsumeMessage",
-							).Call(Id("msg").Dot("Data"))
-							g.If(Id("err").Op("!=").Nil()).Block(
-								Id("log").Dot("Error").Call(
-									Line().Id("err"), Lit("failed to consume message data from NATS"),
-									Line().Lit("msgData"), Id("string").Call(Id("msg").Dot("Data")),
-									Line(),
-								),
-								Id("r").Dot("RequeueRaw").Call(Id("msg")),
-								Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
-									Lit(fmt.Sprintf(
-										"%s reconciliation requeued with identical payload and fixed delay",
-										strcase.ToDelimited(obj.Name, ' '),
-									)),
-								),
-								Continue(),
-							)
-							g.Line()
+							g.Comment("handle the notification in a function so that tracking of the")
+							g.Comment("reconciliation ends however it is handled")
+							g.Func().Params().BlockFunc(func(g *Group) {
+								g.Defer().Id("r").Dot("EndReconcile").Call(Id("msg"))
+								g.Line()
 
-							g.Comment("determine the correct object version from the notification")
-							g.Var().Id(varObjectName).Qual(
-								"github.com/threeport/threeport/pkg/api/lib/v0",
-								"ReconciledThreeportApiObject",
-							)
-							g.Switch(Id("notif").Dot("ObjectVersion")).BlockFunc(func(h *Group) {
-								for _, version := range obj.Versions {
-									h.Case(Lit(version)).Block(
-										Id(varObjectName).Op("=").Op("&").Qual(
-											fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, version),
-											obj.Name,
-										).Values(),
-									)
-								}
-								h.Default().Block(
+								g.Comment("continue the trace from the API request that triggered the")
+								g.Comment("notification in API and kubernetes calls made while reconciling")
+								g.Id("r").Dot("APIClient").Op("=").Qual(
+									"github.com/threeport/threeport/pkg/client/lib/v0",
+									"WithTraceContext",
+								).Call(Id("r").Dot("APIClient"), Id("r").Dot("TraceContext").Call(Id("msg")))
+								g.Line()
+
+								g.Comment("consume message data to capture notification from API")
+								g.Id("notif").Op(",").Id("err").Op(":=").Qual(
+									"github.com/threeport/threeport/pkg/notifications/v0",
+									"ConsumeMessage",
+								).Call(Id("msg").Dot("Data"))
+								g.If(Id("err").Op("!=").Nil()).Block(
 									Id("log").Dot("Error").Call(
-										Qual("errors", "New").Call(
+										Line().Id("err"), Lit("failed to consume message data from NATS"),
+										Line().Lit("msgData"), Id("string").Call(Id("msg").Dot("Data")),
+										Line(),
+									),
+									Id("r").Dot("RequeueRaw").Call(Id("msg")),
+									Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
+										Lit(fmt.Sprintf(
+											"%s reconciliation requeued with identical payload and fixed delay",
+											strcase.ToDelimited(obj.Name, ' '),
+										)),
+									),
+									Return(),
+								)
+								g.Line()
+
+								g.Comment("determine the correct object version from the notification")
+								g.Var().Id(varObjectName).Qual(
+									"github.com/threeport/threeport/pkg/api/lib/v0",
+									"ReconciledThreeportApiObject",
+								)
+								g.Switch(Id("notif").Dot("ObjectVersion")).BlockFunc(func(h *Group) {
+									for _, version := range obj.Versions {
+										h.Case(Lit(version)).Block(
+											Id(varObjectName).Op("=").Op("&").Qual(
+												fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, version),
+												obj.Name,
+											).Values(),
+										)
+									}
+									h.Default().Block(
+										Id("log").Dot("Error").Call(
+											Qual("errors", "New").Call(
+												Lit(fmt.Sprintf(
+													"received unrecognized version of %s object",
+													strcase.ToDelimited(obj.Name, ' '),
+												)),
+											),
+											Lit(""),
+										),
+										Id("r").Dot("RequeueRaw").Call(Id("msg")),
+										Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
 											Lit(fmt.Sprintf(
-												"received unrecognized version of %s object",
+												"%s reconciliation requeued with identical payload and fixed delay",
 												strcase.ToDelimited(obj.Name, ' '),
 											)),
 										),
-										Lit(""),
+										Return(),
+									)
+								})
+								g.Line()
+
+								g.Comment("decode the object that was sent in the notification")
+								g.If(Id("err").Op(":=").Id(varObjectName).Dot("DecodeNotifObject").Call(
+									Id("notif").Dot("Object"),
+								).Op(";").Id("err").Op("!=").Nil().Block(
+									Id("log").Dot("Error").Call(
+										Id("err"), Lit("failed to marshal object map from consumed notification message"),
 									),
 									Id("r").Dot("RequeueRaw").Call(Id("msg")),
 									Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
@@ -156,254 +195,269 @@ func GenReconcilers(gen *gen.Generator) error {
 											strcase.ToDelimited(obj.Name, ' '),
 										)),
 									),
-									Continue(),
-								)
-							})
-							g.Line()
-
-							g.Comment("decode the object that was sent in the notification")
-							g.If(Id("err").Op(":=").Id(varObjectName).Dot("DecodeNotifObject").Call(
-								Id("notif").Dot("Object"),
-							).Op(";").Id("err").Op("!=").Nil().Block(
-								Id("log").Dot("Error").Call(
-									Id("err"), Lit("failed to marshal object map from consumed notification message"),
-								),
-								Id("r").Dot("RequeueRaw").Call(Id("msg")),
-								Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
+									Return(),
+								))
+								g.Id("log").Op("=").Id("log").Dot("WithValues").Call(
 									Lit(fmt.Sprintf(
-										"%s reconciliation requeued with identical payload and fixed delay",
-										strcase.ToDelimited(obj.Name, ' '),
-									)),
-								),
-								Continue(),
-							))
-							g.Id("log").Op("=").Id("log").Dot("WithValues").Call(
-								Lit(fmt.Sprintf(
-									"%sID",
-									varObjectName,
-								)).Op(",").Id(varObjectName).Dot("GetId").Call(),
-							)
-							g.Line()
+										"%sID",
+										varObjectName,
+									)).Op(",").Id(varObjectName).Dot("GetId").Call(),
+								)
+								g.Id("r").Dot("TraceObject").Call(Id("msg"), Id(varObjectName), Id("notif").Dot("Operation"))
+								g.Line()
 
-							g.Comment("back off the requeue delay as needed")
-							g.Id("requeueDelay").Op(":=").Id("controller").Dot("SetRequeueDelay").Call(
-								Line().Id("notif").Dot("CreationTime"),
-								Line(),
-							)
-							g.Line()
+								g.Comment("back off the requeue delay according to the retry policy")
+								g.Id("requeueDelay").Op(":=").Id("r").Dot("RequeueDelay").Call(Id(varObjectName))
+								g.Line()
 
-							g.Comment("check for lock on object")
-							g.Id("locked").Op(",").Id("ok").Op(":=").Id("r").Dot("CheckLock").Call(
-								Id(varObjectName),
-							)
-							g.If(Id("locked").Op("||").Id("ok").Op("==").Id("false")).Block(
-								Id("r").Dot("Requeue").Call(
-									Id(varObjectName).Op(",").Id("requeueDelay").Op(",").Id("msg"),
-								),
-								Id("log").Dot("V").Call(
-									Lit(1),
-								).Dot("Info").Call(Lit(fmt.Sprintf(
-									"%s reconciliation requeued",
-									strcase.ToDelimited(obj.Name, ' '),
-								))),
-								Continue(),
-							)
-							g.Line()
-							g.Comment("set up handler to unlock and requeue on termination signal")
-							g.Go().Func().Params().Block(
-								Select().Block(
-									Case(Op("<-").Id("osSignals")).Block(
-										Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
-											"received termination signal, performing unlock and requeue of %s",
-											strcase.ToDelimited(obj.Name, ' '),
-										))),
-										Id("r").Dot("UnlockAndRequeue").Call(
-											Id(varObjectName), Id("requeueDelay"), Id("lockReleased"), Id("msg"),
+								g.Comment("check for lock on object")
+								g.Id("locked").Op(",").Id("ok").Op(":=").Id("r").Dot("CheckLock").Call(
+									Id(varObjectName),
+								)
+								g.If(Id("locked").Op("||").Id("ok").Op("==").Id("false")).Block(
+									Id("r").Dot("Requeue").Call(
+										Id(varObjectName).Op(",").Id("requeueDelay").Op(",").Id("msg"),
+									),
+									Id("log").Dot("V").Call(
+										Lit(1),
+									).Dot("Info").Call(Lit(fmt.Sprintf(
+										"%s reconciliation requeued",
+										strcase.ToDelimited(obj.Name, ' '),
+									))),
+									Return(),
+								)
+								g.Line()
+								g.Comment("set up handler to unlock and requeue on termination signal")
+								g.Go().Func().Params().Block(
+									Select().Block(
+										Case(Op("<-").Id("osSignals")).Block(
+											Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
+												"received termination signal, performing unlock and requeue of %s",
+												strcase.ToDelimited(obj.Name, ' '),
+											))),
+											Id("r").Dot("UnlockAndRequeue").Call(
+												Id(varObjectName), Id("requeueDelay"), Id("lockReleased"), Id("msg"),
+											),
+										),
+										Case(Op("<-").Id("lockReleased")).Block(
+											Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
+												"reached end of reconcile loop for %s, closing out signal handler",
+												strcase.ToDelimited(obj.Name, ' '),
+											))),
 										),
 									),
-									Case(Op("<-").Id("lockReleased")).Block(
-										Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
-											"reached end of reconcile loop for %s, closing out signal handler",
-											strcase.ToDelimited(obj.Name, ' '),
-										))),
+								).Call()
+								g.Line()
+								g.Comment("put a lock on the reconciliation of the created object")
+								g.If(Id("ok").Op(":=").Id("r").Dot("Lock").Call(
+									Id(varObjectName)).Op(";").Op("!").Id("ok"),
+								).Block(
+									Id("r").Dot("Requeue").Call(
+										Id(varObjectName).Op(",").Id("requeueDelay").Op(",").Id("msg"),
 									),
-								),
-							).Call()
-							g.Line()
-							g.Comment("put a lock on the reconciliation of the created object")
-							g.If(Id("ok").Op(":=").Id("r").Dot("Lock").Call(
-								Id(varObjectName)).Op(";").Op("!").Id("ok"),
-							).Block(
-								Id("r").Dot("Requeue").Call(
-									Id(varObjectName).Op(",").Id("requeueDelay").Op(",").Id("msg"),
-								),
-								Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
-									"%s reconciliation requeued",
-									strcase.ToDelimited(obj.Name, ' '),
-								))),
-								Continue(),
-							)
-							g.Line()
+									Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
+										"%s reconciliation requeued",
+										strcase.ToDelimited(obj.Name, ' '),
+									))),
+									Return(),
+								)
+								g.Line()
 
-							// If the object has a "Data" field with a "persist" tag set to "false", skip
-							// the retrieval of the latest object. Otherwise, generate the
-							// source code to retrieve the latest object.
-							if !objGroup.CheckStructTagMap(obj.Name, "Data", "persist", "false") {
-								getLatestObject(g, &obj, gen.ModulePath)
-							}
+								// If the object has a "Data" field with a "persist" tag set to "false", skip
+								// the retrieval of the latest object. Otherwise, generate the
+								// source code to retrieve the latest object.
+								if !objGroup.CheckStructTagMap(obj.Name, "Data", "persist", "false") {
+									getLatestObject(g, &obj, gen.ModulePath)
+								}
 
-							g.Line()
-							g.Comment("determine which operation and act accordingly")
-							g.Switch(Id("notif").Dot("Operation")).BlockFunc(func(h *Group) {
-								operationCase(h, "create", &obj, varObjectName, gen.ModulePath)
-								operationCase(h, "update", &obj, varObjectName, gen.ModulePath)
-								operationCase(h, "delete", &obj, varObjectName, gen.ModulePath)
-								h.Default().Block(
-									Id("log").Dot("Error").Call(
-										Line().Id("errors").Dot("New").Call(Lit("unrecognized notifcation operation")),
-										Line().Lit("notification included an invalid operation"),
-										Line(),
-									),
-									Id("r").Dot("UnlockAndRequeue").Call(
+								g.Line()
+								g.Comment("determine which operation and act accordingly")
+								g.Switch(Id("notif").Dot("Operation")).BlockFunc(func(h *Group) {
+									operationCase(h, "create", &obj, varObjectName, gen.ModulePath)
+									operationCase(h, "update", &obj, varObjectName, gen.ModulePath)
+									operationCase(h, "delete", &obj, varObjectName, gen.ModulePath)
+									if obj.DriftDetection {
+										driftCheckCase(h, &obj, varObjectName, gen.ModulePath)
+									}
+									h.Default().Block(
+										Id("operationErr").Op(":=").Id("errors").Dot("New").Call(Lit("unrecognized notifcation operation")),
+										Id("log").Dot("Error").Call(
+											Line().Id("operationErr"),
+											Line().Lit("notification included an invalid operation"),
+											Line(),
+										),
+										Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("operationErr")),
+										Id("r").Dot("UnlockAndRequeue").Call(
+											Line().Id(varObjectName),
+											Line().Id("requeueDelay"),
+											Line().Id("lockReleased"),
+											Line().Id("msg"),
+											Line(),
+										),
+										Return(),
+									)
+								})
+								g.Line()
+
+								g.Comment("set the object's Reconciled field to true if not deleted or")
+								g.Comment("resynced")
+								g.If(Id("notif").Dot("Operation").Op("!=").Qual(
+									"github.com/threeport/threeport/pkg/notifications/v0",
+									"NotificationOperationDeleted",
+								).Op("&&").Id("notif").Dot("Operation").Op("!=").Qual(
+									"github.com/threeport/threeport/pkg/notifications/v0",
+									"NotificationOperationResync",
+								)).Block(
+									Id("reconciledConditions").Op(",").Id("_").Op(":=").Qual(
+										"github.com/threeport/threeport/pkg/controller/v0",
+										"ReconciledConditions",
+									).Call(
 										Line().Id(varObjectName),
-										Line().Id("requeueDelay"),
-										Line().Id("lockReleased"),
-										Line().Id("msg"),
+										Line().Qual(
+											"github.com/threeport/threeport/pkg/event/v0",
+											"GetSuccessReasonForOperation",
+										).Call(Id("notif").Dot("Operation")),
 										Line(),
 									),
-									Continue(),
-								)
-							})
-							g.Line()
-
-							g.Comment("set the object's Reconciled field to true if not deleted")
-							g.If(Id("notif").Dot("Operation").Op("!=").Qual(
-								"github.com/threeport/threeport/pkg/notifications/v0",
-								"NotificationOperationDeleted",
-							).Block(
-								Id(fmt.Sprintf(
-									"reconciled%s",
-									obj.Name,
-								)).Op(":=").Qual(
-									fmt.Sprintf("%s/pkg/api/v0", gen.ModulePath),
-									obj.Name,
-								).Values(Dict{
-									Id("Common"): Qual(
-										"github.com/threeport/threeport/pkg/api/v0",
-										"Common",
-									).Values(Dict{
-										Id("ID"): Qual(
-											"github.com/threeport/threeport/pkg/util/v0",
-											"Ptr",
-										).Call(Id(varObjectName).Dot("GetId").Call()),
-									}),
-									Id("Reconciliation"): Qual(
-										"github.com/threeport/threeport/pkg/api/v0",
-										"Reconciliation",
-									).Values(Dict{
-										Id("Reconciled"): Qual(
-											"github.com/threeport/threeport/pkg/util/v0",
-											"Ptr",
-										).Call(Lit(true)),
-									}),
-								}),
-								Id(fmt.Sprintf(
-									"updated%s",
-									obj.Name,
-								)).Op(",").Id("err").Op(":=").Qual(
-									fmt.Sprintf("%s/pkg/client/v0", gen.ModulePath),
-									fmt.Sprintf("Update%s", obj.Name),
-								).Call(
-									Line().Id("r").Dot("APIClient"),
-									Line().Id("r").Dot("APIServer"),
-									Line().Op("&").Id(fmt.Sprintf(
+									Id(fmt.Sprintf(
 										"reconciled%s",
 										obj.Name,
-									)),
-									Line(),
-								),
-								If(Id("err").Op("!=").Nil()).Block(
-									Id("log").Dot("Error").Call(Id("err"), Lit(fmt.Sprintf(
-										"failed to update %s to mark as reconciled",
-										strcase.ToDelimited(obj.Name, ' '),
-									))),
-									Id("r").Dot("UnlockAndRequeue").Call(
-										Id(varObjectName),
-										Id("requeueDelay"),
-										Id("lockReleased"),
-										Id("msg"),
-									),
-									Continue(),
-								),
-								Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
-									Line().Lit(fmt.Sprintf(
-										"%s marked as reconciled in API",
-										strcase.ToDelimited(obj.Name, ' '),
-									)),
-									Line().Lit(fmt.Sprintf(
-										"%sName",
-										strcase.ToDelimited(obj.Name, ' '),
-									)), Id(fmt.Sprintf(
+									)).Op(":=").Qual(
+										fmt.Sprintf("%s/pkg/api/v0", gen.ModulePath),
+										obj.Name,
+									).Values(Dict{
+										Id("Common"): Qual(
+											"github.com/threeport/threeport/pkg/api/v0",
+											"Common",
+										).Values(Dict{
+											Id("ID"): Qual(
+												"github.com/threeport/threeport/pkg/util/v0",
+												"Ptr",
+											).Call(Id(varObjectName).Dot("GetId").Call()),
+										}),
+										Id("Reconciliation"): Qual(
+											"github.com/threeport/threeport/pkg/api/v0",
+											"Reconciliation",
+										).Values(Dict{
+											Id("Reconciled"): Qual(
+												"github.com/threeport/threeport/pkg/util/v0",
+												"Ptr",
+											).Call(Lit(true)),
+											Id("Conditions"): Id("reconciledConditions"),
+										}),
+									}),
+									Id(fmt.Sprintf(
 										"updated%s",
 										obj.Name,
-									)).Dot("Name"),
-									Line(),
-								),
-							))
-							g.Line()
-
-							g.Comment("release the lock on the reconciliation of the created object")
-							g.If(Id("ok").Op(":=").Id("r").Dot("ReleaseLock").Call(
-								Id(varObjectName),
-								Id("lockReleased"),
-								Id("msg"),
-								Lit(true)),
-								Op("!").Id("ok"),
-							).Block(
-								Id("log").Dot("Error").Call(
-									Qual("errors", "New").Call(
-										Lit(fmt.Sprintf(
-											"%s remains locked - will unlock when TTL expires",
+									)).Op(",").Id("err").Op(":=").Qual(
+										fmt.Sprintf("%s/pkg/client/v0", gen.ModulePath),
+										fmt.Sprintf("Update%s", obj.Name),
+									).Call(
+										Line().Id("r").Dot("APIClient"),
+										Line().Id("r").Dot("APIServer"),
+										Line().Op("&").Id(fmt.Sprintf(
+											"reconciled%s",
+											obj.Name,
+										)),
+										Line(),
+									),
+									If(Id("err").Op("!=").Nil()).Block(
+										Id("log").Dot("Error").Call(Id("err"), Lit(fmt.Sprintf(
+											"failed to update %s to mark as reconciled",
+											strcase.ToDelimited(obj.Name, ' '),
+										))),
+										Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("err")),
+										Id("r").Dot("UnlockAndRequeue").Call(
+											Id(varObjectName),
+											Id("requeueDelay"),
+											Id("lockReleased"),
+											Id("msg"),
+										),
+										Return(),
+									),
+									Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(
+										Line().Lit(fmt.Sprintf(
+											"%s marked as reconciled in API",
 											strcase.ToDelimited(obj.Name, ' '),
 										)),
+										Line().Lit(fmt.Sprintf(
+											"%sName",
+											strcase.ToDelimited(obj.Name, ' '),
+										)), Id(fmt.Sprintf(
+											"updated%s",
+											obj.Name,
+										)).Dot("Name"),
+										Line(),
 									),
-									Lit(""),
-								),
-							).Else().Block(
-								Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
-									"%s unlocked",
-									strcase.ToDelimited(obj.Name, ' '),
-								))),
-							)
-							g.Line()
+								)
+								g.Line()
 
-							g.Comment("log and record event for successful reconciliation")
-							g.Id("successMsg").Op(":=").Qual("fmt", "Sprintf").Call(
-								Line().Lit(fmt.Sprintf("%s successfully reconciled for %%s operation", strcase.ToDelimited(obj.Name, ' '))),
-								Line().Qual("strings", "ToLower").Call(Id("string").Call(Id("notif").Dot("Operation"))),
-								Line(),
-							)
-							g.If(Id("err").Op(":=").Id("r").Dot("EventsRecorder").Dot("RecordEvent").Call(
-								Line().Op("&").Qual("github.com/threeport/threeport/pkg/api/v0", "Event").Values(Dict{
-									Id("Reason"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(
-										Qual("github.com/threeport/threeport/pkg/event/v0", "GetSuccessReasonForOperation").Call(Id("notif").Dot("Operation")),
+								g.Comment("release the lock on the reconciliation of the created object")
+								g.If(Id("ok").Op(":=").Id("r").Dot("ReleaseLock").Call(
+									Id(varObjectName),
+									Id("lockReleased"),
+									Id("msg"),
+									Lit(true)),
+									Op("!").Id("ok"),
+								).Block(
+									Id("log").Dot("Error").Call(
+										Qual("errors", "New").Call(
+											Lit(fmt.Sprintf(
+												"%s remains locked - will unlock when TTL expires",
+												strcase.ToDelimited(obj.Name, ' '),
+											)),
+										),
+										Lit(""),
 									),
-									Id("Note"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(Id("successMsg")),
-									Id("Type"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(
-										Qual("github.com/threeport/threeport/pkg/event/v0", "TypeNormal"),
+								).Else().Block(
+									Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
+										"%s unlocked",
+										strcase.ToDelimited(obj.Name, ' '),
+									))),
+								)
+								g.Line()
+
+								g.Comment("resyncs of reconciled objects are not reported as reconciliations")
+								g.If(Id("notif").Dot("Operation").Op("==").Qual(
+									"github.com/threeport/threeport/pkg/notifications/v0",
+									"NotificationOperationResync",
+								)).Block(
+									Id("log").Dot("V").Call(Lit(1)).Dot("Info").Call(Lit(fmt.Sprintf(
+										"%s resynced",
+										strcase.ToDelimited(obj.Name, ' '),
+									))),
+									Return(),
+								)
+								g.Line()
+
+								g.Comment("log and record event for successful reconciliation")
+								g.Id("successMsg").Op(":=").Qual("fmt", "Sprintf").Call(
+									Line().Lit(fmt.Sprintf("%s successfully reconciled for %%s operation", strcase.ToDelimited(obj.Name, ' '))),
+									Line().Qual("strings", "ToLower").Call(Id("string").Call(Id("notif").Dot("Operation"))),
+									Line(),
+								)
+								g.If(Id("err").Op(":=").Id("r").Dot("EventsRecorder").Dot("RecordEvent").Call(
+									Line().Op("&").Qual("github.com/threeport/threeport/pkg/api/v0", "Event").Values(Dict{
+										Id("Reason"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(
+											Qual("github.com/threeport/threeport/pkg/event/v0", "GetSuccessReasonForOperation").Call(Id("notif").Dot("Operation")),
+										),
+										Id("Note"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(Id("successMsg")),
+										Id("Type"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(
+											Qual("github.com/threeport/threeport/pkg/event/v0", "TypeNormal"),
+										),
+									}),
+									Line().Id(strcase.ToLowerCamel(obj.Name)).Dot("GetId").Call(),
+									Line().Id(strcase.ToLowerCamel(obj.Name)).Dot("GetVersion").Call(),
+									Line().Id(strcase.ToLowerCamel(obj.Name)).Dot("GetType").Call(),
+									Line(),
+								).Op(";").Id("err").Op("!=").Nil().Block(
+									Id("log").Dot("Error").Call(
+										Err(),
+										Lit(fmt.Sprintf("failed to record event for successful %s reconciliation", strcase.ToDelimited(obj.Name, ' '))),
 									),
-								}),
-								Line().Id(strcase.ToLowerCamel(obj.Name)).Dot("GetId").Call(),
-								Line().Id(strcase.ToLowerCamel(obj.Name)).Dot("GetVersion").Call(),
-								Line().Id(strcase.ToLowerCamel(obj.Name)).Dot("GetType").Call(),
-								Line(),
-							).Op(";").Id("err").Op("!=").Nil().Block(
-								Id("log").Dot("Error").Call(
-									Err(),
-									Lit(fmt.Sprintf("failed to record event for successful %s reconciliation", strcase.ToDelimited(obj.Name, ' '))),
-								),
-							))
-							g.Id("log").Dot("Info").Call(Id("successMsg"))
+								))
+								g.Id("log").Dot("Info").Call(Id("successMsg"))
+							}).Call()
 						}),
 					),
 				),
@@ -413,6 +467,12 @@ func GenReconcilers(gen *gen.Generator) error {
 				Id("r").Dot("ShutdownWait").Dot("Done").Call(),
 			)
 
+			// objects that are not persisted can't be resynced
+			if objGroup.Resyncable(&obj) {
+				f.Line()
+				listReconciledFunc(f, &obj, gen.ModulePath)
+			}
+
 			// write code to file
 			genFilepath := filepath.Join(
 				"internal",
@@ -484,15 +544,16 @@ func getLatestObject(
 			Lit("object no longer exists - halting reconciliation"),
 		),
 		Id("r").Dot("ReleaseLock").Call(Id(objVar), Id("lockReleased"), Id("msg"), Lit(true)),
-		Continue(),
+		Return(),
 	)
 	g.If(Id(latestObjErrVar).Op("!=").Nil()).Block(
 		Id("log").Dot("Error").Call(Id(latestObjErrVar), Lit(fmt.Sprintf(
 			"failed to get %s by ID from API",
 			strcase.ToDelimited(obj.Name, ' '),
 		))),
+		Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id(latestObjErrVar)),
 		Id("r").Dot("UnlockAndRequeue").Call(Id(objVar), Id("requeueDelay"), Id("lockReleased"), Id("msg")),
-		Continue(),
+		Return(),
 	)
 	g.Id(objVar).Op("=").Id(fmt.Sprintf(
 		"latest%s",
@@ -513,10 +574,19 @@ func operationCase(
 	lowerOpPast := op + "d"
 	upperOpPast := strcase.ToCamel(lowerOpPast)
 
-	h.Case(Qual(
+	operations := []Code{Qual(
 		"github.com/threeport/threeport/pkg/notifications/v0",
 		fmt.Sprintf("NotificationOperation%s", upperOpPast),
-	)).BlockFunc(func(i *Group) {
+	)}
+	if op == "update" && !obj.DriftDetection {
+		// objects without drift detection are updated when resynced
+		operations = append(operations, Qual(
+			"github.com/threeport/threeport/pkg/notifications/v0",
+			"NotificationOperationResync",
+		))
+	}
+
+	h.Case(operations...).BlockFunc(func(i *Group) {
 		if op == "create" {
 			h.If(Id(varObjectName).Dot("ScheduledForDeletion").Call().Op("!=").Nil()).Block(
 				Id("log").Dot("Info").Call(
@@ -592,6 +662,8 @@ func operationCase(
 				Line().Op("&").Id("log"),
 				Line(),
 			),
+			failedConditionsUpdate(obj, varObjectName, modulePath, uppoerOp),
+			Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("operationErr")),
 			Id("r").Dot("UnlockAndRequeue").Call(
 				Line().Id(varObjectName),
 				Line().Id("requeueDelay"),
@@ -599,7 +671,7 @@ func operationCase(
 				Line().Id("msg"),
 				Line(),
 			),
-			Continue(),
+			Return(),
 		)
 		h.If(Id("customRequeueDelay").Op("!=").Lit(0)).Block(
 			Id("log").Dot("Info").Call(
@@ -615,7 +687,7 @@ func operationCase(
 				Line().Id("msg"),
 				Line(),
 			),
-			Continue(),
+			Return(),
 		)
 		if op == "delete" {
 			h.Id("deletionTimestamp").Op(":=").Qual(
@@ -667,13 +739,14 @@ func operationCase(
 					"failed to update %s to mark as deleted",
 					strcase.ToDelimited(obj.Name, ' '),
 				))),
+				Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("err")),
 				Id("r").Dot("UnlockAndRequeue").Call(
 					Id(varObjectName),
 					Id("requeueDelay"),
 					Id("lockReleased"),
 					Id("msg"),
 				),
-				Continue(),
+				Return(),
 			)
 
 			h.Id("_").Op(",").Id("err").Op("=").Qual(
@@ -690,14 +763,293 @@ func operationCase(
 					"failed to delete %s",
 					strcase.ToDelimited(obj.Name, ' '),
 				))),
+				Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("err")),
 				Id("r").Dot("UnlockAndRequeue").Call(
 					Id(varObjectName),
 					Id("requeueDelay"),
 					Id("lockReleased"),
 					Id("msg"),
 				),
-				Continue(),
+				Return(),
+			)
+		}
+	})
+}
+
+// failedConditionsUpdate generates the source code that updates an object's
+// Reconciled condition with the error when reconciliation fails.  The update
+// is only made when the condition changes and failures to update are logged
+// but don't prevent the object from being requeued.
+func failedConditionsUpdate(
+	obj *gen.ReconciledObject,
+	varObjectName string,
+	modulePath string,
+	upperOp string,
+) Code {
+	return If(
+		Id("failedConditions").Op(",").Id("changed").Op(":=").Qual(
+			"github.com/threeport/threeport/pkg/controller/v0",
+			"FailedConditions",
+		).Call(
+			Line().Id(varObjectName),
+			Line().Qual(
+				"github.com/threeport/threeport/pkg/event/v0",
+				fmt.Sprintf("ReasonFailed%s", upperOp),
+			),
+			Line().Id("operationErr"),
+			Line(),
+		).Op(";").Id("changed"),
+	).Block(
+		Id(fmt.Sprintf(
+			"failed%s",
+			obj.Name,
+		)).Op(":=").Qual(
+			fmt.Sprintf("%s/pkg/api/v0", modulePath),
+			obj.Name,
+		).Values(Dict{
+			Id("Common"): Qual(
+				"github.com/threeport/threeport/pkg/api/v0",
+				"Common",
+			).Values(Dict{
+				Id("ID"): Qual(
+					"github.com/threeport/threeport/pkg/util/v0",
+					"Ptr",
+				).Call(Id(varObjectName).Dot("GetId").Call()),
+			}),
+			Id("Reconciliation"): Qual(
+				"github.com/threeport/threeport/pkg/api/v0",
+				"Reconciliation",
+			).Values(Dict{
+				Id("Conditions"): Id("failedConditions"),
+			}),
+		}),
+		If(
+			Id("_").Op(",").Id("err").Op(":=").Qual(
+				fmt.Sprintf("%s/pkg/client/v0", modulePath),
+				fmt.Sprintf("Update%s", obj.Name),
+			).Call(
+				Line().Id("r").Dot("APIClient"),
+				Line().Id("r").Dot("APIServer"),
+				Line().Op("&").Id(fmt.Sprintf(
+					"failed%s",
+					obj.Name,
+				)),
+				Line(),
+			).Op(";").Id("err").Op("!=").Nil(),
+		).Block(
+			Id("log").Dot("Error").Call(Id("err"), Lit(fmt.Sprintf(
+				"failed to update %s conditions",
+				strcase.ToDelimited(obj.Name, ' '),
+			))),
+		),
+	)
+}
+
+// driftCheckCase generates the source code for the resync case in the
+// operation switch statement for objects with drift detection.  Errors are
+// not requeued as the next resync checks the object again.
+func driftCheckCase(
+	h *jen.Group,
+	obj *gen.ReconciledObject,
+	varObjectName string,
+	modulePath string,
+) {
+	h.Case(Qual(
+		"github.com/threeport/threeport/pkg/notifications/v0",
+		"NotificationOperationResync",
+	)).BlockFunc(func(i *Group) {
+		h.If(Id(varObjectName).Dot("ScheduledForDeletion").Call().Op("!=").Nil()).Block(
+			Id("log").Dot("Info").Call(
+				Lit(fmt.Sprintf(
+					"%s scheduled for deletion - skipping resync",
+					strcase.ToDelimited(obj.Name, ' '),
+				)),
+			),
+			Break(),
+		)
+		h.Var().Id("drift").Op("*").Qual("github.com/threeport/threeport/pkg/controller/v0", "Drift")
+		h.Var().Id("previouslyDrifted").Bool()
+		h.Var().Id("operationErr").Error()
+		h.Switch(Id(varObjectName).Dot("GetVersion").Call()).BlockFunc(func(j *Group) {
+			for _, version := range obj.Versions {
+				typedObject := Id(varObjectName).Assert(Op("*").Qual(
+					fmt.Sprintf("%s/pkg/api/%s", modulePath, version),
+					obj.Name,
+				))
+				j.Case(Lit(version)).Block(
+					List(Id("objectDrift"), Id("err")).Op(":=").Id(fmt.Sprintf(
+						"%s%sDriftCheck",
+						version,
+						obj.Name,
+					)).Call(
+						Line().Id("r"),
+						Line().Add(typedObject.Clone()),
+						Line().Op("&").Id("log"),
+						Line(),
+					),
+					Id("drift").Op("=").Id("objectDrift"),
+					Id("previouslyDrifted").Op("=").Qual(
+						"github.com/threeport/threeport/pkg/util/v0",
+						"DerefBool",
+					).Call(typedObject.Clone().Dot("Drifted")),
+					Id("operationErr").Op("=").Id("err"),
+				)
+			}
+			j.Default().Block(
+				Id("operationErr").Op("=").Qual(
+					"errors",
+					"New",
+				).Call(Lit(fmt.Sprintf(
+					"unrecognized version of %s encountered for resync",
+					strcase.ToDelimited(obj.Name, ' '),
+				))),
+			)
+		})
+		h.If(Id("operationErr").Op("!=").Nil()).Block(
+			Id("errorMsg").Op(":=").Lit(fmt.Sprintf(
+				"failed to check resynced %s object for drift",
+				strcase.ToDelimited(obj.Name, ' '),
+			)),
+			Id("log").Dot("Error").Call(
+				Id("operationErr"),
+				Id("errorMsg"),
+			),
+			Id("r").Dot("EventsRecorder").Dot("HandleEventOverride").Call(
+				Line().Op("&").Qual("github.com/threeport/threeport/pkg/api/v0", "Event").Values(Dict{
+					Id("Reason"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(
+						Qual(
+							"github.com/threeport/threeport/pkg/event/v0",
+							"ReasonFailedResync",
+						),
+					),
+					Id("Note"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(Id("errorMsg")),
+					Id("Type"): Qual("github.com/threeport/threeport/pkg/util/v0", "Ptr").Call(
+						Qual("github.com/threeport/threeport/pkg/event/v0", "TypeNormal"),
+					),
+				}),
+				Line().Id(varObjectName).Dot("GetId").Call(),
+				Line().Id(varObjectName).Dot("GetVersion").Call(),
+				Line().Id(varObjectName).Dot("GetType").Call(),
+				Line().Id("operationErr"),
+				Line().Op("&").Id("log"),
+				Line(),
+			),
+			Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("operationErr")),
+			Id("r").Dot("ReleaseLock").Call(Id(varObjectName), Id("lockReleased"), Id("msg"), Lit(true)),
+			Return(),
+		)
+		h.Id("r").Dot("RecordDrift").Call(Id(varObjectName), Id("drift"), Op("&").Id("log"))
+		h.Id("driftConditions").Op(",").Id("conditionsChanged").Op(":=").Qual(
+			"github.com/threeport/threeport/pkg/controller/v0",
+			"DriftConditions",
+		).Call(Id(varObjectName), Id("drift"))
+		h.If(Id("drift").Dot("Uncorrected").Call().Op("!=").Id("previouslyDrifted").Op("||").Id("conditionsChanged")).Block(
+			Id(fmt.Sprintf(
+				"drifted%s",
+				obj.Name,
+			)).Op(":=").Qual(
+				fmt.Sprintf("%s/pkg/api/v0", modulePath),
+				obj.Name,
+			).Values(Dict{
+				Id("Common"): Qual(
+					"github.com/threeport/threeport/pkg/api/v0",
+					"Common",
+				).Values(Dict{
+					Id("ID"): Qual(
+						"github.com/threeport/threeport/pkg/util/v0",
+						"Ptr",
+					).Call(Id(varObjectName).Dot("GetId").Call()),
+				}),
+				Id("Reconciliation"): Qual(
+					"github.com/threeport/threeport/pkg/api/v0",
+					"Reconciliation",
+				).Values(Dict{
+					Id("Drifted"): Qual(
+						"github.com/threeport/threeport/pkg/util/v0",
+						"Ptr",
+					).Call(Id("drift").Dot("Uncorrected").Call()),
+					Id("Conditions"): Id("driftConditions"),
+				}),
+			}),
+			Id("_").Op(",").Id("err").Op("=").Qual(
+				fmt.Sprintf("%s/pkg/client/v0", modulePath),
+				fmt.Sprintf("Update%s", obj.Name),
+			).Call(
+				Line().Id("r").Dot("APIClient"),
+				Line().Id("r").Dot("APIServer"),
+				Line().Op("&").Id(fmt.Sprintf(
+					"drifted%s",
+					obj.Name,
+				)),
+				Line(),
+			),
+			If(Id("err").Op("!=").Nil()).Block(
+				Id("log").Dot("Error").Call(Id("err"), Lit(fmt.Sprintf(
+					"failed to update %s to record drift",
+					strcase.ToDelimited(obj.Name, ' '),
+				))),
+				Id("r").Dot("RecordReconcileError").Call(Id("msg"), Id("err")),
+				Id("r").Dot("ReleaseLock").Call(Id(varObjectName), Id("lockReleased"), Id("msg"), Lit(true)),
+				Return(),
+			),
+		)
+	})
+}
+
+// listReconciledFunc generates the source code for the function that lists
+// an object's reconciled objects so they may be resynced.
+func listReconciledFunc(
+	f *jen.File,
+	obj *gen.ReconciledObject,
+	modulePath string,
+) {
+	pluralize := pluralize.NewClient()
+	pluralName := pluralize.Pluralize(obj.Name, 2, false)
+	funcName := fmt.Sprintf("ListReconciled%s", pluralName)
+
+	f.Comment(fmt.Sprintf("%s returns the %s that have been", funcName, pluralize.Pluralize(strcase.ToDelimited(obj.Name, ' '), 2, false)))
+	f.Comment("reconciled so they may be resynced.")
+	f.Func().Id(funcName).Params(
+		Id("apiClient").Op("*").Qual("net/http", "Client"),
+		Id("apiServer").String(),
+	).Params(
+		Index().Qual("github.com/threeport/threeport/pkg/api/lib/v0", "ReconciledThreeportApiObject"),
+		Error(),
+	).BlockFunc(func(g *Group) {
+		g.Var().Id("reconciled").Index().Qual("github.com/threeport/threeport/pkg/api/lib/v0", "ReconciledThreeportApiObject")
+		for _, version := range obj.Versions {
+			versionObjects := fmt.Sprintf("%s%s", version, pluralName)
+			g.List(Id(versionObjects), Err()).Op(":=").Qual(
+				fmt.Sprintf("%s/pkg/client/%s", modulePath, version),
+				fmt.Sprintf("Get%sWithOptions", pluralName),
+			).Call(
+				Line().Id("apiClient"),
+				Line().Id("apiServer"),
+				Line().Qual("github.com/threeport/threeport/pkg/client/lib/v0", "WithFilter").Call(
+					Lit("Reconciled"),
+					Qual("github.com/threeport/threeport/pkg/api-server/lib/v0", "FilterOpEquals"),
+					Lit("true"),
+				),
+				Line(),
+			)
+			g.If(Err().Op("!=").Nil()).Block(
+				Return(Nil(), Qual("fmt", "Errorf").Call(
+					Lit(fmt.Sprintf(
+						"failed to get %s %s: %%w",
+						version,
+						pluralize.Pluralize(strcase.ToDelimited(obj.Name, ' '), 2, false),
+					)),
+					Err(),
+				)),
+			)
+			g.For(Id("i").Op(":=").Range().Op("*").Id(versionObjects)).Block(
+				Id("reconciled").Op("=").Append(
+					Id("reconciled"),
+					Op("&").Parens(Op("*").Id(versionObjects)).Index(Id("i")),
+				),
 			)
 		}
+		g.Line()
+		g.Return(Id("reconciled"), Nil())
 	})
 }
@@ -130,7 +130,7 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 				if apiObject.Reconciler {
 					// configure controller notifications
 					// create notifications
-					notifyControllersCreateHandler = Comment("notify controller if reconciliation is required")
+					notifyControllersCreateHandler = Comment("queue controller notification if reconciliation is required")
 					notifyControllersCreateHandler.Line()
 					notifyControllersCreateHandler.If(Op("!*").Id(
 						strcase.ToLowerCamel(apiObject.TypeName),
@@ -147,31 +147,34 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							Line(),
 						),
 						If(Id("err").Op("!=").Nil().Block(
-							Return(Qual(
-								"github.com/threeport/threeport/pkg/api-server/lib/v0",
-								"ResponseStatus500",
-							).Call(Id("c").Op(",").Nil().Op(",").Id("err").Op(",").Id("objectType")))),
-						),
-						Do(func(s *Statement) {
-							if gen.Module {
-								s.Id("h").Dot("Handler")
-							} else {
-								s.Id("h")
-							}
-						}).Dot("JS").Dot("Publish").Call(Qual(
-							fmt.Sprintf(
-								"%s/internal/%s/notif",
-								gen.ModulePath,
-								objGroup.Name,
+							Return(Id("err")),
+						)),
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"AddOutboxNotification",
+						).Call(
+							Id("tx"),
+							Qual(
+								fmt.Sprintf(
+									"%s/internal/%s/notif",
+									gen.ModulePath,
+									objGroup.Name,
+								),
+								apiObject.CreateSubject,
 							),
-							apiObject.CreateSubject,
-						).Op(",").Op("*").Id("notifPayload")),
+							Op("*").Id("notifPayload"),
+						)),
 					))
 
 					// update notifications
-					notifyControllersUpdateHandler = Comment("notify controller if reconciliation is required")
+					notifyControllersUpdateHandler = Comment("queue controller notification if reconciliation is required")
+					notifyControllersUpdateHandler.Line()
+					notifyControllersUpdateHandler.Comment("unless the update only changes the object's status, e.g. its conditions")
 					notifyControllersUpdateHandler.Line()
-					notifyControllersUpdateHandler.If(Op("!*").Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("Reconciled").Block(
+					notifyControllersUpdateHandler.If(Op("!*").Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("Reconciled").Op("&&").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"ReconciliationRequired",
+					).Call(Id(fmt.Sprintf("updated%s", apiObject.TypeName))).Block(
 						Id("notifPayload").Op(",").Id("err").Op(":=").Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("NotificationPayload").Call(
 							Line().Qual(
 								"github.com/threeport/threeport/pkg/notifications/v0",
@@ -182,25 +185,23 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							Line(),
 						),
 						If(Id("err").Op("!=").Nil().Block(
-							Return(Qual(
-								"github.com/threeport/threeport/pkg/api-server/lib/v0",
-								"ResponseStatus500",
-							).Call(Id("c").Op(",").Nil().Op(",").Id("err").Op(",").Id("objectType")))),
-						),
-						Do(func(s *Statement) {
-							if gen.Module {
-								s.Id("h").Dot("Handler")
-							} else {
-								s.Id("h")
-							}
-						}).Dot("JS").Dot("Publish").Call(Qual(
-							fmt.Sprintf(
-								"%s/internal/%s/notif",
-								gen.ModulePath,
-								objGroup.Name,
+							Return(Id("err")),
+						)),
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"AddOutboxNotification",
+						).Call(
+							Id("tx"),
+							Qual(
+								fmt.Sprintf(
+									"%s/internal/%s/notif",
+									gen.ModulePath,
+									objGroup.Name,
+								),
+								apiObject.UpdateSubject,
 							),
-							apiObject.UpdateSubject,
-						).Op(",").Op("*").Id("notifPayload")),
+							Op("*").Id("notifPayload"),
+						)),
 					))
 
 					// schedule for deletion
@@ -219,7 +220,18 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							apiObject.TypeName,
 						).Values(
 							Dict{
-								Line().Id("Reconciliation"): Qual(
+								Id("Common"): Qual(
+									"github.com/threeport/threeport/pkg/api/v0",
+									"Common",
+								).Values(
+									Dict{
+										Id("ResourceVersion"): Qual(
+											"github.com/threeport/threeport/pkg/api-server/lib/v0",
+											"NextResourceVersion",
+										).Call(Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion")),
+									},
+								),
+								Id("Reconciliation"): Qual(
 									"github.com/threeport/threeport/pkg/api/v0",
 									"Reconciliation",
 								).Values(
@@ -230,58 +242,95 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 								),
 							},
 						),
-						If(
-							Id("result").Op(":=").Do(func(s *Statement) {
-								if gen.Module {
-									s.Id("h").Dot("Handler")
-								} else {
-									s.Id("h")
-								}
-							}).Dot("DB").Dot("Model").Call(
+						Comment("schedule deletion, delete dependents and queue controller"),
+						Comment("notification in a single transaction"),
+						outboxTransaction(
+							gen.Module,
+							Comment("dependents deleted with the object share the time its deletion"),
+							Comment("was scheduled so that they can be restored with it"),
+							Id("tx").Op("=").Qual(
+								"github.com/threeport/threeport/pkg/api-server/lib/v0",
+								"DeletionSession",
+							).Call(Id("tx"), Id("timestamp")),
+							Line(),
+							Comment("the object waits for its dependents to be deleted first when"),
+							Comment("deleted in the foreground"),
+							List(Id("waitForDependents"), Id("err")).Op(":=").Qual(
+								"github.com/threeport/threeport/pkg/api-server/lib/v0",
+								"DeleteDependents",
+							).Call(
+								Line().Id("tx"),
+								Line().Id("objectType"),
+								Line().Op("*").Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ID"),
+								Line().Id("propagation"),
+								Line(),
+							),
+							If(Id("err").Op("!=").Nil()).Block(
+								Return(Id("err")),
+							),
+							If(Id("waitForDependents")).Block(
+								Id(fmt.Sprintf("scheduled%s", apiObject.TypeName)).Dot("Finalizers").Op("=").Id(
+									strcase.ToLowerCamel(apiObject.TypeName),
+								).Dot("Finalizers"),
+								Id(fmt.Sprintf("scheduled%s", apiObject.TypeName)).Dot("AddFinalizer").Call(Qual(
+									"github.com/threeport/threeport/pkg/api/v0",
+									"FinalizerForegroundDeletion",
+								)),
+							),
+							Line(),
+							Id("result").Op(":=").Id("tx").Dot("Model").Call(
 								Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)),
+							).Dot("Where").Call(
+								Lit("resource_version = ?"),
+								Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion"),
 							).Dot("Updates").Call(
 								Id(fmt.Sprintf("scheduled%s", apiObject.TypeName)),
 							),
-							Id("result").Dot("Error").Op("!=").Nil(),
-						).Block(
-							Return(Qual(
-								"github.com/threeport/threeport/pkg/api-server/lib/v0",
-								"ResponseStatus500",
-							).Call(
-								Id("c"), Nil(), Id("result").Dot("Error"), Id("objectType")),
+							If(Id("result").Dot("Error").Op("!=").Nil()).Block(
+								Return(Id("result").Dot("Error")),
 							),
-						),
-						Comment("notify controller"),
-						List(Id("notifPayload"), Id("err")).Op(":=").Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("NotificationPayload").Call(
-							Line().Qual(
-								"github.com/threeport/threeport/pkg/notifications/v0",
-								"NotificationOperationDeleted",
+							txResourceVersionConflictCheck(strcase.ToLowerCamel(apiObject.TypeName)),
+							Line(),
+							Comment("the controller is notified once the object's dependents have"),
+							Comment("been deleted"),
+							If(Id("waitForDependents")).Block(
+								Return(Nil()),
 							),
-							Line().Lit(false),
-							Line().Qual("time", "Now").Call().Dot("Unix").Call(),
 							Line(),
-						),
-						If(Id("err").Op("!=").Nil()).Block(
+							Comment("queue controller notification"),
+							List(Id("notifPayload"), Id("err")).Op(":=").Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("NotificationPayload").Call(
+								Line().Qual(
+									"github.com/threeport/threeport/pkg/notifications/v0",
+									"NotificationOperationDeleted",
+								),
+								Line().Lit(false),
+								Line().Qual("time", "Now").Call().Dot("Unix").Call(),
+								Line(),
+							),
+							If(Id("err").Op("!=").Nil()).Block(
+								Return(Id("err")),
+							),
 							Return(Qual(
 								"github.com/threeport/threeport/pkg/api-server/lib/v0",
-								"ResponseStatus500",
-							).Call(Id("c"), Nil(), Id("err"), Id("objectType"))),
-						),
-						Do(func(s *Statement) {
-							if gen.Module {
-								s.Id("h").Dot("Handler")
-							} else {
-								s.Id("h")
-							}
-						}).Dot("JS").Dot("Publish").Call(
-							Qual(
-								fmt.Sprintf(
-									"%s/internal/%s/notif",
-									gen.ModulePath,
-									objGroup.Name,
+								"AddOutboxNotification",
+							).Call(
+								Id("tx"),
+								Qual(
+									fmt.Sprintf(
+										"%s/internal/%s/notif",
+										gen.ModulePath,
+										objGroup.Name,
+									),
+									fmt.Sprintf("%sDeleteSubject", apiObject.TypeName),
 								),
-								fmt.Sprintf("%sDeleteSubject", apiObject.TypeName),
-							), Op("*").Id("notifPayload"),
+								Op("*").Id("notifPayload"),
+							)),
+						),
+						publishWatchEvent(
+							gen.Module,
+							"WatchEventUpdated",
+							objCollection.Version,
+							strcase.ToLowerCamel(apiObject.TypeName),
 						),
 					).Else().Block(
 						If(Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("DeletionConfirmed").Op("==").Nil()).Block(
@@ -302,45 +351,95 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 									Id("objectType"),
 								),
 							),
-						).Else().Block(
+						).Else().If(Len(Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("GetFinalizers").Call()).Op("==").Lit(0)).Block(
 							Comment("object scheduled for deletion and confirmed - it can be deleted"),
-							Comment("from DB"),
-							If(
-								Id("result").Op(":=").Do(func(s *Statement) {
-									if gen.Module {
-										s.Id("h").Dot("Handler")
-									} else {
-										s.Id("h")
-									}
-								}).Dot("DB").Dot("Delete").Call(Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName))),
-								Id("result").Dot("Error").Op("!=").Nil(),
-							).Block(
+							Comment("from DB once it has no finalizers, otherwise it is deleted when"),
+							Comment("the last finalizer is removed"),
+							outboxTransaction(
+								gen.Module,
+								Id("result").Op(":=").Id("tx").Dot("Where").Call(
+									Lit("resource_version = ?"),
+									Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion"),
+								).Dot("Delete").Call(Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName))),
+								If(Id("result").Dot("Error").Op("!=").Nil()).Block(
+									Return(Id("result").Dot("Error")),
+								),
+								txResourceVersionConflictCheck(strcase.ToLowerCamel(apiObject.TypeName)),
+								Line(),
+								Comment("notify owners waiting for their dependents to be deleted"),
 								Return(Qual(
 									"github.com/threeport/threeport/pkg/api-server/lib/v0",
-									"ResponseStatus500",
-								).Call(Id("c"), Nil(), Id("result").Dot("Error"), Id("objectType"))),
+									"ReleaseOwners",
+								).Call(
+									Id("tx"),
+									Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("GetOwnerReferences").Call(),
+								)),
+							),
+							publishWatchEvent(
+								gen.Module,
+								"WatchEventDeleted",
+								objCollection.Version,
+								strcase.ToLowerCamel(apiObject.TypeName),
 							),
 						),
 					)
 				} else {
 					// delete object that doesn't require scheduling (no reconciler)
-					deleteObjectExecution = Comment("delete object")
+					deleteObjectExecution = Comment("delete object if it has not changed since it was read along with")
 					deleteObjectExecution.Line()
-					deleteObjectExecution.If(
-						Id("result").Op(":=").Do(func(s *Statement) {
-							if gen.Module {
-								s.Id("h").Dot("Handler")
-							} else {
-								s.Id("h")
-							}
-						}).Dot("DB").Dot("Delete").Call(Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName))),
-						Id("result").Dot("Error").Op("!=").Nil(),
-					).Block(
+					deleteObjectExecution.Comment("its dependents in a single transaction - objects that aren't")
+					deleteObjectExecution.Line()
+					deleteObjectExecution.Comment("reconciled can't wait for their dependents to be deleted first")
+					deleteObjectExecution.Line()
+					deleteObjectExecution.Add(outboxTransaction(
+						gen.Module,
+						Comment("dependents deleted with the object share its deletion time so"),
+						Comment("that they can be restored with it"),
+						Id("tx").Op("=").Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"DeletionSession",
+						).Call(Id("tx"), Qual("time", "Now").Call().Dot("UTC").Call()),
+						Line(),
+						If(
+							List(Id("_"), Id("err")).Op(":=").Qual(
+								"github.com/threeport/threeport/pkg/api-server/lib/v0",
+								"DeleteDependents",
+							).Call(
+								Line().Id("tx"),
+								Line().Id("objectType"),
+								Line().Op("*").Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ID"),
+								Line().Id("propagation"),
+								Line(),
+							),
+							Id("err").Op("!=").Nil(),
+						).Block(
+							Return(Id("err")),
+						),
+						Id("result").Op(":=").Id("tx").Dot("Where").Call(
+							Lit("resource_version = ?"),
+							Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion"),
+						).Dot("Delete").Call(Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName))),
+						If(Id("result").Dot("Error").Op("!=").Nil()).Block(
+							Return(Id("result").Dot("Error")),
+						),
+						txResourceVersionConflictCheck(strcase.ToLowerCamel(apiObject.TypeName)),
+						Line(),
+						Comment("notify owners waiting for their dependents to be deleted"),
 						Return(Qual(
 							"github.com/threeport/threeport/pkg/api-server/lib/v0",
-							"ResponseStatus500",
-						).Call(Id("c"), Nil(), Id("result").Dot("Error"), Id("objectType"))),
-					)
+							"ReleaseOwners",
+						).Call(
+							Id("tx"),
+							Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("GetOwnerReferences").Call(),
+						)),
+					))
+					deleteObjectExecution.Line()
+					deleteObjectExecution.Add(publishWatchEvent(
+						gen.Module,
+						"WatchEventDeleted",
+						objCollection.Version,
+						strcase.ToLowerCamel(apiObject.TypeName),
+					))
 				}
 
 				instanceCheck := false
@@ -631,24 +730,45 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					)
 					g.Line()
 					g.Add(checkDuplicateNames)
-					g.Comment("persist to DB")
-					g.If(Id("result").Op(":=").Do(func(s *Statement) {
-						if gen.Module {
-							s.Id("h").Dot("Handler")
-						} else {
-							s.Id("h")
-						}
-					}).Dot("DB").Dot("Create").Call(
-						Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)),
-					).Op(";").Id("result").Dot("Error").Op("!=").Nil()).Block(
-						Return(Qual(
-							"github.com/threeport/threeport/pkg/api-server/lib/v0",
-							"ResponseStatus500",
-						).Call(Id("c").Op(",").Nil().Op(",").Id("result").Dot("Error").Op(",").Id("objectType")),
-						),
-					)
+					if apiObject.Reconciler {
+						g.Comment("persist to DB and queue controller notification in a single transaction")
+						g.Add(outboxTransaction(
+							gen.Module,
+							If(Id("result").Op(":=").Id("tx").Dot("Create").Call(
+								Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)),
+							).Op(";").Id("result").Dot("Error").Op("!=").Nil()).Block(
+								Return(Id("result").Dot("Error")),
+							),
+							Line(),
+							notifyControllersCreateHandler,
+							Line(),
+							Return(Nil()),
+						))
+					} else {
+						g.Comment("persist to DB")
+						g.If(Id("result").Op(":=").Do(func(s *Statement) {
+							if gen.Module {
+								s.Id("h").Dot("Handler")
+							} else {
+								s.Id("h")
+							}
+						}).Dot("DB").Dot("Create").Call(
+							Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)),
+						).Op(";").Id("result").Dot("Error").Op("!=").Nil()).Block(
+							Return(Qual(
+								"github.com/threeport/threeport/pkg/api-server/lib/v0",
+								"ResponseStatus500",
+							).Call(Id("c").Op(",").Nil().Op(",").Id("result").Dot("Error").Op(",").Id("objectType")),
+							),
+						)
+					}
 					g.Line()
-					g.Add(notifyControllersCreateHandler)
+					g.Add(publishWatchEvent(
+						gen.Module,
+						"WatchEventCreated",
+						objCollection.Version,
+						strcase.ToLowerCamel(apiObject.TypeName),
+					))
 					g.Line()
 					g.Id("response").Op(",").Id("err").Op(":=").Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
@@ -661,6 +781,10 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						).Call(Id("c").Op(",").Nil().Op(",").Id("err").Op(",").Id("objectType"))),
 					)
 					g.Line()
+					g.Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"SetETag",
+					).Call(Id("c"), Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion"))
 					g.Return(Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"ResponseStatus201",
@@ -675,6 +799,8 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					"@Description Get all %s from the Threeport database.",
 					pluralize.Pluralize(strcase.ToDelimited(apiObject.TypeName, ' '), 2, false),
 				))
+				f.Comment("@Description Filter by field value with Field[op]=value where op is one of")
+				f.Comment("@Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.")
 				f.Comment(fmt.Sprintf(
 					"@ID get-%s-%s",
 					objCollection.Version,
@@ -687,8 +813,16 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					"name", // TODO: get fields from model for query params
 					strcase.ToDelimited(apiObject.TypeName, ' '),
 				))
+				f.Comment("@Param labelSelector query string false \"select objects by label, e.g. team=payments,tier!=dev\"")
+				f.Comment("@Param sort query string false \"comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name\"")
+				f.Comment("@Param fields query string false \"comma-separated fields to return, e.g. ID,Name\"")
+				f.Comment("@Param continue query string false \"continue token from the Meta of the previous page to fetch the next page\"")
+				f.Comment("@Param skipCount query bool false \"skip counting the total number of objects\"")
+				f.Comment("@Param watch query bool false \"stream changes as server-sent events instead of returning a list\"")
+				f.Comment("@Param since query int false \"resume a watch following the event with this marker\"")
 				f.Comment("@Success 200 {object} v0.Response \"OK\"")
 				f.Comment("@Failure 400 {object} v0.Response \"Bad Request\"")
+				f.Comment("@Failure 410 {object} v0.Response \"Gone\"")
 				f.Comment("@Failure 500 {object} v0.Response \"Internal Server Error\"")
 				if gen.Module {
 					f.Comment(fmt.Sprintf(
@@ -725,6 +859,19 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							apiObject.TypeName,
 						),
 					),
+					Line(),
+					Comment("check for a watch request"),
+					List(Id("watch"), Id("since"), Id("err")).Op(":=").Id("c").Assert(Op("*").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"CustomContext",
+					)).Dot("GetWatchParams").Call(),
+					If(Id("err").Op("!=").Nil()).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus400",
+						).Call(Id("c"), Nil(), Id("err"), Id("objectType"))),
+					),
+					Line(),
 					Id("params").Op(",").Id("err").Op(":=").Id("c").Assert(Op("*").Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"CustomContext",
@@ -751,8 +898,95 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						).Call(Id("c").Op(",").Op("&").Id("params").Op(",").Id("err").Op(",").Id("objectType"))),
 					)),
 					Line(),
-					Var().Id("totalCount").Int64(),
-					If(Id("result").Op(":=").Do(func(s *Statement) {
+					List(Id("labelSelector"), Id("err")).Op(":=").Id("c").Assert(Op("*").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"CustomContext",
+					)).Dot("GetLabelSelector").Call(),
+					If(Id("err").Op("!=").Nil()).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus400",
+						).Call(Id("c"), Op("&").Id("params"), Id("err"), Id("objectType"))),
+					),
+					Line(),
+					List(Id("queryOptions"), Id("err")).Op(":=").Id("c").Assert(Op("*").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"CustomContext",
+					)).Dot("GetListQueryOptions").Call(
+						Do(func(s *Statement) {
+							if gen.Module {
+								s.Id("h").Dot("Handler")
+							} else {
+								s.Id("h")
+							}
+						}).Dot("DB"),
+						Op("&").Qual(
+							fmt.Sprintf(
+								"%s/pkg/api/%s",
+								gen.ModulePath,
+								objCollection.Version,
+							),
+							apiObject.TypeName,
+						).Values(),
+					),
+					If(Id("err").Op("!=").Nil()).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus400",
+						).Call(Id("c"), Op("&").Id("params"), Id("err"), Id("objectType"))),
+					),
+					Line(),
+					Comment("stream changes to objects that match the filters if watching"),
+					If(Id("watch")).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"StreamWatchEvents",
+						).Call(
+							Id("c"),
+							Do(func(s *Statement) {
+								if gen.Module {
+									s.Id("h").Dot("Handler")
+								} else {
+									s.Id("h")
+								}
+							}).Dot("JS"),
+							Id("objectType"),
+							Lit(objCollection.Version),
+							Id("since"),
+							Qual(
+								"github.com/threeport/threeport/pkg/api-server/lib/v0",
+								"NewWatchFilter",
+							).Call(
+								Do(func(s *Statement) {
+									if gen.Module {
+										s.Id("h").Dot("Handler")
+									} else {
+										s.Id("h")
+									}
+								}).Dot("DB"),
+								Op("&").Id("filter"),
+								Id("labelSelector"),
+								Id("queryOptions"),
+							),
+						)),
+					),
+					Line(),
+					List(Id("cursorParams"), Id("err")).Op(":=").Id("c").Assert(Op("*").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"CustomContext",
+					)).Dot("GetCursorParams").Call(Id("queryOptions")),
+					If(Id("err").Op("!=").Nil()).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus400",
+						).Call(Id("c"), Op("&").Id("params"), Id("err"), Id("objectType"))),
+					),
+					Line(),
+					Id("totalCount").Op(":=").Int64().Call(Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"TotalCountSkipped",
+					)),
+					If(Op("!").Id("cursorParams").Dot("SkipCount")).Block(If(Id("result").Op(":=").Do(func(s *Statement) {
 						if gen.Module {
 							s.Id("h").Dot("Handler")
 						} else {
@@ -767,14 +1001,20 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							),
 							apiObject.TypeName,
 						).Values(),
-					).Dot("Where").Call(Op("&").Id("filter")).Dot("Count").Call(Op("&").Id("totalCount")),
+					).Dot("Where").Call(Op("&").Id("filter")).Dot("Scopes").Call(
+						Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"LabelSelectorScope",
+						).Call(Id("labelSelector")),
+						Id("queryOptions").Dot("FilterScope").Call(),
+					).Dot("Count").Call(Op("&").Id("totalCount")),
 						Id("result").Dot("Error").Op("!=").Nil().Block(
 							Return(Qual(
 								"github.com/threeport/threeport/pkg/api-server/lib/v0",
 								"ResponseStatus500",
 							).Call(Id("c").Op(",").Op("&").Id("params").Op(",").Id("result").Dot("Error").Op(",").Id("objectType"))),
 						),
-					),
+					)),
 					Line(),
 					Id("records").Op(":=").Op("&").Index().Qual(
 						fmt.Sprintf(
@@ -790,11 +1030,17 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						} else {
 							s.Id("h")
 						}
-					}).Dot("DB").Add(dbLoadAssociationStatement).Dot("Order").Call(
-						Lit("ID asc")).Dot("Where").Call(Op("&").Id("filter")).
-						Dot("Limit").Call(Id("params").Dot("Size")).
-						Dot("Offset").Call(Call(
-						Id("params").Dot("Page").Op("-").Lit(1)).Op("*").Id("params").Dot("Size")).
+					}).Dot("DB").Add(dbLoadAssociationStatement).Dot("Where").Call(Op("&").Id("filter")).
+						Dot("Scopes").Call(
+						Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"LabelSelectorScope",
+						).Call(Id("labelSelector")),
+						Id("queryOptions").Dot("FilterScope").Call(),
+						Id("queryOptions").Dot("SortScope").Call(),
+						Id("queryOptions").Dot("FieldsScope").Call(),
+						Id("cursorParams").Dot("PageScope").Call(Id("params")),
+					).
 						// TODO: figure out DB preloads
 						Dot("Find").Call(Id("records")).Op(";").Id("result").Dot("Error").Op("!=").Nil().Block(
 						Return(Qual(
@@ -804,13 +1050,26 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						)),
 					),
 					Line(),
+					Id("meta").Op(":=").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"CreateMeta",
+					).Call(Id("params"), Id("totalCount")),
+					If(
+						List(Id("meta").Dot("Continue"), Id("err")).Op("=").Id("cursorParams").Dot("NextContinueToken").Call(
+							Id("records"), Id("params"),
+						),
+						Id("err").Op("!=").Nil(),
+					).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus500",
+						).Call(Id("c"), Op("&").Id("params"), Id("err"), Id("objectType"))),
+					),
+					Line(),
 					Id("response").Op(",").Id("err").Op(":=").Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"CreateResponse",
-					).Call(Qual(
-						"github.com/threeport/threeport/pkg/api-server/lib/v0",
-						"CreateMeta",
-					).Call(Id("params").Op(",").Id("totalCount")).Op(",").Op("*").Id("records").Op(",").Id("objectType")),
+					).Call(Id("meta").Op(",").Op("*").Id("records").Op(",").Id("objectType")),
 					If(Id("err").Op("!=").Nil().Block(
 						Return(Qual(
 							"github.com/threeport/threeport/pkg/api-server/lib/v0",
@@ -839,6 +1098,7 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 				f.Comment("@Produce json")
 				f.Comment("@Param id path int true \"ID\"")
 				f.Comment("@Success 200 {object} v0.Response \"OK\"")
+				f.Comment("@Header 200 {string} ETag \"resource version\"")
 				f.Comment("@Failure 404 {object} v0.Response \"Not Found\"")
 				f.Comment("@Failure 500 {object} v0.Response \"Internal Server Error\"")
 				if gen.Module {
@@ -930,6 +1190,10 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						)),
 						Line(),
 						Line(),
+						Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"SetETag",
+						).Call(Id("c"), Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion")),
 						Return(Qual(
 							"github.com/threeport/threeport/pkg/api-server/lib/v0",
 							"ResponseStatus200",
@@ -964,9 +1228,12 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					objectImportAlias,
 					apiObject.TypeName,
 				))
+				f.Comment("@Param If-Match header string false \"resource version the update is based on\"")
 				f.Comment("@Success 200 {object} v0.Response \"OK\"")
+				f.Comment("@Header 200 {string} ETag \"resource version\"")
 				f.Comment("@Failure 400 {object} v0.Response \"Bad Request\"")
 				f.Comment("@Failure 404 {object} v0.Response \"Not Found\"")
+				f.Comment("@Failure 409 {object} v0.Response \"Conflict\"")
 				f.Comment("@Failure 500 {object} v0.Response \"Internal Server Error\"")
 				if gen.Module {
 					f.Comment(fmt.Sprintf(
@@ -1043,6 +1310,8 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
+					g.Add(ifMatchCheck(fmt.Sprintf("existing%s", apiObject.TypeName)))
+					g.Line()
 					g.Comment("check for empty payload, invalid or unsupported fields, optional associations, etc.")
 					if gen.Module {
 						g.If(
@@ -1108,9 +1377,56 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
-					g.Comment("update object in database")
-					g.If(
-						Id("result").Op(":=").Do(func(s *Statement) {
+					g.Comment("update object in database if it has not changed since it was read")
+					if apiObject.Reconciler {
+						g.Comment("and queue controller notification in a single transaction")
+					}
+					g.Id(fmt.Sprintf("updated%s", apiObject.TypeName)).Dot("ResourceVersion").Op("=").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"NextResourceVersion",
+					).Call(Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"))
+					if apiObject.Reconciler {
+						g.Add(outboxTransaction(
+							gen.Module,
+							Id("result").Op(":=").Id("tx").Dot("Model").Call(
+								Op("&").Id(fmt.Sprintf("existing%s", apiObject.TypeName)),
+							).Dot("Where").Call(
+								Lit("resource_version = ?"),
+								Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"),
+							).Dot("Updates").Call(
+								Id(fmt.Sprintf("updated%s", apiObject.TypeName)),
+							),
+							If(Id("result").Dot("Error").Op("!=").Nil()).Block(
+								Return(Id("result").Dot("Error")),
+							),
+							txResourceVersionConflictCheck(fmt.Sprintf("existing%s", apiObject.TypeName)),
+							Line(),
+							Comment("delete the object if its deletion was waiting for the finalizers"),
+							Comment("that have been removed"),
+							If(Id(fmt.Sprintf("updated%s", apiObject.TypeName)).Dot("Finalizers").Op("!=").Nil()).Block(
+								List(Id("deleted"), Id("err")).Op(":=").Qual(
+									"github.com/threeport/threeport/pkg/api-server/lib/v0",
+									"DeleteFinalized",
+								).Call(
+									Line().Id("tx"),
+									Line().Op("&").Id(fmt.Sprintf("existing%s", apiObject.TypeName)),
+									Line().Op("*").Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ID"),
+									Line(),
+								),
+								If(Id("err").Op("!=").Nil()).Block(
+									Return(Id("err")),
+								),
+								If(Id("deleted")).Block(
+									Return(Nil()),
+								),
+							),
+							Line(),
+							notifyControllersUpdateHandler,
+							Line(),
+							Return(Nil()),
+						))
+					} else {
+						g.Id("result").Op(":=").Do(func(s *Statement) {
 							if gen.Module {
 								s.Id("h").Dot("Handler")
 							} else {
@@ -1118,17 +1434,27 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 							}
 						}).Dot("DB").Dot("Model").Call(
 							Op("&").Id(fmt.Sprintf("existing%s", apiObject.TypeName)),
+						).Dot("Where").Call(
+							Lit("resource_version = ?"),
+							Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"),
 						).Dot("Updates").Call(
 							Id(fmt.Sprintf("updated%s", apiObject.TypeName)),
-						).Op(";").Id("result").Dot("Error").Op("!=").Nil().Block(
+						)
+						g.If(Id("result").Dot("Error").Op("!=").Nil()).Block(
 							Return(Qual(
 								"github.com/threeport/threeport/pkg/api-server/lib/v0",
 								"ResponseStatus500",
 							).Call(Id("c").Op(",").Nil().Op(",").Id("result").Dot("Error").Op(",").Id("objectType"))),
-						),
-					)
+						)
+						g.Add(resourceVersionConflictCheck(fmt.Sprintf("existing%s", apiObject.TypeName)))
+					}
 					g.Line()
-					g.Add(notifyControllersUpdateHandler)
+					g.Add(publishWatchEvent(
+						gen.Module,
+						"WatchEventUpdated",
+						objCollection.Version,
+						fmt.Sprintf("existing%s", apiObject.TypeName),
+					))
 					g.Line()
 					g.Id("response").Op(",").Id("err").Op(":=").Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
@@ -1143,6 +1469,10 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
+					g.Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"SetETag",
+					).Call(Id("c"), Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"))
 					g.Return(Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"ResponseStatus200",
@@ -1176,9 +1506,12 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					objectImportAlias,
 					apiObject.TypeName,
 				))
+				f.Comment("@Param If-Match header string false \"resource version the update is based on\"")
 				f.Comment("@Success 200 {object} v0.Response \"OK\"")
+				f.Comment("@Header 200 {string} ETag \"resource version\"")
 				f.Comment("@Failure 400 {object} v0.Response \"Bad Request\"")
 				f.Comment("@Failure 404 {object} v0.Response \"Not Found\"")
+				f.Comment("@Failure 409 {object} v0.Response \"Conflict\"")
 				f.Comment("@Failure 500 {object} v0.Response \"Internal Server Error\"")
 				if gen.Module {
 					f.Comment(fmt.Sprintf(
@@ -1255,6 +1588,8 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
+					g.Add(ifMatchCheck(fmt.Sprintf("existing%s", apiObject.TypeName)))
+					g.Line()
 					g.Comment("check for empty payload, invalid or unsupported fields, optional associations, etc.")
 					if gen.Module {
 						g.If(
@@ -1337,33 +1672,40 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
-					g.Comment("persist provided data")
+					g.Comment("persist provided data if the object has not changed since it was read")
 					g.Id(fmt.Sprintf("updated%s", apiObject.TypeName)).Dot("ID").Op("=").Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ID")
-					g.If(
-						Id("result").Op(":=").Do(func(s *Statement) {
-							if gen.Module {
-								s.Id("h").Dot("Handler")
-							} else {
-								s.Id("h")
-							}
-						}).Dot("DB").Dot("Session").Call(
-							Op("&").Qual(
-								"gorm.io/gorm",
-								"Session",
-							).Values(Dict{
-								Id("FullSaveAssociations"): Lit(false),
-							})).Dot("Omit").Call(
-							Lit("CreatedAt").Op(",").Lit("DeletedAt"),
-						).Dot("Save").Call(
-							Op("&").Id(fmt.Sprintf("updated%s", apiObject.TypeName)),
-						).Op(";").Id("result").Dot("Error").Op("!=").Nil().Block(
-							Return(Qual(
-								"github.com/threeport/threeport/pkg/api-server/lib/v0",
-								"ResponseStatus500",
-							).Call(Id("c").Op(",").Nil().Op(",").Id("result").Dot("Error").Op(",").Id("objectType")),
-							),
+					g.Id(fmt.Sprintf("updated%s", apiObject.TypeName)).Dot("ResourceVersion").Op("=").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"NextResourceVersion",
+					).Call(Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"))
+					g.Id("result").Op(":=").Do(func(s *Statement) {
+						if gen.Module {
+							s.Id("h").Dot("Handler")
+						} else {
+							s.Id("h")
+						}
+					}).Dot("DB").Dot("Session").Call(
+						Op("&").Qual(
+							"gorm.io/gorm",
+							"Session",
+						).Values(Dict{
+							Id("FullSaveAssociations"): Lit(false),
+						})).Dot("Select").Call(Lit("*")).Dot("Omit").Call(
+						Lit("CreatedAt").Op(",").Lit("DeletedAt"),
+					).Dot("Where").Call(
+						Lit("resource_version = ?"),
+						Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"),
+					).Dot("Save").Call(
+						Op("&").Id(fmt.Sprintf("updated%s", apiObject.TypeName)),
+					)
+					g.If(Id("result").Dot("Error").Op("!=").Nil()).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus500",
+						).Call(Id("c").Op(",").Nil().Op(",").Id("result").Dot("Error").Op(",").Id("objectType")),
 						),
 					)
+					g.Add(resourceVersionConflictCheck(fmt.Sprintf("existing%s", apiObject.TypeName)))
 					g.Line()
 					g.Comment("reload updated data from DB")
 					g.If(
@@ -1396,6 +1738,13 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
+					g.Add(publishWatchEvent(
+						gen.Module,
+						"WatchEventUpdated",
+						objCollection.Version,
+						fmt.Sprintf("existing%s", apiObject.TypeName),
+					))
+					g.Line()
 					g.Id("response").Op(",").Id("err").Op(":=").Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"CreateResponse",
@@ -1409,6 +1758,10 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 						),
 					)
 					g.Line()
+					g.Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"SetETag",
+					).Call(Id("c"), Id(fmt.Sprintf("existing%s", apiObject.TypeName)).Dot("ResourceVersion"))
 					g.Return(Qual(
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"ResponseStatus200",
@@ -1428,7 +1781,10 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 				f.Comment("@Accept json")
 				f.Comment("@Produce json")
 				f.Comment("@Param id path int true \"ID\"")
+				f.Comment("@Param If-Match header string false \"resource version the deletion is based on\"")
+				f.Comment("@Param propagationPolicy query string false \"how dependents are deleted: Foreground, Background (default) or Orphan\"")
 				f.Comment("@Success 200 {object} v0.Response \"OK\"")
+				f.Comment("@Failure 400 {object} v0.Response \"Bad Request\"")
 				f.Comment("@Failure 404 {object} v0.Response \"Not Found\"")
 				f.Comment("@Failure 409 {object} v0.Response \"Conflict\"")
 				f.Comment("@Failure 500 {object} v0.Response \"Internal Server Error\"")
@@ -1480,6 +1836,20 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 					// TODO: figure out all preload objects
 					deleteObjectChecks,
 					Line(),
+					ifMatchCheck(strcase.ToLowerCamel(apiObject.TypeName)),
+					Line(),
+					Comment("get the policy for deleting the object's dependents"),
+					List(Id("propagation"), Id("err")).Op(":=").Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"GetDeletionPropagation",
+					).Call(Id("c")),
+					If(Id("err").Op("!=").Nil()).Block(
+						Return(Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"ResponseStatus400",
+						).Call(Id("c"), Nil(), Id("err"), Id("objectType"))),
+					),
+					Line(),
 					deleteObjectExecution,
 					Line(),
 					Id("response").Op(",").Id("err").Op(":=").Qual(
@@ -1520,3 +1890,123 @@ func GenHandlers(gen *gen.Generator, sdkConfig *sdk.SdkConfig) error {
 
 	return nil
 }
+
+// ifMatchCheck returns the statement that rejects a request when the resource
+// version in the If-Match header does not match the object loaded from the
+// database.
+func ifMatchCheck(objectVar string) *Statement {
+	return Comment("check resource version provided by client, if any").Line().If(
+		Id("err").Op(":=").Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"CheckIfMatch",
+		).Call(Id("c"), Id(objectVar).Dot("ResourceVersion")),
+		Id("err").Op("!=").Nil(),
+	).Block(
+		Return(Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"ResponseStatus409",
+		).Call(Id("c"), Nil(), Id("err"), Id("objectType"))),
+	)
+}
+
+// resourceVersionConflictCheck returns the statement that rejects a request
+// when a conditional write affected no rows because the object was changed by
+// another client after it was loaded.
+func resourceVersionConflictCheck(objectVar string) *Statement {
+	return If(Id("result").Dot("RowsAffected").Op("==").Lit(0)).Block(
+		Return(Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"ResponseStatus409",
+		).Call(
+			Id("c"),
+			Nil(),
+			Qual(
+				"github.com/threeport/threeport/pkg/api-server/lib/v0",
+				"ResourceVersionConflictErr",
+			).Call(Id(objectVar).Dot("ResourceVersion")),
+			Id("objectType"),
+		)),
+	)
+}
+
+// txResourceVersionConflictCheck returns the statement that fails a
+// transaction when an update did not affect any rows because the object was
+// modified after it was read.
+func txResourceVersionConflictCheck(objectVar string) *Statement {
+	return If(Id("result").Dot("RowsAffected").Op("==").Lit(0)).Block(
+		Return(Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"ResourceVersionConflictErr",
+		).Call(Id(objectVar).Dot("ResourceVersion"))),
+	)
+}
+
+// outboxTransaction returns the statement that runs the provided statements in
+// a database transaction bound to tx and then signals the outbox relay so that
+// any controller notifications queued in the transaction are published.
+func outboxTransaction(module bool, body ...Code) *Statement {
+	return If(
+		Err().Op(":=").Do(func(s *Statement) {
+			if module {
+				s.Id("h").Dot("Handler")
+			} else {
+				s.Id("h")
+			}
+		}).Dot("DB").Dot("WithContext").Call(
+			Id("c").Dot("Request").Call().Dot("Context").Call(),
+		).Dot("Transaction").Call(
+			Func().Params(Id("tx").Op("*").Qual("gorm.io/gorm", "DB")).Error().Block(body...),
+		),
+		Err().Op("!=").Nil(),
+	).Block(
+		If(Qual("errors", "Is").Call(
+			Err(),
+			Qual("github.com/threeport/threeport/pkg/api-server/lib/v0", "ErrResourceVersionConflict"),
+		)).Block(
+			Return(Qual(
+				"github.com/threeport/threeport/pkg/api-server/lib/v0",
+				"ResponseStatus409",
+			).Call(Id("c"), Nil(), Err(), Id("objectType"))),
+		),
+		Return(Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			"ResponseStatus500",
+		).Call(Id("c"), Nil(), Err(), Id("objectType"))),
+	).Line().Qual(
+		"github.com/threeport/threeport/pkg/api-server/lib/v0",
+		"SignalOutboxRelay",
+	).Call()
+}
+
+// publishWatchEvent returns the statement that sends a change to an object to
+// clients watching that object type.  The change has already been committed so
+// a failure to publish is logged rather than returned to the client.
+func publishWatchEvent(module bool, eventType, objectVersion, objectVar string) *Statement {
+	return Comment("notify watch clients").Line().If(Err().Op(":=").Qual(
+		"github.com/threeport/threeport/pkg/api-server/lib/v0",
+		"PublishWatchEvent",
+	).Call(
+		Line().Id("c").Dot("Request").Call().Dot("Context").Call(),
+		Line().Do(func(s *Statement) {
+			if module {
+				s.Id("h").Dot("Handler")
+			} else {
+				s.Id("h")
+			}
+		}).Dot("JS"),
+		Line().Qual(
+			"github.com/threeport/threeport/pkg/api-server/lib/v0",
+			eventType,
+		),
+		Line().Id("objectType"),
+		Line().Lit(objectVersion),
+		Line().Id(objectVar),
+		Line(),
+	), Err().Op("!=").Nil()).Block(
+		Id("c").Dot("Logger").Call().Dot("Errorf").Call(
+			Lit("failed to publish watch event for %s: %v"),
+			Id("objectType"),
+			Err(),
+		),
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	. "github.com/dave/jennifer/jen"
+	"github.com/gertd/go-pluralize"
 	"github.com/iancoleman/strcase"
 
 	"github.com/threeport/threeport/internal/sdk/gen"
@@ -17,6 +18,7 @@ import (
 func GenObjValidationVersions(gen *gen.Generator) error {
 	for _, objCollection := range gen.VersionedApiObjectCollections {
 		for _, objGroup := range objCollection.VersionedApiObjectGroups {
+			pluralize := pluralize.NewClient()
 			f := NewFile("versions")
 			f.HeaderComment("generated by 'threeport-sdk gen' - do not edit")
 
@@ -128,6 +130,46 @@ func GenObjValidationVersions(gen *gen.Generator) error {
 						"github.com/threeport/threeport/pkg/api-server/lib/v0",
 						"AddObjectVersion",
 					).Call(Id("versionObj")),
+					Line(),
+					Comment("register the object's REST path for request authorization"),
+					Qual(
+						"github.com/threeport/threeport/pkg/api-server/lib/v0",
+						"AddAuthzObject",
+					).Call(
+						Line().Qual(
+							fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
+							fmt.Sprintf("Path%s", pluralize.Pluralize(apiObject.TypeName, 2, false)),
+						),
+						Line().Id("versionObj").Dot("Object"),
+						Line().Id("new").Call(Qual(
+							fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
+							apiObject.TypeName,
+						)),
+						Line(),
+					),
+					Do(func(s *Statement) {
+						if !apiObject.Reconciler {
+							return
+						}
+						s.Line().Comment("register the object's controller notification subjects so that").Line()
+						s.Comment("restored objects and objects deleted with their owners are").Line()
+						s.Comment("reconciled").Line()
+						s.Qual(
+							"github.com/threeport/threeport/pkg/api-server/lib/v0",
+							"AddReconciledObject",
+						).Call(
+							Line().Id("versionObj").Dot("Object"),
+							Line().Qual(
+								fmt.Sprintf("%s/internal/%s/notif", gen.ModulePath, objGroup.Name),
+								apiObject.CreateSubject,
+							),
+							Line().Qual(
+								fmt.Sprintf("%s/internal/%s/notif", gen.ModulePath, objGroup.Name),
+								fmt.Sprintf("%sDeleteSubject", apiObject.TypeName),
+							),
+							Line(),
+						)
+					}),
 				)
 				f.Line()
 			}
@@ -43,12 +43,12 @@ func GenClientLib(gen *gen.Generator) error {
 			for _, apiObject := range objGroup.ApiObjects {
 				// get all objects
 				getAllFuncName := fmt.Sprintf("Get%s", pluralize.Pluralize(apiObject.TypeName, 2, false))
+				getWithOptionsFuncName := fmt.Sprintf("Get%sWithOptions", pluralize.Pluralize(apiObject.TypeName, 2, false))
 				f.Comment(fmt.Sprintf(
 					"%s fetches all %s.",
 					getAllFuncName,
 					pluralize.Pluralize(strcase.ToDelimited(apiObject.TypeName, ' '), 2, false),
 				))
-				f.Comment("TODO: implement pagination")
 				f.Func().Id(getAllFuncName).Params(
 					Id("apiClient").Op("*").Qual("net/http", "Client"),
 					Id("apiAddr").String(),
@@ -59,7 +59,28 @@ func GenClientLib(gen *gen.Generator) error {
 					),
 					Error(),
 				)).Block(
-					Var().Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Index().Qual(
+					Return(Id(getWithOptionsFuncName).Call(Id("apiClient"), Id("apiAddr"))),
+				)
+				f.Line()
+				// get object by ID
+				getByIDFuncName := fmt.Sprintf("Get%sByID", apiObject.TypeName)
+				f.Comment(fmt.Sprintf(
+					"%s fetches a %s by ID.",
+					getByIDFuncName,
+					strcase.ToDelimited(apiObject.TypeName, ' '),
+				))
+				f.Func().Id(getByIDFuncName).Params(
+					Id("apiClient").Op("*").Qual("net/http", "Client"),
+					Id("apiAddr").String(),
+					Id("id").Uint(),
+				).Parens(List(
+					Op("*").Qual(
+						fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
+						apiObject.TypeName,
+					),
+					Error(),
+				)).Block(
+					Var().Id(strcase.ToLowerCamel(apiObject.TypeName)).Qual(
 						fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
 						apiObject.TypeName,
 					),
@@ -70,12 +91,13 @@ func GenClientLib(gen *gen.Generator) error {
 					).Call(
 						Line().Id("apiClient"),
 						Line().Qual("fmt", "Sprintf").Call(
-							Lit("%s%s"),
+							Lit("%s%s/%d"),
 							Id("apiAddr"),
 							Qual(
 								fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
 								fmt.Sprintf("Path%s", pluralize.Pluralize(apiObject.TypeName, 2, false)),
 							),
+							Id("id"),
 						),
 						Line().Qual("net/http", "MethodGet"),
 						Line().New(Qual("bytes", "Buffer")),
@@ -84,16 +106,16 @@ func GenClientLib(gen *gen.Generator) error {
 						Line(),
 					),
 					If(Id("err").Op("!=").Nil().Block(
-						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Qual(
+						Return().Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)).Op(",").Qual(
 							"fmt", "Errorf",
 						).Call(Lit(ResponseErr).Op(",").Id("err")),
 					)),
 					Line(),
 					Id("jsonData").Op(",").Id("err").Op(":=").Qual("encoding/json", "Marshal").Call(
-						Id("response").Dot("Data"),
+						Id("response").Dot("Data").Index(Lit(0)),
 					),
 					If(Id("err").Op("!=").Nil().Block(
-						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Qual(
+						Return().Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)).Op(",").Qual(
 							"fmt", "Errorf",
 						).Call(Lit(MarshalResponseDataErr).Op(",").Id("err")),
 					)),
@@ -105,35 +127,35 @@ func GenClientLib(gen *gen.Generator) error {
 					).Call(Id("jsonData"))),
 					Id("decoder").Dot("UseNumber").Call(),
 					If(Id("err").Op(":=").Id("decoder").Dot("Decode").Call(
-						Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)),
+						Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)),
 					).Op(";").Id("err").Op("!=").Nil()).Block(
 						Return().Nil().Op(",").Qual(
 							"fmt", "Errorf",
 						).Call(Lit("failed to decode object in response data from threeport API: %w").Op(",").Id("err")),
 					),
 					Line(),
-					Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Nil(),
+					Return().Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)).Op(",").Nil(),
 				)
 				f.Line()
-				// get object by ID
-				getByIDFuncName := fmt.Sprintf("Get%sByID", apiObject.TypeName)
+				// get object by query string
+				getByQueryStringFuncName := fmt.Sprintf("Get%sByQueryString", pluralize.Pluralize(apiObject.TypeName, 2, false))
 				f.Comment(fmt.Sprintf(
-					"%s fetches a %s by ID.",
-					getByIDFuncName,
-					strcase.ToDelimited(apiObject.TypeName, ' '),
+					"%s fetches %s by provided query string.",
+					getByQueryStringFuncName,
+					pluralize.Pluralize(strcase.ToDelimited(apiObject.TypeName, ' '), 2, false),
 				))
-				f.Func().Id(getByIDFuncName).Params(
+				f.Func().Id(getByQueryStringFuncName).Params(
 					Id("apiClient").Op("*").Qual("net/http", "Client"),
 					Id("apiAddr").String(),
-					Id("id").Uint(),
+					Id("queryString").String(),
 				).Parens(List(
-					Op("*").Qual(
+					Op("*").Index().Qual(
 						fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
 						apiObject.TypeName,
 					),
 					Error(),
 				)).Block(
-					Var().Id(strcase.ToLowerCamel(apiObject.TypeName)).Qual(
+					Var().Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Index().Qual(
 						fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
 						apiObject.TypeName,
 					),
@@ -144,13 +166,13 @@ func GenClientLib(gen *gen.Generator) error {
 					).Call(
 						Line().Id("apiClient"),
 						Line().Qual("fmt", "Sprintf").Call(
-							Lit("%s%s/%d"),
+							Lit("%s%s?%s"),
 							Id("apiAddr"),
 							Qual(
 								fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
 								fmt.Sprintf("Path%s", pluralize.Pluralize(apiObject.TypeName, 2, false)),
 							),
-							Id("id"),
+							Id("queryString"),
 						),
 						Line().Qual("net/http", "MethodGet"),
 						Line().New(Qual("bytes", "Buffer")),
@@ -159,16 +181,16 @@ func GenClientLib(gen *gen.Generator) error {
 						Line(),
 					),
 					If(Id("err").Op("!=").Nil().Block(
-						Return().Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)).Op(",").Qual(
+						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Qual(
 							"fmt", "Errorf",
 						).Call(Lit(ResponseErr).Op(",").Id("err")),
 					)),
 					Line(),
 					Id("jsonData").Op(",").Id("err").Op(":=").Qual("encoding/json", "Marshal").Call(
-						Id("response").Dot("Data").Index(Lit(0)),
+						Id("response").Dot("Data"),
 					),
 					If(Id("err").Op("!=").Nil().Block(
-						Return().Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)).Op(",").Qual(
+						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Qual(
 							"fmt", "Errorf",
 						).Call(Lit(MarshalResponseDataErr).Op(",").Id("err")),
 					)),
@@ -180,27 +202,30 @@ func GenClientLib(gen *gen.Generator) error {
 					).Call(Id("jsonData"))),
 					Id("decoder").Dot("UseNumber").Call(),
 					If(Id("err").Op(":=").Id("decoder").Dot("Decode").Call(
-						Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)),
+						Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)),
 					).Op(";").Id("err").Op("!=").Nil()).Block(
 						Return().Nil().Op(",").Qual(
 							"fmt", "Errorf",
 						).Call(Lit("failed to decode object in response data from threeport API: %w").Op(",").Id("err")),
 					),
 					Line(),
-					Return().Op("&").Id(strcase.ToLowerCamel(apiObject.TypeName)).Op(",").Nil(),
+					Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Nil(),
 				)
 				f.Line()
-				// get object by query string
-				getByQueryStringFuncName := fmt.Sprintf("Get%sByQueryString", pluralize.Pluralize(apiObject.TypeName, 2, false))
+				// get objects with list options
 				f.Comment(fmt.Sprintf(
-					"%s fetches %s by provided query string.",
-					getByQueryStringFuncName,
+					"%s fetches all %s using the provided sorting, field",
+					getWithOptionsFuncName,
 					pluralize.Pluralize(strcase.ToDelimited(apiObject.TypeName, ' '), 2, false),
 				))
-				f.Func().Id(getByQueryStringFuncName).Params(
+				f.Comment("and filter options.  Every page of objects is fetched.")
+				f.Func().Id(getWithOptionsFuncName).Params(
 					Id("apiClient").Op("*").Qual("net/http", "Client"),
 					Id("apiAddr").String(),
-					Id("queryString").String(),
+					Id("options").Op("...").Qual(
+						"github.com/threeport/threeport/pkg/client/lib/v0",
+						"ListOption",
+					),
 				).Parens(List(
 					Op("*").Index().Qual(
 						fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
@@ -213,34 +238,28 @@ func GenClientLib(gen *gen.Generator) error {
 						apiObject.TypeName,
 					),
 					Line(),
-					Id("response").Op(",").Id("err").Op(":=").Qual(
+					Id("data").Op(",").Id("err").Op(":=").Qual(
 						"github.com/threeport/threeport/pkg/client/lib/v0",
-						"GetResponse",
+						"GetAllPages",
 					).Call(
 						Line().Id("apiClient"),
 						Line().Qual("fmt", "Sprintf").Call(
-							Lit("%s%s?%s"),
+							Lit("%s%s"),
 							Id("apiAddr"),
 							Qual(
 								fmt.Sprintf("%s/pkg/api/%s", gen.ModulePath, objCollection.Version),
 								fmt.Sprintf("Path%s", pluralize.Pluralize(apiObject.TypeName, 2, false)),
 							),
-							Id("queryString"),
 						),
-						Line().Qual("net/http", "MethodGet"),
-						Line().New(Qual("bytes", "Buffer")),
-						Line().Map(String()).String().Block(),
-						Line().Qual("net/http", "StatusOK"),
+						Line().Id("options").Op("..."),
 						Line(),
 					),
 					If(Id("err").Op("!=").Nil().Block(
-						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Qual(
-							"fmt", "Errorf",
-						).Call(Lit(ResponseErr).Op(",").Id("err")),
+						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Id("err"),
 					)),
 					Line(),
 					Id("jsonData").Op(",").Id("err").Op(":=").Qual("encoding/json", "Marshal").Call(
-						Id("response").Dot("Data"),
+						Id("data"),
 					),
 					If(Id("err").Op("!=").Nil().Block(
 						Return().Op("&").Id(pluralize.Pluralize(strcase.ToLowerCamel(apiObject.TypeName), 2, false)).Op(",").Qual(
@@ -490,6 +509,7 @@ func GenClientLib(gen *gen.Generator) error {
 					Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Dot("ID").Op("=").Nil(),
 					Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Dot("CreatedAt").Op("=").Nil(),
 					Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Dot("UpdatedAt").Op("=").Nil(),
+					Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Dot("ResourceVersion").Op("=").Nil(),
 					Line(),
 					Id(fmt.Sprintf("json%s", apiObject.TypeName)).Op(",").Id("err").Op(":=").Qual(
 						"github.com/threeport/threeport/pkg/util/v0",
@@ -519,7 +539,10 @@ func GenClientLib(gen *gen.Generator) error {
 						Line().Qual("bytes", "NewBuffer").Call(Id(
 							fmt.Sprintf("json%s", apiObject.TypeName),
 						)),
-						Line().Map(String()).String().Block(),
+						Line().Qual(
+							"github.com/threeport/threeport/pkg/client/lib/v0",
+							"IfMatchHeader",
+						).Call(Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion")),
 						Line().Qual("net/http", "StatusOK"),
 						Line(),
 					),
@@ -552,6 +575,10 @@ func GenClientLib(gen *gen.Generator) error {
 						).Call(Lit("failed to decode object in response data from threeport API: %w").Op(",").Id("err")),
 					),
 					Line(),
+					Comment("keep the provided object's resource version current so it can be"),
+					Comment("used for subsequent updates"),
+					Id(strcase.ToLowerCamel(apiObject.TypeName)).Dot("ResourceVersion").Op("=").Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Dot("ResourceVersion"),
+					Line(),
 					Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Dot("ID").Op("=").Op("&").Id(fmt.Sprintf("%sID", strcase.ToLowerCamel(apiObject.TypeName))),
 					Return().Op("&").Id(fmt.Sprintf("payload%s", apiObject.TypeName)).Op(",").Nil(),
 				)
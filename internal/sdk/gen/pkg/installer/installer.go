@@ -522,7 +522,14 @@ GRANT ALL ON DATABASE %[1]s TO threeport;`, moduleDbName)).Op(",").Line(),
 			List(Id("clientCert"), Id("clientKey"), Err()).Op(":=").Qual(
 				"github.com/threeport/threeport/pkg/auth/v0",
 				"GenerateCertificate",
-			).Call(Id("x509CaCert"), Id("rsaCaKey"), Lit("localhost")),
+			).Call(
+				Id("x509CaCert"),
+				Id("rsaCaKey"),
+				Qual("github.com/threeport/threeport/pkg/api/v0", "AuthzSystemSubject").Call(Lit(fmt.Sprintf(
+					"%s-controller",
+					moduleNameKebab,
+				))),
+			),
 			If(Err().Op("!=").Nil()).Block(
 				Return(Qual("fmt", "Errorf").Call(Lit(fmt.Sprintf(
 					"failed to generate client cert and key for %s controller: %%w",
@@ -42,6 +42,21 @@ func (c *SecretDefinitionConfig) PushSecret() error {
 	return nil
 }
 
+// UpdateSecret updates the value of a secret in a secret store.
+func (c *SecretDefinitionConfig) UpdateSecret() error {
+
+	// update secret in secret store based
+	// on the secret definition's provider
+	switch {
+	case c.secretDefinition.AwsAccountID != nil:
+		if err := c.UpdateSecretInAwsSecretsManager(); err != nil {
+			return fmt.Errorf("failed to update secret in AWS Secrets Manager: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // DeleteSecret pushes a secret to a secret store.
 func (c *SecretDefinitionConfig) DeleteSecret() error {
 
@@ -225,6 +240,62 @@ func (c *SecretDefinitionConfig) PushSecretToAwsSecretsManager() error {
 	return nil
 }
 
+// UpdateSecretInAwsSecretsManager puts a new value for an existing secret in
+// AWS Secrets Manager.
+func (c *SecretDefinitionConfig) UpdateSecretInAwsSecretsManager() error {
+
+	// configure aws session
+	awsAccount, err := client.GetAwsAccountByID(
+		c.r.APIClient,
+		c.r.APIServer,
+		*c.secretDefinition.AwsAccountID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve AWS account by ID: %w", err)
+	}
+
+	// get aws config
+	awsConfig, err := kube.GetAwsConfigFromAwsAccount(c.r.EncryptionKey, *awsAccount.DefaultRegion, awsAccount)
+	if err != nil {
+		return fmt.Errorf("failed to get AWS config from AWS account: %w", err)
+	}
+
+	// Create a Secrets Manager awssmClient
+	awssmClient := secretsmanager.NewFromConfig(*awsConfig)
+
+	// get secret data
+	var secretData map[string]string
+	if c.secretDefinition.Data == nil {
+		return fmt.Errorf("secret data not found")
+	}
+	if err = json.Unmarshal([]byte(*c.secretDefinition.Data), &secretData); err != nil {
+		return fmt.Errorf("failed to unmarshal secret data")
+	}
+
+	// decrypt sensitive values
+	decryptedDataMap, err := encryption.DecryptStringMap(c.r.EncryptionKey, secretData)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt secret data: %w", err)
+	}
+
+	// Marshal the map into JSON format
+	jsonBytes, err := json.Marshal(decryptedDataMap)
+	if err != nil {
+		return fmt.Errorf("failed to marshal secret data: %w", err)
+	}
+
+	// put the new secret value
+	input := &secretsmanager.PutSecretValueInput{
+		SecretId:     c.secretDefinition.Name,
+		SecretString: util.Ptr(string(jsonBytes)),
+	}
+	if _, err = awssmClient.PutSecretValue(context.Background(), input); err != nil {
+		return fmt.Errorf("failed to put secret value: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteSecretFromAwsSecretsManager deletes a secret from AWS Secrets Manager.
 func (c *SecretDefinitionConfig) DeleteSecretFromAwsSecretsManager() error {
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client_v0 "github.com/threeport/threeport/pkg/client/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	event "github.com/threeport/threeport/pkg/event/v0"
@@ -34,6 +35,10 @@ func SecretDefinitionReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -54,310 +59,392 @@ func SecretDefinitionReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("secret definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var secretDefinition tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				secretDefinition = &api_v0.SecretDefinition{}
-			default:
-				log.Error(errors.New("received unrecognized version of secret definition object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("secret definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := secretDefinition.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("secret definition reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("secretDefinitionID", secretDefinition.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("secret definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(secretDefinition)
-			if locked || ok == false {
-				r.Requeue(secretDefinition, requeueDelay, msg)
-				log.V(1).Info("secret definition reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var secretDefinition tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					secretDefinition = &api_v0.SecretDefinition{}
+				default:
+					log.Error(errors.New("received unrecognized version of secret definition object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("secret definition reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of secret definition")
-					r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for secret definition, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := secretDefinition.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("secret definition reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("secretDefinitionID", secretDefinition.GetId())
+				r.TraceObject(msg, secretDefinition, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(secretDefinition); !ok {
-				r.Requeue(secretDefinition, requeueDelay, msg)
-				log.V(1).Info("secret definition reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(secretDefinition)
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if secretDefinition.ScheduledForDeletion() != nil {
-					log.Info("secret definition scheduled for deletion - skipping create")
-					break
+				// check for lock on object
+				locked, ok := r.CheckLock(secretDefinition)
+				if locked || ok == false {
+					r.Requeue(secretDefinition, requeueDelay, msg)
+					log.V(1).Info("secret definition reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch secretDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0SecretDefinitionCreated(
-						r,
-						secretDefinition.(*api_v0.SecretDefinition),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of secret definition encountered for creation")
+
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of secret definition")
+						r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for secret definition, closing out signal handler")
+					}
+				}()
+
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(secretDefinition); !ok {
+					r.Requeue(secretDefinition, requeueDelay, msg)
+					log.V(1).Info("secret definition reconciliation requeued")
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created secret definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if secretDefinition.ScheduledForDeletion() != nil {
+						log.Info("secret definition scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch secretDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0SecretDefinitionCreated(
+							r,
+							secretDefinition.(*api_v0.SecretDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of secret definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created secret definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							secretDefinition.GetId(),
+							secretDefinition.GetVersion(),
+							secretDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							secretDefinition,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedSecretDefinition := api_v0.SecretDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateSecretDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedSecretDefinition,
+							); err != nil {
+								log.Error(err, "failed to update secret definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							secretDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							secretDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated, notifications.NotificationOperationResync:
+					var operationErr error
+					var customRequeueDelay int64
+					switch secretDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0SecretDefinitionUpdated(
+							r,
+							secretDefinition.(*api_v0.SecretDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of secret definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated secret definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							secretDefinition.GetId(),
+							secretDefinition.GetVersion(),
+							secretDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							secretDefinition,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedSecretDefinition := api_v0.SecretDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateSecretDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedSecretDefinition,
+							); err != nil {
+								log.Error(err, "failed to update secret definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							secretDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							secretDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch secretDefinition.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0SecretDefinitionDeleted(
+							r,
+							secretDefinition.(*api_v0.SecretDefinition),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of secret definition encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted secret definition object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							secretDefinition.GetId(),
+							secretDefinition.GetVersion(),
+							secretDefinition.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							secretDefinition,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedSecretDefinition := api_v0.SecretDefinition{
+								Common:         api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateSecretDefinition(
+								r.APIClient,
+								r.APIServer,
+								&failedSecretDefinition,
+							); err != nil {
+								log.Error(err, "failed to update secret definition conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							secretDefinition,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							secretDefinition,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedSecretDefinition := api_v0.SecretDefinition{
+						Common: api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
-						secretDefinition.GetId(),
-						secretDefinition.GetVersion(),
-						secretDefinition.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						secretDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					_, err = client_v0.UpdateSecretDefinition(
+						r.APIClient,
+						r.APIServer,
+						&deletedSecretDefinition,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						secretDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch secretDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0SecretDefinitionUpdated(
-						r,
-						secretDefinition.(*api_v0.SecretDefinition),
-						&log,
+					if err != nil {
+						log.Error(err, "failed to update secret definition to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteSecretDefinition(
+						r.APIClient,
+						r.APIServer,
+						secretDefinition.GetId(),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
+					if err != nil {
+						log.Error(err, "failed to delete secret definition")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
 				default:
-					operationErr = errors.New("unrecognized version of secret definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated secret definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
-						secretDefinition.GetId(),
-						secretDefinition.GetVersion(),
-						secretDefinition.GetType(),
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						secretDefinition,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						secretDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch secretDefinition.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0SecretDefinitionDeleted(
-						r,
-						secretDefinition.(*api_v0.SecretDefinition),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of secret definition encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted secret definition object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledSecretDefinition := api_v0.SecretDefinition{
+						Common: api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						secretDefinition.GetId(),
-						secretDefinition.GetVersion(),
-						secretDefinition.GetType(),
-						operationErr,
-						&log,
+					}
+					updatedSecretDefinition, err := client_v0.UpdateSecretDefinition(
+						r.APIClient,
+						r.APIServer,
+						&reconciledSecretDefinition,
 					)
-					r.UnlockAndRequeue(
-						secretDefinition,
-						requeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update secret definition to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"secret definition marked as reconciled in API",
+						"secret definitionName", updatedSecretDefinition.Name,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						secretDefinition,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedSecretDefinition := api_v0.SecretDefinition{
-					Common: api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
-				}
-				_, err = client_v0.UpdateSecretDefinition(
-					r.APIClient,
-					r.APIServer,
-					&deletedSecretDefinition,
-				)
-				if err != nil {
-					log.Error(err, "failed to update secret definition to mark as deleted")
-					r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
-					continue
 				}
-				_, err = client_v0.DeleteSecretDefinition(
-					r.APIClient,
-					r.APIServer,
-					secretDefinition.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete secret definition")
-					r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(secretDefinition, lockReleased, msg, true); !ok {
+					log.Error(errors.New("secret definition remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("secret definition unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					secretDefinition,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledSecretDefinition := api_v0.SecretDefinition{
-					Common:         api_v0.Common{ID: util.Ptr(secretDefinition.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("secret definition resynced")
+					return
 				}
-				updatedSecretDefinition, err := client_v0.UpdateSecretDefinition(
-					r.APIClient,
-					r.APIServer,
-					&reconciledSecretDefinition,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"secret definition successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update secret definition to mark as reconciled")
-					r.UnlockAndRequeue(secretDefinition, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					secretDefinition.GetId(),
+					secretDefinition.GetVersion(),
+					secretDefinition.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful secret definition reconciliation")
 				}
-				log.V(1).Info(
-					"secret definition marked as reconciled in API",
-					"secret definitionName", updatedSecretDefinition.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(secretDefinition, lockReleased, msg, true); !ok {
-				log.Error(errors.New("secret definition remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("secret definition unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"secret definition successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				secretDefinition.GetId(),
-				secretDefinition.GetVersion(),
-				secretDefinition.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful secret definition reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "secretLock"
 	LockBucketDescr = "contains locks on secret objects"
 )
+
+// The names of the NATS bucket used to track secret controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "secretMembers"
+	ShardStreamName  = "secretShard"
+)
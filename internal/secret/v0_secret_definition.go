@@ -40,6 +40,18 @@ func v0SecretDefinitionUpdated(
 	secretDefinition *v0.SecretDefinition,
 	log *logr.Logger,
 ) (int64, error) {
+	// configure secret definition config
+	secretDefinitionConfig := &SecretDefinitionConfig{
+		r:                r,
+		secretDefinition: secretDefinition,
+		log:              log,
+	}
+
+	// update secret
+	if err := secretDefinitionConfig.UpdateSecret(); err != nil {
+		return 0, fmt.Errorf("failed to update secret: %w", err)
+	}
+
 	return 0, nil
 }
 
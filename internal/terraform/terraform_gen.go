@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "terraformLock"
 	LockBucketDescr = "contains locks on terraform objects"
 )
+
+// The names of the NATS bucket used to track terraform controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "terraformMembers"
+	ShardStreamName  = "terraformShard"
+)
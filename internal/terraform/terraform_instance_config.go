@@ -34,6 +34,7 @@ func (c *TerraformInstanceConfig) getTerraformInstanceOperations() *util.Operati
 	operations.AppendOperation(util.Operation{
 		Name:   "terraformInstance",
 		Create: c.createTerraformInstance,
+		Update: c.updateTerraformInstance,
 		Delete: c.deleteTerraformInstance,
 	})
 
@@ -108,6 +109,21 @@ func (c *TerraformInstanceConfig) createTerraformInstance() error {
 	return nil
 }
 
+// updateTerraformInstance writes the existing terraform state to disk and
+// runs the 'terraform apply' command to bring terraform-defined resources in
+// line with the current definition and vars.
+func (c *TerraformInstanceConfig) updateTerraformInstance() error {
+	// write terraform state file if one exists for the instance
+	if c.terraformInstance.StateDocument != nil {
+		tfStateFilepath := fmt.Sprintf("%s/terraform.tfstate", c.tfDirName)
+		if err := os.WriteFile(tfStateFilepath, []byte(*c.terraformInstance.StateDocument), 0644); err != nil {
+			return fmt.Errorf("failed to write terraform state to file: %w", err)
+		}
+	}
+
+	return c.createTerraformInstance()
+}
+
 // deleteTerraformInstance deletes the terraform resources recoreded in the
 // terraform state file with the 'terraform destroy' command.
 func (c *TerraformInstanceConfig) deleteTerraformInstance() error {
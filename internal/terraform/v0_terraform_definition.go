@@ -3,8 +3,11 @@
 package terraform
 
 import (
+	"fmt"
+
 	logr "github.com/go-logr/logr"
 	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 )
 
@@ -25,6 +28,45 @@ func v0TerraformDefinitionUpdated(
 	terraformDefinition *v0.TerraformDefinition,
 	log *logr.Logger,
 ) (int64, error) {
+	// a definition that is already reconciled has no changes to apply
+	if terraformDefinition.Reconciled != nil && *terraformDefinition.Reconciled {
+		return 0, nil
+	}
+
+	// get terraform instances - filtered here rather than with a query string
+	// because the definition ID query tag on terraform instances does not
+	// match the field name
+	terraformInstances, err := client.GetTerraformInstances(r.APIClient, r.APIServer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get terraform instances: %w", err)
+	}
+
+	// trigger a reconciliation of each terraform instance derived from this
+	// definition so the updated terraform config is applied
+	for _, terraformInstance := range *terraformInstances {
+		if terraformInstance.TerraformDefinitionID == nil ||
+			*terraformInstance.TerraformDefinitionID != *terraformDefinition.ID {
+			continue
+		}
+		reconciled := false
+		update := v0.TerraformInstance{
+			Common: v0.Common{
+				ID:              terraformInstance.ID,
+				ResourceVersion: terraformInstance.ResourceVersion,
+			},
+			Reconciliation: v0.Reconciliation{
+				Reconciled: &reconciled,
+			},
+		}
+		if _, err := client.UpdateTerraformInstance(r.APIClient, r.APIServer, &update); err != nil {
+			return 0, fmt.Errorf("failed to update terraform instance with ID %d: %w", *terraformInstance.ID, err)
+		}
+		log.V(1).Info(
+			"terraform instance marked for update",
+			"terraformInstanceID", terraformInstance.ID,
+		)
+	}
+
 	return 0, nil
 }
 
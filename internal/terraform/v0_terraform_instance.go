@@ -77,6 +77,53 @@ func v0TerraformInstanceUpdated(
 	terraformInstance *v0.TerraformInstance,
 	log *logr.Logger,
 ) (int64, error) {
+	// an instance that is already reconciled has no changes to apply
+	if terraformInstance.Reconciled != nil && *terraformInstance.Reconciled {
+		return 0, nil
+	}
+
+	// set up terraform
+	tfDirName, awsConfig, err := setupTerraform(
+		r,
+		terraformInstance,
+		log,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set up terraform: %w", err)
+	}
+
+	c := &TerraformInstanceConfig{
+		r:                 r,
+		terraformInstance: terraformInstance,
+		log:               log,
+		awsConfig:         awsConfig,
+		tfDirName:         tfDirName,
+	}
+
+	// execute terraform instance update
+	if err := c.getTerraformInstanceOperations().Update(); err != nil {
+		return 0, fmt.Errorf("failed to execute terraform instance update operations: %w", err)
+	}
+
+	// update the terraform instance
+	terraformInstance.Reconciled = util.Ptr(true)
+	terraformInstance.StateDocument = &c.tfState
+	terraformInstance.Outputs = &c.tfOutput
+	if _, err := client.UpdateTerraformInstance(
+		r.APIClient,
+		r.APIServer,
+		terraformInstance,
+	); err != nil {
+		return 0, fmt.Errorf("failed to update terraform instance: %w", err)
+	}
+
+	// clean up local files
+	if err := os.RemoveAll(c.tfDirName); err != nil {
+		// logging err but not returning it as it is non-critical and we do not
+		// want to re-queue reconciliation
+		log.Error(err, "failed to remove terraform files written to disk")
+	}
+
 	return 0, nil
 }
 
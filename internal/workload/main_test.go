@@ -0,0 +1,50 @@
+package workload
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	controllertest "github.com/threeport/threeport/pkg/controller/v0/controllertest"
+)
+
+// testEnv is the in-process control plane shared by the tests in this
+// package.  It is nil if the environment could not be started, e.g. when the
+// CockroachDB binary can't be downloaded, and testEnvErr holds the reason.
+var (
+	testEnv    *controllertest.Environment
+	testEnvErr error
+)
+
+// TestMain starts a test environment for the package's tests.  A database
+// may be provided with the THREEPORT_TEST_DATABASE_URL environment variable
+// rather than starting a throwaway CockroachDB server.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Short() {
+		testEnv, testEnvErr = controllertest.Start(controllertest.Config{
+			DatabaseURL: os.Getenv("THREEPORT_TEST_DATABASE_URL"),
+		})
+	}
+
+	code := m.Run()
+
+	if testEnv != nil {
+		testEnv.Stop()
+	}
+	os.Exit(code)
+}
+
+// environment returns the shared test environment or skips the test if it
+// isn't available.
+func environment(t *testing.T) *controllertest.Environment {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping test that requires a test environment in short mode")
+	}
+	if testEnv == nil {
+		t.Skipf("test environment could not be started: %v", testEnvErr)
+	}
+
+	return testEnv
+}
@@ -5,13 +5,17 @@ package workload
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"time"
 
 	logr "github.com/go-logr/logr"
 	v0 "github.com/threeport/threeport/pkg/api/v0"
 	client "github.com/threeport/threeport/pkg/client/v0"
 	controller "github.com/threeport/threeport/pkg/controller/v0"
 	kube "github.com/threeport/threeport/pkg/kube/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
 	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // v0WorkloadDefinitionCreated performs reconciliation when a v0 workload definition
@@ -71,6 +75,148 @@ func v0WorkloadDefinitionUpdated(
 	workloadDefinition *v0.WorkloadDefinition,
 	log *logr.Logger,
 ) (int64, error) {
+	// parse YAMLDocument and get kube objects in JSON
+	jsonObjects, err := kube.GetJsonResourcesFromYamlDoc(*workloadDefinition.YAMLDocument)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get JSON kube objects from YAML document: %w", err)
+	}
+
+	// get existing workload resource definitions
+	existingWRDs, err := client.GetWorkloadResourceDefinitionsByWorkloadDefinitionID(
+		r.APIClient,
+		r.APIServer,
+		*workloadDefinition.ID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workload resource definitions by workload definition ID: %w", err)
+	}
+
+	// index the existing and updated resources by kind and name
+	existingResources := make(map[string]datatypes.JSON)
+	for _, wrd := range *existingWRDs {
+		key, err := workloadResourceKey(*wrd.JSONDefinition)
+		if err != nil {
+			return 0, fmt.Errorf("failed to identify workload resource definition with ID %d: %w", *wrd.ID, err)
+		}
+		existingResources[key] = *wrd.JSONDefinition
+	}
+	var updatedResourceKeys []string
+	updatedResources := make(map[string]datatypes.JSON)
+	for _, jsonContent := range jsonObjects {
+		var jsonDefinition datatypes.JSON
+		if err := jsonDefinition.UnmarshalJSON(jsonContent); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal json to datatypes.JSON: %w", err)
+		}
+		key, err := workloadResourceKey(jsonDefinition)
+		if err != nil {
+			return 0, fmt.Errorf("failed to identify resource in YAML document: %w", err)
+		}
+		if _, exists := updatedResources[key]; exists {
+			return 0, fmt.Errorf("resource %s included more than once in YAML document", key)
+		}
+		updatedResourceKeys = append(updatedResourceKeys, key)
+		updatedResources[key] = jsonDefinition
+	}
+
+	// if the resources have not changed there is nothing to reconcile
+	resourcesChanged, err := workloadResourcesChanged(existingResources, updatedResources)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compare workload resource definitions: %w", err)
+	}
+	if !resourcesChanged {
+		return 0, nil
+	}
+
+	// update the resources of each workload instance derived from this
+	// definition and trigger reconciliation of the workload instance.  This
+	// is done before the workload resource definitions are replaced so that,
+	// if reconciliation fails part way, the previous workload resource
+	// definitions remain to be compared against when it is retried.
+	workloadInstances, err := client.GetWorkloadInstancesByWorkloadDefinitionID(
+		r.APIClient,
+		r.APIServer,
+		*workloadDefinition.ID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workload instances by workload definition ID: %w", err)
+	}
+	for _, workloadInstance := range *workloadInstances {
+		if err := updateWorkloadInstanceResources(
+			r,
+			&workloadInstance,
+			existingResources,
+			updatedResourceKeys,
+			updatedResources,
+		); err != nil {
+			return 0, fmt.Errorf("failed to update resources for workload instance with ID %d: %w", *workloadInstance.ID, err)
+		}
+		log.V(1).Info(
+			"workload instance resources updated",
+			"workloadInstanceID", workloadInstance.ID,
+		)
+	}
+
+	// replace the workload resource definitions that have changed
+	for _, wrd := range *existingWRDs {
+		key, _ := workloadResourceKey(*wrd.JSONDefinition)
+		jsonDefinition, defined := updatedResources[key]
+		if !defined {
+			if _, err := client.DeleteWorkloadResourceDefinition(r.APIClient, r.APIServer, *wrd.ID); err != nil {
+				return 0, fmt.Errorf("failed to delete workload resource definition with ID %d: %w", *wrd.ID, err)
+			}
+			log.V(1).Info(
+				"workload resource definition deleted",
+				"workloadResourceDefinitionID", wrd.ID,
+			)
+			continue
+		}
+		changed, err := workloadResourcesChanged(
+			map[string]datatypes.JSON{key: *wrd.JSONDefinition},
+			map[string]datatypes.JSON{key: jsonDefinition},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to compare workload resource definition with ID %d: %w", *wrd.ID, err)
+		}
+		if !changed {
+			continue
+		}
+		wrd.JSONDefinition = &jsonDefinition
+		if _, err := client.UpdateWorkloadResourceDefinition(r.APIClient, r.APIServer, &wrd); err != nil {
+			return 0, fmt.Errorf("failed to update workload resource definition with ID %d: %w", *wrd.ID, err)
+		}
+		log.V(1).Info(
+			"workload resource definition updated",
+			"workloadResourceDefinitionID", wrd.ID,
+		)
+	}
+	var workloadResourceDefinitions []v0.WorkloadResourceDefinition
+	for _, key := range updatedResourceKeys {
+		if _, exists := existingResources[key]; exists {
+			continue
+		}
+		jsonDefinition := updatedResources[key]
+		workloadResourceDefinitions = append(workloadResourceDefinitions, v0.WorkloadResourceDefinition{
+			JSONDefinition:       &jsonDefinition,
+			WorkloadDefinitionID: workloadDefinition.ID,
+		})
+	}
+	if len(workloadResourceDefinitions) > 0 {
+		createdWRDs, err := client.CreateWorkloadResourceDefinitions(
+			r.APIClient,
+			r.APIServer,
+			&workloadResourceDefinitions,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create workload resource definitions in API: %w", err)
+		}
+		for _, wrd := range *createdWRDs {
+			log.V(1).Info(
+				"workload resource definition created",
+				"workloadResourceDefinitionID", wrd.ID,
+			)
+		}
+	}
+
 	return 0, nil
 }
 
@@ -116,3 +262,150 @@ func v0WorkloadDefinitionDeleted(
 
 	return 0, nil
 }
+
+// updateWorkloadInstanceResources updates the workload resource instances of a
+// workload instance that were derived from the previous or updated workload
+// resource definitions so they match the updated definitions.  Resources no
+// longer defined are scheduled for deletion, new resources are added and
+// resource instances not derived from the workload definition, such as the
+// managed namespace and those added by other controllers, are left unchanged.
+// Resource instances that already match are not changed so that it may be
+// called again if a previous call failed part way.  The workload instance is
+// then marked as not reconciled so the workload controller applies the
+// changes.
+func updateWorkloadInstanceResources(
+	r *controller.Reconciler,
+	workloadInstance *v0.WorkloadInstance,
+	existingResources map[string]datatypes.JSON,
+	updatedResourceKeys []string,
+	updatedResources map[string]datatypes.JSON,
+) error {
+	workloadResourceInstances, err := client.GetWorkloadResourceInstancesByWorkloadInstanceID(
+		r.APIClient,
+		r.APIServer,
+		*workloadInstance.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get workload resource instances by workload instance ID: %w", err)
+	}
+
+	// update or schedule deletion of existing resource instances
+	reconciled := false
+	updatedKeys := make(map[string]bool)
+	for _, wri := range *workloadResourceInstances {
+		key, err := workloadResourceKey(*wri.JSONDefinition)
+		if err != nil {
+			return fmt.Errorf("failed to identify workload resource instance with ID %d: %w", *wri.ID, err)
+		}
+		_, previouslyDefined := existingResources[key]
+		jsonDefinition, defined := updatedResources[key]
+		if (!previouslyDefined && !defined) || updatedKeys[key] || wri.ScheduledForDeletion != nil {
+			continue
+		}
+
+		if defined {
+			updatedKeys[key] = true
+			matches, err := workloadResourceInstanceMatches(*wri.JSONDefinition, jsonDefinition)
+			if err != nil {
+				return fmt.Errorf("failed to compare workload resource instance with ID %d: %w", *wri.ID, err)
+			}
+			if matches {
+				continue
+			}
+			wri.JSONDefinition = &jsonDefinition
+		} else {
+			scheduledForDeletion := time.Now().UTC()
+			wri.ScheduledForDeletion = &scheduledForDeletion
+		}
+		wri.Reconciled = &reconciled
+		if _, err := client.UpdateWorkloadResourceInstance(r.APIClient, r.APIServer, &wri); err != nil {
+			return fmt.Errorf("failed to update workload resource instance with ID %d: %w", *wri.ID, err)
+		}
+	}
+
+	// add resource instances for new resources
+	for _, key := range updatedResourceKeys {
+		if updatedKeys[key] {
+			continue
+		}
+		jsonDefinition := updatedResources[key]
+		wri := v0.WorkloadResourceInstance{
+			JSONDefinition:     &jsonDefinition,
+			WorkloadInstanceID: workloadInstance.ID,
+			Reconciled:         &reconciled,
+		}
+		if _, err := client.CreateWorkloadResourceInstance(r.APIClient, r.APIServer, &wri); err != nil {
+			return fmt.Errorf("failed to create workload resource instance for resource %s: %w", key, err)
+		}
+	}
+
+	// trigger a reconciliation of the workload instance
+	workloadInstance.Reconciled = &reconciled
+	if _, err := client.UpdateWorkloadInstance(r.APIClient, r.APIServer, workloadInstance); err != nil {
+		return fmt.Errorf("failed to update workload instance: %w", err)
+	}
+
+	return nil
+}
+
+// workloadResourceKey returns the kind and name that identify a Kubernetes
+// resource in a workload.  The namespace is not included as it is assigned
+// to resource instances by threeport.
+func workloadResourceKey(jsonDefinition datatypes.JSON) (string, error) {
+	kubeObject := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := kubeObject.UnmarshalJSON(jsonDefinition); err != nil {
+		return "", fmt.Errorf("failed to unmarshal json to kubernetes unstructured object: %w", err)
+	}
+
+	return fmt.Sprintf("%s/%s", kubeObject.GetKind(), kubeObject.GetName()), nil
+}
+
+// workloadResourceInstanceMatches returns true if a workload resource
+// instance matches a resource definition.  A namespace assigned to the
+// resource instance by threeport is ignored if the definition doesn't set one.
+func workloadResourceInstanceMatches(instanceJSON, definitionJSON datatypes.JSON) (bool, error) {
+	instanceObject := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := instanceObject.UnmarshalJSON(instanceJSON); err != nil {
+		return false, fmt.Errorf("failed to unmarshal json to kubernetes unstructured object: %w", err)
+	}
+	definitionObject := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if err := definitionObject.UnmarshalJSON(definitionJSON); err != nil {
+		return false, fmt.Errorf("failed to unmarshal json to kubernetes unstructured object: %w", err)
+	}
+	if definitionObject.GetNamespace() == "" {
+		instanceObject.SetNamespace("")
+	}
+
+	return reflect.DeepEqual(instanceObject.Object, definitionObject.Object), nil
+}
+
+// workloadResourcesChanged returns true if the existing and updated
+// resources differ.
+func workloadResourcesChanged(
+	existingResources map[string]datatypes.JSON,
+	updatedResources map[string]datatypes.JSON,
+) (bool, error) {
+	if len(existingResources) != len(updatedResources) {
+		return true, nil
+	}
+
+	for key, updatedJSON := range updatedResources {
+		existingJSON, exists := existingResources[key]
+		if !exists {
+			return true, nil
+		}
+		existingDef, err := util.UnmarshalJSON(existingJSON)
+		if err != nil {
+			return false, err
+		}
+		updatedDef, err := util.UnmarshalJSON(updatedJSON)
+		if err != nil {
+			return false, err
+		}
+		if !reflect.DeepEqual(existingDef, updatedDef) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,267 @@
+package workload
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/threeport/threeport/internal/workload/notif"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
+	controller "github.com/threeport/threeport/pkg/controller/v0"
+	controllertest "github.com/threeport/threeport/pkg/controller/v0/controllertest"
+	kube "github.com/threeport/threeport/pkg/kube/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// TestWorkloadDefinitionCreated tests that a workload resource definition is
+// created for each resource in a workload definition's YAML document.
+func TestWorkloadDefinitionCreated(t *testing.T) {
+	env := environment(t)
+	r, err := env.Reconciler(controller.ReconcilerConfig{
+		Name:         "TestWorkloadDefinitionCreatedReconciler",
+		NotifSubject: notif.WorkloadDefinitionSubject,
+	})
+	require.NoError(t, err)
+
+	workloadDefinition := createTestWorkloadDefinition(
+		t,
+		env,
+		"created",
+		testConfigMap("created-a", "1")+"---\n"+testConfigMap("created-b", "1"),
+	)
+
+	_, err = v0WorkloadDefinitionCreated(r, workloadDefinition, r.Log)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"ConfigMap/created-a": "1",
+		"ConfigMap/created-b": "1",
+	}, testWorkloadResourceDefinitions(t, env, *workloadDefinition.ID))
+}
+
+// TestWorkloadDefinitionUpdated tests that updating a workload definition
+// updates its workload resource definitions and the resource instances of its
+// workload instances, and that the update can be retried after a previous
+// attempt failed once the workload instances were updated.
+func TestWorkloadDefinitionUpdated(t *testing.T) {
+	env := environment(t)
+	r, err := env.Reconciler(controller.ReconcilerConfig{
+		Name:         "TestWorkloadDefinitionUpdatedReconciler",
+		NotifSubject: notif.WorkloadDefinitionSubject,
+	})
+	require.NoError(t, err)
+
+	// create the workload definition and an instance with the resource
+	// instances the workload instance reconciler creates for it
+	workloadDefinition := createTestWorkloadDefinition(
+		t,
+		env,
+		"updated",
+		testConfigMap("updated-a", "1")+"---\n"+testConfigMap("updated-b", "1"),
+	)
+	_, err = v0WorkloadDefinitionCreated(r, workloadDefinition, r.Log)
+	require.NoError(t, err)
+
+	kubernetesRuntimeInstance := createTestKubernetesRuntimeInstance(t, env, "updated")
+	workloadInstance, err := client.CreateWorkloadInstance(env.APIClient, env.APIServer, &v0.WorkloadInstance{
+		Instance:                    v0.Instance{Name: util.Ptr("updated")},
+		KubernetesRuntimeInstanceID: kubernetesRuntimeInstance.ID,
+		WorkloadDefinitionID:        workloadDefinition.ID,
+	})
+	require.NoError(t, err)
+
+	workloadResourceDefinitions, err := client.GetWorkloadResourceDefinitionsByWorkloadDefinitionID(
+		env.APIClient,
+		env.APIServer,
+		*workloadDefinition.ID,
+	)
+	require.NoError(t, err)
+	existingResources := make(map[string]datatypes.JSON)
+	for _, wrd := range *workloadResourceDefinitions {
+		key, err := workloadResourceKey(*wrd.JSONDefinition)
+		require.NoError(t, err)
+		existingResources[key] = *wrd.JSONDefinition
+
+		namespaced, err := util.UpdateNamespace(*wrd.JSONDefinition, "updated-ns")
+		require.NoError(t, err)
+		jsonDefinition := datatypes.JSON(namespaced)
+		_, err = client.CreateWorkloadResourceInstance(env.APIClient, env.APIServer, &v0.WorkloadResourceInstance{
+			JSONDefinition:     &jsonDefinition,
+			WorkloadInstanceID: workloadInstance.ID,
+			Reconciled:         util.Ptr(true),
+		})
+		require.NoError(t, err)
+	}
+
+	// update the definition to change one resource, remove one and add one
+	updatedYAML := testConfigMap("updated-a", "2") + "---\n" + testConfigMap("updated-c", "1")
+	workloadDefinition.YAMLDocument = &updatedYAML
+	_, err = client.UpdateWorkloadDefinition(env.APIClient, env.APIServer, &v0.WorkloadDefinition{
+		Common:       v0.Common{ID: workloadDefinition.ID},
+		YAMLDocument: &updatedYAML,
+	})
+	require.NoError(t, err)
+
+	// a previous attempt updated the workload instance then failed before
+	// the workload resource definitions were replaced
+	jsonObjects, err := kube.GetJsonResourcesFromYamlDoc(updatedYAML)
+	require.NoError(t, err)
+	var updatedResourceKeys []string
+	updatedResources := make(map[string]datatypes.JSON)
+	for _, jsonObject := range jsonObjects {
+		key, err := workloadResourceKey(jsonObject)
+		require.NoError(t, err)
+		updatedResourceKeys = append(updatedResourceKeys, key)
+		updatedResources[key] = jsonObject
+	}
+	require.NoError(t, updateWorkloadInstanceResources(
+		r,
+		workloadInstance,
+		existingResources,
+		updatedResourceKeys,
+		updatedResources,
+	))
+
+	// the retry completes the update without duplicating resource instances
+	_, err = v0WorkloadDefinitionUpdated(r, workloadDefinition, r.Log)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"ConfigMap/updated-a": "2",
+		"ConfigMap/updated-c": "1",
+	}, testWorkloadResourceDefinitions(t, env, *workloadDefinition.ID))
+
+	workloadResourceInstances, err := client.GetWorkloadResourceInstancesByWorkloadInstanceID(
+		env.APIClient,
+		env.APIServer,
+		*workloadInstance.ID,
+	)
+	require.NoError(t, err)
+	instanceValues := make(map[string][]string)
+	for _, wri := range *workloadResourceInstances {
+		key, err := workloadResourceKey(*wri.JSONDefinition)
+		require.NoError(t, err)
+		value := testConfigMapValue(t, *wri.JSONDefinition)
+		if wri.ScheduledForDeletion != nil {
+			value = "deleted"
+		}
+		instanceValues[key] = append(instanceValues[key], value)
+	}
+	assert.Equal(t, map[string][]string{
+		"ConfigMap/updated-a": {"2"},
+		"ConfigMap/updated-b": {"deleted"},
+		"ConfigMap/updated-c": {"1"},
+	}, instanceValues)
+
+	updatedInstance, err := client.GetWorkloadInstanceByID(env.APIClient, env.APIServer, *workloadInstance.ID)
+	require.NoError(t, err)
+	require.NotNil(t, updatedInstance.Reconciled)
+	assert.False(t, *updatedInstance.Reconciled, "workload instance should be reconciled again")
+}
+
+// createTestWorkloadDefinition creates a workload definition with the
+// provided YAML document.
+func createTestWorkloadDefinition(
+	t *testing.T,
+	env *controllertest.Environment,
+	name string,
+	yamlDocument string,
+) *v0.WorkloadDefinition {
+	t.Helper()
+
+	workloadDefinition, err := client.CreateWorkloadDefinition(env.APIClient, env.APIServer, &v0.WorkloadDefinition{
+		Definition:   v0.Definition{Name: &name},
+		YAMLDocument: &yamlDocument,
+	})
+	require.NoError(t, err)
+
+	return workloadDefinition
+}
+
+// createTestKubernetesRuntimeInstance creates a kubernetes runtime definition
+// and instance for workload instances to refer to.
+func createTestKubernetesRuntimeInstance(
+	t *testing.T,
+	env *controllertest.Environment,
+	name string,
+) *v0.KubernetesRuntimeInstance {
+	t.Helper()
+
+	kubernetesRuntimeDefinition, err := client.CreateKubernetesRuntimeDefinition(
+		env.APIClient,
+		env.APIServer,
+		&v0.KubernetesRuntimeDefinition{
+			Definition:    v0.Definition{Name: &name},
+			InfraProvider: util.Ptr(v0.KubernetesRuntimeInfraProviderKind),
+		},
+	)
+	require.NoError(t, err)
+
+	kubernetesRuntimeInstance, err := client.CreateKubernetesRuntimeInstance(
+		env.APIClient,
+		env.APIServer,
+		&v0.KubernetesRuntimeInstance{
+			Instance:                      v0.Instance{Name: &name},
+			Location:                      util.Ptr("Local"),
+			KubernetesRuntimeDefinitionID: kubernetesRuntimeDefinition.ID,
+		},
+	)
+	require.NoError(t, err)
+
+	return kubernetesRuntimeInstance
+}
+
+// testWorkloadResourceDefinitions returns the value of the config map for
+// each of a workload definition's workload resource definitions.
+func testWorkloadResourceDefinitions(
+	t *testing.T,
+	env *controllertest.Environment,
+	workloadDefinitionID uint,
+) map[string]string {
+	t.Helper()
+
+	workloadResourceDefinitions, err := client.GetWorkloadResourceDefinitionsByWorkloadDefinitionID(
+		env.APIClient,
+		env.APIServer,
+		workloadDefinitionID,
+	)
+	require.NoError(t, err)
+
+	values := make(map[string]string)
+	for _, wrd := range *workloadResourceDefinitions {
+		key, err := workloadResourceKey(*wrd.JSONDefinition)
+		require.NoError(t, err)
+		values[key] = testConfigMapValue(t, *wrd.JSONDefinition)
+	}
+
+	return values
+}
+
+// testConfigMap returns the manifest for a config map with a single value.
+func testConfigMap(name, value string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+data:
+  value: "%s"
+`, name, value)
+}
+
+// testConfigMapValue returns the value of a config map created with
+// testConfigMap.
+func testConfigMapValue(t *testing.T, jsonDefinition datatypes.JSON) string {
+	t.Helper()
+
+	kubeObject := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	require.NoError(t, kubeObject.UnmarshalJSON(jsonDefinition))
+	value, _, err := unstructured.NestedString(kubeObject.Object, "data", "value")
+	require.NoError(t, err)
+
+	return value
+}
@@ -541,6 +541,122 @@ func v0WorkloadInstanceDeleted(
 	return 0, nil
 }
 
+// v0WorkloadInstanceDriftCheck checks the Kubernetes resources for a v0
+// WorkloadInstance for drift from the desired state when it is resynced.
+// Resources that have been changed or removed are re-applied.
+func v0WorkloadInstanceDriftCheck(
+	r *controller.Reconciler,
+	workloadInstance *v0.WorkloadInstance,
+	log *logr.Logger,
+) (*controller.Drift, error) {
+	// get workload resource instances
+	workloadResourceInstances, err := client.GetWorkloadResourceInstancesByWorkloadInstanceID(
+		r.APIClient,
+		r.APIServer,
+		*workloadInstance.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload resource instances by workload instance ID: %w", err)
+	}
+	if len(*workloadResourceInstances) == 0 {
+		return nil, nil
+	}
+
+	// get workload definition for this instance
+	workloadDefinition, err := client.GetWorkloadDefinitionByID(
+		r.APIClient,
+		r.APIServer,
+		*workloadInstance.WorkloadDefinitionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload definition for the instance being resynced: %w", err)
+	}
+
+	// get kubernetes runtime instance info
+	kubernetesRuntimeInstance, err := client.GetKubernetesRuntimeInstanceByID(
+		r.APIClient,
+		r.APIServer,
+		*workloadInstance.KubernetesRuntimeInstanceID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload kubernetes runtime instance by ID: %w", err)
+	}
+
+	// create a client to connect to kube API
+	dynamicKubeClient, mapper, err := kube.GetClient(
+		kubernetesRuntimeInstance,
+		true,
+		r.APIClient,
+		r.APIServer,
+		r.EncryptionKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube API client object: %w", err)
+	}
+
+	drift := controller.Drift{}
+	for _, wri := range *workloadResourceInstances {
+		// resource instances that are being changed will be reconciled by an
+		// update
+		if wri.Reconciled == nil || !*wri.Reconciled || wri.ScheduledForDeletion != nil {
+			continue
+		}
+
+		// marshal the resource instance json
+		jsonDefinition, err := wri.JSONDefinition.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal json for workload resource instance with ID %d: %w", wri.ID, err)
+		}
+
+		// build kube unstructured object from json
+		kubeObject := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		if err := kubeObject.UnmarshalJSON(jsonDefinition); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal json to kubernetes unstructured object workload resource instance with ID %d: %w", wri.ID, err)
+		}
+
+		// the labels added when the resource was created are part of the
+		// desired state
+		kubeObject, err = kube.AddLabels(
+			kubeObject,
+			*workloadDefinition.Name,
+			*workloadInstance.Name,
+			*workloadInstance.ID,
+			agent.WorkloadInstanceLabelKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add label metadata to objects: %w", err)
+		}
+
+		missing, fields, err := kube.GetResourceDrift(
+			kubeObject,
+			*workloadInstance.Name,
+			dynamicKubeClient,
+			*mapper,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check drift of Kubernetes resource for workload resource instance with ID %d: %w", wri.ID, err)
+		}
+		if !missing && len(fields) == 0 {
+			continue
+		}
+		log.V(1).Info(
+			"workload resource instance drifted",
+			"workloadResourceInstanceID", wri.ID,
+			"missing", missing,
+			"fields", fields,
+		)
+		drift.Add(kube.DriftedResourceName(kubeObject))
+
+		// restore the desired state
+		if _, err := kube.CreateOrUpdateResource(kubeObject, dynamicKubeClient, *mapper); err != nil {
+			return &drift, fmt.Errorf("failed to correct drift of Kubernetes resource for workload resource instance with ID %d: %w", wri.ID, err)
+		}
+	}
+	drift.Corrected = drift.Detected()
+
+	return &drift, nil
+}
+
 // confirmWorkloadDefReconciled confirms the workload definition related to a
 // workload instance is reconciled.
 func confirmWorkloadDefReconciled(
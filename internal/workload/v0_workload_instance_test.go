@@ -0,0 +1,57 @@
+package workload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/threeport/threeport/internal/workload/notif"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
+	controller "github.com/threeport/threeport/pkg/controller/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// TestWorkloadInstanceCreated tests that a workload instance is not
+// deployed until its workload definition has been reconciled and has
+// workload resource definitions to deploy.
+func TestWorkloadInstanceCreated(t *testing.T) {
+	env := environment(t)
+	r, err := env.Reconciler(controller.ReconcilerConfig{
+		Name:         "TestWorkloadInstanceCreatedReconciler",
+		NotifSubject: notif.WorkloadInstanceSubject,
+	})
+	require.NoError(t, err)
+
+	workloadDefinition := createTestWorkloadDefinition(t, env, "instance", testConfigMap("instance-a", "1"))
+	kubernetesRuntimeInstance := createTestKubernetesRuntimeInstance(t, env, "instance")
+	workloadInstance, err := client.CreateWorkloadInstance(env.APIClient, env.APIServer, &v0.WorkloadInstance{
+		Instance:                    v0.Instance{Name: util.Ptr("instance")},
+		KubernetesRuntimeInstanceID: kubernetesRuntimeInstance.ID,
+		WorkloadDefinitionID:        workloadDefinition.ID,
+	})
+	require.NoError(t, err)
+
+	// the workload definition has not been reconciled
+	_, err = v0WorkloadInstanceCreated(r, workloadInstance, r.Log)
+	assert.ErrorContains(t, err, "workload definition not reconciled")
+
+	// the workload definition is reconciled but has no resources to deploy
+	_, err = client.UpdateWorkloadDefinition(env.APIClient, env.APIServer, &v0.WorkloadDefinition{
+		Common:         v0.Common{ID: workloadDefinition.ID},
+		Reconciliation: v0.Reconciliation{Reconciled: util.Ptr(true)},
+	})
+	require.NoError(t, err)
+	_, err = v0WorkloadInstanceCreated(r, workloadInstance, r.Log)
+	assert.ErrorContains(t, err, "zero workload resource definitions to deploy")
+
+	// no resource instances are created for the workload instance
+	workloadResourceInstances, err := client.GetWorkloadResourceInstancesByWorkloadInstanceID(
+		env.APIClient,
+		env.APIServer,
+		*workloadInstance.ID,
+	)
+	require.NoError(t, err)
+	assert.Empty(t, *workloadResourceInstances)
+}
@@ -7,3 +7,10 @@ const (
 	LockBucketName  = "workloadLock"
 	LockBucketDescr = "contains locks on workload objects"
 )
+
+// The names of the NATS bucket used to track workload controller replicas and
+// the NATS stream used to route notifications to the replica that owns each object
+const (
+	MemberBucketName = "workloadMembers"
+	ShardStreamName  = "workloadShard"
+)
@@ -5,6 +5,7 @@ package workload
 import (
 	"errors"
 	"fmt"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	tpapi_lib "github.com/threeport/threeport/pkg/api/lib/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
@@ -13,6 +14,7 @@ import (
 	event "github.com/threeport/threeport/pkg/event/v0"
 	notifications "github.com/threeport/threeport/pkg/notifications/v0"
 	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -35,6 +37,10 @@ func WorkloadInstanceReconciler(r *controller.Reconciler) {
 	// register the os signals channel to receive SIGINT and SIGTERM signals
 	signal.Notify(osSignals, syscall.SIGINT, syscall.SIGTERM)
 
+	// stop receiving signals when the reconciler exits, e.g. when it is
+	// scaled down by the autoscaler
+	defer signal.Stop(osSignals)
+
 	for {
 		// create a fresh log object per reconciliation loop so we don't
 		// accumulate values across multiple loops
@@ -55,339 +61,484 @@ func WorkloadInstanceReconciler(r *controller.Reconciler) {
 				continue
 			}
 
-			// consume message data to capture notification from API
-			notif, err := notifications.ConsumeMessage(msg.Data)
-			if err != nil {
-				log.Error(
-					err, "failed to consume message data from NATS",
-					"msgData", string(msg.Data),
-				)
-				r.RequeueRaw(msg)
-				log.V(1).Info("workload instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-
-			// determine the correct object version from the notification
-			var workloadInstance tpapi_lib.ReconciledThreeportApiObject
-			switch notif.ObjectVersion {
-			case "v0":
-				workloadInstance = &api_v0.WorkloadInstance{}
-			default:
-				log.Error(errors.New("received unrecognized version of workload instance object"), "")
-				r.RequeueRaw(msg)
-				log.V(1).Info("workload instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
+			// handle the notification in a function so that tracking of the
+			// reconciliation ends however it is handled
+			func() {
+				defer r.EndReconcile(msg)
 
-			// decode the object that was sent in the notification
-			if err := workloadInstance.DecodeNotifObject(notif.Object); err != nil {
-				log.Error(err, "failed to marshal object map from consumed notification message")
-				r.RequeueRaw(msg)
-				log.V(1).Info("workload instance reconciliation requeued with identical payload and fixed delay")
-				continue
-			}
-			log = log.WithValues("workloadInstanceID", workloadInstance.GetId())
+				// continue the trace from the API request that triggered the
+				// notification in API and kubernetes calls made while reconciling
+				r.APIClient = tpclient_lib.WithTraceContext(r.APIClient, r.TraceContext(msg))
 
-			// back off the requeue delay as needed
-			requeueDelay := controller.SetRequeueDelay(
-				notif.CreationTime,
-			)
+				// consume message data to capture notification from API
+				notif, err := notifications.ConsumeMessage(msg.Data)
+				if err != nil {
+					log.Error(
+						err, "failed to consume message data from NATS",
+						"msgData", string(msg.Data),
+					)
+					r.RequeueRaw(msg)
+					log.V(1).Info("workload instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// check for lock on object
-			locked, ok := r.CheckLock(workloadInstance)
-			if locked || ok == false {
-				r.Requeue(workloadInstance, requeueDelay, msg)
-				log.V(1).Info("workload instance reconciliation requeued")
-				continue
-			}
+				// determine the correct object version from the notification
+				var workloadInstance tpapi_lib.ReconciledThreeportApiObject
+				switch notif.ObjectVersion {
+				case "v0":
+					workloadInstance = &api_v0.WorkloadInstance{}
+				default:
+					log.Error(errors.New("received unrecognized version of workload instance object"), "")
+					r.RequeueRaw(msg)
+					log.V(1).Info("workload instance reconciliation requeued with identical payload and fixed delay")
+					return
+				}
 
-			// set up handler to unlock and requeue on termination signal
-			go func() {
-				select {
-				case <-osSignals:
-					log.V(1).Info("received termination signal, performing unlock and requeue of workload instance")
-					r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
-				case <-lockReleased:
-					log.V(1).Info("reached end of reconcile loop for workload instance, closing out signal handler")
+				// decode the object that was sent in the notification
+				if err := workloadInstance.DecodeNotifObject(notif.Object); err != nil {
+					log.Error(err, "failed to marshal object map from consumed notification message")
+					r.RequeueRaw(msg)
+					log.V(1).Info("workload instance reconciliation requeued with identical payload and fixed delay")
+					return
 				}
-			}()
+				log = log.WithValues("workloadInstanceID", workloadInstance.GetId())
+				r.TraceObject(msg, workloadInstance, notif.Operation)
 
-			// put a lock on the reconciliation of the created object
-			if ok := r.Lock(workloadInstance); !ok {
-				r.Requeue(workloadInstance, requeueDelay, msg)
-				log.V(1).Info("workload instance reconciliation requeued")
-				continue
-			}
+				// back off the requeue delay according to the retry policy
+				requeueDelay := r.RequeueDelay(workloadInstance)
 
-			// retrieve latest version of object
-			var latestWorkloadInstance tpapi_lib.ReconciledThreeportApiObject
-			var getLatestErr error
-			switch notif.ObjectVersion {
-			case "v0":
-				latestObject, err := client_v0.GetWorkloadInstanceByID(
-					r.APIClient,
-					r.APIServer,
-					workloadInstance.GetId(),
-				)
-				latestWorkloadInstance = latestObject
-				getLatestErr = err
-			default:
-				getLatestErr = errors.New("received unrecognized version of workload instance object")
-			}
+				// check for lock on object
+				locked, ok := r.CheckLock(workloadInstance)
+				if locked || ok == false {
+					r.Requeue(workloadInstance, requeueDelay, msg)
+					log.V(1).Info("workload instance reconciliation requeued")
+					return
+				}
 
-			// check if error is 404 - if object no longer exists, no need to requeue
-			if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
-				log.Info("object no longer exists - halting reconciliation")
-				r.ReleaseLock(workloadInstance, lockReleased, msg, true)
-				continue
-			}
-			if getLatestErr != nil {
-				log.Error(getLatestErr, "failed to get workload instance by ID from API")
-				r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
-				continue
-			}
-			workloadInstance = latestWorkloadInstance
+				// set up handler to unlock and requeue on termination signal
+				go func() {
+					select {
+					case <-osSignals:
+						log.V(1).Info("received termination signal, performing unlock and requeue of workload instance")
+						r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
+					case <-lockReleased:
+						log.V(1).Info("reached end of reconcile loop for workload instance, closing out signal handler")
+					}
+				}()
 
-			// determine which operation and act accordingly
-			switch notif.Operation {
-			case notifications.NotificationOperationCreated:
-				if workloadInstance.ScheduledForDeletion() != nil {
-					log.Info("workload instance scheduled for deletion - skipping create")
-					break
+				// put a lock on the reconciliation of the created object
+				if ok := r.Lock(workloadInstance); !ok {
+					r.Requeue(workloadInstance, requeueDelay, msg)
+					log.V(1).Info("workload instance reconciliation requeued")
+					return
 				}
-				var operationErr error
-				var customRequeueDelay int64
-				switch workloadInstance.GetVersion() {
+
+				// retrieve latest version of object
+				var latestWorkloadInstance tpapi_lib.ReconciledThreeportApiObject
+				var getLatestErr error
+				switch notif.ObjectVersion {
 				case "v0":
-					requeueDelay, err := v0WorkloadInstanceCreated(
-						r,
-						workloadInstance.(*api_v0.WorkloadInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of workload instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile created workload instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedCreate),
-							Type:   util.Ptr(event.TypeNormal),
-						},
+					latestObject, err := client_v0.GetWorkloadInstanceByID(
+						r.APIClient,
+						r.APIServer,
 						workloadInstance.GetId(),
-						workloadInstance.GetVersion(),
-						workloadInstance.GetType(),
-						operationErr,
-						&log,
 					)
-					r.UnlockAndRequeue(
-						workloadInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+					latestWorkloadInstance = latestObject
+					getLatestErr = err
+				default:
+					getLatestErr = errors.New("received unrecognized version of workload instance object")
 				}
-				if customRequeueDelay != 0 {
-					log.Info("create requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						workloadInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
+
+				// check if error is 404 - if object no longer exists, no need to requeue
+				if errors.Is(getLatestErr, tpclient_lib.ErrObjectNotFound) {
+					log.Info("object no longer exists - halting reconciliation")
+					r.ReleaseLock(workloadInstance, lockReleased, msg, true)
+					return
 				}
-			case notifications.NotificationOperationUpdated:
-				var operationErr error
-				var customRequeueDelay int64
-				switch workloadInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0WorkloadInstanceUpdated(
-						r,
-						workloadInstance.(*api_v0.WorkloadInstance),
-						&log,
-					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of workload instance encountered for creation")
+				if getLatestErr != nil {
+					log.Error(getLatestErr, "failed to get workload instance by ID from API")
+					r.RecordReconcileError(msg, getLatestErr)
+					r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
+					return
 				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile updated workload instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedUpdate),
-							Type:   util.Ptr(event.TypeNormal),
+				workloadInstance = latestWorkloadInstance
+
+				// determine which operation and act accordingly
+				switch notif.Operation {
+				case notifications.NotificationOperationCreated:
+					if workloadInstance.ScheduledForDeletion() != nil {
+						log.Info("workload instance scheduled for deletion - skipping create")
+						break
+					}
+					var operationErr error
+					var customRequeueDelay int64
+					switch workloadInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0WorkloadInstanceCreated(
+							r,
+							workloadInstance.(*api_v0.WorkloadInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of workload instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile created workload instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedCreate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							workloadInstance.GetId(),
+							workloadInstance.GetVersion(),
+							workloadInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							workloadInstance,
+							event.ReasonFailedCreate,
+							operationErr,
+						); changed {
+							failedWorkloadInstance := api_v0.WorkloadInstance{
+								Common:         api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateWorkloadInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedWorkloadInstance,
+							); err != nil {
+								log.Error(err, "failed to update workload instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							workloadInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("create requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							workloadInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationUpdated:
+					var operationErr error
+					var customRequeueDelay int64
+					switch workloadInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0WorkloadInstanceUpdated(
+							r,
+							workloadInstance.(*api_v0.WorkloadInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of workload instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile updated workload instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedUpdate),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							workloadInstance.GetId(),
+							workloadInstance.GetVersion(),
+							workloadInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							workloadInstance,
+							event.ReasonFailedUpdate,
+							operationErr,
+						); changed {
+							failedWorkloadInstance := api_v0.WorkloadInstance{
+								Common:         api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateWorkloadInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedWorkloadInstance,
+							); err != nil {
+								log.Error(err, "failed to update workload instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							workloadInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("update requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							workloadInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+				case notifications.NotificationOperationDeleted:
+					var operationErr error
+					var customRequeueDelay int64
+					switch workloadInstance.GetVersion() {
+					case "v0":
+						requeueDelay, err := v0WorkloadInstanceDeleted(
+							r,
+							workloadInstance.(*api_v0.WorkloadInstance),
+							&log,
+						)
+						customRequeueDelay = requeueDelay
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of workload instance encountered for creation")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to reconcile deleted workload instance object"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedDelete),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							workloadInstance.GetId(),
+							workloadInstance.GetVersion(),
+							workloadInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						if failedConditions, changed := controller.FailedConditions(
+							workloadInstance,
+							event.ReasonFailedDelete,
+							operationErr,
+						); changed {
+							failedWorkloadInstance := api_v0.WorkloadInstance{
+								Common:         api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
+								Reconciliation: api_v0.Reconciliation{Conditions: failedConditions},
+							}
+							if _, err := client_v0.UpdateWorkloadInstance(
+								r.APIClient,
+								r.APIServer,
+								&failedWorkloadInstance,
+							); err != nil {
+								log.Error(err, "failed to update workload instance conditions")
+							}
+						}
+						r.RecordReconcileError(msg, operationErr)
+						r.UnlockAndRequeue(
+							workloadInstance,
+							requeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					if customRequeueDelay != 0 {
+						log.Info("delete requeued for future reconciliation")
+						r.UnlockAndRequeue(
+							workloadInstance,
+							customRequeueDelay,
+							lockReleased,
+							msg,
+						)
+						return
+					}
+					deletionTimestamp := util.Ptr(time.Now().UTC())
+					deletedWorkloadInstance := api_v0.WorkloadInstance{
+						Common: api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							DeletionAcknowledged: deletionTimestamp,
+							DeletionConfirmed:    deletionTimestamp,
+							Reconciled:           util.Ptr(true),
 						},
+					}
+					_, err = client_v0.UpdateWorkloadInstance(
+						r.APIClient,
+						r.APIServer,
+						&deletedWorkloadInstance,
+					)
+					if err != nil {
+						log.Error(err, "failed to update workload instance to mark as deleted")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					_, err = client_v0.DeleteWorkloadInstance(
+						r.APIClient,
+						r.APIServer,
 						workloadInstance.GetId(),
-						workloadInstance.GetVersion(),
-						workloadInstance.GetType(),
+					)
+					if err != nil {
+						log.Error(err, "failed to delete workload instance")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+				case notifications.NotificationOperationResync:
+					if workloadInstance.ScheduledForDeletion() != nil {
+						log.Info("workload instance scheduled for deletion - skipping resync")
+						break
+					}
+					var drift *controller.Drift
+					var previouslyDrifted bool
+					var operationErr error
+					switch workloadInstance.GetVersion() {
+					case "v0":
+						objectDrift, err := v0WorkloadInstanceDriftCheck(
+							r,
+							workloadInstance.(*api_v0.WorkloadInstance),
+							&log,
+						)
+						drift = objectDrift
+						previouslyDrifted = util.DerefBool(workloadInstance.(*api_v0.WorkloadInstance).Drifted)
+						operationErr = err
+					default:
+						operationErr = errors.New("unrecognized version of workload instance encountered for resync")
+					}
+					if operationErr != nil {
+						errorMsg := "failed to check resynced workload instance object for drift"
+						log.Error(operationErr, errorMsg)
+						r.EventsRecorder.HandleEventOverride(
+							&api_v0.Event{
+								Note:   util.Ptr(errorMsg),
+								Reason: util.Ptr(event.ReasonFailedResync),
+								Type:   util.Ptr(event.TypeNormal),
+							},
+							workloadInstance.GetId(),
+							workloadInstance.GetVersion(),
+							workloadInstance.GetType(),
+							operationErr,
+							&log,
+						)
+						r.RecordReconcileError(msg, operationErr)
+						r.ReleaseLock(workloadInstance, lockReleased, msg, true)
+						return
+					}
+					r.RecordDrift(workloadInstance, drift, &log)
+					driftConditions, conditionsChanged := controller.DriftConditions(workloadInstance, drift)
+					if drift.Uncorrected() != previouslyDrifted || conditionsChanged {
+						driftedWorkloadInstance := api_v0.WorkloadInstance{
+							Common: api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
+							Reconciliation: api_v0.Reconciliation{
+								Conditions: driftConditions,
+								Drifted:    util.Ptr(drift.Uncorrected()),
+							},
+						}
+						_, err = client_v0.UpdateWorkloadInstance(
+							r.APIClient,
+							r.APIServer,
+							&driftedWorkloadInstance,
+						)
+						if err != nil {
+							log.Error(err, "failed to update workload instance to record drift")
+							r.RecordReconcileError(msg, err)
+							r.ReleaseLock(workloadInstance, lockReleased, msg, true)
+							return
+						}
+					}
+				default:
+					operationErr := errors.New("unrecognized notifcation operation")
+					log.Error(
 						operationErr,
-						&log,
+						"notification included an invalid operation",
 					)
+					r.RecordReconcileError(msg, operationErr)
 					r.UnlockAndRequeue(
 						workloadInstance,
 						requeueDelay,
 						lockReleased,
 						msg,
 					)
-					continue
+					return
 				}
-				if customRequeueDelay != 0 {
-					log.Info("update requeued for future reconciliation")
-					r.UnlockAndRequeue(
+
+				// set the object's Reconciled field to true if not deleted or
+				// resynced
+				if notif.Operation != notifications.NotificationOperationDeleted && notif.Operation != notifications.NotificationOperationResync {
+					reconciledConditions, _ := controller.ReconciledConditions(
 						workloadInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
-					)
-					continue
-				}
-			case notifications.NotificationOperationDeleted:
-				var operationErr error
-				var customRequeueDelay int64
-				switch workloadInstance.GetVersion() {
-				case "v0":
-					requeueDelay, err := v0WorkloadInstanceDeleted(
-						r,
-						workloadInstance.(*api_v0.WorkloadInstance),
-						&log,
+						event.GetSuccessReasonForOperation(notif.Operation),
 					)
-					customRequeueDelay = requeueDelay
-					operationErr = err
-				default:
-					operationErr = errors.New("unrecognized version of workload instance encountered for creation")
-				}
-				if operationErr != nil {
-					errorMsg := "failed to reconcile deleted workload instance object"
-					log.Error(operationErr, errorMsg)
-					r.EventsRecorder.HandleEventOverride(
-						&api_v0.Event{
-							Note:   util.Ptr(errorMsg),
-							Reason: util.Ptr(event.ReasonFailedDelete),
-							Type:   util.Ptr(event.TypeNormal),
+					reconciledWorkloadInstance := api_v0.WorkloadInstance{
+						Common: api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
+						Reconciliation: api_v0.Reconciliation{
+							Conditions: reconciledConditions,
+							Reconciled: util.Ptr(true),
 						},
-						workloadInstance.GetId(),
-						workloadInstance.GetVersion(),
-						workloadInstance.GetType(),
-						operationErr,
-						&log,
-					)
-					r.UnlockAndRequeue(
-						workloadInstance,
-						requeueDelay,
-						lockReleased,
-						msg,
+					}
+					updatedWorkloadInstance, err := client_v0.UpdateWorkloadInstance(
+						r.APIClient,
+						r.APIServer,
+						&reconciledWorkloadInstance,
 					)
-					continue
-				}
-				if customRequeueDelay != 0 {
-					log.Info("delete requeued for future reconciliation")
-					r.UnlockAndRequeue(
-						workloadInstance,
-						customRequeueDelay,
-						lockReleased,
-						msg,
+					if err != nil {
+						log.Error(err, "failed to update workload instance to mark as reconciled")
+						r.RecordReconcileError(msg, err)
+						r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
+						return
+					}
+					log.V(1).Info(
+						"workload instance marked as reconciled in API",
+						"workload instanceName", updatedWorkloadInstance.Name,
 					)
-					continue
-				}
-				deletionTimestamp := util.Ptr(time.Now().UTC())
-				deletedWorkloadInstance := api_v0.WorkloadInstance{
-					Common: api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{
-						DeletionAcknowledged: deletionTimestamp,
-						DeletionConfirmed:    deletionTimestamp,
-						Reconciled:           util.Ptr(true),
-					},
 				}
-				_, err = client_v0.UpdateWorkloadInstance(
-					r.APIClient,
-					r.APIServer,
-					&deletedWorkloadInstance,
-				)
-				if err != nil {
-					log.Error(err, "failed to update workload instance to mark as deleted")
-					r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
-					continue
-				}
-				_, err = client_v0.DeleteWorkloadInstance(
-					r.APIClient,
-					r.APIServer,
-					workloadInstance.GetId(),
-				)
-				if err != nil {
-					log.Error(err, "failed to delete workload instance")
-					r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
-					continue
+
+				// release the lock on the reconciliation of the created object
+				if ok := r.ReleaseLock(workloadInstance, lockReleased, msg, true); !ok {
+					log.Error(errors.New("workload instance remains locked - will unlock when TTL expires"), "")
+				} else {
+					log.V(1).Info("workload instance unlocked")
 				}
-			default:
-				log.Error(
-					errors.New("unrecognized notifcation operation"),
-					"notification included an invalid operation",
-				)
-				r.UnlockAndRequeue(
-					workloadInstance,
-					requeueDelay,
-					lockReleased,
-					msg,
-				)
-				continue
-			}
 
-			// set the object's Reconciled field to true if not deleted
-			if notif.Operation != notifications.NotificationOperationDeleted {
-				reconciledWorkloadInstance := api_v0.WorkloadInstance{
-					Common:         api_v0.Common{ID: util.Ptr(workloadInstance.GetId())},
-					Reconciliation: api_v0.Reconciliation{Reconciled: util.Ptr(true)},
+				// resyncs of reconciled objects are not reported as reconciliations
+				if notif.Operation == notifications.NotificationOperationResync {
+					log.V(1).Info("workload instance resynced")
+					return
 				}
-				updatedWorkloadInstance, err := client_v0.UpdateWorkloadInstance(
-					r.APIClient,
-					r.APIServer,
-					&reconciledWorkloadInstance,
+
+				// log and record event for successful reconciliation
+				successMsg := fmt.Sprintf(
+					"workload instance successfully reconciled for %s operation",
+					strings.ToLower(string(notif.Operation)),
 				)
-				if err != nil {
-					log.Error(err, "failed to update workload instance to mark as reconciled")
-					r.UnlockAndRequeue(workloadInstance, requeueDelay, lockReleased, msg)
-					continue
+				if err := r.EventsRecorder.RecordEvent(
+					&api_v0.Event{
+						Note:   util.Ptr(successMsg),
+						Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
+						Type:   util.Ptr(event.TypeNormal),
+					},
+					workloadInstance.GetId(),
+					workloadInstance.GetVersion(),
+					workloadInstance.GetType(),
+				); err != nil {
+					log.Error(err, "failed to record event for successful workload instance reconciliation")
 				}
-				log.V(1).Info(
-					"workload instance marked as reconciled in API",
-					"workload instanceName", updatedWorkloadInstance.Name,
-				)
-			}
-
-			// release the lock on the reconciliation of the created object
-			if ok := r.ReleaseLock(workloadInstance, lockReleased, msg, true); !ok {
-				log.Error(errors.New("workload instance remains locked - will unlock when TTL expires"), "")
-			} else {
-				log.V(1).Info("workload instance unlocked")
-			}
-
-			// log and record event for successful reconciliation
-			successMsg := fmt.Sprintf(
-				"workload instance successfully reconciled for %s operation",
-				strings.ToLower(string(notif.Operation)),
-			)
-			if err := r.EventsRecorder.RecordEvent(
-				&api_v0.Event{
-					Note:   util.Ptr(successMsg),
-					Reason: util.Ptr(event.GetSuccessReasonForOperation(notif.Operation)),
-					Type:   util.Ptr(event.TypeNormal),
-				},
-				workloadInstance.GetId(),
-				workloadInstance.GetVersion(),
-				workloadInstance.GetType(),
-			); err != nil {
-				log.Error(err, "failed to record event for successful workload instance reconciliation")
-			}
-			log.Info(successMsg)
+				log.Info(successMsg)
+			}()
 		}
 	}
 
@@ -395,3 +546,22 @@ func WorkloadInstanceReconciler(r *controller.Reconciler) {
 	reconcilerLog.Info("reconciler shutting down")
 	r.ShutdownWait.Done()
 }
+
+// ListReconciledWorkloadInstances returns the workload instances that have been
+// reconciled so they may be resynced.
+func ListReconciledWorkloadInstances(apiClient *http.Client, apiServer string) ([]tpapi_lib.ReconciledThreeportApiObject, error) {
+	var reconciled []tpapi_lib.ReconciledThreeportApiObject
+	v0WorkloadInstances, err := client_v0.GetWorkloadInstancesWithOptions(
+		apiClient,
+		apiServer,
+		tpclient_lib.WithFilter("Reconciled", apiserver_lib.FilterOpEquals, "true"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get v0 workload instances: %w", err)
+	}
+	for i := range *v0WorkloadInstances {
+		reconciled = append(reconciled, &(*v0WorkloadInstances)[i])
+	}
+
+	return reconciled, nil
+}
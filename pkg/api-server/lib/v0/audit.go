@@ -0,0 +1,175 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// auditIgnoredFields are not included in audit record diffs since they change
+// with every update.
+var auditIgnoredFields = []string{"UpdatedAt", "ResourceVersion"}
+
+// AuditFieldChange is the value of an object field before and after a
+// request.
+type AuditFieldChange struct {
+	Before interface{} `json:"Before"`
+	After  interface{} `json:"After"`
+}
+
+// auditResponseWriter captures the response body so the ID of created objects
+// can be recorded.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+// Write writes the response to the client and retains a copy.
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// AuditMiddleware returns middleware that persists an audit record for every
+// request that creates, updates or deletes an object.  The record includes
+// the client identity, the object and the changes made to it.  Requests that
+// are rejected are also recorded with the response code they received.
+func AuditMiddleware(db *gorm.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			method := c.Request().Method
+			if method != http.MethodPost &&
+				method != http.MethodPatch &&
+				method != http.MethodPut &&
+				method != http.MethodDelete {
+				return next(c)
+			}
+
+			authzObject, registered := AuthzObjects[strings.TrimSuffix(c.Path(), "/:id")]
+
+			// get the object as it exists before the request
+			var before map[string]interface{}
+			if registered && c.Param("id") != "" {
+				before = auditObjectState(db, authzObject, c.Param("id"))
+			}
+
+			response := c.Response()
+			writer := &auditResponseWriter{ResponseWriter: response.Writer, body: new(bytes.Buffer)}
+			response.Writer = writer
+			handlerErr := next(c)
+			response.Writer = writer.ResponseWriter
+
+			route := c.Request().URL.Path
+			responseCode := response.Status
+			record := api_v0.AuditRecord{
+				Method:       &method,
+				Route:        &route,
+				ResponseCode: &responseCode,
+			}
+			if subject, err := requestSubject(c); err == nil {
+				record.Subject = &subject
+			}
+
+			if registered {
+				record.ObjectType = &authzObject.ObjectType
+				id := c.Param("id")
+				if id == "" && method == http.MethodPost {
+					id = createdObjectId(writer.body.Bytes())
+				}
+				if objectId, err := strconv.ParseUint(id, 10, 0); err == nil {
+					uintId := uint(objectId)
+					record.ObjectID = &uintId
+				}
+
+				// get the object as it exists after the request
+				var after map[string]interface{}
+				if id != "" && responseCode < http.StatusMultipleChoices {
+					after = auditObjectState(db, authzObject, id)
+				}
+				if diff := auditDiff(before, after); len(diff) > 0 {
+					if diffJson, err := json.Marshal(diff); err == nil {
+						jsonDiff := datatypes.JSON(diffJson)
+						record.Diff = &jsonDiff
+					}
+				}
+			}
+
+			// the response has already been sent so failures to persist the
+			// audit record are logged rather than returned to the client
+			if result := db.Create(&record); result.Error != nil {
+				c.Logger().Errorf("failed to persist audit record for %s %s: %v", method, route, result.Error)
+			}
+
+			return handlerErr
+		}
+	}
+}
+
+// auditObjectState returns the fields of an object as they exist in the
+// database.  It returns nil if the object does not exist.
+func auditObjectState(db *gorm.DB, authzObject AuthzObject, id string) map[string]interface{} {
+	object := reflect.New(reflect.TypeOf(authzObject.Model).Elem()).Interface()
+	if result := db.First(object, "id = ?", id); result.Error != nil {
+		return nil
+	}
+
+	objectJson, err := json.Marshal(object)
+	if err != nil {
+		return nil
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(objectJson, &state); err != nil {
+		return nil
+	}
+
+	return state
+}
+
+// createdObjectId returns the ID of the object in a response to a create
+// request.
+func createdObjectId(responseBody []byte) string {
+	var response struct {
+		Data []struct {
+			ID *uint `json:"ID"`
+		} `json:"Data"`
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return ""
+	}
+	if len(response.Data) == 0 || response.Data[0].ID == nil {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(*response.Data[0].ID), 10)
+}
+
+// auditDiff returns the fields that differ between two states of an object.
+func auditDiff(before, after map[string]interface{}) map[string]AuditFieldChange {
+	diff := make(map[string]AuditFieldChange)
+	for field, beforeValue := range before {
+		afterValue := after[field]
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			diff[field] = AuditFieldChange{Before: beforeValue, After: afterValue}
+		}
+	}
+	for field, afterValue := range after {
+		if _, found := before[field]; !found {
+			diff[field] = AuditFieldChange{Before: nil, After: afterValue}
+		}
+	}
+
+	for _, field := range auditIgnoredFields {
+		delete(diff, field)
+	}
+
+	return diff
+}
@@ -0,0 +1,417 @@
+package v0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// AuthzObject is an API object that is subject to authorization.
+type AuthzObject struct {
+	// The type of the object, e.g. WorkloadInstance.
+	ObjectType string
+
+	// A pointer to an empty instance of the object's struct used to query
+	// existing objects from the database.
+	Model interface{}
+}
+
+// AuthzObjects maps the REST path for an object collection, e.g.
+// /v0/workload-instances, to the object served at that path.
+var AuthzObjects = make(map[string]AuthzObject)
+
+// AddAuthzObject registers the REST path for an object type so that requests
+// to that path can be authorized.
+func AddAuthzObject(path string, objectType string, model interface{}) {
+	AuthzObjects[path] = AuthzObject{
+		ObjectType: objectType,
+		Model:      model,
+	}
+}
+
+// authzDelegatedPaths are REST paths with handlers that authorize each
+// operation they perform rather than the request as a whole.
+var authzDelegatedPaths = make(map[string]bool)
+
+// AddAuthzDelegatedPath registers a REST path with a handler that authorizes
+// each of the operations it performs, e.g. the operations in a batch.
+func AddAuthzDelegatedPath(path string) {
+	authzDelegatedPaths[path] = true
+}
+
+// AuthzVerb returns the authorization verb for an HTTP method.
+func AuthzVerb(method string) string {
+	switch method {
+	case http.MethodPost:
+		return api_v0.AuthzVerbCreate
+	case http.MethodPatch, http.MethodPut:
+		return api_v0.AuthzVerbUpdate
+	case http.MethodDelete:
+		return api_v0.AuthzVerbDelete
+	default:
+		return api_v0.AuthzVerbRead
+	}
+}
+
+// AuthorizationMiddleware returns middleware that authorizes each request
+// using the roles bound to the subject of the client certificate.  A request
+// is permitted if a single role bound to the subject has a rule that permits
+// the verb for the object type and, for writes to objects associated with a
+// tier, the tier's criticality does not exceed the role's maximum.  Requests
+// to paths that don't serve a registered object type are permitted for reads
+// and otherwise require a rule for all object types.
+func AuthorizationMiddleware(db *gorm.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			path := strings.TrimSuffix(c.Path(), "/:id")
+			if authzDelegatedPaths[path] {
+				if _, err := requestSubject(c); err != nil {
+					return ResponseStatus401(c, nil, err, ObjectTypeUnknown)
+				}
+				return next(c)
+			}
+
+			if permitted, err := AuthorizeRequest(c, db, AuthzVerb(c.Request().Method), path); !permitted {
+				return err
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// AuthorizeRequest authorizes a request to perform the verb on the object
+// type served at the provided REST path, e.g. /v0/workload-instances.  It is
+// used by the authorization middleware and by handlers for delegated paths
+// that authorize the operations they perform.  If the request is not
+// permitted, the error response is written and false is returned along with
+// the result of writing the response.
+func AuthorizeRequest(c echo.Context, db *gorm.DB, verb string, path string) (bool, error) {
+	subject, err := requestSubject(c)
+	if err != nil {
+		return false, ResponseStatus401(c, nil, err, ObjectTypeUnknown)
+	}
+
+	authzObject, registered := AuthzObjects[path]
+	objectType := authzObject.ObjectType
+	if !registered {
+		if verb == api_v0.AuthzVerbRead {
+			return true, nil
+		}
+		objectType = api_v0.AuthzAll
+	}
+
+	var criticality *int
+	if registered && verb != api_v0.AuthzVerbRead {
+		criticality, err = requestTierCriticality(c, db, authzObject)
+		if err != nil {
+			return false, ResponseStatus500(c, nil, err, objectType)
+		}
+	}
+
+	permitted, err := subjectPermitted(db, subject, verb, objectType, criticality)
+	if err != nil {
+		return false, ResponseStatus500(c, nil, err, objectType)
+	}
+	if !permitted {
+		return false, ResponseStatus403(
+			c,
+			nil,
+			fmt.Errorf(
+				"%s : subject %s may not %s object type %s",
+				ErrMsgPermissionDenied,
+				subject,
+				verb,
+				objectType,
+			),
+			objectType,
+		)
+	}
+
+	return true, nil
+}
+
+// AuthzObjectPath returns the REST path for the registered object type, e.g.
+// /v0/workload-instances for WorkloadInstance.
+func AuthzObjectPath(objectType string) (string, bool) {
+	for path, authzObject := range AuthzObjects {
+		if authzObject.ObjectType == objectType {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// requestSubject returns the common name of the client certificate used for a
+// request.
+func requestSubject(c echo.Context) (string, error) {
+	tlsState := c.Request().TLS
+	if tlsState == nil || len(tlsState.PeerCertificates) == 0 {
+		return "", errors.New(ErrMsgStatusUnauthorized)
+	}
+
+	return tlsState.PeerCertificates[0].Subject.CommonName, nil
+}
+
+// subjectPermitted returns true if a role bound to the subject permits the
+// verb for the object type at the provided tier criticality.
+func subjectPermitted(
+	db *gorm.DB,
+	subject string,
+	verb string,
+	objectType string,
+	criticality *int,
+) (bool, error) {
+	var roleBindings []api_v0.RoleBinding
+	if result := db.Where(
+		"subject = ? OR subject LIKE ?",
+		subject,
+		"%"+api_v0.AuthzAll,
+	).Find(&roleBindings); result.Error != nil {
+		return false, fmt.Errorf("failed to query role bindings for subject %s: %w", subject, result.Error)
+	}
+
+	var roleIds []uint
+	for _, roleBinding := range roleBindings {
+		if !subjectMatch(*roleBinding.Subject, subject) {
+			continue
+		}
+		roleIds = append(roleIds, *roleBinding.RoleID)
+	}
+	if len(roleIds) == 0 {
+		return false, nil
+	}
+	var roles []api_v0.Role
+	if result := db.Where("id IN ?", roleIds).Find(&roles); result.Error != nil {
+		return false, fmt.Errorf("failed to query roles for subject %s: %w", subject, result.Error)
+	}
+
+	for _, role := range roles {
+		if !rolePermits(&role, verb, objectType) {
+			continue
+		}
+		if verb != api_v0.AuthzVerbRead &&
+			criticality != nil &&
+			role.MaxTierCriticality != nil &&
+			*criticality > *role.MaxTierCriticality {
+			continue
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// subjectMatch returns true if the subject of a role binding matches the
+// requested subject.  A role binding subject ending in '*' matches all
+// subjects with the preceding prefix.
+func subjectMatch(bindingSubject string, subject string) bool {
+	if prefix, found := strings.CutSuffix(bindingSubject, api_v0.AuthzAll); found {
+		return strings.HasPrefix(subject, prefix)
+	}
+
+	return bindingSubject == subject
+}
+
+// rolePermits returns true if a rule in the role permits the verb for the
+// object type.
+func rolePermits(role *api_v0.Role, verb string, objectType string) bool {
+	if role.Rules == nil {
+		return false
+	}
+
+	for _, rule := range *role.Rules {
+		if !authzMatch(rule.Verbs, verb) {
+			continue
+		}
+		if authzMatch(rule.ObjectTypes, objectType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authzMatch returns true if the values from a role rule include the
+// requested value or all values.
+func authzMatch(values []string, requested string) bool {
+	for _, value := range values {
+		if value == api_v0.AuthzAll || value == requested {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestTierCriticality returns the highest criticality of the tiers
+// associated with the object being written.  For create requests the tier
+// is taken from the payload.  For updates and deletes, the tier of the
+// existing object is used as well as any tier provided in the payload.
+// Instances are associated with the tier of their definition.  It returns nil
+// if the object is not associated with a tier.
+func requestTierCriticality(
+	c echo.Context,
+	db *gorm.DB,
+	authzObject AuthzObject,
+) (*int, error) {
+	var tierIds []uint
+
+	// tier associations provided in the request payload
+	if c.Request().Method != http.MethodDelete {
+		var payload map[string]interface{}
+		if err := json.Unmarshal(readBody(c), &payload); err == nil {
+			tierId, err := tierIdFromPayload(db, authzObject, payload)
+			if err != nil {
+				return nil, err
+			}
+			if tierId != nil {
+				tierIds = append(tierIds, *tierId)
+			}
+		}
+	}
+
+	// tier association of the existing object
+	if id := c.Param("id"); id != "" {
+		// soft-deleted objects are included so that restores are authorized
+		// against the tier of the object being restored
+		existing := reflect.New(reflect.TypeOf(authzObject.Model).Elem()).Interface()
+		result := db.Unscoped().First(existing, "id = ?", id)
+		if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to query existing object for authorization: %w", result.Error)
+		}
+		if result.Error == nil {
+			tierId, err := tierIdFromObject(db, authzObject.ObjectType, existing)
+			if err != nil {
+				return nil, err
+			}
+			if tierId != nil {
+				tierIds = append(tierIds, *tierId)
+			}
+		}
+	}
+
+	var criticality *int
+	for _, tierId := range tierIds {
+		var tier api_v0.Tier
+		if result := db.First(&tier, tierId); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to query tier with ID %d: %w", tierId, result.Error)
+		}
+		if tier.Criticality != nil && (criticality == nil || *tier.Criticality > *criticality) {
+			criticality = tier.Criticality
+		}
+	}
+
+	return criticality, nil
+}
+
+// tierIdFromPayload returns the tier ID for an object from a request payload
+// either directly or via the definition referenced by an instance.
+func tierIdFromPayload(
+	db *gorm.DB,
+	authzObject AuthzObject,
+	payload map[string]interface{},
+) (*uint, error) {
+	if tierId := payloadUint(payload, "TierID"); tierId != nil {
+		return tierId, nil
+	}
+
+	definitionType, definitionIdField, ok := instanceDefinition(authzObject.ObjectType)
+	if !ok {
+		return nil, nil
+	}
+	definitionId := payloadUint(payload, definitionIdField)
+	if definitionId == nil {
+		return nil, nil
+	}
+
+	return definitionTierId(db, definitionType, *definitionId)
+}
+
+// tierIdFromObject returns the tier ID for an existing object either directly
+// or via the definition referenced by an instance.
+func tierIdFromObject(db *gorm.DB, objectType string, object interface{}) (*uint, error) {
+	if tierId := objectUint(object, "TierID"); tierId != nil {
+		return tierId, nil
+	}
+
+	definitionType, definitionIdField, ok := instanceDefinition(objectType)
+	if !ok {
+		return nil, nil
+	}
+	definitionId := objectUint(object, definitionIdField)
+	if definitionId == nil {
+		return nil, nil
+	}
+
+	return definitionTierId(db, definitionType, *definitionId)
+}
+
+// definitionTierId returns the tier ID of a definition object.
+func definitionTierId(db *gorm.DB, definitionType string, definitionId uint) (*uint, error) {
+	for _, authzObject := range AuthzObjects {
+		if authzObject.ObjectType != definitionType {
+			continue
+		}
+		definition := reflect.New(reflect.TypeOf(authzObject.Model).Elem()).Interface()
+		if result := db.First(definition, definitionId); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to query %s with ID %d: %w", definitionType, definitionId, result.Error)
+		}
+		return objectUint(definition, "TierID"), nil
+	}
+
+	return nil, nil
+}
+
+// instanceDefinition returns the definition object type and the name of the
+// field that references it for an instance object type, e.g.
+// WorkloadDefinition and WorkloadDefinitionID for WorkloadInstance.
+func instanceDefinition(objectType string) (string, string, bool) {
+	if !strings.HasSuffix(objectType, "Instance") {
+		return "", "", false
+	}
+	definitionType := strings.TrimSuffix(objectType, "Instance") + "Definition"
+
+	return definitionType, definitionType + "ID", true
+}
+
+// payloadUint returns the unsigned integer value of a field in a request
+// payload.
+func payloadUint(payload map[string]interface{}, field string) *uint {
+	value, ok := payload[field].(float64)
+	if !ok || value < 0 {
+		return nil
+	}
+	uintValue := uint(value)
+
+	return &uintValue
+}
+
+// objectUint returns the value of a *uint field in an object.
+func objectUint(object interface{}, field string) *uint {
+	value := reflect.Indirect(reflect.ValueOf(object)).FieldByName(field)
+	if !value.IsValid() || value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil
+	}
+	uintValue, ok := value.Interface().(*uint)
+	if !ok {
+		return nil
+	}
+
+	return uintValue
+}
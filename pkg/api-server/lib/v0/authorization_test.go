@@ -0,0 +1,177 @@
+package v0
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// newMockTestDB returns a database backed by a mock that expects the queries
+// made by a test.
+func newMockTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+
+	return db, mock
+}
+
+// newAuthzTestContext returns a request context for the path authenticated
+// with a client certificate for the subject.  No client certificate is used
+// if the subject is empty.
+func newAuthzTestContext(method, path, subject string) (echo.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(method, path, nil)
+	if subject != "" {
+		request.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: subject}}},
+		}
+	}
+	recorder := httptest.NewRecorder()
+	c := echo.New().NewContext(request, recorder)
+	c.SetPath(path)
+
+	return c, recorder
+}
+
+// TestAuthorizationMiddleware tests that requests are authorized by the roles
+// bound to the subject of the client certificate, including the subject used
+// by control planes installed before each component had its own subject.
+func TestAuthorizationMiddleware(t *testing.T) {
+	const objectPath = "/v0/authz-test-objects"
+	AddAuthzObject(objectPath, "AuthzTestObject", &queryTestObject{})
+	t.Cleanup(func() { delete(AuthzObjects, objectPath) })
+
+	adminRules := `[{"Verbs":["*"],"ObjectTypes":["*"]}]`
+	readRules := `[{"Verbs":["read"],"ObjectTypes":["AuthzTestObject"]}]`
+
+	testCases := []struct {
+		name    string
+		method  string
+		path    string
+		subject string
+		// role bindings and the rules of the roles they bind, nil if the
+		// role bindings aren't queried
+		bindings map[string]string
+		// true if none of the role bindings match the subject so the roles
+		// aren't queried
+		unmatched bool
+		status    int
+	}{
+		{
+			name:   "no client certificate",
+			method: http.MethodGet,
+			path:   objectPath,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:     "legacy subject bound to admin role",
+			method:   http.MethodPost,
+			path:     objectPath,
+			subject:  api_v0.AuthzLegacySubject,
+			bindings: map[string]string{api_v0.AuthzLegacySubject: adminRules},
+			status:   http.StatusOK,
+		},
+		{
+			name:     "control plane component bound by prefix",
+			method:   http.MethodDelete,
+			path:     objectPath,
+			subject:  api_v0.AuthzSystemSubject("workload-controller"),
+			bindings: map[string]string{api_v0.AuthzSystemSubjectPrefix + api_v0.AuthzAll: adminRules},
+			status:   http.StatusOK,
+		},
+		{
+			name:     "legacy subject not bound",
+			method:   http.MethodGet,
+			path:     objectPath,
+			subject:  api_v0.AuthzLegacySubject,
+			bindings: map[string]string{},
+			status:   http.StatusForbidden,
+		},
+		{
+			name:      "prefix binding does not match other subjects",
+			method:    http.MethodGet,
+			path:      objectPath,
+			subject:   "threeport-user",
+			bindings:  map[string]string{api_v0.AuthzSystemSubjectPrefix + api_v0.AuthzAll: adminRules},
+			unmatched: true,
+			status:    http.StatusForbidden,
+		},
+		{
+			name:     "role permits verb",
+			method:   http.MethodGet,
+			path:     objectPath,
+			subject:  "reader",
+			bindings: map[string]string{"reader": readRules},
+			status:   http.StatusOK,
+		},
+		{
+			name:     "role does not permit verb",
+			method:   http.MethodPost,
+			path:     objectPath,
+			subject:  "reader",
+			bindings: map[string]string{"reader": readRules},
+			status:   http.StatusForbidden,
+		},
+		{
+			name:    "read from unregistered path",
+			method:  http.MethodGet,
+			path:    "/v0/unregistered",
+			subject: "reader",
+			status:  http.StatusOK,
+		},
+		{
+			name:     "write to unregistered path requires all object types",
+			method:   http.MethodPost,
+			path:     "/v0/unregistered",
+			subject:  "reader",
+			bindings: map[string]string{"reader": readRules},
+			status:   http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockTestDB(t)
+			if tc.bindings != nil {
+				bindingRows := sqlmock.NewRows([]string{"id", "name", "subject", "role_id"})
+				roleRows := sqlmock.NewRows([]string{"id", "name", "rules"})
+				var roleId uint
+				for subject, rules := range tc.bindings {
+					roleId++
+					bindingRows.AddRow(roleId, subject, subject, roleId)
+					roleRows.AddRow(roleId, subject, rules)
+				}
+				mock.ExpectQuery(`SELECT \* FROM "v0_role_bindings"`).
+					WithArgs(tc.subject, "%"+api_v0.AuthzAll).
+					WillReturnRows(bindingRows)
+				if len(tc.bindings) > 0 && !tc.unmatched {
+					mock.ExpectQuery(`SELECT \* FROM "v0_roles"`).WillReturnRows(roleRows)
+				}
+			}
+
+			c, recorder := newAuthzTestContext(tc.method, tc.path, tc.subject)
+			handler := AuthorizationMiddleware(db)(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+			require.NoError(t, handler(c))
+
+			assert.Equal(t, tc.status, recorder.Code, recorder.Body.String())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
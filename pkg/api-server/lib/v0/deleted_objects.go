@@ -0,0 +1,329 @@
+package v0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+)
+
+const (
+	// The default length of time deleted objects are retained before they
+	// are purged from the database.
+	DefaultDeletedObjectRetention = 30 * 24 * time.Hour
+
+	// The interval at which deleted objects that have passed the retention
+	// window are purged from the database.
+	DeletedObjectPurgeInterval = time.Hour
+
+	ErrMsgDeletedObjectRetentionExpired = "deleted object retention window has expired"
+	ErrMsgDeletedObjectNameConflict     = "an object with the same name already exists"
+)
+
+var (
+	ErrDeletedObjectRetentionExpired = errors.New(ErrMsgDeletedObjectRetentionExpired)
+	ErrDeletedObjectNameConflict     = errors.New(ErrMsgDeletedObjectNameConflict)
+)
+
+// DeletedObjectRetention is the length of time deleted objects can be
+// restored before they are purged from the database.
+var DeletedObjectRetention = DefaultDeletedObjectRetention
+
+// reconciledObjectSubject includes the NATS subjects used to notify a
+// controller of new and deleted objects.
+type reconciledObjectSubject struct {
+	create string
+	delete string
+}
+
+// reconciledObjectSubjects maps the object types that are reconciled by
+// controllers to the NATS subjects used to notify their controllers.
+var reconciledObjectSubjects = make(map[string]reconciledObjectSubject)
+
+// AddReconciledObject registers an object type that is reconciled by a
+// controller so that its controller is notified when an object is restored
+// or deleted along with its owner.
+func AddReconciledObject(objectType string, createSubject string, deleteSubject string) {
+	reconciledObjectSubjects[objectType] = reconciledObjectSubject{
+		create: createSubject,
+		delete: deleteSubject,
+	}
+}
+
+// notificationPayloader is implemented by objects that are reconciled by
+// controllers.
+type notificationPayloader interface {
+	NotificationPayload(
+		operation notifications.NotificationOperation,
+		requeue bool,
+		creationTime int64,
+	) (*[]byte, error)
+}
+
+// GetDeletedObjects returns a page of the soft-deleted objects of the
+// provided model's type that have not yet been purged, most recently deleted
+// first, along with the total number of deleted objects.
+func GetDeletedObjects(
+	db *gorm.DB,
+	model interface{},
+	params PageRequestParams,
+) (interface{}, int64, error) {
+	query := db.Unscoped().Model(model).Where("deleted_at IS NOT NULL").Session(&gorm.Session{})
+
+	var totalCount int64
+	if result := query.Count(&totalCount); result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	records := reflect.New(reflect.SliceOf(reflect.TypeOf(model).Elem())).Interface()
+	if result := query.Order("deleted_at desc").Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return reflect.ValueOf(records).Elem().Interface(), totalCount, nil
+}
+
+// DeletionSession returns a session for a transaction in which an object is
+// deleted so that the object and the dependents deleted along with it are
+// soft-deleted at the same time.  Objects that are reconciled by a controller
+// are only removed from the database once their controller has deleted their
+// resources, so the time their deletion was scheduled is used for their
+// dependents instead.  This allows RestoreObject to restore an object's
+// dependents with it.
+func DeletionSession(tx *gorm.DB, deletedAt time.Time) *gorm.DB {
+	return tx.Session(&gorm.Session{
+		NowFunc: func() time.Time { return deletedAt },
+	})
+}
+
+// RestoreObject restores the soft-deleted object of the provided model's type
+// with the given ID if it was deleted within the retention window.  Restored
+// objects that are reconciled by a controller are marked unreconciled and a
+// notification is queued in the outbox so that the controller creates the
+// object's resources again.  The caller should signal the outbox relay once
+// the transaction is committed.  gorm.ErrRecordNotFound is returned if there
+// is no deleted object with the ID.
+//
+// The dependents that were deleted along with the object, i.e. that were
+// deleted when the object's deletion began, are restored with it.  Dependents
+// that were deleted by their controllers afterwards, as well as objects that
+// are managed by the object's controller such as workload resource instances,
+// remain deleted as the object's controller creates them again when it
+// reconciles the restored object.
+func RestoreObject(
+	tx *gorm.DB,
+	objectType string,
+	model interface{},
+	id uint,
+) (interface{}, error) {
+	return restoreObject(tx, objectType, model, id, make(map[string]bool))
+}
+
+// restoreObject restores a soft-deleted object along with its dependents.
+// Objects that have already been visited are skipped so that ownership
+// cycles don't cause infinite recursion.
+func restoreObject(
+	tx *gorm.DB,
+	objectType string,
+	model interface{},
+	id uint,
+	visited map[string]bool,
+) (interface{}, error) {
+	visited[objectKey(objectType, id)] = true
+
+	object := reflect.New(reflect.TypeOf(model).Elem()).Interface()
+	if result := tx.Unscoped().Where("deleted_at IS NOT NULL").First(object, id); result.Error != nil {
+		return nil, result.Error
+	}
+	objectValue := reflect.ValueOf(object).Elem()
+
+	// objects are only restored within the retention window
+	deletedAt, _ := objectValue.FieldByName("DeletedAt").Interface().(*gorm.DeletedAt)
+	if deletedAt != nil && deletedAt.Valid && deletedAt.Time.Before(time.Now().Add(-DeletedObjectRetention)) {
+		return nil, fmt.Errorf(
+			"%w : object was deleted at %s and could only be restored within %s",
+			ErrDeletedObjectRetentionExpired,
+			deletedAt.Time.UTC().Format(time.RFC3339),
+			DeletedObjectRetention,
+		)
+	}
+
+	// names must remain unique among objects that are not deleted
+	if nameField := objectValue.FieldByName("Name"); nameField.IsValid() {
+		if name, ok := nameField.Interface().(*string); ok && name != nil {
+			var nameCount int64
+			if result := tx.Model(model).Where("name = ?", *name).Count(&nameCount); result.Error != nil {
+				return nil, result.Error
+			}
+			if nameCount > 0 {
+				return nil, fmt.Errorf("%w : %s", ErrDeletedObjectNameConflict, *name)
+			}
+		}
+	}
+
+	// dependents were deleted along with the object when its deletion began
+	var deletionStarted *time.Time
+	if deletedAt != nil && deletedAt.Valid {
+		deletionStarted = &deletedAt.Time
+	}
+	if scheduled, ok := objectValue.FieldByName("DeletionScheduled").Interface().(*time.Time); ok && scheduled != nil {
+		deletionStarted = scheduled
+	}
+
+	resourceVersion, _ := objectValue.FieldByName("ResourceVersion").Interface().(*uint64)
+	restored := map[string]interface{}{
+		"DeletedAt":       nil,
+		"ResourceVersion": NextResourceVersion(resourceVersion),
+	}
+	subjects, reconciled := reconciledObjectSubjects[objectType]
+	if reconciled {
+		restored["Reconciled"] = false
+		restored["CreationAcknowledged"] = nil
+		restored["CreationConfirmed"] = nil
+		restored["CreationFailed"] = false
+		restored["DeletionScheduled"] = nil
+		restored["DeletionAcknowledged"] = nil
+		restored["DeletionConfirmed"] = nil
+		restored["InterruptReconciliation"] = false
+	}
+	if result := tx.Unscoped().Model(object).Updates(restored); result.Error != nil {
+		return nil, result.Error
+	}
+	if result := tx.First(object, id); result.Error != nil {
+		return nil, result.Error
+	}
+
+	// queue a notification so the controller reconciles the restored object
+	if reconciled {
+		payloader, ok := object.(notificationPayloader)
+		if !ok {
+			return nil, fmt.Errorf("object type %s does not provide notification payloads", objectType)
+		}
+		notifPayload, err := payloader.NotificationPayload(
+			notifications.NotificationOperationCreated,
+			false,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := AddOutboxNotification(tx, subjects.create, *notifPayload); err != nil {
+			return nil, err
+		}
+	}
+
+	if deletionStarted != nil {
+		if err := restoreDependents(tx, objectType, id, *deletionStarted, visited); err != nil {
+			return nil, err
+		}
+	}
+
+	return object, nil
+}
+
+// restoreDependents restores the soft-deleted dependents of an object that
+// were deleted when the object's deletion began.
+func restoreDependents(
+	tx *gorm.DB,
+	objectType string,
+	id uint,
+	deletionStarted time.Time,
+	visited map[string]bool,
+) error {
+	ownerFilter, err := ownerReferenceFilter(objectType, id)
+	if err != nil {
+		return err
+	}
+
+	for _, authzObject := range AuthzObjects {
+		if !hasOwnerReferences(authzObject.Model) {
+			continue
+		}
+		var dependentIDs []uint
+		if result := tx.Unscoped().Model(authzObject.Model).Where(
+			"deleted_at = ? AND owner_references @> ?::jsonb", deletionStarted, ownerFilter,
+		).Pluck("id", &dependentIDs); result.Error != nil {
+			return fmt.Errorf("failed to get deleted dependent %s objects: %w", authzObject.ObjectType, result.Error)
+		}
+
+		for _, dependentID := range dependentIDs {
+			// objects served at more than one path are only restored once
+			if visited[objectKey(authzObject.ObjectType, dependentID)] {
+				continue
+			}
+			if _, err := restoreObject(
+				tx,
+				authzObject.ObjectType,
+				authzObject.Model,
+				dependentID,
+				visited,
+			); err != nil {
+				return fmt.Errorf(
+					"failed to restore dependent %s with ID %d: %w",
+					authzObject.ObjectType,
+					dependentID,
+					err,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeletedObjectPurger hard-deletes objects that were deleted longer ago than
+// the retention window.
+type DeletedObjectPurger struct {
+	DB     *gorm.DB
+	Logger *zap.Logger
+}
+
+// Run purges expired deleted objects at the purge interval until the context
+// is cancelled.
+func (p *DeletedObjectPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(DeletedObjectPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.purge()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge hard-deletes expired deleted objects of every registered object
+// type.  Objects that can't be purged yet, e.g. because they are still
+// referenced by another deleted object, are retried on the next interval.
+func (p *DeletedObjectPurger) purge() {
+	cutoff := time.Now().Add(-DeletedObjectRetention)
+	for _, authzObject := range AuthzObjects {
+		result := p.DB.Unscoped().
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Delete(authzObject.Model)
+		if result.Error != nil {
+			p.Logger.Error(
+				"failed to purge deleted objects",
+				zap.String("objectType", authzObject.ObjectType),
+				zap.Error(result.Error),
+			)
+			continue
+		}
+		if result.RowsAffected > 0 {
+			p.Logger.Info(
+				"purged deleted objects",
+				zap.String("objectType", authzObject.ObjectType),
+				zap.Int64("count", result.RowsAffected),
+			)
+		}
+	}
+}
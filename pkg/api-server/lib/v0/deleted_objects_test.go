@@ -0,0 +1,124 @@
+package v0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// expectRestore expects a deleted owned test object to be read and
+// restored.
+func expectRestore(mock sqlmock.Sqlmock, id uint, deletedAt time.Time, deletionScheduled interface{}) {
+	mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE deleted_at IS NOT NULL AND "owned_test_objects"."id" = \$1`).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "resource_version", "deleted_at", "deletion_scheduled"}).
+			AddRow(id, 3, deletedAt, deletionScheduled))
+	mock.ExpectExec(`UPDATE "owned_test_objects" SET .*"deleted_at"=\$\d+.*"resource_version"=\$\d+`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE "owned_test_objects"."id" = \$1 AND "owned_test_objects"."deleted_at" IS NULL AND "owned_test_objects"."id" = \$2`).
+		WithArgs(id, id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "resource_version"}).AddRow(id, 4))
+}
+
+// expectDeletedDependents expects a query for the dependents of an owned
+// test object deleted at the time its deletion began and returns their IDs.
+func expectDeletedDependents(mock sqlmock.Sqlmock, ownerID uint, deletionStarted time.Time, ids ...uint) {
+	rows := sqlmock.NewRows([]string{"id"})
+	for _, id := range ids {
+		rows.AddRow(id)
+	}
+	ownerFilter, _ := ownerReferenceFilter(ownedTestObjectType, ownerID)
+	mock.ExpectQuery(`SELECT "id" FROM "owned_test_objects" WHERE deleted_at = \$1 AND owner_references @> \$2::jsonb`).
+		WithArgs(deletionStarted, ownerFilter).
+		WillReturnRows(rows)
+}
+
+// TestRestoreObject tests that the dependents deleted along with an object
+// are restored with it and that the controllers of restored objects are
+// notified.
+func TestRestoreObject(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	deletionScheduled := deletedAt.Add(-time.Minute)
+
+	testCases := []struct {
+		name       string
+		reconciled bool
+		expect     func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "no dependents",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectRestore(mock, 1, deletedAt, nil)
+				expectDeletedDependents(mock, 1, deletedAt)
+			},
+		},
+		{
+			name: "dependents deleted with object restored",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectRestore(mock, 1, deletedAt, nil)
+				expectDeletedDependents(mock, 1, deletedAt, 2)
+				expectRestore(mock, 2, deletedAt, nil)
+				expectDeletedDependents(mock, 2, deletedAt, 3)
+				expectRestore(mock, 3, deletedAt, nil)
+				expectDeletedDependents(mock, 3, deletedAt)
+			},
+		},
+		{
+			name: "dependent owning its owner restored once",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectRestore(mock, 1, deletedAt, nil)
+				expectDeletedDependents(mock, 1, deletedAt, 2)
+				expectRestore(mock, 2, deletedAt, nil)
+				expectDeletedDependents(mock, 2, deletedAt, 1)
+			},
+		},
+		{
+			name:       "reconciled object restores dependents deleted when its deletion was scheduled",
+			reconciled: true,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectRestore(mock, 1, deletedAt, deletionScheduled)
+				mock.ExpectQuery(`INSERT INTO "v0_outbox_notifications"`).
+					WithArgs(append(append(anyArgs(7), "ownedTestObject.created"), anyArgs(7)...)...).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				expectDeletedDependents(mock, 1, deletionScheduled)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registerOwnerTestObjects(t, tc.reconciled)
+			db, mock := newOwnerTestDB(t)
+			tc.expect(mock)
+
+			object, err := RestoreObject(db, ownedTestObjectType, &ownedTestObject{}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, uint(1), *object.(*ownedTestObject).ID)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestDeletionSession tests that objects deleted in a deletion session share
+// the deletion time.
+func TestDeletionSession(t *testing.T) {
+	db, mock := newOwnerTestDB(t)
+	deletedAt := time.Now().Add(-time.Minute).UTC()
+	for _, id := range []uint{1, 2} {
+		mock.ExpectExec(`UPDATE "owned_test_objects" SET "deleted_at"=\$1 WHERE "owned_test_objects"."id" = \$2`).
+			WithArgs(deletedAt, id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tx := DeletionSession(db, deletedAt)
+	for _, id := range []uint{1, 2} {
+		require.NoError(t, tx.Delete(&ownedTestObject{Common: api_v0.Common{ID: &id}}).Error)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,109 @@
+package v0
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
+
+const ErrMsgFailedReconciliationNotFound = "failed reconciliation not found"
+
+var ErrFailedReconciliationNotFound = errors.New(ErrMsgFailedReconciliationNotFound)
+
+// GetFailedReconciliations returns a page of the failed reconciliations in
+// the dead-letter stream, most recent first, along with the total number of
+// failed reconciliations.
+func GetFailedReconciliations(
+	js nats.JetStreamContext,
+	params PageRequestParams,
+) ([]v0.FailedReconciliation, int64, error) {
+	failedReconciliations := []v0.FailedReconciliation{}
+
+	streamInfo, err := js.StreamInfo(notifications.DeadLetterStreamName)
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return failedReconciliations, 0, nil
+		}
+		return nil, 0, fmt.Errorf("failed to get dead-letter stream info: %w", err)
+	}
+	if streamInfo.State.Msgs == 0 {
+		return failedReconciliations, 0, nil
+	}
+
+	offset := (params.Page - 1) * params.Size
+	skipped := 0
+	for seq := streamInfo.State.LastSeq; seq >= streamInfo.State.FirstSeq && len(failedReconciliations) < params.Size; seq-- {
+		failedReconciliation, err := getFailedReconciliation(js, seq)
+		if errors.Is(err, ErrFailedReconciliationNotFound) {
+			continue
+		} else if err != nil {
+			return nil, 0, err
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		failedReconciliations = append(failedReconciliations, *failedReconciliation)
+	}
+
+	return failedReconciliations, int64(streamInfo.State.Msgs), nil
+}
+
+// RetryFailedReconciliation republishes the notification for a failed
+// reconciliation to its reconciler and removes the failed reconciliation from
+// the dead-letter stream.  The reconciler starts again with no failed
+// attempts.
+func RetryFailedReconciliation(
+	ctx context.Context,
+	js nats.JetStreamContext,
+	id uint64,
+) (*v0.FailedReconciliation, error) {
+	failedReconciliation, err := getFailedReconciliation(js, id)
+	if err != nil {
+		return nil, err
+	}
+	if failedReconciliation.Subject == nil || failedReconciliation.Notification == nil {
+		return nil, fmt.Errorf("failed reconciliation %d has no notification to retry", id)
+	}
+
+	if _, err := js.PublishMsg(tracing.NewMsg(
+		ctx,
+		*failedReconciliation.Subject,
+		*failedReconciliation.Notification,
+	)); err != nil {
+		return nil, fmt.Errorf("failed to republish notification: %w", err)
+	}
+
+	if err := js.DeleteMsg(notifications.DeadLetterStreamName, id); err != nil {
+		return nil, fmt.Errorf("failed to remove failed reconciliation from dead-letter stream: %w", err)
+	}
+
+	return failedReconciliation, nil
+}
+
+// getFailedReconciliation returns the failed reconciliation with the provided
+// sequence number in the dead-letter stream.
+func getFailedReconciliation(js nats.JetStreamContext, seq uint64) (*v0.FailedReconciliation, error) {
+	msg, err := js.GetMsg(notifications.DeadLetterStreamName, seq)
+	if err != nil {
+		if errors.Is(err, nats.ErrMsgNotFound) || errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, ErrFailedReconciliationNotFound
+		}
+		return nil, fmt.Errorf("failed to get message %d from dead-letter stream: %w", seq, err)
+	}
+
+	var failedReconciliation v0.FailedReconciliation
+	if err := json.Unmarshal(msg.Data, &failedReconciliation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal failed reconciliation %d: %w", seq, err)
+	}
+	failedReconciliation.ID = &msg.Sequence
+
+	return &failedReconciliation, nil
+}
@@ -0,0 +1,169 @@
+package v0
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	QueryParamLabelSelector    = "labelSelector"
+	ErrMsgInvalidLabelSelector = "Query parameter is not a valid label selector: " + QueryParamLabelSelector
+)
+
+// LabelSelectorOperator is the comparison a label requirement makes.
+type LabelSelectorOperator string
+
+const (
+	LabelSelectorOpEquals       LabelSelectorOperator = "="
+	LabelSelectorOpNotEquals    LabelSelectorOperator = "!="
+	LabelSelectorOpIn           LabelSelectorOperator = "in"
+	LabelSelectorOpNotIn        LabelSelectorOperator = "notin"
+	LabelSelectorOpExists       LabelSelectorOperator = "exists"
+	LabelSelectorOpDoesNotExist LabelSelectorOperator = "!"
+)
+
+var (
+	labelKeyRegex   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_./]*[A-Za-z0-9])?$`)
+	labelValueRegex = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?)?$`)
+	labelSetRegex   = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// LabelRequirement is a single condition in a label selector that an
+// object's labels must satisfy.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelSelectorOperator
+	Values   []string
+}
+
+// GetLabelSelector parses the labelSelector query parameter.  It returns no
+// requirements if the query parameter is not provided.
+func (c *CustomContext) GetLabelSelector() ([]LabelRequirement, error) {
+	requirements, err := ParseLabelSelector(c.QueryParam(QueryParamLabelSelector))
+	if err != nil {
+		return nil, fmt.Errorf("%s : %w", ErrMsgInvalidLabelSelector, err)
+	}
+
+	return requirements, nil
+}
+
+// ParseLabelSelector parses a comma-separated list of label requirements.
+// Objects match the selector if their labels satisfy all requirements.  The
+// supported requirements are:
+//   - key=value or key==value: the label has the value
+//   - key!=value: the label does not have the value or is not set
+//   - key in (value1,value2): the label has one of the values
+//   - key notin (value1,value2): the label has none of the values or is not set
+//   - key: the label is set
+//   - !key: the label is not set
+func ParseLabelSelector(selector string) ([]LabelRequirement, error) {
+	var requirements []LabelRequirement
+	for _, term := range splitLabelSelector(selector) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var requirement LabelRequirement
+		switch {
+		case labelSetRegex.MatchString(term):
+			matches := labelSetRegex.FindStringSubmatch(term)
+			requirement.Key = matches[1]
+			requirement.Operator = LabelSelectorOperator(matches[2])
+			for _, value := range strings.Split(matches[3], ",") {
+				requirement.Values = append(requirement.Values, strings.TrimSpace(value))
+			}
+		case strings.HasPrefix(term, "!") && !strings.Contains(term, "="):
+			requirement.Key = strings.TrimSpace(strings.TrimPrefix(term, "!"))
+			requirement.Operator = LabelSelectorOpDoesNotExist
+		case strings.Contains(term, "!="):
+			parts := strings.SplitN(term, "!=", 2)
+			requirement.Key = strings.TrimSpace(parts[0])
+			requirement.Operator = LabelSelectorOpNotEquals
+			requirement.Values = []string{strings.TrimSpace(parts[1])}
+		case strings.Contains(term, "="):
+			parts := strings.SplitN(strings.Replace(term, "==", "=", 1), "=", 2)
+			requirement.Key = strings.TrimSpace(parts[0])
+			requirement.Operator = LabelSelectorOpEquals
+			requirement.Values = []string{strings.TrimSpace(parts[1])}
+		default:
+			requirement.Key = term
+			requirement.Operator = LabelSelectorOpExists
+		}
+
+		if !labelKeyRegex.MatchString(requirement.Key) {
+			return nil, fmt.Errorf("invalid label key %q", requirement.Key)
+		}
+		for _, value := range requirement.Values {
+			if !labelValueRegex.MatchString(value) {
+				return nil, fmt.Errorf("invalid value %q for label %s", value, requirement.Key)
+			}
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+// LabelSelectorScope returns a GORM scope that limits a query to objects with
+// labels that satisfy all the requirements.
+func LabelSelectorScope(requirements []LabelRequirement) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, requirement := range requirements {
+			switch requirement.Operator {
+			case LabelSelectorOpEquals:
+				db = db.Where("labels ->> ? = ?", requirement.Key, requirement.Values[0])
+			case LabelSelectorOpNotEquals:
+				db = db.Where(
+					"(labels ->> ? IS NULL OR labels ->> ? <> ?)",
+					requirement.Key,
+					requirement.Key,
+					requirement.Values[0],
+				)
+			case LabelSelectorOpIn:
+				db = db.Where("labels ->> ? IN ?", requirement.Key, requirement.Values)
+			case LabelSelectorOpNotIn:
+				db = db.Where(
+					"(labels ->> ? IS NULL OR labels ->> ? NOT IN ?)",
+					requirement.Key,
+					requirement.Key,
+					requirement.Values,
+				)
+			case LabelSelectorOpExists:
+				db = db.Where("labels ->> ? IS NOT NULL", requirement.Key)
+			case LabelSelectorOpDoesNotExist:
+				db = db.Where("labels ->> ? IS NULL", requirement.Key)
+			}
+		}
+
+		return db
+	}
+}
+
+// splitLabelSelector splits a label selector on the commas that separate
+// requirements, ignoring commas within the value sets of in and notin
+// requirements.
+func splitLabelSelector(selector string) []string {
+	var terms []string
+	depth := 0
+	start := 0
+	for i, char := range selector {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(terms, selector[start:])
+}
@@ -0,0 +1,115 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseLabelSelector tests that label selectors are parsed into their
+// requirements and that invalid selectors are rejected.
+func TestParseLabelSelector(t *testing.T) {
+	testCases := []struct {
+		name         string
+		selector     string
+		requirements []LabelRequirement
+		wantErr      bool
+	}{
+		{
+			name:     "empty selector",
+			selector: "",
+		},
+		{
+			name:     "equals",
+			selector: "team=payments",
+			requirements: []LabelRequirement{
+				{Key: "team", Operator: LabelSelectorOpEquals, Values: []string{"payments"}},
+			},
+		},
+		{
+			name:     "double equals",
+			selector: "team==payments",
+			requirements: []LabelRequirement{
+				{Key: "team", Operator: LabelSelectorOpEquals, Values: []string{"payments"}},
+			},
+		},
+		{
+			name:     "not equals",
+			selector: "tier!=dev",
+			requirements: []LabelRequirement{
+				{Key: "tier", Operator: LabelSelectorOpNotEquals, Values: []string{"dev"}},
+			},
+		},
+		{
+			name:     "in set",
+			selector: "tier in (dev, staging)",
+			requirements: []LabelRequirement{
+				{Key: "tier", Operator: LabelSelectorOpIn, Values: []string{"dev", "staging"}},
+			},
+		},
+		{
+			name:     "not in set",
+			selector: "tier notin (prod)",
+			requirements: []LabelRequirement{
+				{Key: "tier", Operator: LabelSelectorOpNotIn, Values: []string{"prod"}},
+			},
+		},
+		{
+			name:     "exists",
+			selector: "example.com/owner",
+			requirements: []LabelRequirement{
+				{Key: "example.com/owner", Operator: LabelSelectorOpExists},
+			},
+		},
+		{
+			name:     "does not exist",
+			selector: "!deprecated",
+			requirements: []LabelRequirement{
+				{Key: "deprecated", Operator: LabelSelectorOpDoesNotExist},
+			},
+		},
+		{
+			name:     "multiple requirements with set commas",
+			selector: "team=payments, tier in (dev,staging),!deprecated",
+			requirements: []LabelRequirement{
+				{Key: "team", Operator: LabelSelectorOpEquals, Values: []string{"payments"}},
+				{Key: "tier", Operator: LabelSelectorOpIn, Values: []string{"dev", "staging"}},
+				{Key: "deprecated", Operator: LabelSelectorOpDoesNotExist},
+			},
+		},
+		{
+			name:     "empty value",
+			selector: "team=",
+			requirements: []LabelRequirement{
+				{Key: "team", Operator: LabelSelectorOpEquals, Values: []string{""}},
+			},
+		},
+		{
+			name:     "invalid key",
+			selector: "-team=payments",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid value",
+			selector: "team=pay ments",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid set value",
+			selector: "tier in (dev,-staging)",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requirements, err := ParseLabelSelector(tc.selector)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.requirements, requirements)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package v0
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
+
+const (
+	// The interval at which the outbox relay checks for notifications that
+	// are due to be published if it has not been signalled.
+	OutboxRelayInterval = 5 * time.Second
+
+	// The number of notifications the outbox relay publishes at a time.
+	OutboxRelayBatchSize = 100
+
+	// The delay before the first retry of a failed publish.  The delay
+	// doubles with each failed attempt up to OutboxRelayMaxBackoff.
+	OutboxRelayInitialBackoff = time.Second
+
+	// The maximum delay between attempts to publish a notification.
+	OutboxRelayMaxBackoff = 5 * time.Minute
+
+	// The default length of time delivered notifications are kept in the
+	// outbox before they are purged from the database.
+	DefaultOutboxRetention = 24 * time.Hour
+
+	// The interval at which delivered notifications that have passed the
+	// retention window are purged from the database.
+	OutboxPurgeInterval = time.Hour
+)
+
+// OutboxRetention is the length of time delivered notifications are kept in
+// the outbox before they are purged from the database.
+var OutboxRetention = DefaultOutboxRetention
+
+// outboxUndelivered is the number of notifications in the outbox that have
+// not yet been published.
+var outboxUndelivered = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: "threeport",
+	Subsystem: "api",
+	Name:      "outbox_undelivered_notifications",
+	Help:      "The number of controller notifications in the outbox that have not been published to NATS.",
+})
+
+// outboxSignal wakes the outbox relay when notifications are added so that
+// they are published without waiting for the next interval.
+var outboxSignal = make(chan struct{}, 1)
+
+// AddOutboxNotification writes a controller notification to the outbox.  The
+// provided database should be the transaction that persists the change the
+// notification concerns so that the notification is only sent if the change
+// is committed.  Call SignalOutboxRelay once the transaction is committed.
+// The trace context from the database session's context is stored with the
+// notification so the trace continues when it is published.
+func AddOutboxNotification(db *gorm.DB, subject string, payload []byte) error {
+	jsonPayload := datatypes.JSON(payload)
+	delivered := false
+	attempts := 0
+	now := time.Now().UTC()
+	notification := api_v0.OutboxNotification{
+		Subject:       &subject,
+		Payload:       &jsonPayload,
+		Delivered:     &delivered,
+		Attempts:      &attempts,
+		NextAttemptAt: &now,
+	}
+	if db.Statement != nil && db.Statement.Context != nil {
+		if traceHeaders := tracing.Inject(db.Statement.Context); len(traceHeaders) > 0 {
+			traceContext, err := json.Marshal(traceHeaders)
+			if err != nil {
+				return fmt.Errorf("failed to marshal trace context for outbox notification: %w", err)
+			}
+			jsonTraceContext := datatypes.JSON(traceContext)
+			notification.TraceContext = &jsonTraceContext
+		}
+	}
+	if result := db.Create(&notification); result.Error != nil {
+		return fmt.Errorf("failed to write notification to outbox: %w", result.Error)
+	}
+
+	return nil
+}
+
+// SignalOutboxRelay tells the outbox relay that new notifications are ready
+// to be published.
+func SignalOutboxRelay() {
+	select {
+	case outboxSignal <- struct{}{}:
+	default:
+	}
+}
+
+// OutboxRelay publishes the notifications in the outbox to NATS and marks
+// them delivered.  Failed publishes are retried with an exponential backoff.
+type OutboxRelay struct {
+	DB     *gorm.DB
+	JS     nats.JetStreamContext
+	Logger *zap.Logger
+}
+
+// Run publishes notifications from the outbox until the context is
+// cancelled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(OutboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		// publish until no further notifications are due
+		for {
+			published, err := r.relay()
+			if err != nil {
+				r.Logger.Error("failed to relay notifications from outbox", zap.Error(err))
+				break
+			}
+			if published < OutboxRelayBatchSize {
+				break
+			}
+		}
+
+		if err := r.updateUndeliveredMetric(); err != nil {
+			r.Logger.Error("failed to count undelivered outbox notifications", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-outboxSignal:
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay publishes a batch of notifications that are due and returns the
+// number of notifications it attempted to publish.  Rows are locked while
+// they are published so that multiple API server replicas don't publish the
+// same notification.
+func (r *OutboxRelay) relay() (int, error) {
+	var attempted int
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var notifications []api_v0.OutboxNotification
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("delivered = ? AND next_attempt_at <= ?", false, time.Now().UTC()).
+			Order("id asc").
+			Limit(OutboxRelayBatchSize).
+			Find(&notifications)
+		if result.Error != nil {
+			return fmt.Errorf("failed to query outbox for undelivered notifications: %w", result.Error)
+		}
+		attempted = len(notifications)
+
+		for _, notification := range notifications {
+			attempts := *notification.Attempts + 1
+			now := time.Now().UTC()
+			update := map[string]interface{}{"attempts": attempts}
+			if err := r.publish(&notification); err != nil {
+				nextAttempt := now.Add(outboxBackoff(attempts))
+				update["last_error"] = err.Error()
+				update["next_attempt_at"] = nextAttempt
+				r.Logger.Error(
+					"failed to publish notification from outbox",
+					zap.String("subject", *notification.Subject),
+					zap.Int("attempts", attempts),
+					zap.Time("nextAttempt", nextAttempt),
+					zap.Error(err),
+				)
+			} else {
+				update["delivered"] = true
+				update["delivered_at"] = now
+			}
+			if result := tx.Model(&notification).Updates(update); result.Error != nil {
+				return fmt.Errorf("failed to update outbox notification with ID %d: %w", *notification.ID, result.Error)
+			}
+		}
+
+		return nil
+	})
+
+	return attempted, err
+}
+
+// publish sends a notification to NATS.  The publish is traced as part of
+// the trace of the request that made the change and the trace context is
+// sent in the message headers for the controller.
+func (r *OutboxRelay) publish(notification *api_v0.OutboxNotification) error {
+	ctx := context.Background()
+	if notification.TraceContext != nil {
+		var traceHeaders map[string]string
+		if err := json.Unmarshal(*notification.TraceContext, &traceHeaders); err != nil {
+			r.Logger.Error(
+				"failed to unmarshal trace context for outbox notification",
+				zap.Uint("id", *notification.ID),
+				zap.Error(err),
+			)
+		} else {
+			ctx = tracing.Extract(ctx, traceHeaders)
+		}
+	}
+
+	ctx, span := tracing.Tracer().Start(
+		ctx,
+		fmt.Sprintf("%s publish", *notification.Subject),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			tracing.MessagingSystemNATS,
+			semconv.MessagingDestinationName(*notification.Subject),
+		),
+	)
+	defer span.End()
+
+	if _, err := r.JS.PublishMsg(tracing.NewMsg(ctx, *notification.Subject, *notification.Payload)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to publish notification")
+		return err
+	}
+
+	return nil
+}
+
+// updateUndeliveredMetric sets the undelivered notifications metric to the
+// number of undelivered notifications in the outbox.
+func (r *OutboxRelay) updateUndeliveredMetric() error {
+	var undelivered int64
+	if result := r.DB.Model(&api_v0.OutboxNotification{}).Where("delivered = ?", false).Count(&undelivered); result.Error != nil {
+		return result.Error
+	}
+	outboxUndelivered.Set(float64(undelivered))
+
+	return nil
+}
+
+// OutboxPurger hard-deletes notifications that were delivered longer ago
+// than the retention window so the outbox doesn't grow without bound.
+// Undelivered notifications are never purged.
+type OutboxPurger struct {
+	DB     *gorm.DB
+	Logger *zap.Logger
+}
+
+// Run purges expired delivered notifications at the purge interval until the
+// context is cancelled.
+func (p *OutboxPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(OutboxPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.purge()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge hard-deletes delivered notifications that have passed the retention
+// window.
+func (p *OutboxPurger) purge() {
+	cutoff := time.Now().UTC().Add(-OutboxRetention)
+	result := p.DB.Unscoped().
+		Where("delivered = ? AND delivered_at < ?", true, cutoff).
+		Delete(&api_v0.OutboxNotification{})
+	if result.Error != nil {
+		p.Logger.Error("failed to purge delivered notifications from outbox", zap.Error(result.Error))
+		return
+	}
+	if result.RowsAffected > 0 {
+		p.Logger.Info(
+			"purged delivered notifications from outbox",
+			zap.Int64("count", result.RowsAffected),
+		)
+	}
+}
+
+// outboxBackoff returns the delay before the next attempt to publish a
+// notification that has failed the provided number of times.
+func outboxBackoff(attempts int) time.Duration {
+	backoff := OutboxRelayInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= OutboxRelayMaxBackoff {
+			return OutboxRelayMaxBackoff
+		}
+	}
+
+	return backoff
+}
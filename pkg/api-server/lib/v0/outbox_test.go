@@ -0,0 +1,181 @@
+package v0
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// outboxTestJetStream records the messages published by the outbox relay
+// and fails to publish to the subjects in fail.
+type outboxTestJetStream struct {
+	nats.JetStreamContext
+	fail      map[string]bool
+	published []string
+}
+
+// PublishMsg records a published message or returns an error if publishing
+// to its subject fails.
+func (js *outboxTestJetStream) PublishMsg(msg *nats.Msg, opts ...nats.PubOpt) (*nats.PubAck, error) {
+	if js.fail[msg.Subject] {
+		return nil, errors.New("no responders available for request")
+	}
+	js.published = append(js.published, msg.Subject)
+
+	return &nats.PubAck{}, nil
+}
+
+// TestOutboxRelay tests that notifications that are due are published and
+// marked delivered and that failed publishes are scheduled for a later
+// attempt with the error recorded.
+func TestOutboxRelay(t *testing.T) {
+	testCases := []struct {
+		name string
+		// the subjects of the notifications that are due and the number of
+		// previous attempts to publish each
+		due       []string
+		attempts  []int
+		fail      map[string]bool
+		published []string
+		// true for each notification that is delivered
+		delivered []bool
+	}{
+		{
+			name: "no notifications due",
+		},
+		{
+			name:      "all notifications published",
+			due:       []string{"workloadInstance.created", "workloadInstance.deleted"},
+			attempts:  []int{0, 0},
+			published: []string{"workloadInstance.created", "workloadInstance.deleted"},
+			delivered: []bool{true, true},
+		},
+		{
+			name:      "failed publish is retried later",
+			due:       []string{"workloadInstance.created", "gatewayInstance.created"},
+			attempts:  []int{0, 3},
+			fail:      map[string]bool{"gatewayInstance.created": true},
+			published: []string{"workloadInstance.created"},
+			delivered: []bool{true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockTestDB(t)
+
+			rows := sqlmock.NewRows([]string{"id", "subject", "payload", "delivered", "attempts"})
+			for i, subject := range tc.due {
+				rows.AddRow(i+1, subject, `{}`, false, tc.attempts[i])
+			}
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT \* FROM "v0_outbox_notifications" WHERE \(delivered = \$1 AND next_attempt_at <= \$2\) .* ORDER BY id asc LIMIT \$\d+ FOR UPDATE SKIP LOCKED`).
+				WithArgs(false, sqlmock.AnyArg(), OutboxRelayBatchSize).
+				WillReturnRows(rows)
+			for i := range tc.due {
+				if tc.delivered[i] {
+					mock.ExpectExec(`UPDATE "v0_outbox_notifications" SET "attempts"=\$1,"delivered"=\$2,"delivered_at"=\$3,"updated_at"=\$4 WHERE .*"id" = \$5`).
+						WithArgs(tc.attempts[i]+1, true, sqlmock.AnyArg(), sqlmock.AnyArg(), i+1).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				} else {
+					mock.ExpectExec(`UPDATE "v0_outbox_notifications" SET "attempts"=\$1,"last_error"=\$2,"next_attempt_at"=\$3,"updated_at"=\$4 WHERE .*"id" = \$5`).
+						WithArgs(tc.attempts[i]+1, "no responders available for request", sqlmock.AnyArg(), sqlmock.AnyArg(), i+1).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+			}
+			mock.ExpectCommit()
+
+			js := &outboxTestJetStream{fail: tc.fail}
+			relay := OutboxRelay{DB: db, JS: js, Logger: zap.NewNop()}
+			attempted, err := relay.relay()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tc.due), attempted)
+			assert.Equal(t, tc.published, js.published)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestOutboxPurger tests that only delivered notifications older than the
+// retention window are purged and that failures are logged.
+func TestOutboxPurger(t *testing.T) {
+	testCases := []struct {
+		name    string
+		purged  int64
+		err     error
+		message string
+	}{
+		{
+			name: "nothing to purge",
+		},
+		{
+			name:    "expired notifications purged",
+			purged:  3,
+			message: "purged delivered notifications from outbox",
+		},
+		{
+			name:    "purge failed",
+			err:     errors.New("connection refused"),
+			message: "failed to purge delivered notifications from outbox",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockTestDB(t)
+			mock.ExpectBegin()
+			exec := mock.ExpectExec(`DELETE FROM "v0_outbox_notifications" WHERE delivered = \$1 AND delivered_at < \$2`).
+				WithArgs(true, sqlmock.AnyArg())
+			if tc.err != nil {
+				exec.WillReturnError(tc.err)
+				mock.ExpectRollback()
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, tc.purged))
+				mock.ExpectCommit()
+			}
+
+			core, logs := observer.New(zap.InfoLevel)
+			purger := OutboxPurger{DB: db, Logger: zap.New(core)}
+			purger.purge()
+
+			if tc.message == "" {
+				assert.Zero(t, logs.Len())
+			} else {
+				require.Equal(t, 1, logs.Len())
+				assert.Equal(t, tc.message, logs.All()[0].Message)
+			}
+			if tc.purged > 0 {
+				assert.Equal(t, tc.purged, logs.All()[0].ContextMap()["count"])
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestOutboxBackoff tests that the delay between attempts to publish a
+// notification doubles up to the maximum.
+func TestOutboxBackoff(t *testing.T) {
+	testCases := []struct {
+		attempts int
+		backoff  time.Duration
+	}{
+		{attempts: 1, backoff: time.Second},
+		{attempts: 2, backoff: 2 * time.Second},
+		{attempts: 5, backoff: 16 * time.Second},
+		{attempts: 9, backoff: 256 * time.Second},
+		{attempts: 10, backoff: OutboxRelayMaxBackoff},
+		{attempts: 100, backoff: OutboxRelayMaxBackoff},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.backoff, outboxBackoff(tc.attempts), "attempts: %d", tc.attempts)
+	}
+}
@@ -0,0 +1,451 @@
+package v0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+)
+
+// QueryParamPropagationPolicy is the query parameter used to set the deletion
+// propagation policy when deleting an object, e.g.
+// ?propagationPolicy=Foreground
+const QueryParamPropagationPolicy = "propagationPolicy"
+
+// GetDeletionPropagation returns the deletion propagation policy requested
+// for a delete request.  Background is returned if no policy is provided.
+func GetDeletionPropagation(c echo.Context) (api_v0.DeletionPropagation, error) {
+	policy := c.QueryParam(QueryParamPropagationPolicy)
+	if policy == "" {
+		return api_v0.DeletionPropagationBackground, nil
+	}
+
+	for _, propagation := range []api_v0.DeletionPropagation{
+		api_v0.DeletionPropagationForeground,
+		api_v0.DeletionPropagationBackground,
+		api_v0.DeletionPropagationOrphan,
+	} {
+		if strings.EqualFold(policy, string(propagation)) {
+			return propagation, nil
+		}
+	}
+
+	return "", fmt.Errorf(
+		"invalid %s %q - must be one of %s, %s or %s",
+		QueryParamPropagationPolicy,
+		policy,
+		api_v0.DeletionPropagationForeground,
+		api_v0.DeletionPropagationBackground,
+		api_v0.DeletionPropagationOrphan,
+	)
+}
+
+// ownedObject is implemented by all API objects.
+type ownedObject interface {
+	GetOwnerReferences() []api_v0.OwnerReference
+}
+
+// finalizedObject is implemented by objects that are reconciled by
+// controllers.
+type finalizedObject interface {
+	GetFinalizers() []string
+	HasFinalizer(finalizer string) bool
+}
+
+// dependent is an object owned by an object that is being deleted.
+type dependent struct {
+	objectType string
+	id         uint
+	object     interface{}
+}
+
+// DeleteDependents applies the deletion propagation policy to the dependents
+// of an object that is being deleted.  Dependents that have other owners only
+// have the reference to the object removed.  Otherwise dependents that are
+// reconciled by a controller are scheduled for deletion and their controllers
+// notified, and other dependents are deleted immediately.  Dependents are
+// deleted with the same policy so that deletion cascades through their own
+// dependents.
+//
+// It returns true if the object must wait for its dependents to be deleted,
+// i.e. it is being deleted in the foreground and it has dependents that are
+// still being deleted by their controllers.  In that case the caller should
+// add the foreground deletion finalizer to the object instead of notifying
+// its controller, and the controller is notified by ReleaseOwners once the
+// last dependent is deleted.  The caller should signal the outbox relay once
+// the transaction is committed.
+func DeleteDependents(
+	tx *gorm.DB,
+	objectType string,
+	id uint,
+	propagation api_v0.DeletionPropagation,
+) (bool, error) {
+	return deleteDependents(tx, objectType, id, propagation, make(map[string]bool))
+}
+
+// deleteDependents applies the deletion propagation policy to an object's
+// dependents.  Objects that have already been visited are skipped so that
+// ownership cycles don't cause infinite recursion.
+func deleteDependents(
+	tx *gorm.DB,
+	objectType string,
+	id uint,
+	propagation api_v0.DeletionPropagation,
+	visited map[string]bool,
+) (bool, error) {
+	visited[objectKey(objectType, id)] = true
+
+	dependents, err := getDependents(tx, objectType, id)
+	if err != nil {
+		return false, err
+	}
+
+	for _, dep := range dependents {
+		if visited[objectKey(dep.objectType, dep.id)] {
+			continue
+		}
+
+		owners := dep.object.(ownedObject).GetOwnerReferences()
+		if propagation == api_v0.DeletionPropagationOrphan || len(owners) > 1 {
+			if err := removeOwnerReference(tx, dep, objectType, id); err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		if err := deleteDependent(tx, dep, propagation, visited); err != nil {
+			return false, err
+		}
+	}
+
+	if propagation != api_v0.DeletionPropagationForeground {
+		return false, nil
+	}
+
+	remaining, err := countDependents(tx, objectType, id)
+	if err != nil {
+		return false, err
+	}
+
+	return remaining > 0, nil
+}
+
+// deleteDependent deletes a dependent of an object that is being deleted.
+// Dependents that are reconciled by a controller are scheduled for deletion.
+func deleteDependent(
+	tx *gorm.DB,
+	dep dependent,
+	propagation api_v0.DeletionPropagation,
+	visited map[string]bool,
+) error {
+	subjects, reconciled := reconciledObjectSubjects[dep.objectType]
+	if !reconciled {
+		// objects without finalizers can't wait for their dependents
+		if _, err := deleteDependents(
+			tx,
+			dep.objectType,
+			dep.id,
+			api_v0.DeletionPropagationBackground,
+			visited,
+		); err != nil {
+			return err
+		}
+		if result := tx.Delete(dep.object); result.Error != nil {
+			return fmt.Errorf("failed to delete %s with ID %d: %w", dep.objectType, dep.id, result.Error)
+		}
+		return nil
+	}
+
+	// objects already scheduled for deletion are being deleted by their
+	// controllers
+	objectValue := reflect.ValueOf(dep.object).Elem()
+	if scheduled, _ := objectValue.FieldByName("DeletionScheduled").Interface().(*time.Time); scheduled != nil {
+		return nil
+	}
+
+	waitForDependents, err := deleteDependents(tx, dep.objectType, dep.id, propagation, visited)
+	if err != nil {
+		return err
+	}
+
+	resourceVersion, _ := objectValue.FieldByName("ResourceVersion").Interface().(*uint64)
+	scheduled := map[string]interface{}{
+		"Reconciled":        false,
+		"DeletionScheduled": tx.NowFunc().UTC(),
+		"ResourceVersion":   NextResourceVersion(resourceVersion),
+	}
+	if waitForDependents {
+		finalizers := append(
+			append([]string{}, dep.object.(finalizedObject).GetFinalizers()...),
+			api_v0.FinalizerForegroundDeletion,
+		)
+		scheduled["Finalizers"] = datatypes.JSONSlice[string](finalizers)
+	}
+	if result := tx.Model(dep.object).Updates(scheduled); result.Error != nil {
+		return fmt.Errorf("failed to schedule deletion of %s with ID %d: %w", dep.objectType, dep.id, result.Error)
+	}
+
+	if waitForDependents {
+		return nil
+	}
+
+	return queueDeleteNotification(tx, dep.object, subjects.delete)
+}
+
+// ReleaseOwners is called when an object is removed from the database.  The
+// owners of the object that are waiting for their dependents to be deleted
+// have the foreground deletion finalizer removed and their controllers are
+// notified once they have no remaining dependents.  The caller should signal
+// the outbox relay once the transaction is committed.
+func ReleaseOwners(tx *gorm.DB, ownerReferences []api_v0.OwnerReference) error {
+	for _, ownerReference := range ownerReferences {
+		subjects, reconciled := reconciledObjectSubjects[ownerReference.ObjectType]
+		if !reconciled {
+			continue
+		}
+		model, found := objectModel(ownerReference.ObjectType)
+		if !found {
+			continue
+		}
+
+		owner := reflect.New(reflect.TypeOf(model).Elem()).Interface()
+		if result := tx.First(owner, ownerReference.ID); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return fmt.Errorf(
+				"failed to get owner %s with ID %d: %w",
+				ownerReference.ObjectType,
+				ownerReference.ID,
+				result.Error,
+			)
+		}
+		finalized, ok := owner.(finalizedObject)
+		if !ok || !finalized.HasFinalizer(api_v0.FinalizerForegroundDeletion) {
+			continue
+		}
+
+		remaining, err := countDependents(tx, ownerReference.ObjectType, ownerReference.ID)
+		if err != nil {
+			return err
+		}
+		if remaining > 0 {
+			continue
+		}
+
+		finalizers := []string{}
+		for _, finalizer := range finalized.GetFinalizers() {
+			if finalizer != api_v0.FinalizerForegroundDeletion {
+				finalizers = append(finalizers, finalizer)
+			}
+		}
+		resourceVersion, _ := reflect.ValueOf(owner).Elem().FieldByName("ResourceVersion").Interface().(*uint64)
+		if result := tx.Model(owner).Updates(map[string]interface{}{
+			"Finalizers":      datatypes.JSONSlice[string](finalizers),
+			"ResourceVersion": NextResourceVersion(resourceVersion),
+		}); result.Error != nil {
+			return fmt.Errorf(
+				"failed to remove foreground deletion finalizer from %s with ID %d: %w",
+				ownerReference.ObjectType,
+				ownerReference.ID,
+				result.Error,
+			)
+		}
+
+		if err := queueDeleteNotification(tx, owner, subjects.delete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteFinalized removes an object that is being deleted from the database
+// once its controller has confirmed the deletion and all of its finalizers
+// have been removed.  It is called after an object is updated and returns
+// true if the object was deleted.  The caller should signal the outbox relay
+// once the transaction is committed.
+func DeleteFinalized(tx *gorm.DB, object interface{}, id uint) (bool, error) {
+	current := reflect.New(reflect.TypeOf(object).Elem()).Interface()
+	if result := tx.First(current, id); result.Error != nil {
+		return false, result.Error
+	}
+
+	confirmed, _ := reflect.ValueOf(current).Elem().FieldByName("DeletionConfirmed").Interface().(*time.Time)
+	if confirmed == nil {
+		return false, nil
+	}
+	if finalized, ok := current.(finalizedObject); !ok || len(finalized.GetFinalizers()) > 0 {
+		return false, nil
+	}
+
+	if result := tx.Delete(current); result.Error != nil {
+		return false, result.Error
+	}
+
+	if owned, ok := current.(ownedObject); ok {
+		if err := ReleaseOwners(tx, owned.GetOwnerReferences()); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// getDependents returns the objects of every registered type that are owned
+// by the object with the given type and ID.
+func getDependents(tx *gorm.DB, objectType string, id uint) ([]dependent, error) {
+	ownerFilter, err := ownerReferenceFilter(objectType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []dependent
+	found := make(map[string]bool)
+	for _, authzObject := range AuthzObjects {
+		if !hasOwnerReferences(authzObject.Model) {
+			continue
+		}
+		records := reflect.New(reflect.SliceOf(reflect.TypeOf(authzObject.Model).Elem()))
+		if result := tx.Model(authzObject.Model).Where(
+			"owner_references @> ?::jsonb", ownerFilter,
+		).Find(records.Interface()); result.Error != nil {
+			return nil, fmt.Errorf("failed to get dependent %s objects: %w", authzObject.ObjectType, result.Error)
+		}
+
+		for i := 0; i < records.Elem().Len(); i++ {
+			record := records.Elem().Index(i).Addr().Interface()
+			recordID, _ := records.Elem().Index(i).FieldByName("ID").Interface().(*uint)
+			// objects served at more than one path are only included once
+			if recordID == nil || found[objectKey(authzObject.ObjectType, *recordID)] {
+				continue
+			}
+			found[objectKey(authzObject.ObjectType, *recordID)] = true
+			dependents = append(dependents, dependent{
+				objectType: authzObject.ObjectType,
+				id:         *recordID,
+				object:     record,
+			})
+		}
+	}
+
+	return dependents, nil
+}
+
+// countDependents returns the number of objects that are owned by the object
+// with the given type and ID.
+func countDependents(tx *gorm.DB, objectType string, id uint) (int64, error) {
+	ownerFilter, err := ownerReferenceFilter(objectType, id)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, authzObject := range AuthzObjects {
+		if !hasOwnerReferences(authzObject.Model) {
+			continue
+		}
+		var count int64
+		if result := tx.Model(authzObject.Model).Where(
+			"owner_references @> ?::jsonb", ownerFilter,
+		).Count(&count); result.Error != nil {
+			return 0, fmt.Errorf("failed to count dependent %s objects: %w", authzObject.ObjectType, result.Error)
+		}
+		total += count
+	}
+
+	return total, nil
+}
+
+// removeOwnerReference removes the reference to an owner that is being
+// deleted from a dependent so that the dependent is not deleted with it.
+func removeOwnerReference(tx *gorm.DB, dep dependent, objectType string, id uint) error {
+	ownerReferences := []api_v0.OwnerReference{}
+	for _, ownerReference := range dep.object.(ownedObject).GetOwnerReferences() {
+		if ownerReference.ObjectType == objectType && ownerReference.ID == id {
+			continue
+		}
+		ownerReferences = append(ownerReferences, ownerReference)
+	}
+
+	resourceVersion, _ := reflect.ValueOf(dep.object).Elem().FieldByName("ResourceVersion").Interface().(*uint64)
+	if result := tx.Model(dep.object).Updates(map[string]interface{}{
+		"OwnerReferences": datatypes.JSONSlice[api_v0.OwnerReference](ownerReferences),
+		"ResourceVersion": NextResourceVersion(resourceVersion),
+	}); result.Error != nil {
+		return fmt.Errorf(
+			"failed to remove owner reference from %s with ID %d: %w",
+			dep.objectType,
+			dep.id,
+			result.Error,
+		)
+	}
+
+	return nil
+}
+
+// queueDeleteNotification adds a notification to the outbox so that an
+// object's controller reconciles its deletion.
+func queueDeleteNotification(tx *gorm.DB, object interface{}, deleteSubject string) error {
+	payloader, ok := object.(notificationPayloader)
+	if !ok {
+		return fmt.Errorf("object of type %T does not provide notification payloads", object)
+	}
+	notifPayload, err := payloader.NotificationPayload(
+		notifications.NotificationOperationDeleted,
+		false,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return AddOutboxNotification(tx, deleteSubject, *notifPayload)
+}
+
+// ownerReferenceFilter returns the JSON used to find objects that include a
+// reference to the owner with the given type and ID.
+func ownerReferenceFilter(objectType string, id uint) (string, error) {
+	filter, err := json.Marshal([]map[string]interface{}{
+		{
+			"ObjectType": objectType,
+			"ID":         id,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal owner reference filter: %w", err)
+	}
+
+	return string(filter), nil
+}
+
+// objectModel returns the model registered for an object type.
+func objectModel(objectType string) (interface{}, bool) {
+	for _, authzObject := range AuthzObjects {
+		if authzObject.ObjectType == objectType {
+			return authzObject.Model, true
+		}
+	}
+
+	return nil, false
+}
+
+// hasOwnerReferences returns true if the model's objects can have owners.
+func hasOwnerReferences(model interface{}) bool {
+	_, found := reflect.TypeOf(model).Elem().FieldByName("OwnerReferences")
+	return found
+}
+
+// objectKey returns a key that uniquely identifies an object.
+func objectKey(objectType string, id uint) string {
+	return fmt.Sprintf("%s/%d", objectType, id)
+}
@@ -0,0 +1,386 @@
+package v0
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+)
+
+const (
+	// ownerTestObjectType is the type of the objects that own other objects
+	// in tests.
+	ownerTestObjectType = "OwnerTestObject"
+
+	// ownedTestObjectType is the type of the objects owned by other objects
+	// in tests.
+	ownedTestObjectType = "OwnedTestObject"
+)
+
+// ownerTestObject is a reconciled object that owns other objects.
+type ownerTestObject struct {
+	ID              *uint `gorm:"primarykey"`
+	ResourceVersion *uint64
+	api_v0.Reconciliation
+}
+
+// NotificationPayload returns the object as the payload of a notification
+// to its controller.
+func (o *ownerTestObject) NotificationPayload(
+	operation notifications.NotificationOperation,
+	requeue bool,
+	creationTime int64,
+) (*[]byte, error) {
+	payload, err := json.Marshal(o)
+	return &payload, err
+}
+
+// ownedTestObject is an object with owner references.
+type ownedTestObject struct {
+	api_v0.Common
+	api_v0.Reconciliation
+}
+
+// NotificationPayload returns the object as the payload of a notification
+// to its controller.
+func (o *ownedTestObject) NotificationPayload(
+	operation notifications.NotificationOperation,
+	requeue bool,
+	creationTime int64,
+) (*[]byte, error) {
+	payload, err := json.Marshal(o)
+	return &payload, err
+}
+
+// registerOwnerTestObjects registers the owner and owned test objects in
+// place of the objects registered by the API server, and registers the owned
+// objects as reconciled if ownedReconciled is true.
+func registerOwnerTestObjects(t *testing.T, ownedReconciled bool) {
+	authzObjects, reconciledObjects := AuthzObjects, reconciledObjectSubjects
+	t.Cleanup(func() { AuthzObjects, reconciledObjectSubjects = authzObjects, reconciledObjects })
+
+	AuthzObjects = make(map[string]AuthzObject)
+	reconciledObjectSubjects = make(map[string]reconciledObjectSubject)
+	AddAuthzObject("/v0/owner-test-objects", ownerTestObjectType, &ownerTestObject{})
+	AddAuthzObject("/v0/owned-test-objects", ownedTestObjectType, &ownedTestObject{})
+	AddReconciledObject(ownerTestObjectType, "ownerTestObject.created", "ownerTestObject.deleted")
+	if ownedReconciled {
+		AddReconciledObject(ownedTestObjectType, "ownedTestObject.created", "ownedTestObject.deleted")
+	}
+}
+
+// newOwnerTestDB returns a database backed by a mock that doesn't wrap each
+// write in a transaction, as the functions tested are called within the
+// caller's transaction.
+func newOwnerTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock := newMockTestDB(t)
+	return db.Session(&gorm.Session{SkipDefaultTransaction: true}), mock
+}
+
+// ownerTestReferences returns the JSON owner references to the owners with
+// the IDs.
+func ownerTestReferences(ids ...uint) string {
+	ownerReferences := []api_v0.OwnerReference{}
+	for _, id := range ids {
+		ownerReferences = append(ownerReferences, api_v0.OwnerReference{ObjectType: ownerTestObjectType, ID: id})
+	}
+	ownerReferencesJson, _ := json.Marshal(ownerReferences)
+
+	return string(ownerReferencesJson)
+}
+
+// ownedTestRows returns the rows for an owned object.
+func ownedTestRows(id uint, ownerReferences string, deletionScheduled interface{}) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "resource_version", "owner_references", "deletion_scheduled"}).
+		AddRow(id, 1, ownerReferences, deletionScheduled)
+}
+
+// expectDependents expects a query for the objects owned by the object and
+// returns the rows.
+func expectDependents(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	if rows == nil {
+		rows = sqlmock.NewRows([]string{"id"})
+	}
+	mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE owner_references @> \$1::jsonb`).
+		WillReturnRows(rows)
+}
+
+// expectCountDependents expects the objects owned by an object to be
+// counted.
+func expectCountDependents(mock sqlmock.Sqlmock, count int) {
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "owned_test_objects" WHERE owner_references @> \$1::jsonb`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+// expectDeleteNotification expects a delete notification to be added to the
+// outbox for the subject.
+func expectDeleteNotification(mock sqlmock.Sqlmock, subject string) {
+	mock.ExpectQuery(`INSERT INTO "v0_outbox_notifications"`).
+		WithArgs(append(append(anyArgs(7), subject), anyArgs(7)...)...).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+}
+
+// anyArgs returns count arguments that match any value.
+func anyArgs(count int) []driver.Value {
+	args := make([]driver.Value, count)
+	for i := range args {
+		args[i] = sqlmock.AnyArg()
+	}
+
+	return args
+}
+
+// TestDeleteDependents tests that the deletion propagation policy is applied
+// to the dependents of an object that is being deleted and that objects
+// deleted in the foreground wait for their reconciled dependents.
+func TestDeleteDependents(t *testing.T) {
+	testCases := []struct {
+		name            string
+		propagation     api_v0.DeletionPropagation
+		ownedReconciled bool
+		expect          func(mock sqlmock.Sqlmock)
+		wait            bool
+	}{
+		{
+			name:        "no dependents",
+			propagation: api_v0.DeletionPropagationForeground,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, nil)
+				expectCountDependents(mock, 0)
+			},
+		},
+		{
+			name:        "orphaned dependent",
+			propagation: api_v0.DeletionPropagationOrphan,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, ownedTestRows(2, ownerTestReferences(1), nil))
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "owner_references"=\$1,"resource_version"=\$2`).
+					WithArgs("[]", 2, sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:        "dependent with other owners",
+			propagation: api_v0.DeletionPropagationBackground,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, ownedTestRows(2, ownerTestReferences(1, 3), nil))
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "owner_references"=\$1,"resource_version"=\$2`).
+					WithArgs(ownerTestReferences(3), 2, sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:        "dependent deleted in background",
+			propagation: api_v0.DeletionPropagationBackground,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, ownedTestRows(2, ownerTestReferences(1), nil))
+				expectDependents(mock, nil)
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "deleted_at"=\$1 WHERE "owned_test_objects"."id" = \$2`).
+					WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:            "reconciled dependent scheduled for deletion in background",
+			propagation:     api_v0.DeletionPropagationBackground,
+			ownedReconciled: true,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, ownedTestRows(2, ownerTestReferences(1), nil))
+				expectDependents(mock, nil)
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "deletion_scheduled"=\$1,"reconciled"=\$2,"resource_version"=\$3`).
+					WithArgs(sqlmock.AnyArg(), false, 2, sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDeleteNotification(mock, "ownedTestObject.deleted")
+			},
+		},
+		{
+			name:            "reconciled dependent scheduled for deletion in foreground",
+			propagation:     api_v0.DeletionPropagationForeground,
+			ownedReconciled: true,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, ownedTestRows(2, ownerTestReferences(1), nil))
+				expectDependents(mock, nil)
+				expectCountDependents(mock, 0)
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "deletion_scheduled"=\$1,"reconciled"=\$2,"resource_version"=\$3`).
+					WithArgs(sqlmock.AnyArg(), false, 2, sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDeleteNotification(mock, "ownedTestObject.deleted")
+				expectCountDependents(mock, 1)
+			},
+			wait: true,
+		},
+		{
+			name:            "reconciled dependent already scheduled for deletion",
+			propagation:     api_v0.DeletionPropagationForeground,
+			ownedReconciled: true,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDependents(mock, ownedTestRows(2, ownerTestReferences(1), time.Now()))
+				expectCountDependents(mock, 1)
+			},
+			wait: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registerOwnerTestObjects(t, tc.ownedReconciled)
+			db, mock := newOwnerTestDB(t)
+			tc.expect(mock)
+
+			wait, err := DeleteDependents(db, ownerTestObjectType, 1, tc.propagation)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wait, wait)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestReleaseOwners tests that owners waiting for their dependents to be
+// deleted have the foreground deletion finalizer removed and their
+// controllers notified once their last dependent is deleted.
+func TestReleaseOwners(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ownerReferences []api_v0.OwnerReference
+		expect          func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:            "owner not reconciled",
+			ownerReferences: []api_v0.OwnerReference{{ObjectType: ownedTestObjectType, ID: 1}},
+			expect:          func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:            "owner already deleted",
+			ownerReferences: []api_v0.OwnerReference{{ObjectType: ownerTestObjectType, ID: 1}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owner_test_objects" WHERE "owner_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name:            "owner not waiting for dependents",
+			ownerReferences: []api_v0.OwnerReference{{ObjectType: ownerTestObjectType, ID: 1}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owner_test_objects" WHERE "owner_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_version", "finalizers"}).
+						AddRow(1, 4, `["threeport.io/test"]`))
+			},
+		},
+		{
+			name:            "owner with remaining dependents",
+			ownerReferences: []api_v0.OwnerReference{{ObjectType: ownerTestObjectType, ID: 1}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owner_test_objects" WHERE "owner_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_version", "finalizers"}).
+						AddRow(1, 4, `["threeport.io/foreground-deletion"]`))
+				expectCountDependents(mock, 1)
+			},
+		},
+		{
+			name:            "owner released",
+			ownerReferences: []api_v0.OwnerReference{{ObjectType: ownerTestObjectType, ID: 1}},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owner_test_objects" WHERE "owner_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_version", "finalizers"}).
+						AddRow(1, 4, `["threeport.io/test","threeport.io/foreground-deletion"]`))
+				expectCountDependents(mock, 0)
+				mock.ExpectExec(`UPDATE "owner_test_objects" SET "finalizers"=\$1,"resource_version"=\$2 WHERE "id" = \$3`).
+					WithArgs(`["threeport.io/test"]`, 5, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDeleteNotification(mock, "ownerTestObject.deleted")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registerOwnerTestObjects(t, false)
+			db, mock := newOwnerTestDB(t)
+			tc.expect(mock)
+
+			require.NoError(t, ReleaseOwners(db, tc.ownerReferences))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestDeleteFinalized tests that objects are only removed from the database
+// once their deletion is confirmed and their finalizers are removed, and
+// that their owners are released when they are removed.
+func TestDeleteFinalized(t *testing.T) {
+	columns := []string{"id", "resource_version", "owner_references", "deletion_confirmed", "finalizers"}
+
+	testCases := []struct {
+		name    string
+		expect  func(mock sqlmock.Sqlmock)
+		deleted bool
+	}{
+		{
+			name: "deletion not confirmed",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE "owned_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 3, nil, nil, `[]`))
+			},
+		},
+		{
+			name: "finalizers remaining",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE "owned_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 3, nil, time.Now(), `["threeport.io/test"]`))
+			},
+		},
+		{
+			name: "deleted",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE "owned_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 3, nil, time.Now(), `[]`))
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "deleted_at"=\$1 WHERE "owned_test_objects"."id" = \$2`).
+					WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			deleted: true,
+		},
+		{
+			name: "deleted and owner released",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "owned_test_objects" WHERE "owned_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 3, ownerTestReferences(1), time.Now(), `[]`))
+				mock.ExpectExec(`UPDATE "owned_test_objects" SET "deleted_at"=\$1 WHERE "owned_test_objects"."id" = \$2`).
+					WithArgs(sqlmock.AnyArg(), 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT \* FROM "owner_test_objects" WHERE "owner_test_objects"."id" = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_version", "finalizers"}).
+						AddRow(1, 4, `["threeport.io/foreground-deletion"]`))
+				expectCountDependents(mock, 0)
+				mock.ExpectExec(`UPDATE "owner_test_objects" SET "finalizers"=\$1,"resource_version"=\$2 WHERE "id" = \$3`).
+					WithArgs(`[]`, 5, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDeleteNotification(mock, "ownerTestObject.deleted")
+			},
+			deleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registerOwnerTestObjects(t, true)
+			db, mock := newOwnerTestDB(t)
+			tc.expect(mock)
+
+			deleted, err := DeleteFinalized(db, &ownedTestObject{}, 2)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.deleted, deleted)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
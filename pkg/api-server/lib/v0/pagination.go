@@ -0,0 +1,253 @@
+package v0
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	QueryParamContinue               = "continue"
+	QueryParamSkipCount              = "skipCount"
+	TotalCountSkipped                = -1
+	ErrMsgInvalidContinueToken       = "Query parameter is not a valid continue token: " + QueryParamContinue
+	ErrMsgQueryParamInvalidSkipCount = "Query parameter is not a valid boolean value: " + QueryParamSkipCount
+)
+
+// keyset columns that continue tokens can paginate on
+const (
+	keysetColumnId        = "id"
+	keysetColumnCreatedAt = "created_at"
+)
+
+// ContinueToken is the position in a list of objects from which the next page
+// begins.  It is encoded as an opaque string for API clients.
+type ContinueToken struct {
+	// The column the objects are ordered by.
+	Column string `json:"c"`
+
+	// True if the objects are in descending order.
+	Descending bool `json:"d,omitempty"`
+
+	// The ID of the last object on the previous page.
+	ID uint `json:"i"`
+
+	// The creation time of the last object on the previous page.  Only set
+	// when ordering by creation time.
+	CreatedAt *time.Time `json:"t,omitempty"`
+}
+
+// Encode returns the opaque string representation of a continue token.
+func (t *ContinueToken) Encode() (string, error) {
+	tokenJson, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal continue token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(tokenJson), nil
+}
+
+// DecodeContinueToken parses the opaque string representation of a continue
+// token.
+func DecodeContinueToken(token string) (*ContinueToken, error) {
+	tokenJson, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode continue token: %w", err)
+	}
+
+	var continueToken ContinueToken
+	if err := json.Unmarshal(tokenJson, &continueToken); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal continue token: %w", err)
+	}
+	if continueToken.Column != keysetColumnId && continueToken.Column != keysetColumnCreatedAt {
+		return nil, fmt.Errorf("unsupported continue token column %s", continueToken.Column)
+	}
+	if continueToken.Column == keysetColumnCreatedAt && continueToken.CreatedAt == nil {
+		return nil, errors.New("continue token is missing creation time")
+	}
+
+	return &continueToken, nil
+}
+
+// CursorParams are the keyset pagination options for a list request.  Keyset
+// pagination is only available when objects are ordered by ID or CreatedAt.
+type CursorParams struct {
+	// The position to continue from.  Nil for the first page.
+	Continue *ContinueToken
+
+	// If true, the total number of matching objects is not counted.
+	SkipCount bool
+
+	// The column the objects are ordered by if it supports keyset
+	// pagination.  Empty if it does not.
+	column string
+
+	// True if the objects are in descending order.
+	descending bool
+}
+
+// GetCursorParams parses the continue and skipCount query parameters for a
+// list request.  When the requested order supports keyset pagination, the
+// list query options are updated so that the order is deterministic and the
+// columns needed to build the next continue token are selected.  A continue
+// token may only be used with the order it was issued for.
+func (c *CustomContext) GetCursorParams(options *ListQueryOptions) (*CursorParams, error) {
+	var params CursorParams
+
+	if skipCount := c.QueryParam(QueryParamSkipCount); skipCount != "" {
+		skip, err := strconv.ParseBool(skipCount)
+		if err != nil {
+			return nil, errors.New(ErrMsgQueryParamInvalidSkipCount)
+		}
+		params.SkipCount = skip
+	}
+
+	params.column, params.descending = options.keyset()
+	switch params.column {
+	case keysetColumnId:
+		if len(options.Fields) > 0 {
+			options.Fields = appendUniqueColumn(options.Fields, keysetColumnId)
+		}
+	case keysetColumnCreatedAt:
+		options.Order = append(options.Order, fmt.Sprintf("%s %s", keysetColumnId, orderDirection(params.descending)))
+		if len(options.Fields) > 0 {
+			options.Fields = appendUniqueColumn(options.Fields, keysetColumnCreatedAt)
+		}
+	}
+
+	token := c.QueryParam(QueryParamContinue)
+	if token == "" {
+		return &params, nil
+	}
+
+	continueToken, err := DecodeContinueToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("%s : %w", ErrMsgInvalidContinueToken, err)
+	}
+	if params.column == "" {
+		return nil, fmt.Errorf(
+			"%s : continue tokens require objects to be sorted by ID or CreatedAt",
+			ErrMsgInvalidContinueToken,
+		)
+	}
+	if continueToken.Column != params.column || continueToken.Descending != params.descending {
+		return nil, fmt.Errorf(
+			"%s : continue token was issued for a different sort order",
+			ErrMsgInvalidContinueToken,
+		)
+	}
+	params.Continue = continueToken
+
+	return &params, nil
+}
+
+// PageScope returns a GORM scope that limits a query to a single page of
+// objects.  If a continue token was provided, the page begins after the
+// position in the token and the page number is ignored.  Otherwise, the page
+// number is used as an offset.
+func (p *CursorParams) PageScope(params PageRequestParams) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Limit(params.Size)
+		if p.Continue == nil {
+			return db.Offset((params.Page - 1) * params.Size)
+		}
+
+		comparison := ">"
+		if p.descending {
+			comparison = "<"
+		}
+		if p.column == keysetColumnCreatedAt {
+			return db.Where(
+				fmt.Sprintf("(created_at, id) %s (?, ?)", comparison),
+				*p.Continue.CreatedAt,
+				p.Continue.ID,
+			)
+		}
+
+		return db.Where(fmt.Sprintf("id %s ?", comparison), p.Continue.ID)
+	}
+}
+
+// NextContinueToken returns the continue token for the page that follows the
+// provided records.  It returns an empty string if the page was not full,
+// since there are no further objects, or if the order of the objects does not
+// support keyset pagination.
+func (p *CursorParams) NextContinueToken(records interface{}, params PageRequestParams) (string, error) {
+	if p.column == "" || params.Size <= 0 {
+		return "", nil
+	}
+
+	recordsValue := reflect.Indirect(reflect.ValueOf(records))
+	if recordsValue.Kind() != reflect.Slice || recordsValue.Len() < params.Size {
+		return "", nil
+	}
+	last := recordsValue.Index(recordsValue.Len() - 1)
+
+	continueToken := ContinueToken{
+		Column:     p.column,
+		Descending: p.descending,
+	}
+	id, ok := last.FieldByName("ID").Interface().(*uint)
+	if !ok || id == nil {
+		return "", errors.New("failed to get ID of last object for continue token")
+	}
+	continueToken.ID = *id
+	if p.column == keysetColumnCreatedAt {
+		createdAt, ok := last.FieldByName("CreatedAt").Interface().(*time.Time)
+		if !ok || createdAt == nil {
+			return "", errors.New("failed to get creation time of last object for continue token")
+		}
+		continueToken.CreatedAt = createdAt
+	}
+
+	return continueToken.Encode()
+}
+
+// keyset returns the column and direction of the requested order if it
+// supports keyset pagination.  It returns an empty column if it does not.
+func (o *ListQueryOptions) keyset() (string, bool) {
+	if len(o.Order) == 0 {
+		return keysetColumnId, false
+	}
+	if len(o.Order) > 1 {
+		return "", false
+	}
+
+	for _, column := range []string{keysetColumnId, keysetColumnCreatedAt} {
+		switch o.Order[0] {
+		case fmt.Sprintf("%s asc", column):
+			return column, false
+		case fmt.Sprintf("%s desc", column):
+			return column, true
+		}
+	}
+
+	return "", false
+}
+
+// orderDirection returns the SQL keyword for a sort direction.
+func orderDirection(descending bool) string {
+	if descending {
+		return "desc"
+	}
+
+	return "asc"
+}
+
+// appendUniqueColumn adds a column to a list of columns if not already
+// present.
+func appendUniqueColumn(columns []string, column string) []string {
+	for _, existing := range columns {
+		if existing == column {
+			return columns
+		}
+	}
+
+	return append(columns, column)
+}
@@ -0,0 +1,158 @@
+package v0
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContinueToken tests that continue tokens decode to the position they
+// were encoded with and that invalid tokens are rejected.
+func TestContinueToken(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		token   *ContinueToken
+		encoded string
+		wantErr bool
+	}{
+		{
+			name:  "ID ascending",
+			token: &ContinueToken{Column: keysetColumnId, ID: 42},
+		},
+		{
+			name:  "creation time descending",
+			token: &ContinueToken{Column: keysetColumnCreatedAt, Descending: true, ID: 7, CreatedAt: &createdAt},
+		},
+		{
+			name:    "not base64",
+			encoded: "not a token!",
+			wantErr: true,
+		},
+		{
+			name:    "not json",
+			encoded: base64.RawURLEncoding.EncodeToString([]byte("not json")),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported column",
+			encoded: base64.RawURLEncoding.EncodeToString([]byte(`{"c":"name","i":1}`)),
+			wantErr: true,
+		},
+		{
+			name:    "creation time missing",
+			encoded: base64.RawURLEncoding.EncodeToString([]byte(`{"c":"created_at","i":1}`)),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded := tc.encoded
+			if tc.token != nil {
+				var err error
+				encoded, err = tc.token.Encode()
+				require.NoError(t, err)
+			}
+
+			decoded, err := DecodeContinueToken(encoded)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.token, decoded)
+		})
+	}
+}
+
+// TestGetCursorParams tests that continue tokens are only accepted for the
+// order they were issued for and that the order is made deterministic when
+// paginating by creation time.
+func TestGetCursorParams(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	encode := func(token ContinueToken) string {
+		encoded, err := token.Encode()
+		require.NoError(t, err)
+		return encoded
+	}
+
+	testCases := []struct {
+		name        string
+		queryString string
+		order       []string
+		wantOrder   []string
+		wantErr     bool
+	}{
+		{
+			name:        "first page by ID",
+			queryString: "",
+		},
+		{
+			name:        "next page by ID",
+			queryString: "continue=" + encode(ContinueToken{Column: keysetColumnId, ID: 10}),
+		},
+		{
+			name:        "next page by creation time",
+			queryString: "continue=" + encode(ContinueToken{Column: keysetColumnCreatedAt, Descending: true, ID: 10, CreatedAt: &createdAt}),
+			order:       []string{"created_at desc"},
+			wantOrder:   []string{"created_at desc", "id desc"},
+		},
+		{
+			name:        "token for a different direction",
+			queryString: "continue=" + encode(ContinueToken{Column: keysetColumnId, ID: 10}),
+			order:       []string{"id desc"},
+			wantErr:     true,
+		},
+		{
+			name:        "token with an order that doesn't support keyset pagination",
+			queryString: "continue=" + encode(ContinueToken{Column: keysetColumnId, ID: 10}),
+			order:       []string{"name asc"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid skip count",
+			queryString: "skipCount=maybe",
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := ListQueryOptions{Order: tc.order}
+			params, err := newQueryTestContext(tc.queryString).GetCursorParams(&options)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, strings.HasPrefix(tc.queryString, QueryParamContinue), params.Continue != nil)
+			if tc.wantOrder != nil {
+				assert.Equal(t, tc.wantOrder, options.Order)
+			}
+		})
+	}
+}
+
+// TestNextContinueToken tests that a continue token is only returned for a
+// full page of objects and that it holds the position of the last object.
+func TestNextContinueToken(t *testing.T) {
+	ids := []uint{1, 2, 3}
+	records := []queryTestObject{{ID: &ids[0]}, {ID: &ids[1]}, {ID: &ids[2]}}
+	params := CursorParams{column: keysetColumnId}
+
+	token, err := params.NextContinueToken(&records, PageRequestParams{Size: 3})
+	require.NoError(t, err)
+	decoded, err := DecodeContinueToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), decoded.ID)
+
+	token, err = params.NextContinueToken(&records, PageRequestParams{Size: 4})
+	require.NoError(t, err)
+	assert.Empty(t, token, "no continue token should be returned for the last page")
+}
@@ -0,0 +1,210 @@
+package v0
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	QueryParamSort         = "sort"
+	QueryParamFields       = "fields"
+	ErrMsgInvalidListQuery = "Query parameters for list are not valid"
+)
+
+// FilterOperator is a comparison used to filter objects by a field value in
+// list requests, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
+type FilterOperator string
+
+const (
+	FilterOpEquals             FilterOperator = "eq"
+	FilterOpNotEquals          FilterOperator = "ne"
+	FilterOpGreaterThan        FilterOperator = "gt"
+	FilterOpGreaterThanOrEqual FilterOperator = "gte"
+	FilterOpLessThan           FilterOperator = "lt"
+	FilterOpLessThanOrEqual    FilterOperator = "lte"
+	FilterOpLike               FilterOperator = "like"
+	FilterOpIn                 FilterOperator = "in"
+	FilterOpNotIn              FilterOperator = "notin"
+)
+
+// filterOperatorConditions maps filter operators to SQL conditions for a
+// column.
+var filterOperatorConditions = map[FilterOperator]string{
+	FilterOpEquals:             "%s = ?",
+	FilterOpNotEquals:          "%s <> ?",
+	FilterOpGreaterThan:        "%s > ?",
+	FilterOpGreaterThanOrEqual: "%s >= ?",
+	FilterOpLessThan:           "%s < ?",
+	FilterOpLessThanOrEqual:    "%s <= ?",
+	FilterOpLike:               "%s LIKE ?",
+	FilterOpIn:                 "%s IN ?",
+	FilterOpNotIn:              "%s NOT IN ?",
+}
+
+// filterParamRegex matches query parameters with a filter operator, e.g.
+// Name[like].
+var filterParamRegex = regexp.MustCompile(`^([A-Za-z0-9_]+)\[([a-z]+)\]$`)
+
+// ListQueryOptions are the sorting, sparse fieldset and filtering options
+// requested for a list of objects.  Field names are resolved to database
+// columns so they are safe to include in queries.
+type ListQueryOptions struct {
+	// The columns to order by with the direction, e.g. "created_at desc".
+	Order []string
+
+	// The columns to return.  All columns are returned if empty.
+	Fields []string
+
+	// The filter conditions and the values for each.
+	Filters []ListQueryFilter
+}
+
+// ListQueryFilter is a single filter condition for a list of objects.
+type ListQueryFilter struct {
+	Condition string
+	Value     interface{}
+}
+
+// GetListQueryOptions parses the sort, fields and filter operator query
+// parameters for a list request.  Field names are those of the provided
+// model, e.g. CreatedAt.  The syntax for each is:
+//   - sort=-CreatedAt,Name: order by CreatedAt descending, then Name ascending
+//   - fields=ID,Name,Status: only return the ID, Name and Status fields
+//   - Field[op]=value: filter by field value where op is one of eq, ne, gt,
+//     gte, lt, lte, like, in and notin.  The in and notin operators take a
+//     comma-separated list of values.
+func (c *CustomContext) GetListQueryOptions(db *gorm.DB, model interface{}) (*ListQueryOptions, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return nil, fmt.Errorf("failed to parse object schema: %w", err)
+	}
+
+	var options ListQueryOptions
+
+	// sorting
+	for _, sortField := range splitQueryList(c.QueryParam(QueryParamSort)) {
+		direction := "asc"
+		if strings.HasPrefix(sortField, "-") {
+			direction = "desc"
+			sortField = strings.TrimPrefix(sortField, "-")
+		}
+		column, err := queryColumn(stmt.Schema, sortField)
+		if err != nil {
+			return nil, fmt.Errorf("%s : %s : %w", ErrMsgInvalidListQuery, QueryParamSort, err)
+		}
+		options.Order = append(options.Order, fmt.Sprintf("%s %s", column, direction))
+	}
+
+	// sparse fieldsets - the primary key is always included so that
+	// associations can be loaded
+	fields := splitQueryList(c.QueryParam(QueryParamFields))
+	if len(fields) > 0 && stmt.Schema.PrioritizedPrimaryField != nil {
+		options.Fields = append(options.Fields, stmt.Schema.PrioritizedPrimaryField.DBName)
+	}
+	for _, field := range fields {
+		column, err := queryColumn(stmt.Schema, field)
+		if err != nil {
+			return nil, fmt.Errorf("%s : %s : %w", ErrMsgInvalidListQuery, QueryParamFields, err)
+		}
+		if stmt.Schema.PrioritizedPrimaryField != nil &&
+			column == stmt.Schema.PrioritizedPrimaryField.DBName {
+			continue
+		}
+		options.Fields = append(options.Fields, column)
+	}
+
+	// filter operators
+	for param, values := range c.QueryParams() {
+		matches := filterParamRegex.FindStringSubmatch(param)
+		if matches == nil {
+			continue
+		}
+		column, err := queryColumn(stmt.Schema, matches[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s : %s : %w", ErrMsgInvalidListQuery, param, err)
+		}
+		operator := FilterOperator(matches[2])
+		condition, found := filterOperatorConditions[operator]
+		if !found {
+			return nil, fmt.Errorf("%s : %s : unsupported filter operator %s", ErrMsgInvalidListQuery, param, operator)
+		}
+		for _, value := range values {
+			filter := ListQueryFilter{Condition: fmt.Sprintf(condition, column)}
+			if operator == FilterOpIn || operator == FilterOpNotIn {
+				filter.Value = splitQueryList(value)
+			} else {
+				filter.Value = value
+			}
+			options.Filters = append(options.Filters, filter)
+		}
+	}
+
+	return &options, nil
+}
+
+// FilterScope returns a GORM scope that limits a query to the objects that
+// match the filters.
+func (o *ListQueryOptions) FilterScope() func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, filter := range o.Filters {
+			db = db.Where(filter.Condition, filter.Value)
+		}
+		return db
+	}
+}
+
+// SortScope returns a GORM scope that orders a query.  Objects are ordered by
+// ID after the requested order, if the requested order doesn't already
+// include it, so that objects with equal sort values are always returned in
+// the same order.
+func (o *ListQueryOptions) SortScope() func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		orderedById := false
+		for _, order := range o.Order {
+			if strings.Fields(order)[0] == keysetColumnId {
+				orderedById = true
+			}
+			db = db.Order(order)
+		}
+		if !orderedById {
+			db = db.Order("id asc")
+		}
+		return db
+	}
+}
+
+// FieldsScope returns a GORM scope that selects the requested fields.
+func (o *ListQueryOptions) FieldsScope() func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(o.Fields) == 0 {
+			return db
+		}
+		return db.Select(o.Fields)
+	}
+}
+
+// queryColumn returns the database column for an object's field.
+func queryColumn(objectSchema *schema.Schema, fieldName string) (string, error) {
+	field := objectSchema.LookUpField(fieldName)
+	if field == nil || field.DBName == "" {
+		return "", fmt.Errorf("unknown field %s", fieldName)
+	}
+
+	return field.DBName, nil
+}
+
+// splitQueryList splits a comma-separated query parameter value.
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,148 @@
+package v0
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// queryTestObject is the model list query options are resolved against.
+type queryTestObject struct {
+	ID        *uint   `gorm:"primarykey"`
+	Name      *string `gorm:"not null"`
+	CreatedAt *string
+}
+
+// newQueryTestDB returns a database that builds queries without connecting
+// to a database server.
+func newQueryTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+
+	return db
+}
+
+// newQueryTestContext returns a request context with the provided query
+// string.
+func newQueryTestContext(queryString string) *CustomContext {
+	request := httptest.NewRequest("GET", "/?"+queryString, nil)
+	return &CustomContext{echo.New().NewContext(request, httptest.NewRecorder())}
+}
+
+// TestGetListQueryOptions tests that sort, fields and filter query parameters
+// are resolved to database columns.
+func TestGetListQueryOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		queryString string
+		options     ListQueryOptions
+		wantErr     bool
+	}{
+		{
+			name:        "no options",
+			queryString: "",
+		},
+		{
+			name:        "sort ascending and descending",
+			queryString: "sort=-CreatedAt,Name",
+			options:     ListQueryOptions{Order: []string{"created_at desc", "name asc"}},
+		},
+		{
+			name:        "fields always include ID",
+			queryString: "fields=Name,ID",
+			options:     ListQueryOptions{Fields: []string{"id", "name"}},
+		},
+		{
+			name:        "equals filter",
+			queryString: "Name[eq]=test",
+			options: ListQueryOptions{Filters: []ListQueryFilter{
+				{Condition: "name = ?", Value: "test"},
+			}},
+		},
+		{
+			name:        "in filter",
+			queryString: "Name[in]=a,b",
+			options: ListQueryOptions{Filters: []ListQueryFilter{
+				{Condition: "name IN ?", Value: []string{"a", "b"}},
+			}},
+		},
+		{
+			name:        "unknown sort field",
+			queryString: "sort=Unknown",
+			wantErr:     true,
+		},
+		{
+			name:        "unknown fields field",
+			queryString: "fields=Unknown",
+			wantErr:     true,
+		},
+		{
+			name:        "unsupported filter operator",
+			queryString: "Name[regex]=test",
+			wantErr:     true,
+		},
+	}
+
+	db := newQueryTestDB(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := newQueryTestContext(tc.queryString).GetListQueryOptions(db, &queryTestObject{})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.options, *options)
+		})
+	}
+}
+
+// TestSortScope tests that objects are ordered by ID after the requested
+// order so that objects with equal sort values are returned in the same
+// order.
+func TestSortScope(t *testing.T) {
+	testCases := []struct {
+		name    string
+		order   []string
+		orderBy string
+	}{
+		{
+			name:    "no order",
+			orderBy: "ORDER BY id asc",
+		},
+		{
+			name:    "order by name",
+			order:   []string{"name asc"},
+			orderBy: "ORDER BY name asc,id asc",
+		},
+		{
+			name:    "order by ID",
+			order:   []string{"id desc"},
+			orderBy: "ORDER BY id desc",
+		},
+		{
+			name:    "order by ID after another field",
+			order:   []string{"name desc", "id desc"},
+			orderBy: "ORDER BY name desc,id desc",
+		},
+	}
+
+	db := newQueryTestDB(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := ListQueryOptions{Order: tc.order}
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(options.SortScope()).Find(&[]queryTestObject{})
+			})
+			assert.Contains(t, sql, tc.orderBy)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package v0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+const ErrMsgReconcileLockNotFound = "reconcile lock not found"
+
+var ErrReconcileLockNotFound = errors.New(ErrMsgReconcileLockNotFound)
+
+// GetReconcileLocks returns a page of the reconcile locks held by
+// controllers, ordered by bucket and key, along with the total number of
+// locks.
+func GetReconcileLocks(
+	js nats.JetStreamContext,
+	params PageRequestParams,
+) ([]v0.ReconcileLock, int64, error) {
+	reconcileLocks := []v0.ReconcileLock{}
+	for bucket := range js.KeyValueStoreNames() {
+		if !strings.HasSuffix(bucket, v0.ReconcileLockBucketSuffix) {
+			continue
+		}
+		kv, err := js.KeyValue(bucket)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to bind to key-value bucket %s: %w", bucket, err)
+		}
+		keys, err := kv.Keys()
+		if errors.Is(err, nats.ErrNoKeysFound) {
+			continue
+		} else if err != nil {
+			return nil, 0, fmt.Errorf("failed to get keys in key-value bucket %s: %w", bucket, err)
+		}
+		for _, key := range keys {
+			reconcileLock, err := getReconcileLock(kv, key)
+			if errors.Is(err, ErrReconcileLockNotFound) {
+				continue
+			} else if err != nil {
+				return nil, 0, err
+			}
+			reconcileLocks = append(reconcileLocks, *reconcileLock)
+		}
+	}
+	sort.Slice(reconcileLocks, func(i, j int) bool {
+		if *reconcileLocks[i].Bucket != *reconcileLocks[j].Bucket {
+			return *reconcileLocks[i].Bucket < *reconcileLocks[j].Bucket
+		}
+		return *reconcileLocks[i].Key < *reconcileLocks[j].Key
+	})
+
+	totalCount := int64(len(reconcileLocks))
+	start := min((params.Page-1)*params.Size, len(reconcileLocks))
+	end := min(start+params.Size, len(reconcileLocks))
+
+	return reconcileLocks[start:end], totalCount, nil
+}
+
+// ReleaseReconcileLock forcibly releases the lock held by a reconciler on an
+// object so that the object can be reconciled again.  If a controller is
+// still reconciling the object, it loses its lease on the lock.
+func ReleaseReconcileLock(
+	js nats.JetStreamContext,
+	reconcilerName string,
+	objectID uint,
+) (*v0.ReconcileLock, error) {
+	key := fmt.Sprintf("%s.%d", reconcilerName, objectID)
+	for bucket := range js.KeyValueStoreNames() {
+		if !strings.HasSuffix(bucket, v0.ReconcileLockBucketSuffix) {
+			continue
+		}
+		kv, err := js.KeyValue(bucket)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind to key-value bucket %s: %w", bucket, err)
+		}
+		reconcileLock, err := getReconcileLock(kv, key)
+		if errors.Is(err, ErrReconcileLockNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if err := kv.Delete(key); err != nil {
+			return nil, fmt.Errorf("failed to delete lock %s from key-value bucket %s: %w", key, bucket, err)
+		}
+
+		return reconcileLock, nil
+	}
+
+	return nil, ErrReconcileLockNotFound
+}
+
+// getReconcileLock returns the reconcile lock with the provided key in a
+// lock bucket.  Keys for anything other than locks, such as counts of failed
+// reconciliation attempts, are reported as not found.
+func getReconcileLock(kv nats.KeyValue, key string) (*v0.ReconcileLock, error) {
+	reconcilerName, id, found := strings.Cut(key, ".")
+	if !found || strings.Contains(id, ".") {
+		return nil, ErrReconcileLockNotFound
+	}
+
+	kvEntry, err := kv.Get(key)
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) || errors.Is(err, nats.ErrKeyDeleted) {
+			return nil, ErrReconcileLockNotFound
+		}
+		return nil, fmt.Errorf("failed to get lock %s from key-value bucket %s: %w", key, kv.Bucket(), err)
+	}
+
+	// locks written by older controllers contain only the controller ID
+	var reconcileLock v0.ReconcileLock
+	if err := json.Unmarshal(kvEntry.Value(), &reconcileLock); err != nil || reconcileLock.ControllerID == nil {
+		reconcileLock = v0.ReconcileLock{
+			ReconcilerName: &reconcilerName,
+			ControllerID:   util.Ptr(string(kvEntry.Value())),
+		}
+		if objectID, err := strconv.ParseUint(id, 10, 0); err == nil {
+			reconcileLock.ObjectID = util.Ptr(uint(objectID))
+		}
+	}
+	reconcileLock.Bucket = util.Ptr(kv.Bucket())
+	reconcileLock.Key = &key
+
+	return &reconcileLock, nil
+}
@@ -0,0 +1,52 @@
+package v0
+
+import "encoding/json"
+
+// fields that are set on every update and are ignored when determining if
+// an update requires reconciliation
+var reconciliationIgnoredFields = map[string]bool{
+	"ID":              true,
+	"ResourceVersion": true,
+}
+
+// fields that record information about an object for controllers and clients
+// rather than its desired state so changing them does not require
+// reconciliation
+var reconciliationStatusFields = map[string]bool{
+	"Annotations":     true,
+	"Conditions":      true,
+	"Finalizers":      true,
+	"Labels":          true,
+	"OwnerReferences": true,
+}
+
+// ReconciliationRequired returns false if the fields set in an update to a
+// reconciled object are limited to those that don't affect its desired
+// state, i.e. its conditions, finalizers, owner references, labels and
+// annotations.  Controllers report conditions on objects that are not yet
+// reconciled and remove finalizers from objects that are being deleted, and
+// tptctl apply labels the objects it creates, and those updates must not
+// trigger another reconciliation.
+func ReconciliationRequired(update interface{}) bool {
+	updateJson, err := json.Marshal(update)
+	if err != nil {
+		return true
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(updateJson, &fields); err != nil {
+		return true
+	}
+
+	statusOnly := false
+	for field := range fields {
+		switch {
+		case reconciliationStatusFields[field]:
+			statusOnly = true
+		case !reconciliationIgnoredFields[field]:
+			return true
+		}
+	}
+
+	return !statusOnly
+}
@@ -0,0 +1,86 @@
+package v0
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+// ETag returns the entity tag for an object's resource version as it is
+// presented in the ETag response header and expected in the If-Match request
+// header.
+func ETag(resourceVersion *uint64) string {
+	if resourceVersion == nil {
+		return ""
+	}
+
+	return strconv.Quote(strconv.FormatUint(*resourceVersion, 10))
+}
+
+// SetETag sets the ETag response header to the provided resource version.
+func SetETag(c echo.Context, resourceVersion *uint64) {
+	if resourceVersion == nil {
+		return
+	}
+
+	c.Response().Header().Set(HeaderETag, ETag(resourceVersion))
+}
+
+// NextResourceVersion returns the resource version an object will have after
+// its next update.
+func NextResourceVersion(resourceVersion *uint64) *uint64 {
+	next := uint64(1)
+	if resourceVersion != nil {
+		next = *resourceVersion + 1
+	}
+
+	return &next
+}
+
+// CheckIfMatch compares the If-Match request header, if provided, with the
+// current resource version of the object being changed.  It returns an error
+// if the client's view of the object is stale.
+func CheckIfMatch(c echo.Context, resourceVersion *uint64) error {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(HeaderIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil
+	}
+
+	current := ETag(resourceVersion)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		// weak validators are compared the same as strong ones since resource
+		// versions are only ever incremented
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == current {
+			return nil
+		}
+	}
+
+	return ResourceVersionConflictErr(resourceVersion)
+}
+
+// ErrResourceVersionConflict is wrapped by the errors returned when an update
+// is rejected because the object has been changed since it was read.
+var ErrResourceVersionConflict = errors.New(ErrMsgResourceVersionConflict)
+
+// ResourceVersionConflictErr returns the error sent to clients when an update
+// is rejected because the object has been changed since they last read it.
+func ResourceVersionConflictErr(resourceVersion *uint64) error {
+	if resourceVersion == nil {
+		return ErrResourceVersionConflict
+	}
+
+	return fmt.Errorf(
+		"%w : object has been modified - current resource version is %d",
+		ErrResourceVersionConflict,
+		*resourceVersion,
+	)
+}
@@ -0,0 +1,123 @@
+package v0
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNextResourceVersion tests that resource versions are incremented from
+// the current version or start at 1 for objects without one.
+func TestNextResourceVersion(t *testing.T) {
+	testCases := []struct {
+		name            string
+		resourceVersion *uint64
+		next            uint64
+	}{
+		{
+			name: "no resource version",
+			next: 1,
+		},
+		{
+			name:            "first version",
+			resourceVersion: uint64Ptr(1),
+			next:            2,
+		},
+		{
+			name:            "later version",
+			resourceVersion: uint64Ptr(41),
+			next:            42,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := NextResourceVersion(tc.resourceVersion)
+			assert.Equal(t, tc.next, *next)
+			if tc.resourceVersion != nil {
+				assert.NotSame(t, tc.resourceVersion, next, "current resource version should not be changed")
+			}
+		})
+	}
+}
+
+// TestCheckIfMatch tests that updates are only permitted when the If-Match
+// header is absent or matches the object's current resource version.
+func TestCheckIfMatch(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ifMatch         string
+		resourceVersion *uint64
+		conflict        bool
+	}{
+		{
+			name:            "no header",
+			resourceVersion: uint64Ptr(3),
+		},
+		{
+			name:            "any version",
+			ifMatch:         "*",
+			resourceVersion: uint64Ptr(3),
+		},
+		{
+			name:            "current version",
+			ifMatch:         `"3"`,
+			resourceVersion: uint64Ptr(3),
+		},
+		{
+			name:            "weak current version",
+			ifMatch:         `W/"3"`,
+			resourceVersion: uint64Ptr(3),
+		},
+		{
+			name:            "current version in list",
+			ifMatch:         `"2", "3"`,
+			resourceVersion: uint64Ptr(3),
+		},
+		{
+			name:            "stale version",
+			ifMatch:         `"2"`,
+			resourceVersion: uint64Ptr(3),
+			conflict:        true,
+		},
+		{
+			name:            "unquoted version",
+			ifMatch:         "3",
+			resourceVersion: uint64Ptr(3),
+			conflict:        true,
+		},
+		{
+			name:     "object without resource version",
+			ifMatch:  `"1"`,
+			conflict: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := httptest.NewRequest("PATCH", "/", nil)
+			if tc.ifMatch != "" {
+				request.Header.Set(HeaderIfMatch, tc.ifMatch)
+			}
+			c := echo.New().NewContext(request, httptest.NewRecorder())
+
+			err := CheckIfMatch(c, tc.resourceVersion)
+			if !tc.conflict {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, ErrResourceVersionConflict))
+			if tc.resourceVersion != nil {
+				assert.ErrorContains(t, err, "current resource version is 3")
+			}
+		})
+	}
+}
+
+// uint64Ptr returns a pointer to the provided value.
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
@@ -21,9 +21,11 @@ const (
 	ErrMsgAssociationsUpdateNotAllowed    = "Update of associated objects is not allowed. Use PUT for each associated object"
 	ErrMsgGORMModelFieldsUpdateNotAllowed = "Update of GORM Model fields is not allowed"
 	ErrMsgUnsupportedFieldsNotAllowed     = "Unsupported fields are not allowed"
+	ErrMsgResourceVersionConflict         = "Resource version conflict"
+	ErrMsgPermissionDenied                = "Permission denied"
 )
 
-var GORMModelFields = []string{"ID", "CreatedAt", "UpdatedAt", "DeletedAt"}
+var GORMModelFields = []string{"ID", "CreatedAt", "UpdatedAt", "DeletedAt", "ResourceVersion"}
 
 // Object model info.
 type Object interface{}
@@ -70,8 +72,12 @@ type PageRequestParams struct {
 type Meta struct {
 	PageRequestParams
 
-	// TotalCount of returned Object elements
+	// TotalCount of returned Object elements, -1 if counting was skipped
 	TotalCount int64 `json:"TotalCount" example:"1"`
+
+	// Continue is an opaque token used to request the next page of Object
+	// elements, empty if there are no further pages
+	Continue string `json:"Continue,omitempty"`
 }
 
 func CreateMeta(params PageRequestParams, totalCount int64) *Meta {
@@ -95,11 +101,13 @@ func CreateResponse(meta *Meta, obj interface{}, objType string) (*Response, err
 	var page = 1
 	var size = 1
 	var totalCount = int64(1)
+	var continueToken string
 
 	if meta != nil {
 		page = meta.Page
 		size = meta.Size
 		totalCount = meta.TotalCount
+		continueToken = meta.Continue
 	}
 
 	if reflect.TypeOf(obj).Kind() == reflect.Slice {
@@ -120,7 +128,7 @@ func CreateResponse(meta *Meta, obj interface{}, objType string) (*Response, err
 		response.Data = append(response.Data, obj)
 	}
 
-	response.Meta = Meta{PageRequestParams: PageRequestParams{Page: page, Size: size}, TotalCount: totalCount}
+	response.Meta = Meta{PageRequestParams: PageRequestParams{Page: page, Size: size}, TotalCount: totalCount, Continue: continueToken}
 	response.Status = Status{Code: code, Message: message, Error: ""}
 
 	return response, nil
@@ -163,6 +171,10 @@ func CreateResponseWithError409(params *PageRequestParams, error error, objectTy
 	return CreateResponseErrorWithStatus(params, CreateStatus(http.StatusConflict, http.StatusText(http.StatusConflict), error.Error()), objectType)
 }
 
+func CreateResponseWithError410(params *PageRequestParams, error error, objectType string) *Response {
+	return CreateResponseErrorWithStatus(params, CreateStatus(http.StatusGone, http.StatusText(http.StatusGone), error.Error()), objectType)
+}
+
 func CreateResponseWithError500(params *PageRequestParams, error error, objectType string) *Response {
 	return CreateResponseErrorWithStatus(params, CreateStatus(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError), error.Error()), objectType)
 }
@@ -0,0 +1,67 @@
+package v0
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
+
+// TracingMiddleware returns middleware that creates a span for every request.
+// The trace is continued from the trace context in the request headers when
+// the client provides it.  The span is added to the request's context so that
+// database transactions and NATS messages for the request carry the trace on
+// to the outbox relay and controllers.
+func TracingMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(
+				request.Context(),
+				propagation.HeaderCarrier(request.Header),
+			)
+
+			route := c.Path()
+			ctx, span := tracing.Tracer().Start(
+				ctx,
+				fmt.Sprintf("%s %s", request.Method, route),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(request.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(request.URL.Path),
+				),
+			)
+			defer span.End()
+			c.SetRequest(request.WithContext(ctx))
+
+			err := next(c)
+
+			// errors returned by handlers are written to the response by
+			// echo's error handler after the middleware chain returns
+			status := c.Response().Status
+			if err != nil {
+				span.RecordError(err)
+				status = http.StatusInternalServerError
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				}
+			}
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package v0
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nats-io/nats.go"
+	"gorm.io/gorm"
+
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
+
+const (
+	QueryParamWatch                   = "watch"
+	QueryParamSince                   = "since"
+	HeaderLastEventID                 = "Last-Event-ID"
+	ErrMsgQueryParamInvalidSinceValue = "Query parameter is not a valid integer value: " + QueryParamSince
+
+	// WatchStreamName is the NATS JetStream stream that retains watch events
+	// so that clients can resume watches after being disconnected.
+	WatchStreamName = "watchStream"
+
+	// WatchSubjects includes the NATS subjects for all watch events.
+	WatchSubjects = "watch.>"
+
+	// WatchStreamMaxAge is how long watch events are retained.  A watch
+	// cannot be resumed from an event older than this.
+	WatchStreamMaxAge = time.Hour
+
+	// watchHeartbeatInterval is how often a comment is sent to watch clients
+	// when there are no events to keep idle connections open.
+	watchHeartbeatInterval = time.Second * 30
+)
+
+// ErrWatchMarkerExpired is returned when a watch is resumed from a marker
+// for an event that is no longer retained in the watch stream.
+var ErrWatchMarkerExpired = errors.New("watch events following marker are no longer retained - list objects and start a new watch")
+
+// WatchEventType is the kind of change to an object that a watch event
+// represents.
+type WatchEventType string
+
+const (
+	WatchEventCreated WatchEventType = "Created"
+	WatchEventUpdated WatchEventType = "Updated"
+	WatchEventDeleted WatchEventType = "Deleted"
+
+	// WatchEventError is sent when a change could not be sent to the client.
+	// The client has missed the change and should list the objects again if
+	// it needs a complete view of them.
+	WatchEventError WatchEventType = "Error"
+)
+
+// WatchEvent is a change to an API object sent to clients watching an object
+// type.
+type WatchEvent struct {
+	// The kind of change made to the object.
+	Type WatchEventType `json:"Type"`
+
+	// The type of the object that was changed.
+	ObjectType string `json:"ObjectType"`
+
+	// The API version of the object that was changed.
+	ObjectVersion string `json:"ObjectVersion"`
+
+	// The position of the event in the stream of changes.  Provide it with
+	// the since query parameter to resume a watch following this event.
+	Marker uint64 `json:"Marker"`
+
+	// The object as it exists after the change.  For deleted objects, it is
+	// the object as it existed when deleted.
+	Object json.RawMessage `json:"Object"`
+
+	// The reason a change could not be sent for error events.
+	Error string `json:"Error,omitempty"`
+}
+
+// WatchFilter limits the events sent to a watch client to those for objects
+// that match the filters of the watch request, e.g. name or labelSelector.
+type WatchFilter struct {
+	db            *gorm.DB
+	filter        interface{}
+	labelSelector []LabelRequirement
+	queryOptions  *ListQueryOptions
+}
+
+// NewWatchFilter returns a filter for watch events from the filters of a
+// list request.  The filter is a pointer to an object with the fields bound
+// from the request's query parameters.  It returns nil if the request has no
+// filters so that events for all objects are sent.
+func NewWatchFilter(
+	db *gorm.DB,
+	filter interface{},
+	labelSelector []LabelRequirement,
+	queryOptions *ListQueryOptions,
+) *WatchFilter {
+	if reflect.ValueOf(filter).Elem().IsZero() &&
+		len(labelSelector) == 0 &&
+		(queryOptions == nil || len(queryOptions.Filters) == 0) {
+		return nil
+	}
+
+	return &WatchFilter{
+		db:            db,
+		filter:        filter,
+		labelSelector: labelSelector,
+		queryOptions:  queryOptions,
+	}
+}
+
+// Matches returns true if the object from a watch event matches the filters.
+// The filters are applied to the object as it is stored in the database with
+// the same query used to list objects.  Deleted objects are included so that
+// clients are sent the deletion of objects that matched.
+func (f *WatchFilter) Matches(objectJson json.RawMessage) (bool, error) {
+	var object struct {
+		ID *uint `json:"ID"`
+	}
+	if err := json.Unmarshal(objectJson, &object); err != nil {
+		return false, fmt.Errorf("failed to unmarshal watch event object: %w", err)
+	}
+	if object.ID == nil {
+		return false, errors.New("watch event object has no ID")
+	}
+
+	model := reflect.New(reflect.TypeOf(f.filter).Elem()).Interface()
+	query := f.db.Unscoped().Model(model).Where("id = ?", *object.ID).Where(f.filter).Scopes(
+		LabelSelectorScope(f.labelSelector),
+	)
+	if f.queryOptions != nil {
+		query = query.Scopes(f.queryOptions.FilterScope())
+	}
+	var count int64
+	if result := query.Count(&count); result.Error != nil {
+		return false, fmt.Errorf("failed to match watch event object with filters: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
+
+// WatchSubject returns the NATS subject that watch events for an object type
+// are published to.
+func WatchSubject(objectType string, objectVersion string) string {
+	return fmt.Sprintf("watch.%s.%s", objectVersion, objectType)
+}
+
+// PublishWatchEvent publishes a change to an object for any clients watching
+// that object type.  The trace context from ctx is sent in the message
+// headers.
+func PublishWatchEvent(
+	ctx context.Context,
+	js nats.JetStreamContext,
+	eventType WatchEventType,
+	objectType string,
+	objectVersion string,
+	object interface{},
+) error {
+	objectJson, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object for watch event: %w", err)
+	}
+
+	event := WatchEvent{
+		Type:          eventType,
+		ObjectType:    objectType,
+		ObjectVersion: objectVersion,
+		Object:        objectJson,
+	}
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal watch event: %w", err)
+	}
+
+	if _, err := js.PublishMsg(tracing.NewMsg(ctx, WatchSubject(objectType, objectVersion), eventJson)); err != nil {
+		return fmt.Errorf("failed to publish watch event: %w", err)
+	}
+
+	return nil
+}
+
+// GetWatchParams parses the query parameters for watch requests.  The marker
+// to resume from may be provided with the since query parameter or, when an
+// event stream client reconnects, the Last-Event-ID header.
+func (c *CustomContext) GetWatchParams() (watch bool, since uint64, err error) {
+	watch, _ = strconv.ParseBool(c.QueryParam(QueryParamWatch))
+	if !watch {
+		return false, 0, nil
+	}
+
+	strSince := c.QueryParam(QueryParamSince)
+	if strSince == "" {
+		strSince = c.Request().Header.Get(HeaderLastEventID)
+	}
+	if strSince != "" {
+		since, err = strconv.ParseUint(strSince, 10, 64)
+		if err != nil {
+			return true, 0, errors.New(ErrMsgQueryParamInvalidSinceValue)
+		}
+	}
+
+	return true, since, nil
+}
+
+// StreamWatchEvents responds to a watch request by streaming changes for an
+// object type to the client as server-sent events until the client
+// disconnects.  If since is provided, events that followed that marker are
+// sent first.  Otherwise only new changes are sent.  If a filter is provided,
+// only changes to objects that match it are sent.
+func StreamWatchEvents(
+	c echo.Context,
+	js nats.JetStreamContext,
+	objectType string,
+	objectVersion string,
+	since uint64,
+	filter *WatchFilter,
+) error {
+	deliverOpt := nats.DeliverNew()
+	if since > 0 {
+		// if events following the marker are no longer retained the client
+		// has missed changes and must list the objects again before watching
+		streamInfo, err := js.StreamInfo(WatchStreamName)
+		if err != nil {
+			return ResponseStatus500(c, nil, fmt.Errorf("failed to get watch stream info: %w", err), objectType)
+		}
+		if since+1 < streamInfo.State.FirstSeq {
+			return ResponseStatus410(c, nil, fmt.Errorf("%w: %d", ErrWatchMarkerExpired, since), objectType)
+		}
+		deliverOpt = nats.StartSequence(since + 1)
+	}
+
+	msgChan := make(chan *nats.Msg, 64)
+	sub, err := js.ChanSubscribe(
+		WatchSubject(objectType, objectVersion),
+		msgChan,
+		nats.OrderedConsumer(),
+		deliverOpt,
+	)
+	if err != nil {
+		return ResponseStatus500(c, nil, fmt.Errorf("failed to subscribe to watch events: %w", err), objectType)
+	}
+	defer sub.Unsubscribe()
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			response.Flush()
+		case msg := <-msgChan:
+			event, err := decodeWatchEvent(msg, filter)
+			if err != nil {
+				// the response status has already been sent so the client is
+				// notified with an error event that it missed a change
+				c.Logger().Errorf("failed to send watch event for %s: %v", objectType, err)
+				event = &WatchEvent{
+					Type:          WatchEventError,
+					ObjectType:    objectType,
+					ObjectVersion: objectVersion,
+					Error:         err.Error(),
+				}
+				if metadata, err := msg.Metadata(); err == nil {
+					event.Marker = metadata.Sequence.Stream
+				}
+			}
+			if event == nil {
+				continue
+			}
+
+			if err := writeWatchEvent(response, event); err != nil {
+				return nil
+			}
+			response.Flush()
+		}
+	}
+}
+
+// decodeWatchEvent returns the watch event from a message in the watch
+// stream with its marker.  It returns nil if the event's object doesn't
+// match the filter.
+func decodeWatchEvent(msg *nats.Msg, filter *WatchFilter) (*WatchEvent, error) {
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watch event metadata: %w", err)
+	}
+
+	var event WatchEvent
+	if err := json.Unmarshal(msg.Data, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal watch event %d: %w", metadata.Sequence.Stream, err)
+	}
+	event.Marker = metadata.Sequence.Stream
+
+	if filter != nil {
+		matched, err := filter.Matches(event.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter watch event %d: %w", event.Marker, err)
+		}
+		if !matched {
+			return nil, nil
+		}
+	}
+
+	return &event, nil
+}
+
+// writeWatchEvent writes a watch event to the response as a server-sent
+// event.  Error events without a marker are sent without an ID so that
+// clients resume from the last event they received.
+func writeWatchEvent(w io.Writer, event *WatchEvent) error {
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal watch event: %w", err)
+	}
+
+	if event.Marker > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", event.Marker); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, eventJson)
+
+	return err
+}
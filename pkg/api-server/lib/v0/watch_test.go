@@ -0,0 +1,299 @@
+package v0
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// watchTestObjectType is the object type watch events are published for in
+// tests.
+const watchTestObjectType = "WatchTestObject"
+
+// newWatchTestJetStream starts an embedded NATS server with the watch stream
+// and returns a JetStream context for it.
+func newWatchTestJetStream(t *testing.T) nats.JetStreamContext {
+	natsServer, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+	go natsServer.Start()
+	t.Cleanup(natsServer.Shutdown)
+	require.True(t, natsServer.ReadyForConnections(10*time.Second), "NATS server should start")
+
+	nc, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+
+	js, err := nc.JetStream()
+	require.NoError(t, err)
+	_, err = js.AddStream(&nats.StreamConfig{
+		MaxAge:   WatchStreamMaxAge,
+		Name:     WatchStreamName,
+		Subjects: []string{WatchSubjects},
+	})
+	require.NoError(t, err)
+
+	return js
+}
+
+// publishWatchTestEvent publishes a watch event for an object with the ID.
+func publishWatchTestEvent(t *testing.T, js nats.JetStreamContext, eventType WatchEventType, id uint) {
+	t.Helper()
+	require.NoError(t, PublishWatchEvent(
+		context.Background(),
+		js,
+		eventType,
+		watchTestObjectType,
+		"v0",
+		queryTestObject{ID: &id},
+	))
+}
+
+// watchTestEvent is a server-sent event received by a watch client.
+type watchTestEvent struct {
+	ID    string
+	Event WatchEvent
+}
+
+// readWatchTestEvents reads server-sent events from a watch response until
+// count events are received.
+func readWatchTestEvents(t *testing.T, reader *bufio.Reader, count int) []watchTestEvent {
+	t.Helper()
+
+	var events []watchTestEvent
+	var event watchTestEvent
+	for len(events) < count {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			events = append(events, event)
+			event = watchTestEvent{}
+		case strings.HasPrefix(line, "id: "):
+			event.ID = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.Event))
+		}
+	}
+
+	return events
+}
+
+// TestStreamWatchEvents tests that watch clients are sent new changes or the
+// changes following a marker, that only changes to objects matching the
+// filter are sent, that clients are sent an error event for changes that
+// can't be read and that watches can't be resumed from expired markers.
+func TestStreamWatchEvents(t *testing.T) {
+	testCases := []struct {
+		name string
+		// events published before the watch starts
+		before []uint
+		// events published after the watch starts
+		after []uint
+		// stream sequence to purge the stream up to, if any
+		purge uint64
+		since uint64
+		// the IDs of objects that match the watch filter, if filtered
+		matching map[uint]bool
+		// publish a message that isn't a watch event after the watch starts
+		invalid bool
+		status  int
+		// the IDs of the objects sent to the client, 0 for an error event
+		received []uint
+		markers  []string
+	}{
+		{
+			name:     "new changes only",
+			before:   []uint{1},
+			after:    []uint{2, 3},
+			status:   http.StatusOK,
+			received: []uint{2, 3},
+			markers:  []string{"2", "3"},
+		},
+		{
+			name:     "resume following marker",
+			before:   []uint{1, 2, 3},
+			since:    1,
+			status:   http.StatusOK,
+			received: []uint{2, 3},
+			markers:  []string{"2", "3"},
+		},
+		{
+			name:     "resume from oldest retained marker",
+			before:   []uint{1, 2, 3},
+			purge:    3,
+			since:    2,
+			status:   http.StatusOK,
+			received: []uint{3},
+			markers:  []string{"3"},
+		},
+		{
+			name:   "resume from expired marker",
+			before: []uint{1, 2, 3},
+			purge:  3,
+			since:  1,
+			status: http.StatusGone,
+		},
+		{
+			name:     "filtered changes",
+			after:    []uint{1, 2, 3},
+			matching: map[uint]bool{1: true, 2: false, 3: true},
+			status:   http.StatusOK,
+			received: []uint{1, 3},
+			markers:  []string{"1", "3"},
+		},
+		{
+			name:     "change that can't be read",
+			after:    []uint{1},
+			invalid:  true,
+			status:   http.StatusOK,
+			received: []uint{0, 1},
+			markers:  []string{"1", "2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			js := newWatchTestJetStream(t)
+			for _, id := range tc.before {
+				publishWatchTestEvent(t, js, WatchEventCreated, id)
+			}
+			if tc.purge > 0 {
+				require.NoError(t, js.PurgeStream(WatchStreamName, &nats.StreamPurgeRequest{Sequence: tc.purge}))
+			}
+
+			var filter *WatchFilter
+			if tc.matching != nil {
+				db, mock := newMockTestDB(t)
+				for _, id := range tc.after {
+					count := 0
+					if tc.matching[id] {
+						count = 1
+					}
+					mock.ExpectQuery(`SELECT count\(\*\) FROM "query_test_objects" WHERE id = \$1 AND "query_test_objects"."name" = \$2`).
+						WithArgs(id, "web").
+						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+				}
+				name := "web"
+				filter = NewWatchFilter(db, &queryTestObject{Name: &name}, nil, &ListQueryOptions{})
+				require.NotNil(t, filter)
+				t.Cleanup(func() { assert.NoError(t, mock.ExpectationsWereMet()) })
+			}
+
+			e := echo.New()
+			e.GET("/watch", func(c echo.Context) error {
+				return StreamWatchEvents(c, js, watchTestObjectType, "v0", tc.since, filter)
+			})
+			apiServer := httptest.NewServer(e)
+			defer apiServer.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, apiServer.URL+"/watch", nil)
+			require.NoError(t, err)
+			response, err := http.DefaultClient.Do(request)
+			require.NoError(t, err)
+			defer response.Body.Close()
+
+			require.Equal(t, tc.status, response.StatusCode)
+			if tc.status != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "text/event-stream", response.Header.Get(echo.HeaderContentType))
+
+			// the watch has started once the response status is received
+			if tc.invalid {
+				_, err := js.Publish(WatchSubject(watchTestObjectType, "v0"), []byte("not a watch event"))
+				require.NoError(t, err)
+			}
+			for _, id := range tc.after {
+				publishWatchTestEvent(t, js, WatchEventUpdated, id)
+			}
+
+			events := readWatchTestEvents(t, bufio.NewReader(response.Body), len(tc.received))
+			var received []uint
+			var markers []string
+			for _, event := range events {
+				markers = append(markers, event.ID)
+				assert.Equal(t, event.ID, strconv.FormatUint(event.Event.Marker, 10))
+				assert.Equal(t, watchTestObjectType, event.Event.ObjectType)
+				if event.Event.Type == WatchEventError {
+					assert.NotEmpty(t, event.Event.Error)
+					received = append(received, 0)
+					continue
+				}
+				var object queryTestObject
+				require.NoError(t, json.Unmarshal(event.Event.Object, &object), fmt.Sprintf("event %s", event.ID))
+				received = append(received, *object.ID)
+			}
+			assert.Equal(t, tc.received, received)
+			assert.Equal(t, tc.markers, markers)
+		})
+	}
+}
+
+// TestNewWatchFilter tests that watch events are only filtered when the
+// watch request includes filters.
+func TestNewWatchFilter(t *testing.T) {
+	db := newQueryTestDB(t)
+	name := "web"
+
+	testCases := []struct {
+		name          string
+		filter        *queryTestObject
+		labelSelector []LabelRequirement
+		queryOptions  *ListQueryOptions
+		filtered      bool
+	}{
+		{
+			name:         "no filters",
+			filter:       &queryTestObject{},
+			queryOptions: &ListQueryOptions{},
+		},
+		{
+			name:     "field filter",
+			filter:   &queryTestObject{Name: &name},
+			filtered: true,
+		},
+		{
+			name:          "label selector",
+			filter:        &queryTestObject{},
+			labelSelector: []LabelRequirement{{Key: "team", Operator: LabelSelectorOpExists}},
+			filtered:      true,
+		},
+		{
+			name:         "filter operator",
+			filter:       &queryTestObject{},
+			queryOptions: &ListQueryOptions{Filters: []ListQueryFilter{{Condition: "name LIKE ?", Value: "web%"}}},
+			filtered:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := NewWatchFilter(db, tc.filter, tc.labelSelector, tc.queryOptions)
+			assert.Equal(t, tc.filtered, filter != nil)
+		})
+	}
+}
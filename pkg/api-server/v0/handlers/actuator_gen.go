@@ -9,6 +9,7 @@ import (
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	gorm "gorm.io/gorm"
 	"net/http"
+	"time"
 )
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -73,26 +74,56 @@ func (h Handler) AddProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		profile,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, profile, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, profile.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all profiles.
 // @Description Get all profiles from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-profiles
 // @Accept json
 // @Produce json
 // @Param name query string false "profile search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/profiles [GET]
 func (h Handler) GetProfiles(c echo.Context) error {
 	objectType := api_v0.ObjectTypeProfile
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -103,17 +134,44 @@ func (h Handler) GetProfiles(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.Profile{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.Profile{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.Profile{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.Profile{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -128,6 +186,7 @@ func (h Handler) GetProfiles(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/profiles/{id} [GET]
@@ -147,6 +206,7 @@ func (h Handler) GetProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, profile.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -161,9 +221,12 @@ func (h Handler) GetProfile(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param profile body api_v0.Profile true "Profile object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/profiles/{id} [PATCH]
 func (h Handler) UpdateProfile(c echo.Context) error {
@@ -177,6 +240,11 @@ func (h Handler) UpdateProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingProfile.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingProfile); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -188,16 +256,34 @@ func (h Handler) UpdateProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingProfile).Updates(updatedProfile); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedProfile.ResourceVersion = apiserver_lib.NextResourceVersion(existingProfile.ResourceVersion)
+	result := h.DB.Model(&existingProfile).Where("resource_version = ?", existingProfile.ResourceVersion).Updates(updatedProfile)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingProfile.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingProfile,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingProfile, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingProfile.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -213,9 +299,12 @@ func (h Handler) UpdateProfile(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param profile body api_v0.Profile true "Profile object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/profiles/{id} [PUT]
 func (h Handler) ReplaceProfile(c echo.Context) error {
@@ -229,6 +318,11 @@ func (h Handler) ReplaceProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingProfile.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingProfile); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -245,11 +339,16 @@ func (h Handler) ReplaceProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedProfile.ID = existingProfile.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedProfile); result.Error != nil {
+	updatedProfile.ResourceVersion = apiserver_lib.NextResourceVersion(existingProfile.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingProfile.ResourceVersion).Save(&updatedProfile)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingProfile.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingProfile, profileID); result.Error != nil {
@@ -259,11 +358,24 @@ func (h Handler) ReplaceProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingProfile,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingProfile, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingProfile.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -273,7 +385,10 @@ func (h Handler) ReplaceProfile(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -289,9 +404,60 @@ func (h Handler) DeleteProfile(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&profile); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, profile.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*profile.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", profile.ResourceVersion).Delete(&profile)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(profile.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, profile.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		profile,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, profile, objectType)
@@ -364,26 +530,56 @@ func (h Handler) AddTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		tier,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, tier, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, tier.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all tiers.
 // @Description Get all tiers from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-tiers
 // @Accept json
 // @Produce json
 // @Param name query string false "tier search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/tiers [GET]
 func (h Handler) GetTiers(c echo.Context) error {
 	objectType := api_v0.ObjectTypeTier
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -394,17 +590,44 @@ func (h Handler) GetTiers(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.Tier{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.Tier{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.Tier{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.Tier{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -419,6 +642,7 @@ func (h Handler) GetTiers(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/tiers/{id} [GET]
@@ -438,6 +662,7 @@ func (h Handler) GetTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, tier.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -452,9 +677,12 @@ func (h Handler) GetTier(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param tier body api_v0.Tier true "Tier object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/tiers/{id} [PATCH]
 func (h Handler) UpdateTier(c echo.Context) error {
@@ -468,6 +696,11 @@ func (h Handler) UpdateTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingTier.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingTier); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -479,16 +712,34 @@ func (h Handler) UpdateTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingTier).Updates(updatedTier); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedTier.ResourceVersion = apiserver_lib.NextResourceVersion(existingTier.ResourceVersion)
+	result := h.DB.Model(&existingTier).Where("resource_version = ?", existingTier.ResourceVersion).Updates(updatedTier)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingTier.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingTier,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingTier, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingTier.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -504,9 +755,12 @@ func (h Handler) UpdateTier(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param tier body api_v0.Tier true "Tier object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/tiers/{id} [PUT]
 func (h Handler) ReplaceTier(c echo.Context) error {
@@ -520,6 +774,11 @@ func (h Handler) ReplaceTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingTier.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingTier); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -536,11 +795,16 @@ func (h Handler) ReplaceTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedTier.ID = existingTier.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedTier); result.Error != nil {
+	updatedTier.ResourceVersion = apiserver_lib.NextResourceVersion(existingTier.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingTier.ResourceVersion).Save(&updatedTier)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingTier.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingTier, tierID); result.Error != nil {
@@ -550,11 +814,24 @@ func (h Handler) ReplaceTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingTier,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingTier, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingTier.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -564,7 +841,10 @@ func (h Handler) ReplaceTier(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -580,9 +860,60 @@ func (h Handler) DeleteTier(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&tier); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, tier.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*tier.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", tier.ResourceVersion).Delete(&tier)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(tier.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, tier.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		tier,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, tier, objectType)
@@ -9,6 +9,7 @@ import (
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
 	gorm "gorm.io/gorm"
 	"net/http"
+	"time"
 )
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -58,26 +59,56 @@ func (h Handler) AddAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		attachedObjectReference,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, attachedObjectReference, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, attachedObjectReference.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all attached object references.
 // @Description Get all attached object references from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-attachedObjectReferences
 // @Accept json
 // @Produce json
 // @Param name query string false "attached object reference search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/attached-object-references [GET]
 func (h Handler) GetAttachedObjectReferences(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAttachedObjectReference
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -88,17 +119,44 @@ func (h Handler) GetAttachedObjectReferences(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AttachedObjectReference{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AttachedObjectReference{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AttachedObjectReference{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AttachedObjectReference{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -113,6 +171,7 @@ func (h Handler) GetAttachedObjectReferences(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/attached-object-references/{id} [GET]
@@ -132,6 +191,7 @@ func (h Handler) GetAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, attachedObjectReference.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -146,9 +206,12 @@ func (h Handler) GetAttachedObjectReference(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param attachedObjectReference body api_v0.AttachedObjectReference true "AttachedObjectReference object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/attached-object-references/{id} [PATCH]
 func (h Handler) UpdateAttachedObjectReference(c echo.Context) error {
@@ -162,6 +225,11 @@ func (h Handler) UpdateAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAttachedObjectReference.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAttachedObjectReference); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -173,16 +241,34 @@ func (h Handler) UpdateAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAttachedObjectReference).Updates(updatedAttachedObjectReference); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedAttachedObjectReference.ResourceVersion = apiserver_lib.NextResourceVersion(existingAttachedObjectReference.ResourceVersion)
+	result := h.DB.Model(&existingAttachedObjectReference).Where("resource_version = ?", existingAttachedObjectReference.ResourceVersion).Updates(updatedAttachedObjectReference)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAttachedObjectReference.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAttachedObjectReference,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAttachedObjectReference, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAttachedObjectReference.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -198,9 +284,12 @@ func (h Handler) UpdateAttachedObjectReference(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param attachedObjectReference body api_v0.AttachedObjectReference true "AttachedObjectReference object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/attached-object-references/{id} [PUT]
 func (h Handler) ReplaceAttachedObjectReference(c echo.Context) error {
@@ -214,6 +303,11 @@ func (h Handler) ReplaceAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAttachedObjectReference.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAttachedObjectReference); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -230,11 +324,16 @@ func (h Handler) ReplaceAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAttachedObjectReference.ID = existingAttachedObjectReference.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAttachedObjectReference); result.Error != nil {
+	updatedAttachedObjectReference.ResourceVersion = apiserver_lib.NextResourceVersion(existingAttachedObjectReference.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAttachedObjectReference.ResourceVersion).Save(&updatedAttachedObjectReference)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAttachedObjectReference.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAttachedObjectReference, attachedObjectReferenceID); result.Error != nil {
@@ -244,11 +343,24 @@ func (h Handler) ReplaceAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAttachedObjectReference,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAttachedObjectReference, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAttachedObjectReference.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -258,7 +370,10 @@ func (h Handler) ReplaceAttachedObjectReference(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -274,9 +389,60 @@ func (h Handler) DeleteAttachedObjectReference(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&attachedObjectReference); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, attachedObjectReference.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*attachedObjectReference.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", attachedObjectReference.ResourceVersion).Delete(&attachedObjectReference)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(attachedObjectReference.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, attachedObjectReference.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		attachedObjectReference,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, attachedObjectReference, objectType)
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+	gorm "gorm.io/gorm"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// errAuditRecordImmutable is returned to clients that attempt to change audit
+// records.
+var errAuditRecordImmutable = errors.New("audit records are written by the API server and cannot be changed")
+
+func (h *Handler) AddAuditRecordMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectAuditRecordChanges,
+	}
+}
+
+func (h *Handler) GetAuditRecordMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{}
+}
+
+func (h *Handler) PatchAuditRecordMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectAuditRecordChanges,
+	}
+}
+
+func (h *Handler) PutAuditRecordMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectAuditRecordChanges,
+	}
+}
+
+func (h *Handler) DeleteAuditRecordMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectAuditRecordChanges,
+	}
+}
+
+// RejectAuditRecordChanges prevents clients from creating, updating or
+// deleting audit records.  Audit records are only written by the API server's
+// audit middleware.
+func (h Handler) RejectAuditRecordChanges(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return apiserver_lib.ResponseStatus403(c, nil, errAuditRecordImmutable, v0.ObjectTypeAuditRecord)
+	}
+}
+
+// @Summary gets audit records in a time range.
+// @Description Get audit records from the Threeport database that were
+// @Description recorded in a time range and match the provided filters.
+// @ID get-v0-audit-records-search
+// @Accept json
+// @Produce json
+// @Param start query string false "beginning of time range as RFC3339 timestamp"
+// @Param end query string false "end of time range as RFC3339 timestamp"
+// @Param subject query string false "audit record search by subject"
+// @Param objecttype query string false "audit record search by object type"
+// @Param objectid query string false "audit record search by object ID"
+// @Param labelSelector query string false "select audit records by label"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records-search [GET]
+func (h Handler) GetAuditRecordsSearch(c echo.Context) error {
+	objectType := v0.ObjectTypeAuditRecord
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	var filter v0.AuditRecord
+	if err := c.Bind(&filter); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	query := h.DB.Model(&v0.AuditRecord{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector))
+	for _, timeParam := range []struct {
+		name      string
+		condition string
+	}{
+		{name: v0.AuditRecordQueryParamStart, condition: "created_at >= ?"},
+		{name: v0.AuditRecordQueryParamEnd, condition: "created_at <= ?"},
+	} {
+		value := c.QueryParam(timeParam.name)
+		if value == "" {
+			continue
+		}
+		timestamp, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return apiserver_lib.ResponseStatus400(
+				c,
+				&params,
+				fmt.Errorf("query parameter %s is not a valid RFC3339 timestamp: %w", timeParam.name, err),
+				objectType,
+			)
+		}
+		query = query.Where(timeParam.condition, timestamp)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var totalCount int64
+	if result := query.Count(&totalCount); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	records := &[]v0.AuditRecord{}
+	if result := query.Order("created_at asc").Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
@@ -0,0 +1,454 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package handlers
+
+import (
+	"errors"
+	echo "github.com/labstack/echo/v4"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	gorm "gorm.io/gorm"
+	"net/http"
+	"time"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// AuditRecord
+///////////////////////////////////////////////////////////////////////////////
+
+// @Summary GetAuditRecordVersions gets the supported versions for the audit record API.
+// @Description Get the supported API versions for audit records.
+// @ID auditRecord-get-versions
+// @Produce json
+// @Success 200 {object} apiserver_lib.ApiObjectVersions "OK"
+// @Router /audit-records/versions [GET]
+func (h Handler) GetAuditRecordVersions(c echo.Context) error {
+	return c.JSON(http.StatusOK, apiserver_lib.ObjectVersions[string(api_v0.ObjectTypeAuditRecord)])
+}
+
+// @Summary adds a new audit record.
+// @Description Add a new audit record to the Threeport database.
+// @ID add-v0-auditRecord
+// @Accept json
+// @Produce json
+// @Param auditRecord body api_v0.AuditRecord true "AuditRecord object"
+// @Success 201 {object} v0.Response "Created"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records [POST]
+func (h Handler) AddAuditRecord(c echo.Context) error {
+	objectType := api_v0.ObjectTypeAuditRecord
+	var auditRecord api_v0.AuditRecord
+
+	// check for empty payload, unsupported fields, GORM Model fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, false, objectType, auditRecord); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	if err := c.Bind(&auditRecord); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// check for missing required fields
+	if id, err := apiserver_lib.ValidateBoundData(c, auditRecord, objectType); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// persist to DB
+	if result := h.DB.Create(&auditRecord); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		auditRecord,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, auditRecord, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, auditRecord.ResourceVersion)
+	return apiserver_lib.ResponseStatus201(c, *response)
+}
+
+// @Summary gets all audit records.
+// @Description Get all audit records from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
+// @ID get-v0-auditRecords
+// @Accept json
+// @Produce json
+// @Param name query string false "audit record search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records [GET]
+func (h Handler) GetAuditRecords(c echo.Context) error {
+	objectType := api_v0.ObjectTypeAuditRecord
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	var filter api_v0.AuditRecord
+	if err := c.Bind(&filter); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AuditRecord{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AuditRecord{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
+	}
+
+	records := &[]api_v0.AuditRecord{}
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary gets a audit record.
+// @Description Get a particular audit record from the database.
+// @ID get-v0-auditRecord
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records/{id} [GET]
+func (h Handler) GetAuditRecord(c echo.Context) error {
+	objectType := api_v0.ObjectTypeAuditRecord
+	auditRecordID := c.Param("id")
+	var auditRecord api_v0.AuditRecord
+	if result := h.DB.First(&auditRecord, auditRecordID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, auditRecord, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, auditRecord.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary updates specific fields for an existing audit record.
+// @Description Update a audit record in the database.  Provide one or more fields to update.
+// @Description Note: This API endpint is for updating audit record objects only.
+// @Description Request bodies that include related objects will be accepted, however
+// @Description the related objects will not be changed.  Call the patch or put method for
+// @Description each particular existing object to change them.
+// @ID update-v0-auditRecord
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param auditRecord body api_v0.AuditRecord true "AuditRecord object"
+// @Param If-Match header string false "resource version the update is based on"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records/{id} [PATCH]
+func (h Handler) UpdateAuditRecord(c echo.Context) error {
+	objectType := api_v0.ObjectTypeAuditRecord
+	auditRecordID := c.Param("id")
+	var existingAuditRecord api_v0.AuditRecord
+	if result := h.DB.First(&existingAuditRecord, auditRecordID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAuditRecord.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// check for empty payload, invalid or unsupported fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAuditRecord); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// bind payload
+	var updatedAuditRecord api_v0.AuditRecord
+	if err := c.Bind(&updatedAuditRecord); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// update object in database if it has not changed since it was read
+	updatedAuditRecord.ResourceVersion = apiserver_lib.NextResourceVersion(existingAuditRecord.ResourceVersion)
+	result := h.DB.Model(&existingAuditRecord).Where("resource_version = ?", existingAuditRecord.ResourceVersion).Updates(updatedAuditRecord)
+	if result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAuditRecord.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAuditRecord,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, existingAuditRecord, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, existingAuditRecord.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary updates an existing audit record by replacing the entire object.
+// @Description Replace a audit record in the database.  All required fields must be provided.
+// @Description If any optional fields are not provided, they will be null post-update.
+// @Description Note: This API endpint is for updating audit record objects only.
+// @Description Request bodies that include related objects will be accepted, however
+// @Description the related objects will not be changed.  Call the patch or put method for
+// @Description each particular existing object to change them.
+// @ID replace-v0-auditRecord
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param auditRecord body api_v0.AuditRecord true "AuditRecord object"
+// @Param If-Match header string false "resource version the update is based on"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records/{id} [PUT]
+func (h Handler) ReplaceAuditRecord(c echo.Context) error {
+	objectType := api_v0.ObjectTypeAuditRecord
+	auditRecordID := c.Param("id")
+	var existingAuditRecord api_v0.AuditRecord
+	if result := h.DB.First(&existingAuditRecord, auditRecordID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAuditRecord.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// check for empty payload, invalid or unsupported fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAuditRecord); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// bind payload
+	var updatedAuditRecord api_v0.AuditRecord
+	if err := c.Bind(&updatedAuditRecord); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// check for missing required fields
+	if id, err := apiserver_lib.ValidateBoundData(c, updatedAuditRecord, objectType); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// persist provided data if the object has not changed since it was read
+	updatedAuditRecord.ID = existingAuditRecord.ID
+	updatedAuditRecord.ResourceVersion = apiserver_lib.NextResourceVersion(existingAuditRecord.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAuditRecord.ResourceVersion).Save(&updatedAuditRecord)
+	if result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAuditRecord.ResourceVersion), objectType)
+	}
+
+	// reload updated data from DB
+	if result := h.DB.First(&existingAuditRecord, auditRecordID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAuditRecord,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, existingAuditRecord, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, existingAuditRecord.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary deletes a audit record.
+// @Description Delete a audit record by ID from the database.
+// @ID delete-v0-auditRecord
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/audit-records/{id} [DELETE]
+func (h Handler) DeleteAuditRecord(c echo.Context) error {
+	objectType := api_v0.ObjectTypeAuditRecord
+	auditRecordID := c.Param("id")
+	var auditRecord api_v0.AuditRecord
+	if result := h.DB.First(&auditRecord, auditRecordID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, auditRecord.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*auditRecord.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", auditRecord.ResourceVersion).Delete(&auditRecord)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(auditRecord.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, auditRecord.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		auditRecord,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, auditRecord, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	echo "github.com/labstack/echo/v4"
+	gorm "gorm.io/gorm"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+func (h *Handler) AddRoleBindingMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectReservedRoleBindings,
+	}
+}
+
+func (h *Handler) GetRoleBindingMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{}
+}
+
+func (h *Handler) PatchRoleBindingMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectReservedRoleBindings,
+	}
+}
+
+func (h *Handler) PutRoleBindingMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{
+		h.RejectReservedRoleBindings,
+	}
+}
+
+func (h *Handler) DeleteRoleBindingMiddleware() []echo.MiddlewareFunc {
+	return []echo.MiddlewareFunc{}
+}
+
+// RejectReservedRoleBindings prevents clients from binding roles to the
+// subjects reserved for the threeport control plane components and the admin
+// client.  Those role bindings are created when the API is installed and may
+// be deleted but not created or changed by clients.
+func (h Handler) RejectReservedRoleBindings(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		objectType := v0.ObjectTypeRoleBinding
+
+		// subject of an existing role binding being updated
+		if id := c.Param("id"); id != "" {
+			var existingRoleBinding v0.RoleBinding
+			result := h.DB.First(&existingRoleBinding, "id = ?", id)
+			if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			}
+			if result.Error == nil && v0.AuthzReservedSubject(*existingRoleBinding.Subject) {
+				return apiserver_lib.ResponseStatus403(c, nil, reservedSubjectError(*existingRoleBinding.Subject), objectType)
+			}
+		}
+
+		// subject provided in the request payload
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+		var payload struct {
+			Subject *string `json:"Subject"`
+		}
+		if err := json.Unmarshal(body, &payload); err == nil &&
+			payload.Subject != nil &&
+			v0.AuthzReservedSubject(*payload.Subject) {
+			return apiserver_lib.ResponseStatus403(c, nil, reservedSubjectError(*payload.Subject), objectType)
+		}
+
+		return next(c)
+	}
+}
+
+// reservedSubjectError returns the error for a request that binds a role to
+// a reserved subject.
+func reservedSubjectError(subject string) error {
+	return fmt.Errorf(
+		"%s : subject %s is reserved for threeport control plane components",
+		apiserver_lib.ErrMsgPermissionDenied,
+		subject,
+	)
+}
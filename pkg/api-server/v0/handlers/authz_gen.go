@@ -0,0 +1,925 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package handlers
+
+import (
+	"errors"
+	echo "github.com/labstack/echo/v4"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	gorm "gorm.io/gorm"
+	"net/http"
+	"time"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+// Role
+///////////////////////////////////////////////////////////////////////////////
+
+// @Summary GetRoleVersions gets the supported versions for the role API.
+// @Description Get the supported API versions for roles.
+// @ID role-get-versions
+// @Produce json
+// @Success 200 {object} apiserver_lib.ApiObjectVersions "OK"
+// @Router /roles/versions [GET]
+func (h Handler) GetRoleVersions(c echo.Context) error {
+	return c.JSON(http.StatusOK, apiserver_lib.ObjectVersions[string(api_v0.ObjectTypeRole)])
+}
+
+// @Summary adds a new role.
+// @Description Add a new role to the Threeport database.
+// @ID add-v0-role
+// @Accept json
+// @Produce json
+// @Param role body api_v0.Role true "Role object"
+// @Success 201 {object} v0.Response "Created"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/roles [POST]
+func (h Handler) AddRole(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRole
+	var role api_v0.Role
+
+	// check for empty payload, unsupported fields, GORM Model fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, false, objectType, role); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	if err := c.Bind(&role); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// check for missing required fields
+	if id, err := apiserver_lib.ValidateBoundData(c, role, objectType); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// check for duplicate names
+	var existingRole api_v0.Role
+	nameUsed := true
+	result := h.DB.Where("name = ?", role.Name).First(&existingRole)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			nameUsed = false
+		} else {
+			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+		}
+	}
+	if nameUsed {
+		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
+	}
+
+	// persist to DB
+	if result := h.DB.Create(&role); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		role,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, role, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, role.ResourceVersion)
+	return apiserver_lib.ResponseStatus201(c, *response)
+}
+
+// @Summary gets all roles.
+// @Description Get all roles from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
+// @ID get-v0-roles
+// @Accept json
+// @Produce json
+// @Param name query string false "role search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/roles [GET]
+func (h Handler) GetRoles(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRole
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	var filter api_v0.Role
+	if err := c.Bind(&filter); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.Role{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.Role{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
+	}
+
+	records := &[]api_v0.Role{}
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary gets a role.
+// @Description Get a particular role from the database.
+// @ID get-v0-role
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/roles/{id} [GET]
+func (h Handler) GetRole(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRole
+	roleID := c.Param("id")
+	var role api_v0.Role
+	if result := h.DB.First(&role, roleID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, role, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, role.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary updates specific fields for an existing role.
+// @Description Update a role in the database.  Provide one or more fields to update.
+// @Description Note: This API endpint is for updating role objects only.
+// @Description Request bodies that include related objects will be accepted, however
+// @Description the related objects will not be changed.  Call the patch or put method for
+// @Description each particular existing object to change them.
+// @ID update-v0-role
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param role body api_v0.Role true "Role object"
+// @Param If-Match header string false "resource version the update is based on"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/roles/{id} [PATCH]
+func (h Handler) UpdateRole(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRole
+	roleID := c.Param("id")
+	var existingRole api_v0.Role
+	if result := h.DB.First(&existingRole, roleID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingRole.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// check for empty payload, invalid or unsupported fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingRole); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// bind payload
+	var updatedRole api_v0.Role
+	if err := c.Bind(&updatedRole); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// update object in database if it has not changed since it was read
+	updatedRole.ResourceVersion = apiserver_lib.NextResourceVersion(existingRole.ResourceVersion)
+	result := h.DB.Model(&existingRole).Where("resource_version = ?", existingRole.ResourceVersion).Updates(updatedRole)
+	if result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingRole.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingRole,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, existingRole, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, existingRole.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary updates an existing role by replacing the entire object.
+// @Description Replace a role in the database.  All required fields must be provided.
+// @Description If any optional fields are not provided, they will be null post-update.
+// @Description Note: This API endpint is for updating role objects only.
+// @Description Request bodies that include related objects will be accepted, however
+// @Description the related objects will not be changed.  Call the patch or put method for
+// @Description each particular existing object to change them.
+// @ID replace-v0-role
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param role body api_v0.Role true "Role object"
+// @Param If-Match header string false "resource version the update is based on"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/roles/{id} [PUT]
+func (h Handler) ReplaceRole(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRole
+	roleID := c.Param("id")
+	var existingRole api_v0.Role
+	if result := h.DB.First(&existingRole, roleID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingRole.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// check for empty payload, invalid or unsupported fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingRole); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// bind payload
+	var updatedRole api_v0.Role
+	if err := c.Bind(&updatedRole); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// check for missing required fields
+	if id, err := apiserver_lib.ValidateBoundData(c, updatedRole, objectType); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// persist provided data if the object has not changed since it was read
+	updatedRole.ID = existingRole.ID
+	updatedRole.ResourceVersion = apiserver_lib.NextResourceVersion(existingRole.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingRole.ResourceVersion).Save(&updatedRole)
+	if result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingRole.ResourceVersion), objectType)
+	}
+
+	// reload updated data from DB
+	if result := h.DB.First(&existingRole, roleID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingRole,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, existingRole, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, existingRole.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary deletes a role.
+// @Description Delete a role by ID from the database.
+// @ID delete-v0-role
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/roles/{id} [DELETE]
+func (h Handler) DeleteRole(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRole
+	roleID := c.Param("id")
+	var role api_v0.Role
+	if result := h.DB.First(&role, roleID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, role.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*role.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", role.ResourceVersion).Delete(&role)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(role.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, role.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		role,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, role, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// RoleBinding
+///////////////////////////////////////////////////////////////////////////////
+
+// @Summary GetRoleBindingVersions gets the supported versions for the role binding API.
+// @Description Get the supported API versions for role bindings.
+// @ID roleBinding-get-versions
+// @Produce json
+// @Success 200 {object} apiserver_lib.ApiObjectVersions "OK"
+// @Router /role-bindings/versions [GET]
+func (h Handler) GetRoleBindingVersions(c echo.Context) error {
+	return c.JSON(http.StatusOK, apiserver_lib.ObjectVersions[string(api_v0.ObjectTypeRoleBinding)])
+}
+
+// @Summary adds a new role binding.
+// @Description Add a new role binding to the Threeport database.
+// @ID add-v0-roleBinding
+// @Accept json
+// @Produce json
+// @Param roleBinding body api_v0.RoleBinding true "RoleBinding object"
+// @Success 201 {object} v0.Response "Created"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/role-bindings [POST]
+func (h Handler) AddRoleBinding(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRoleBinding
+	var roleBinding api_v0.RoleBinding
+
+	// check for empty payload, unsupported fields, GORM Model fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, false, objectType, roleBinding); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	if err := c.Bind(&roleBinding); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// check for missing required fields
+	if id, err := apiserver_lib.ValidateBoundData(c, roleBinding, objectType); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// check for duplicate names
+	var existingRoleBinding api_v0.RoleBinding
+	nameUsed := true
+	result := h.DB.Where("name = ?", roleBinding.Name).First(&existingRoleBinding)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			nameUsed = false
+		} else {
+			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+		}
+	}
+	if nameUsed {
+		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
+	}
+
+	// persist to DB
+	if result := h.DB.Create(&roleBinding); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		roleBinding,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, roleBinding, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, roleBinding.ResourceVersion)
+	return apiserver_lib.ResponseStatus201(c, *response)
+}
+
+// @Summary gets all role bindings.
+// @Description Get all role bindings from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
+// @ID get-v0-roleBindings
+// @Accept json
+// @Produce json
+// @Param name query string false "role binding search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/role-bindings [GET]
+func (h Handler) GetRoleBindings(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRoleBinding
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	var filter api_v0.RoleBinding
+	if err := c.Bind(&filter); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.RoleBinding{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.RoleBinding{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
+	}
+
+	records := &[]api_v0.RoleBinding{}
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary gets a role binding.
+// @Description Get a particular role binding from the database.
+// @ID get-v0-roleBinding
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/role-bindings/{id} [GET]
+func (h Handler) GetRoleBinding(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRoleBinding
+	roleBindingID := c.Param("id")
+	var roleBinding api_v0.RoleBinding
+	if result := h.DB.First(&roleBinding, roleBindingID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, roleBinding, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, roleBinding.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary updates specific fields for an existing role binding.
+// @Description Update a role binding in the database.  Provide one or more fields to update.
+// @Description Note: This API endpint is for updating role binding objects only.
+// @Description Request bodies that include related objects will be accepted, however
+// @Description the related objects will not be changed.  Call the patch or put method for
+// @Description each particular existing object to change them.
+// @ID update-v0-roleBinding
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param roleBinding body api_v0.RoleBinding true "RoleBinding object"
+// @Param If-Match header string false "resource version the update is based on"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/role-bindings/{id} [PATCH]
+func (h Handler) UpdateRoleBinding(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRoleBinding
+	roleBindingID := c.Param("id")
+	var existingRoleBinding api_v0.RoleBinding
+	if result := h.DB.First(&existingRoleBinding, roleBindingID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingRoleBinding.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// check for empty payload, invalid or unsupported fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingRoleBinding); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// bind payload
+	var updatedRoleBinding api_v0.RoleBinding
+	if err := c.Bind(&updatedRoleBinding); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// update object in database if it has not changed since it was read
+	updatedRoleBinding.ResourceVersion = apiserver_lib.NextResourceVersion(existingRoleBinding.ResourceVersion)
+	result := h.DB.Model(&existingRoleBinding).Where("resource_version = ?", existingRoleBinding.ResourceVersion).Updates(updatedRoleBinding)
+	if result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingRoleBinding.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingRoleBinding,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, existingRoleBinding, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, existingRoleBinding.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary updates an existing role binding by replacing the entire object.
+// @Description Replace a role binding in the database.  All required fields must be provided.
+// @Description If any optional fields are not provided, they will be null post-update.
+// @Description Note: This API endpint is for updating role binding objects only.
+// @Description Request bodies that include related objects will be accepted, however
+// @Description the related objects will not be changed.  Call the patch or put method for
+// @Description each particular existing object to change them.
+// @ID replace-v0-roleBinding
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param roleBinding body api_v0.RoleBinding true "RoleBinding object"
+// @Param If-Match header string false "resource version the update is based on"
+// @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/role-bindings/{id} [PUT]
+func (h Handler) ReplaceRoleBinding(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRoleBinding
+	roleBindingID := c.Param("id")
+	var existingRoleBinding api_v0.RoleBinding
+	if result := h.DB.First(&existingRoleBinding, roleBindingID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingRoleBinding.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// check for empty payload, invalid or unsupported fields, optional associations, etc.
+	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingRoleBinding); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// bind payload
+	var updatedRoleBinding api_v0.RoleBinding
+	if err := c.Bind(&updatedRoleBinding); err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	// check for missing required fields
+	if id, err := apiserver_lib.ValidateBoundData(c, updatedRoleBinding, objectType); err != nil {
+		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
+	}
+
+	// persist provided data if the object has not changed since it was read
+	updatedRoleBinding.ID = existingRoleBinding.ID
+	updatedRoleBinding.ResourceVersion = apiserver_lib.NextResourceVersion(existingRoleBinding.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingRoleBinding.ResourceVersion).Save(&updatedRoleBinding)
+	if result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingRoleBinding.ResourceVersion), objectType)
+	}
+
+	// reload updated data from DB
+	if result := h.DB.First(&existingRoleBinding, roleBindingID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingRoleBinding,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, existingRoleBinding, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	apiserver_lib.SetETag(c, existingRoleBinding.ResourceVersion)
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary deletes a role binding.
+// @Description Delete a role binding by ID from the database.
+// @ID delete-v0-roleBinding
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/role-bindings/{id} [DELETE]
+func (h Handler) DeleteRoleBinding(c echo.Context) error {
+	objectType := api_v0.ObjectTypeRoleBinding
+	roleBindingID := c.Param("id")
+	var roleBinding api_v0.RoleBinding
+	if result := h.DB.First(&roleBinding, roleBindingID); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return apiserver_lib.ResponseStatus404(c, nil, result.Error, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	}
+
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, roleBinding.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*roleBinding.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", roleBinding.ResourceVersion).Delete(&roleBinding)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(roleBinding.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, roleBinding.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		roleBinding,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, roleBinding, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// TestRejectReservedRoleBindings tests that roles can't be bound to reserved
+// subjects and that role bindings for reserved subjects can't be changed.
+func TestRejectReservedRoleBindings(t *testing.T) {
+	testCases := []struct {
+		name   string
+		method string
+		id     string
+		body   string
+		// the subject of the existing role binding, empty if it doesn't
+		// exist
+		existingSubject string
+		status          int
+	}{
+		{
+			name:   "create for client subject",
+			method: http.MethodPost,
+			body:   `{"Name":"dev","Subject":"dev-team","RoleID":1}`,
+			status: http.StatusOK,
+		},
+		{
+			name:   "create for control plane component",
+			method: http.MethodPost,
+			body:   `{"Name":"agent","Subject":"threeport-system:agent","RoleID":1}`,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "create for admin client",
+			method: http.MethodPost,
+			body:   `{"Name":"admin","Subject":"threeport-admin","RoleID":1}`,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "create for legacy subject",
+			method: http.MethodPost,
+			body:   `{"Name":"legacy","Subject":"localhost","RoleID":1}`,
+			status: http.StatusForbidden,
+		},
+		{
+			name:            "update client role binding",
+			method:          http.MethodPatch,
+			id:              "4",
+			body:            `{"RoleID":2}`,
+			existingSubject: "dev-team",
+			status:          http.StatusOK,
+		},
+		{
+			name:            "update client role binding to reserved subject",
+			method:          http.MethodPatch,
+			id:              "4",
+			body:            `{"Subject":"threeport-system:*"}`,
+			existingSubject: "dev-team",
+			status:          http.StatusForbidden,
+		},
+		{
+			name:            "update reserved role binding",
+			method:          http.MethodPut,
+			id:              "1",
+			body:            `{"Name":"threeport-admin","Subject":"dev-team","RoleID":2}`,
+			existingSubject: "threeport-admin",
+			status:          http.StatusForbidden,
+		},
+		{
+			name:   "update role binding that doesn't exist",
+			method: http.MethodPatch,
+			id:     "9",
+			body:   `{"RoleID":2}`,
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockTestDB(t)
+
+			if tc.id != "" {
+				rows := sqlmock.NewRows([]string{"id", "name", "subject", "role_id"})
+				if tc.existingSubject != "" {
+					rows.AddRow(tc.id, "existing", tc.existingSubject, 1)
+				}
+				mock.ExpectQuery(`SELECT \* FROM "v0_role_bindings"`).WithArgs(tc.id, 1).WillReturnRows(rows)
+			}
+
+			request := httptest.NewRequest(tc.method, v0.PathRoleBindings, strings.NewReader(tc.body))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			recorder := httptest.NewRecorder()
+			c := echo.New().NewContext(request, recorder)
+			if tc.id != "" {
+				c.SetParamNames("id")
+				c.SetParamValues(tc.id)
+			}
+
+			h := Handler{DB: db}
+			handler := h.RejectReservedRoleBindings(func(c echo.Context) error {
+				// the payload remains available to the handler
+				var roleBinding v0.RoleBinding
+				if err := c.Bind(&roleBinding); err != nil {
+					return err
+				}
+				return c.NoContent(http.StatusOK)
+			})
+			require.NoError(t, handler(c))
+
+			assert.Equal(t, tc.status, recorder.Code, recorder.Body.String())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
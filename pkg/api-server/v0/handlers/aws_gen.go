@@ -77,26 +77,56 @@ func (h Handler) AddAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsAccount,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, awsAccount, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsAccount.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws accounts.
 // @Description Get all aws accounts from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsAccounts
 // @Accept json
 // @Produce json
 // @Param name query string false "aws account search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-accounts [GET]
 func (h Handler) GetAwsAccounts(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsAccount
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -107,17 +137,44 @@ func (h Handler) GetAwsAccounts(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsAccount{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsAccount{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsAccount{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsAccount{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -132,6 +189,7 @@ func (h Handler) GetAwsAccounts(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-accounts/{id} [GET]
@@ -151,6 +209,7 @@ func (h Handler) GetAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsAccount.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -165,9 +224,12 @@ func (h Handler) GetAwsAccount(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsAccount body api_v0.AwsAccount true "AwsAccount object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-accounts/{id} [PATCH]
 func (h Handler) UpdateAwsAccount(c echo.Context) error {
@@ -181,6 +243,11 @@ func (h Handler) UpdateAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsAccount.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsAccount); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -192,16 +259,34 @@ func (h Handler) UpdateAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsAccount).Updates(updatedAwsAccount); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedAwsAccount.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsAccount.ResourceVersion)
+	result := h.DB.Model(&existingAwsAccount).Where("resource_version = ?", existingAwsAccount.ResourceVersion).Updates(updatedAwsAccount)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsAccount.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsAccount,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsAccount, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsAccount.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -217,9 +302,12 @@ func (h Handler) UpdateAwsAccount(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsAccount body api_v0.AwsAccount true "AwsAccount object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-accounts/{id} [PUT]
 func (h Handler) ReplaceAwsAccount(c echo.Context) error {
@@ -233,6 +321,11 @@ func (h Handler) ReplaceAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsAccount.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsAccount); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -249,11 +342,16 @@ func (h Handler) ReplaceAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsAccount.ID = existingAwsAccount.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsAccount); result.Error != nil {
+	updatedAwsAccount.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsAccount.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsAccount.ResourceVersion).Save(&updatedAwsAccount)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsAccount.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsAccount, awsAccountID); result.Error != nil {
@@ -263,11 +361,24 @@ func (h Handler) ReplaceAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsAccount,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsAccount, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsAccount.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -277,7 +388,10 @@ func (h Handler) ReplaceAwsAccount(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -293,9 +407,60 @@ func (h Handler) DeleteAwsAccount(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&awsAccount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsAccount.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*awsAccount.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", awsAccount.ResourceVersion).Delete(&awsAccount)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(awsAccount.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, awsAccount.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		awsAccount,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsAccount, objectType)
@@ -368,26 +533,56 @@ func (h Handler) AddAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsEksKubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, awsEksKubernetesRuntimeDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsEksKubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws eks kubernetes runtime definitions.
 // @Description Get all aws eks kubernetes runtime definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsEksKubernetesRuntimeDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "aws eks kubernetes runtime definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-definitions [GET]
 func (h Handler) GetAwsEksKubernetesRuntimeDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsEksKubernetesRuntimeDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -398,17 +593,44 @@ func (h Handler) GetAwsEksKubernetesRuntimeDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsEksKubernetesRuntimeDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsEksKubernetesRuntimeDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsEksKubernetesRuntimeDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsEksKubernetesRuntimeDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -423,6 +645,7 @@ func (h Handler) GetAwsEksKubernetesRuntimeDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-definitions/{id} [GET]
@@ -442,6 +665,7 @@ func (h Handler) GetAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsEksKubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -456,9 +680,12 @@ func (h Handler) GetAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsEksKubernetesRuntimeDefinition body api_v0.AwsEksKubernetesRuntimeDefinition true "AwsEksKubernetesRuntimeDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-definitions/{id} [PATCH]
 func (h Handler) UpdateAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
@@ -472,6 +699,11 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsEksKubernetesRuntimeDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsEksKubernetesRuntimeDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -483,16 +715,34 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsEksKubernetesRuntimeDefinition).Updates(updatedAwsEksKubernetesRuntimeDefinition); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedAwsEksKubernetesRuntimeDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsEksKubernetesRuntimeDefinition.ResourceVersion)
+	result := h.DB.Model(&existingAwsEksKubernetesRuntimeDefinition).Where("resource_version = ?", existingAwsEksKubernetesRuntimeDefinition.ResourceVersion).Updates(updatedAwsEksKubernetesRuntimeDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsEksKubernetesRuntimeDefinition.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsEksKubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsEksKubernetesRuntimeDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsEksKubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -508,9 +758,12 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsEksKubernetesRuntimeDefinition body api_v0.AwsEksKubernetesRuntimeDefinition true "AwsEksKubernetesRuntimeDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-definitions/{id} [PUT]
 func (h Handler) ReplaceAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
@@ -524,6 +777,11 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeDefinition(c echo.Context) error
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsEksKubernetesRuntimeDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsEksKubernetesRuntimeDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -540,11 +798,16 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeDefinition(c echo.Context) error
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsEksKubernetesRuntimeDefinition.ID = existingAwsEksKubernetesRuntimeDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsEksKubernetesRuntimeDefinition); result.Error != nil {
+	updatedAwsEksKubernetesRuntimeDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsEksKubernetesRuntimeDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsEksKubernetesRuntimeDefinition.ResourceVersion).Save(&updatedAwsEksKubernetesRuntimeDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsEksKubernetesRuntimeDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsEksKubernetesRuntimeDefinition, awsEksKubernetesRuntimeDefinitionID); result.Error != nil {
@@ -554,11 +817,24 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeDefinition(c echo.Context) error
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsEksKubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsEksKubernetesRuntimeDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsEksKubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -568,7 +844,10 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeDefinition(c echo.Context) error
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -590,9 +869,60 @@ func (h Handler) DeleteAwsEksKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&awsEksKubernetesRuntimeDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsEksKubernetesRuntimeDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*awsEksKubernetesRuntimeDefinition.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", awsEksKubernetesRuntimeDefinition.ResourceVersion).Delete(&awsEksKubernetesRuntimeDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(awsEksKubernetesRuntimeDefinition.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, awsEksKubernetesRuntimeDefinition.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		awsEksKubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsEksKubernetesRuntimeDefinition, objectType)
@@ -660,22 +990,44 @@ func (h Handler) AddAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&awsEksKubernetesRuntimeInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&awsEksKubernetesRuntimeInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*awsEksKubernetesRuntimeInstance.Reconciled {
-		notifPayload, err := awsEksKubernetesRuntimeInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*awsEksKubernetesRuntimeInstance.Reconciled {
+			notifPayload, err := awsEksKubernetesRuntimeInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsEksKubernetesRuntimeInstanceCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.AwsEksKubernetesRuntimeInstanceCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsEksKubernetesRuntimeInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsEksKubernetesRuntimeInstance, objectType)
@@ -683,21 +1035,39 @@ func (h Handler) AddAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsEksKubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws eks kubernetes runtime instances.
 // @Description Get all aws eks kubernetes runtime instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsEksKubernetesRuntimeInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "aws eks kubernetes runtime instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-instances [GET]
 func (h Handler) GetAwsEksKubernetesRuntimeInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsEksKubernetesRuntimeInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -708,17 +1078,44 @@ func (h Handler) GetAwsEksKubernetesRuntimeInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsEksKubernetesRuntimeInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsEksKubernetesRuntimeInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsEksKubernetesRuntimeInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsEksKubernetesRuntimeInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -733,6 +1130,7 @@ func (h Handler) GetAwsEksKubernetesRuntimeInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-instances/{id} [GET]
@@ -752,6 +1150,7 @@ func (h Handler) GetAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsEksKubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -766,9 +1165,12 @@ func (h Handler) GetAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsEksKubernetesRuntimeInstance body api_v0.AwsEksKubernetesRuntimeInstance true "AwsEksKubernetesRuntimeInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-instances/{id} [PATCH]
 func (h Handler) UpdateAwsEksKubernetesRuntimeInstance(c echo.Context) error {
@@ -782,6 +1184,11 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsEksKubernetesRuntimeInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsEksKubernetesRuntimeInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -793,22 +1200,67 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsEksKubernetesRuntimeInstance).Updates(updatedAwsEksKubernetesRuntimeInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedAwsEksKubernetesRuntimeInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsEksKubernetesRuntimeInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingAwsEksKubernetesRuntimeInstance).Where("resource_version = ?", existingAwsEksKubernetesRuntimeInstance.ResourceVersion).Updates(updatedAwsEksKubernetesRuntimeInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingAwsEksKubernetesRuntimeInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingAwsEksKubernetesRuntimeInstance.Reconciled {
-		notifPayload, err := existingAwsEksKubernetesRuntimeInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedAwsEksKubernetesRuntimeInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingAwsEksKubernetesRuntimeInstance,
+				*existingAwsEksKubernetesRuntimeInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
 		}
-		h.JS.Publish(notif.AwsEksKubernetesRuntimeInstanceUpdateSubject, *notifPayload)
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingAwsEksKubernetesRuntimeInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedAwsEksKubernetesRuntimeInstance) {
+			notifPayload, err := existingAwsEksKubernetesRuntimeInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsEksKubernetesRuntimeInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsEksKubernetesRuntimeInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsEksKubernetesRuntimeInstance, objectType)
@@ -816,6 +1268,7 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsEksKubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -831,9 +1284,12 @@ func (h Handler) UpdateAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsEksKubernetesRuntimeInstance body api_v0.AwsEksKubernetesRuntimeInstance true "AwsEksKubernetesRuntimeInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-eks-kubernetes-runtime-instances/{id} [PUT]
 func (h Handler) ReplaceAwsEksKubernetesRuntimeInstance(c echo.Context) error {
@@ -847,6 +1303,11 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsEksKubernetesRuntimeInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsEksKubernetesRuntimeInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -863,11 +1324,16 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsEksKubernetesRuntimeInstance.ID = existingAwsEksKubernetesRuntimeInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsEksKubernetesRuntimeInstance); result.Error != nil {
+	updatedAwsEksKubernetesRuntimeInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsEksKubernetesRuntimeInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsEksKubernetesRuntimeInstance.ResourceVersion).Save(&updatedAwsEksKubernetesRuntimeInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsEksKubernetesRuntimeInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsEksKubernetesRuntimeInstance, awsEksKubernetesRuntimeInstanceID); result.Error != nil {
@@ -877,11 +1343,24 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsEksKubernetesRuntimeInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsEksKubernetesRuntimeInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsEksKubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -891,7 +1370,10 @@ func (h Handler) ReplaceAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -907,6 +1389,17 @@ func (h Handler) DeleteAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsEksKubernetesRuntimeInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -915,23 +1408,77 @@ func (h Handler) DeleteAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledAwsEksKubernetesRuntimeInstance := api_v0.AwsEksKubernetesRuntimeInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(awsEksKubernetesRuntimeInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&awsEksKubernetesRuntimeInstance).Updates(scheduledAwsEksKubernetesRuntimeInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := awsEksKubernetesRuntimeInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*awsEksKubernetesRuntimeInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledAwsEksKubernetesRuntimeInstance.Finalizers = awsEksKubernetesRuntimeInstance.Finalizers
+				scheduledAwsEksKubernetesRuntimeInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&awsEksKubernetesRuntimeInstance).Where("resource_version = ?", awsEksKubernetesRuntimeInstance.ResourceVersion).Updates(scheduledAwsEksKubernetesRuntimeInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(awsEksKubernetesRuntimeInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := awsEksKubernetesRuntimeInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsEksKubernetesRuntimeInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.AwsEksKubernetesRuntimeInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			awsEksKubernetesRuntimeInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if awsEksKubernetesRuntimeInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -940,11 +1487,38 @@ func (h Handler) DeleteAwsEksKubernetesRuntimeInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*awsEksKubernetesRuntimeInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(awsEksKubernetesRuntimeInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&awsEksKubernetesRuntimeInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", awsEksKubernetesRuntimeInstance.ResourceVersion).Delete(&awsEksKubernetesRuntimeInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(awsEksKubernetesRuntimeInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, awsEksKubernetesRuntimeInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				awsEksKubernetesRuntimeInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -1019,26 +1593,56 @@ func (h Handler) AddAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsObjectStorageBucketDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, awsObjectStorageBucketDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsObjectStorageBucketDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws object storage bucket definitions.
 // @Description Get all aws object storage bucket definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsObjectStorageBucketDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "aws object storage bucket definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-definitions [GET]
 func (h Handler) GetAwsObjectStorageBucketDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsObjectStorageBucketDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -1049,17 +1653,44 @@ func (h Handler) GetAwsObjectStorageBucketDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsObjectStorageBucketDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsObjectStorageBucketDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsObjectStorageBucketDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsObjectStorageBucketDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -1074,6 +1705,7 @@ func (h Handler) GetAwsObjectStorageBucketDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-definitions/{id} [GET]
@@ -1093,6 +1725,7 @@ func (h Handler) GetAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsObjectStorageBucketDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1107,9 +1740,12 @@ func (h Handler) GetAwsObjectStorageBucketDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsObjectStorageBucketDefinition body api_v0.AwsObjectStorageBucketDefinition true "AwsObjectStorageBucketDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-definitions/{id} [PATCH]
 func (h Handler) UpdateAwsObjectStorageBucketDefinition(c echo.Context) error {
@@ -1123,6 +1759,11 @@ func (h Handler) UpdateAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsObjectStorageBucketDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsObjectStorageBucketDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1134,16 +1775,34 @@ func (h Handler) UpdateAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsObjectStorageBucketDefinition).Updates(updatedAwsObjectStorageBucketDefinition); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedAwsObjectStorageBucketDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsObjectStorageBucketDefinition.ResourceVersion)
+	result := h.DB.Model(&existingAwsObjectStorageBucketDefinition).Where("resource_version = ?", existingAwsObjectStorageBucketDefinition.ResourceVersion).Updates(updatedAwsObjectStorageBucketDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsObjectStorageBucketDefinition.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsObjectStorageBucketDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsObjectStorageBucketDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsObjectStorageBucketDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1159,9 +1818,12 @@ func (h Handler) UpdateAwsObjectStorageBucketDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsObjectStorageBucketDefinition body api_v0.AwsObjectStorageBucketDefinition true "AwsObjectStorageBucketDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-definitions/{id} [PUT]
 func (h Handler) ReplaceAwsObjectStorageBucketDefinition(c echo.Context) error {
@@ -1175,6 +1837,11 @@ func (h Handler) ReplaceAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsObjectStorageBucketDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsObjectStorageBucketDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1191,11 +1858,16 @@ func (h Handler) ReplaceAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsObjectStorageBucketDefinition.ID = existingAwsObjectStorageBucketDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsObjectStorageBucketDefinition); result.Error != nil {
+	updatedAwsObjectStorageBucketDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsObjectStorageBucketDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsObjectStorageBucketDefinition.ResourceVersion).Save(&updatedAwsObjectStorageBucketDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsObjectStorageBucketDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsObjectStorageBucketDefinition, awsObjectStorageBucketDefinitionID); result.Error != nil {
@@ -1205,11 +1877,24 @@ func (h Handler) ReplaceAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsObjectStorageBucketDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsObjectStorageBucketDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsObjectStorageBucketDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1219,7 +1904,10 @@ func (h Handler) ReplaceAwsObjectStorageBucketDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -1241,9 +1929,60 @@ func (h Handler) DeleteAwsObjectStorageBucketDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&awsObjectStorageBucketDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsObjectStorageBucketDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*awsObjectStorageBucketDefinition.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", awsObjectStorageBucketDefinition.ResourceVersion).Delete(&awsObjectStorageBucketDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(awsObjectStorageBucketDefinition.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, awsObjectStorageBucketDefinition.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		awsObjectStorageBucketDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsObjectStorageBucketDefinition, objectType)
@@ -1311,22 +2050,44 @@ func (h Handler) AddAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&awsObjectStorageBucketInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&awsObjectStorageBucketInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*awsObjectStorageBucketInstance.Reconciled {
-		notifPayload, err := awsObjectStorageBucketInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*awsObjectStorageBucketInstance.Reconciled {
+			notifPayload, err := awsObjectStorageBucketInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsObjectStorageBucketInstanceCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.AwsObjectStorageBucketInstanceCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsObjectStorageBucketInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsObjectStorageBucketInstance, objectType)
@@ -1334,21 +2095,39 @@ func (h Handler) AddAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsObjectStorageBucketInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws object storage bucket instances.
 // @Description Get all aws object storage bucket instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsObjectStorageBucketInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "aws object storage bucket instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-instances [GET]
 func (h Handler) GetAwsObjectStorageBucketInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsObjectStorageBucketInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -1359,17 +2138,44 @@ func (h Handler) GetAwsObjectStorageBucketInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsObjectStorageBucketInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsObjectStorageBucketInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsObjectStorageBucketInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsObjectStorageBucketInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -1384,6 +2190,7 @@ func (h Handler) GetAwsObjectStorageBucketInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-instances/{id} [GET]
@@ -1403,6 +2210,7 @@ func (h Handler) GetAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsObjectStorageBucketInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1417,9 +2225,12 @@ func (h Handler) GetAwsObjectStorageBucketInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsObjectStorageBucketInstance body api_v0.AwsObjectStorageBucketInstance true "AwsObjectStorageBucketInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-instances/{id} [PATCH]
 func (h Handler) UpdateAwsObjectStorageBucketInstance(c echo.Context) error {
@@ -1433,6 +2244,11 @@ func (h Handler) UpdateAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsObjectStorageBucketInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsObjectStorageBucketInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1444,22 +2260,67 @@ func (h Handler) UpdateAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsObjectStorageBucketInstance).Updates(updatedAwsObjectStorageBucketInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedAwsObjectStorageBucketInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsObjectStorageBucketInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingAwsObjectStorageBucketInstance).Where("resource_version = ?", existingAwsObjectStorageBucketInstance.ResourceVersion).Updates(updatedAwsObjectStorageBucketInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingAwsObjectStorageBucketInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingAwsObjectStorageBucketInstance.Reconciled {
-		notifPayload, err := existingAwsObjectStorageBucketInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedAwsObjectStorageBucketInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingAwsObjectStorageBucketInstance,
+				*existingAwsObjectStorageBucketInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingAwsObjectStorageBucketInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedAwsObjectStorageBucketInstance) {
+			notifPayload, err := existingAwsObjectStorageBucketInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsObjectStorageBucketInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.AwsObjectStorageBucketInstanceUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsObjectStorageBucketInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsObjectStorageBucketInstance, objectType)
@@ -1467,6 +2328,7 @@ func (h Handler) UpdateAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsObjectStorageBucketInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1482,9 +2344,12 @@ func (h Handler) UpdateAwsObjectStorageBucketInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsObjectStorageBucketInstance body api_v0.AwsObjectStorageBucketInstance true "AwsObjectStorageBucketInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-object-storage-bucket-instances/{id} [PUT]
 func (h Handler) ReplaceAwsObjectStorageBucketInstance(c echo.Context) error {
@@ -1498,6 +2363,11 @@ func (h Handler) ReplaceAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsObjectStorageBucketInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsObjectStorageBucketInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1514,11 +2384,16 @@ func (h Handler) ReplaceAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsObjectStorageBucketInstance.ID = existingAwsObjectStorageBucketInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsObjectStorageBucketInstance); result.Error != nil {
+	updatedAwsObjectStorageBucketInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsObjectStorageBucketInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsObjectStorageBucketInstance.ResourceVersion).Save(&updatedAwsObjectStorageBucketInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsObjectStorageBucketInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsObjectStorageBucketInstance, awsObjectStorageBucketInstanceID); result.Error != nil {
@@ -1528,11 +2403,24 @@ func (h Handler) ReplaceAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsObjectStorageBucketInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsObjectStorageBucketInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsObjectStorageBucketInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1542,7 +2430,10 @@ func (h Handler) ReplaceAwsObjectStorageBucketInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -1558,6 +2449,17 @@ func (h Handler) DeleteAwsObjectStorageBucketInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsObjectStorageBucketInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -1566,23 +2468,77 @@ func (h Handler) DeleteAwsObjectStorageBucketInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledAwsObjectStorageBucketInstance := api_v0.AwsObjectStorageBucketInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(awsObjectStorageBucketInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&awsObjectStorageBucketInstance).Updates(scheduledAwsObjectStorageBucketInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := awsObjectStorageBucketInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*awsObjectStorageBucketInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledAwsObjectStorageBucketInstance.Finalizers = awsObjectStorageBucketInstance.Finalizers
+				scheduledAwsObjectStorageBucketInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&awsObjectStorageBucketInstance).Where("resource_version = ?", awsObjectStorageBucketInstance.ResourceVersion).Updates(scheduledAwsObjectStorageBucketInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(awsObjectStorageBucketInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := awsObjectStorageBucketInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsObjectStorageBucketInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.AwsObjectStorageBucketInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			awsObjectStorageBucketInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if awsObjectStorageBucketInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -1591,11 +2547,38 @@ func (h Handler) DeleteAwsObjectStorageBucketInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*awsObjectStorageBucketInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(awsObjectStorageBucketInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&awsObjectStorageBucketInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", awsObjectStorageBucketInstance.ResourceVersion).Delete(&awsObjectStorageBucketInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(awsObjectStorageBucketInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, awsObjectStorageBucketInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				awsObjectStorageBucketInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -1670,26 +2653,56 @@ func (h Handler) AddAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsRelationalDatabaseDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, awsRelationalDatabaseDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsRelationalDatabaseDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws relational database definitions.
 // @Description Get all aws relational database definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsRelationalDatabaseDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "aws relational database definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-definitions [GET]
 func (h Handler) GetAwsRelationalDatabaseDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsRelationalDatabaseDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -1700,17 +2713,44 @@ func (h Handler) GetAwsRelationalDatabaseDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsRelationalDatabaseDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsRelationalDatabaseDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsRelationalDatabaseDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsRelationalDatabaseDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -1725,6 +2765,7 @@ func (h Handler) GetAwsRelationalDatabaseDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-definitions/{id} [GET]
@@ -1744,6 +2785,7 @@ func (h Handler) GetAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsRelationalDatabaseDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1758,9 +2800,12 @@ func (h Handler) GetAwsRelationalDatabaseDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsRelationalDatabaseDefinition body api_v0.AwsRelationalDatabaseDefinition true "AwsRelationalDatabaseDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-definitions/{id} [PATCH]
 func (h Handler) UpdateAwsRelationalDatabaseDefinition(c echo.Context) error {
@@ -1774,6 +2819,11 @@ func (h Handler) UpdateAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsRelationalDatabaseDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsRelationalDatabaseDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1785,16 +2835,34 @@ func (h Handler) UpdateAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsRelationalDatabaseDefinition).Updates(updatedAwsRelationalDatabaseDefinition); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedAwsRelationalDatabaseDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsRelationalDatabaseDefinition.ResourceVersion)
+	result := h.DB.Model(&existingAwsRelationalDatabaseDefinition).Where("resource_version = ?", existingAwsRelationalDatabaseDefinition.ResourceVersion).Updates(updatedAwsRelationalDatabaseDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsRelationalDatabaseDefinition.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsRelationalDatabaseDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsRelationalDatabaseDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsRelationalDatabaseDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1810,9 +2878,12 @@ func (h Handler) UpdateAwsRelationalDatabaseDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsRelationalDatabaseDefinition body api_v0.AwsRelationalDatabaseDefinition true "AwsRelationalDatabaseDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-definitions/{id} [PUT]
 func (h Handler) ReplaceAwsRelationalDatabaseDefinition(c echo.Context) error {
@@ -1826,6 +2897,11 @@ func (h Handler) ReplaceAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsRelationalDatabaseDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsRelationalDatabaseDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1842,11 +2918,16 @@ func (h Handler) ReplaceAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsRelationalDatabaseDefinition.ID = existingAwsRelationalDatabaseDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsRelationalDatabaseDefinition); result.Error != nil {
+	updatedAwsRelationalDatabaseDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsRelationalDatabaseDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsRelationalDatabaseDefinition.ResourceVersion).Save(&updatedAwsRelationalDatabaseDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsRelationalDatabaseDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsRelationalDatabaseDefinition, awsRelationalDatabaseDefinitionID); result.Error != nil {
@@ -1856,11 +2937,24 @@ func (h Handler) ReplaceAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsRelationalDatabaseDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsRelationalDatabaseDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsRelationalDatabaseDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1870,7 +2964,10 @@ func (h Handler) ReplaceAwsRelationalDatabaseDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -1892,9 +2989,60 @@ func (h Handler) DeleteAwsRelationalDatabaseDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&awsRelationalDatabaseDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsRelationalDatabaseDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*awsRelationalDatabaseDefinition.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", awsRelationalDatabaseDefinition.ResourceVersion).Delete(&awsRelationalDatabaseDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(awsRelationalDatabaseDefinition.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, awsRelationalDatabaseDefinition.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		awsRelationalDatabaseDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsRelationalDatabaseDefinition, objectType)
@@ -1962,22 +3110,44 @@ func (h Handler) AddAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&awsRelationalDatabaseInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&awsRelationalDatabaseInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*awsRelationalDatabaseInstance.Reconciled {
-		notifPayload, err := awsRelationalDatabaseInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*awsRelationalDatabaseInstance.Reconciled {
+			notifPayload, err := awsRelationalDatabaseInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsRelationalDatabaseInstanceCreateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.AwsRelationalDatabaseInstanceCreateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		awsRelationalDatabaseInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, awsRelationalDatabaseInstance, objectType)
@@ -1985,21 +3155,39 @@ func (h Handler) AddAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsRelationalDatabaseInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all aws relational database instances.
 // @Description Get all aws relational database instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-awsRelationalDatabaseInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "aws relational database instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-instances [GET]
 func (h Handler) GetAwsRelationalDatabaseInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeAwsRelationalDatabaseInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -2010,17 +3198,44 @@ func (h Handler) GetAwsRelationalDatabaseInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.AwsRelationalDatabaseInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.AwsRelationalDatabaseInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.AwsRelationalDatabaseInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.AwsRelationalDatabaseInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -2035,6 +3250,7 @@ func (h Handler) GetAwsRelationalDatabaseInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-instances/{id} [GET]
@@ -2054,6 +3270,7 @@ func (h Handler) GetAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, awsRelationalDatabaseInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -2068,9 +3285,12 @@ func (h Handler) GetAwsRelationalDatabaseInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsRelationalDatabaseInstance body api_v0.AwsRelationalDatabaseInstance true "AwsRelationalDatabaseInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-instances/{id} [PATCH]
 func (h Handler) UpdateAwsRelationalDatabaseInstance(c echo.Context) error {
@@ -2084,6 +3304,11 @@ func (h Handler) UpdateAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsRelationalDatabaseInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsRelationalDatabaseInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -2095,22 +3320,67 @@ func (h Handler) UpdateAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingAwsRelationalDatabaseInstance).Updates(updatedAwsRelationalDatabaseInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedAwsRelationalDatabaseInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsRelationalDatabaseInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingAwsRelationalDatabaseInstance).Where("resource_version = ?", existingAwsRelationalDatabaseInstance.ResourceVersion).Updates(updatedAwsRelationalDatabaseInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingAwsRelationalDatabaseInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingAwsRelationalDatabaseInstance.Reconciled {
-		notifPayload, err := existingAwsRelationalDatabaseInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedAwsRelationalDatabaseInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingAwsRelationalDatabaseInstance,
+				*existingAwsRelationalDatabaseInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingAwsRelationalDatabaseInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedAwsRelationalDatabaseInstance) {
+			notifPayload, err := existingAwsRelationalDatabaseInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsRelationalDatabaseInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.AwsRelationalDatabaseInstanceUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsRelationalDatabaseInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsRelationalDatabaseInstance, objectType)
@@ -2118,6 +3388,7 @@ func (h Handler) UpdateAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsRelationalDatabaseInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -2133,9 +3404,12 @@ func (h Handler) UpdateAwsRelationalDatabaseInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param awsRelationalDatabaseInstance body api_v0.AwsRelationalDatabaseInstance true "AwsRelationalDatabaseInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/aws-relational-database-instances/{id} [PUT]
 func (h Handler) ReplaceAwsRelationalDatabaseInstance(c echo.Context) error {
@@ -2149,6 +3423,11 @@ func (h Handler) ReplaceAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingAwsRelationalDatabaseInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingAwsRelationalDatabaseInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -2165,11 +3444,16 @@ func (h Handler) ReplaceAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedAwsRelationalDatabaseInstance.ID = existingAwsRelationalDatabaseInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedAwsRelationalDatabaseInstance); result.Error != nil {
+	updatedAwsRelationalDatabaseInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingAwsRelationalDatabaseInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingAwsRelationalDatabaseInstance.ResourceVersion).Save(&updatedAwsRelationalDatabaseInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingAwsRelationalDatabaseInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingAwsRelationalDatabaseInstance, awsRelationalDatabaseInstanceID); result.Error != nil {
@@ -2179,11 +3463,24 @@ func (h Handler) ReplaceAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingAwsRelationalDatabaseInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingAwsRelationalDatabaseInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingAwsRelationalDatabaseInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -2193,7 +3490,10 @@ func (h Handler) ReplaceAwsRelationalDatabaseInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -2209,6 +3509,17 @@ func (h Handler) DeleteAwsRelationalDatabaseInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, awsRelationalDatabaseInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -2217,23 +3528,77 @@ func (h Handler) DeleteAwsRelationalDatabaseInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledAwsRelationalDatabaseInstance := api_v0.AwsRelationalDatabaseInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(awsRelationalDatabaseInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&awsRelationalDatabaseInstance).Updates(scheduledAwsRelationalDatabaseInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := awsRelationalDatabaseInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*awsRelationalDatabaseInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledAwsRelationalDatabaseInstance.Finalizers = awsRelationalDatabaseInstance.Finalizers
+				scheduledAwsRelationalDatabaseInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&awsRelationalDatabaseInstance).Where("resource_version = ?", awsRelationalDatabaseInstance.ResourceVersion).Updates(scheduledAwsRelationalDatabaseInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(awsRelationalDatabaseInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := awsRelationalDatabaseInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.AwsRelationalDatabaseInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.AwsRelationalDatabaseInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			awsRelationalDatabaseInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if awsRelationalDatabaseInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -2242,11 +3607,38 @@ func (h Handler) DeleteAwsRelationalDatabaseInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*awsRelationalDatabaseInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(awsRelationalDatabaseInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&awsRelationalDatabaseInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", awsRelationalDatabaseInstance.ResourceVersion).Delete(&awsRelationalDatabaseInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(awsRelationalDatabaseInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, awsRelationalDatabaseInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				awsRelationalDatabaseInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/nats-io/nats.go"
+	gorm "gorm.io/gorm"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// batchReferenceRegex matches references to fields of objects from earlier
+// operations in a batch, e.g. ${definition.ID}.
+var batchReferenceRegex = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)\.([A-Za-z0-9_]+)\}`)
+
+// batchOperationMethods maps batch operations to the HTTP method used to
+// execute them.
+var batchOperationMethods = map[string]string{
+	v0.BatchOperationCreate: http.MethodPost,
+	v0.BatchOperationUpdate: http.MethodPatch,
+	v0.BatchOperationDelete: http.MethodDelete,
+}
+
+// BatchRouter returns a router that serves the API's object routes using the
+// provided handler.  It is used to execute the operations in a batch with a
+// handler that is bound to the batch's database transaction.
+type BatchRouter func(h *Handler) *echo.Echo
+
+// batchOperationError is returned when an operation in a batch fails.
+type batchOperationError struct {
+	index      int
+	operation  *v0.BatchOperation
+	statusCode int
+	message    string
+}
+
+// Error returns the error message for a failed batch operation.
+func (e *batchOperationError) Error() string {
+	return fmt.Sprintf(
+		"batch operation %d (%s %s) failed: %s",
+		e.index,
+		e.operation.Operation,
+		e.operation.Path,
+		e.message,
+	)
+}
+
+// @Summary executes a batch of operations in a single transaction.
+// @Description Create, update and delete objects of any type in a single
+// @Description database transaction.  Operations are executed in order and
+// @Description may reference objects from earlier operations.  If any
+// @Description operation fails, no changes are made.
+// @ID execute-v0-batch
+// @Accept json
+// @Produce json
+// @Param batch body v0.Batch true "Batch object"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 403 {object} v0.Response "Forbidden"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/batch [POST]
+func (h Handler) ExecuteBatch(newRouter BatchRouter) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		objectType := v0.ObjectTypeBatch
+
+		var batch v0.Batch
+		if err := c.Bind(&batch); err != nil {
+			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		}
+		if len(batch.Operations) == 0 {
+			return apiserver_lib.ResponseStatus400(c, nil, errors.New("batch must include at least one operation"), objectType)
+		}
+		if len(batch.Operations) > v0.MaxBatchOperations {
+			return apiserver_lib.ResponseStatus400(
+				c,
+				nil,
+				fmt.Errorf("batch may include at most %d operations", v0.MaxBatchOperations),
+				objectType,
+			)
+		}
+
+		// controller notifications are queued in the outbox within the
+		// transaction and watch events published by the operations are held
+		// until the transaction is committed so clients don't see changes that
+		// are rolled back
+		publisher := &batchPublisher{JetStreamContext: h.JS}
+
+		var results []v0.BatchResult
+		err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			router := newRouter(&Handler{DB: tx, NC: h.NC, JS: publisher})
+			refs := make(map[string]map[string]interface{})
+			for i := range batch.Operations {
+				result, err := executeBatchOperation(c, router, i, &batch.Operations[i], refs)
+				if err != nil {
+					return err
+				}
+				results = append(results, *result)
+			}
+			return nil
+		})
+		if err != nil {
+			var operationErr *batchOperationError
+			if errors.As(err, &operationErr) {
+				return apiserver_lib.ResponseStatusErr(operationErr.statusCode, c, nil, operationErr, objectType)
+			}
+			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		}
+
+		publisher.flush(c.Logger())
+		apiserver_lib.SignalOutboxRelay()
+
+		response, err := apiserver_lib.CreateResponse(nil, results, objectType)
+		if err != nil {
+			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		}
+
+		return apiserver_lib.ResponseStatus200(c, *response)
+	}
+}
+
+// executeBatchOperation executes a single operation in a batch by calling the
+// object's handler with the router for the batch.  The object returned by the
+// operation is added to the references available to later operations.
+func executeBatchOperation(
+	c echo.Context,
+	router *echo.Echo,
+	index int,
+	operation *v0.BatchOperation,
+	refs map[string]map[string]interface{},
+) (*v0.BatchResult, error) {
+	invalid := func(message string) error {
+		return &batchOperationError{
+			index:      index,
+			operation:  operation,
+			statusCode: http.StatusBadRequest,
+			message:    message,
+		}
+	}
+
+	method, found := batchOperationMethods[operation.Operation]
+	if !found {
+		return nil, invalid(fmt.Sprintf("unsupported operation %q", operation.Operation))
+	}
+	authzObject, registered := apiserver_lib.AuthzObjects[operation.Path]
+	if !registered {
+		return nil, invalid(fmt.Sprintf("path %s does not serve an object type", operation.Path))
+	}
+	if operation.Ref != "" {
+		if _, exists := refs[operation.Ref]; exists {
+			return nil, invalid(fmt.Sprintf("ref %s is used by an earlier operation", operation.Ref))
+		}
+	}
+
+	// updates and deletes are made to a particular object
+	path := operation.Path
+	if operation.Operation != v0.BatchOperationCreate {
+		switch {
+		case operation.ObjectID != nil:
+			path = fmt.Sprintf("%s/%d", path, *operation.ObjectID)
+		case operation.ObjectRef != "":
+			id, err := batchReference(refs, operation.ObjectRef, "ID")
+			if err != nil {
+				return nil, invalid(err.Error())
+			}
+			path = fmt.Sprintf("%s/%v", path, id)
+		default:
+			return nil, invalid("ObjectID or ObjectRef is required")
+		}
+	}
+
+	// creates and updates include the object with references resolved
+	body := []byte{}
+	if operation.Operation != v0.BatchOperationDelete {
+		if len(operation.Object) == 0 {
+			return nil, invalid("Object is required")
+		}
+		var object interface{}
+		decoder := json.NewDecoder(bytes.NewReader(operation.Object))
+		decoder.UseNumber()
+		if err := decoder.Decode(&object); err != nil {
+			return nil, invalid(fmt.Sprintf("failed to decode object: %v", err))
+		}
+		resolved, err := resolveBatchReferences(object, refs)
+		if err != nil {
+			return nil, invalid(err.Error())
+		}
+		if body, err = json.Marshal(resolved); err != nil {
+			return nil, fmt.Errorf("failed to marshal object for batch operation %d: %w", index, err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(c.Request().Context(), method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request for batch operation %d: %w", index, err)
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.TLS = c.Request().TLS
+	req.RemoteAddr = c.Request().RemoteAddr
+
+	writer := &batchResponseWriter{header: make(http.Header)}
+	router.ServeHTTP(writer, req)
+
+	var response apiserver_lib.Response
+	if err := json.Unmarshal(writer.body.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response for batch operation %d: %w", index, err)
+	}
+	if writer.statusCode >= http.StatusMultipleChoices {
+		return nil, &batchOperationError{
+			index:      index,
+			operation:  operation,
+			statusCode: writer.statusCode,
+			message:    response.Status.Error,
+		}
+	}
+
+	result := v0.BatchResult{
+		Ref:        operation.Ref,
+		Operation:  operation.Operation,
+		Path:       path,
+		ObjectType: authzObject.ObjectType,
+		StatusCode: writer.statusCode,
+	}
+	if len(response.Data) > 0 {
+		objectJson, err := json.Marshal(response.Data[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal object from batch operation %d: %w", index, err)
+		}
+		result.Object = objectJson
+
+		if operation.Ref != "" {
+			var object map[string]interface{}
+			decoder := json.NewDecoder(bytes.NewReader(objectJson))
+			decoder.UseNumber()
+			if err := decoder.Decode(&object); err != nil {
+				return nil, fmt.Errorf("failed to decode object from batch operation %d: %w", index, err)
+			}
+			refs[operation.Ref] = object
+		}
+	}
+
+	return &result, nil
+}
+
+// resolveBatchReferences replaces references to objects from earlier
+// operations in a batch with the referenced values.  A string that consists
+// of a single reference is replaced with the referenced value so that IDs
+// remain numbers.  References within a longer string are substituted into
+// the string.
+func resolveBatchReferences(
+	value interface{},
+	refs map[string]map[string]interface{},
+) (interface{}, error) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typedValue {
+			resolved, err := resolveBatchReferences(item, refs)
+			if err != nil {
+				return nil, err
+			}
+			typedValue[key] = resolved
+		}
+	case []interface{}:
+		for i, item := range typedValue {
+			resolved, err := resolveBatchReferences(item, refs)
+			if err != nil {
+				return nil, err
+			}
+			typedValue[i] = resolved
+		}
+	case string:
+		matches := batchReferenceRegex.FindAllStringSubmatch(typedValue, -1)
+		if len(matches) == 0 {
+			return typedValue, nil
+		}
+		if len(matches) == 1 && matches[0][0] == typedValue {
+			return batchReference(refs, matches[0][1], matches[0][2])
+		}
+		resolved := typedValue
+		for _, match := range matches {
+			referenced, err := batchReference(refs, match[1], match[2])
+			if err != nil {
+				return nil, err
+			}
+			resolved = strings.Replace(resolved, match[0], fmt.Sprintf("%v", referenced), 1)
+		}
+		return resolved, nil
+	}
+
+	return value, nil
+}
+
+// batchReference returns a field of an object from an earlier operation in a
+// batch.
+func batchReference(
+	refs map[string]map[string]interface{},
+	ref string,
+	field string,
+) (interface{}, error) {
+	object, found := refs[ref]
+	if !found {
+		return nil, fmt.Errorf("ref %s does not match an earlier operation", ref)
+	}
+	value, found := object[field]
+	if !found || value == nil {
+		return nil, fmt.Errorf("object for ref %s has no value for field %s", ref, field)
+	}
+
+	return value, nil
+}
+
+// batchResponseWriter captures the response to a batch operation.
+type batchResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+// Header returns the response headers.
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write retains the response body.
+func (w *batchResponseWriter) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+// WriteHeader retains the response status code.
+func (w *batchResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+}
+
+// batchMessage is a message published by a batch operation.
+type batchMessage struct {
+	msg  *nats.Msg
+	opts []nats.PubOpt
+}
+
+// batchPublisher holds messages published by batch operations so they can be
+// sent once the batch's transaction is committed.
+type batchPublisher struct {
+	nats.JetStreamContext
+	messages []batchMessage
+}
+
+// Publish holds a message until the batch is committed.
+func (p *batchPublisher) Publish(subj string, data []byte, opts ...nats.PubOpt) (*nats.PubAck, error) {
+	msg := nats.NewMsg(subj)
+	msg.Data = data
+
+	return p.PublishMsg(msg, opts...)
+}
+
+// PublishMsg holds a message, including its headers, until the batch is
+// committed.
+func (p *batchPublisher) PublishMsg(msg *nats.Msg, opts ...nats.PubOpt) (*nats.PubAck, error) {
+	heldMsg := nats.NewMsg(msg.Subject)
+	heldMsg.Data = append([]byte{}, msg.Data...)
+	for key, values := range msg.Header {
+		heldMsg.Header[key] = append([]string{}, values...)
+	}
+	p.messages = append(p.messages, batchMessage{
+		msg:  heldMsg,
+		opts: opts,
+	})
+
+	return &nats.PubAck{}, nil
+}
+
+// flush publishes the held messages.  The batch has already been committed so
+// failures are logged rather than returned to the client.
+func (p *batchPublisher) flush(logger echo.Logger) {
+	for _, message := range p.messages {
+		if _, err := p.JetStreamContext.PublishMsg(message.msg, message.opts...); err != nil {
+			logger.Errorf("failed to publish message to %s following batch: %v", message.msg.Subject, err)
+		}
+	}
+	p.messages = nil
+}
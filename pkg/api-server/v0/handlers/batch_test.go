@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResolveBatchReferences tests that references to objects from earlier
+// operations in a batch are replaced with the referenced values.
+func TestResolveBatchReferences(t *testing.T) {
+	refs := map[string]map[string]interface{}{
+		"definition": {
+			"ID":   json.Number("12"),
+			"Name": "web",
+		},
+		"runtime": {
+			"ID": json.Number("3"),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		value    interface{}
+		resolved interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "no references",
+			value:    "web-app",
+			resolved: "web-app",
+		},
+		{
+			name:     "whole string reference keeps the value's type",
+			value:    "${definition.ID}",
+			resolved: json.Number("12"),
+		},
+		{
+			name:     "references within a string",
+			value:    "${definition.Name}-${runtime.ID}",
+			resolved: "web-3",
+		},
+		{
+			name: "references in nested objects and lists",
+			value: map[string]interface{}{
+				"WorkloadDefinitionID": "${definition.ID}",
+				"Labels":               []interface{}{"${definition.Name}", "static"},
+				"Replicas":             json.Number("2"),
+			},
+			resolved: map[string]interface{}{
+				"WorkloadDefinitionID": json.Number("12"),
+				"Labels":               []interface{}{"web", "static"},
+				"Replicas":             json.Number("2"),
+			},
+		},
+		{
+			name:    "unknown ref",
+			value:   "${instance.ID}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			value:   "prefix-${definition.YAMLDocument}",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := resolveBatchReferences(tc.value, refs)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.resolved, resolved)
+		})
+	}
+}
@@ -73,22 +73,44 @@ func (h Handler) AddControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&controlPlaneDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&controlPlaneDefinition); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*controlPlaneDefinition.Reconciled {
-		notifPayload, err := controlPlaneDefinition.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*controlPlaneDefinition.Reconciled {
+			notifPayload, err := controlPlaneDefinition.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.ControlPlaneDefinitionCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.ControlPlaneDefinitionCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		controlPlaneDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, controlPlaneDefinition, objectType)
@@ -96,21 +118,39 @@ func (h Handler) AddControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, controlPlaneDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all control plane definitions.
 // @Description Get all control plane definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-controlPlaneDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "control plane definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-definitions [GET]
 func (h Handler) GetControlPlaneDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeControlPlaneDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -121,17 +161,44 @@ func (h Handler) GetControlPlaneDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.ControlPlaneDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.ControlPlaneDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.ControlPlaneDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.ControlPlaneDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -146,6 +213,7 @@ func (h Handler) GetControlPlaneDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-definitions/{id} [GET]
@@ -165,6 +233,7 @@ func (h Handler) GetControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, controlPlaneDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -179,9 +248,12 @@ func (h Handler) GetControlPlaneDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param controlPlaneDefinition body api_v0.ControlPlaneDefinition true "ControlPlaneDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-definitions/{id} [PATCH]
 func (h Handler) UpdateControlPlaneDefinition(c echo.Context) error {
@@ -195,6 +267,11 @@ func (h Handler) UpdateControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingControlPlaneDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingControlPlaneDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -206,22 +283,67 @@ func (h Handler) UpdateControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingControlPlaneDefinition).Updates(updatedControlPlaneDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedControlPlaneDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingControlPlaneDefinition.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingControlPlaneDefinition).Where("resource_version = ?", existingControlPlaneDefinition.ResourceVersion).Updates(updatedControlPlaneDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingControlPlaneDefinition.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingControlPlaneDefinition.Reconciled {
-		notifPayload, err := existingControlPlaneDefinition.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedControlPlaneDefinition.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingControlPlaneDefinition,
+				*existingControlPlaneDefinition.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingControlPlaneDefinition.Reconciled && apiserver_lib.ReconciliationRequired(updatedControlPlaneDefinition) {
+			notifPayload, err := existingControlPlaneDefinition.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.ControlPlaneDefinitionUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.ControlPlaneDefinitionUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingControlPlaneDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingControlPlaneDefinition, objectType)
@@ -229,6 +351,7 @@ func (h Handler) UpdateControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingControlPlaneDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -244,9 +367,12 @@ func (h Handler) UpdateControlPlaneDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param controlPlaneDefinition body api_v0.ControlPlaneDefinition true "ControlPlaneDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-definitions/{id} [PUT]
 func (h Handler) ReplaceControlPlaneDefinition(c echo.Context) error {
@@ -260,6 +386,11 @@ func (h Handler) ReplaceControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingControlPlaneDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingControlPlaneDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -276,11 +407,16 @@ func (h Handler) ReplaceControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedControlPlaneDefinition.ID = existingControlPlaneDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedControlPlaneDefinition); result.Error != nil {
+	updatedControlPlaneDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingControlPlaneDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingControlPlaneDefinition.ResourceVersion).Save(&updatedControlPlaneDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingControlPlaneDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingControlPlaneDefinition, controlPlaneDefinitionID); result.Error != nil {
@@ -290,11 +426,24 @@ func (h Handler) ReplaceControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingControlPlaneDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingControlPlaneDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingControlPlaneDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -304,7 +453,10 @@ func (h Handler) ReplaceControlPlaneDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -326,6 +478,17 @@ func (h Handler) DeleteControlPlaneDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, controlPlaneDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -334,23 +497,77 @@ func (h Handler) DeleteControlPlaneDefinition(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledControlPlaneDefinition := api_v0.ControlPlaneDefinition{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(controlPlaneDefinition.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&controlPlaneDefinition).Updates(scheduledControlPlaneDefinition); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := controlPlaneDefinition.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*controlPlaneDefinition.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledControlPlaneDefinition.Finalizers = controlPlaneDefinition.Finalizers
+				scheduledControlPlaneDefinition.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&controlPlaneDefinition).Where("resource_version = ?", controlPlaneDefinition.ResourceVersion).Updates(scheduledControlPlaneDefinition)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(controlPlaneDefinition.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := controlPlaneDefinition.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.ControlPlaneDefinitionDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.ControlPlaneDefinitionDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			controlPlaneDefinition,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if controlPlaneDefinition.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -359,11 +576,38 @@ func (h Handler) DeleteControlPlaneDefinition(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*controlPlaneDefinition.ID,
 			)), objectType)
-		} else {
+		} else if len(controlPlaneDefinition.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&controlPlaneDefinition); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", controlPlaneDefinition.ResourceVersion).Delete(&controlPlaneDefinition)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(controlPlaneDefinition.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, controlPlaneDefinition.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				controlPlaneDefinition,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -433,22 +677,44 @@ func (h Handler) AddControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&controlPlaneInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&controlPlaneInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*controlPlaneInstance.Reconciled {
-		notifPayload, err := controlPlaneInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*controlPlaneInstance.Reconciled {
+			notifPayload, err := controlPlaneInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.ControlPlaneInstanceCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.ControlPlaneInstanceCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		controlPlaneInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, controlPlaneInstance, objectType)
@@ -456,21 +722,39 @@ func (h Handler) AddControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, controlPlaneInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all control plane instances.
 // @Description Get all control plane instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-controlPlaneInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "control plane instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-instances [GET]
 func (h Handler) GetControlPlaneInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeControlPlaneInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -481,17 +765,44 @@ func (h Handler) GetControlPlaneInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Preload(clause.Associations).Model(&api_v0.ControlPlaneInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.ControlPlaneInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Preload(clause.Associations).Model(&api_v0.ControlPlaneInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.ControlPlaneInstance{}
-	if result := h.DB.Preload(clause.Associations).Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Preload(clause.Associations).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -506,6 +817,7 @@ func (h Handler) GetControlPlaneInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-instances/{id} [GET]
@@ -525,6 +837,7 @@ func (h Handler) GetControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, controlPlaneInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -539,9 +852,12 @@ func (h Handler) GetControlPlaneInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param controlPlaneInstance body api_v0.ControlPlaneInstance true "ControlPlaneInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-instances/{id} [PATCH]
 func (h Handler) UpdateControlPlaneInstance(c echo.Context) error {
@@ -555,6 +871,11 @@ func (h Handler) UpdateControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingControlPlaneInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingControlPlaneInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -566,22 +887,67 @@ func (h Handler) UpdateControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingControlPlaneInstance).Updates(updatedControlPlaneInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedControlPlaneInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingControlPlaneInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingControlPlaneInstance).Where("resource_version = ?", existingControlPlaneInstance.ResourceVersion).Updates(updatedControlPlaneInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingControlPlaneInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingControlPlaneInstance.Reconciled {
-		notifPayload, err := existingControlPlaneInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedControlPlaneInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingControlPlaneInstance,
+				*existingControlPlaneInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingControlPlaneInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedControlPlaneInstance) {
+			notifPayload, err := existingControlPlaneInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.ControlPlaneInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.ControlPlaneInstanceUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingControlPlaneInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingControlPlaneInstance, objectType)
@@ -589,6 +955,7 @@ func (h Handler) UpdateControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingControlPlaneInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -604,9 +971,12 @@ func (h Handler) UpdateControlPlaneInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param controlPlaneInstance body api_v0.ControlPlaneInstance true "ControlPlaneInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/control-plane-instances/{id} [PUT]
 func (h Handler) ReplaceControlPlaneInstance(c echo.Context) error {
@@ -620,6 +990,11 @@ func (h Handler) ReplaceControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingControlPlaneInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingControlPlaneInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -636,11 +1011,16 @@ func (h Handler) ReplaceControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedControlPlaneInstance.ID = existingControlPlaneInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedControlPlaneInstance); result.Error != nil {
+	updatedControlPlaneInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingControlPlaneInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingControlPlaneInstance.ResourceVersion).Save(&updatedControlPlaneInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingControlPlaneInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingControlPlaneInstance, controlPlaneInstanceID); result.Error != nil {
@@ -650,11 +1030,24 @@ func (h Handler) ReplaceControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingControlPlaneInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingControlPlaneInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingControlPlaneInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -664,7 +1057,10 @@ func (h Handler) ReplaceControlPlaneInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -680,6 +1076,17 @@ func (h Handler) DeleteControlPlaneInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, controlPlaneInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -688,23 +1095,77 @@ func (h Handler) DeleteControlPlaneInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledControlPlaneInstance := api_v0.ControlPlaneInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(controlPlaneInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&controlPlaneInstance).Updates(scheduledControlPlaneInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := controlPlaneInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*controlPlaneInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledControlPlaneInstance.Finalizers = controlPlaneInstance.Finalizers
+				scheduledControlPlaneInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&controlPlaneInstance).Where("resource_version = ?", controlPlaneInstance.ResourceVersion).Updates(scheduledControlPlaneInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(controlPlaneInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := controlPlaneInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.ControlPlaneInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.ControlPlaneInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			controlPlaneInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if controlPlaneInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -713,11 +1174,38 @@ func (h Handler) DeleteControlPlaneInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*controlPlaneInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(controlPlaneInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&controlPlaneInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", controlPlaneInstance.ResourceVersion).Delete(&controlPlaneInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(controlPlaneInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, controlPlaneInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				controlPlaneInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
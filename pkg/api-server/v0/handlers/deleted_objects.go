@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	echo "github.com/labstack/echo/v4"
+	gorm "gorm.io/gorm"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// @Summary gets soft-deleted objects of an object type.
+// @Description Get objects of an object type that have been deleted but not
+// @Description yet purged from the database, most recently deleted first.
+// @ID get-v0-deleted-objects
+// @Accept json
+// @Produce json
+// @Param objectType path string true "object type, e.g. WorkloadInstance"
+// @Param page query int false "page number"
+// @Param size query int false "page size"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 403 {object} v0.Response "Forbidden"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/deleted-objects/{objectType} [GET]
+func (h Handler) GetDeletedObjects(c echo.Context) error {
+	objectType := c.Param("objectType")
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	path, found := apiserver_lib.AuthzObjectPath(objectType)
+	if !found {
+		return apiserver_lib.ResponseStatus404(c, &params, fmt.Errorf("object type %s not found", objectType), objectType)
+	}
+	if permitted, err := h.authorizeDeletedObjectRequest(c, v0.AuthzVerbRead, path); !permitted {
+		return err
+	}
+
+	records, totalCount, err := apiserver_lib.GetDeletedObjects(h.DB, apiserver_lib.AuthzObjects[path].Model, params)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary restores a soft-deleted object.
+// @Description Restore an object that was deleted within the retention window.
+// @Description Objects that are reconciled by a controller are reconciled
+// @Description again once restored.  Dependents that were deleted along with
+// @Description the object are restored with it.
+// @ID restore-v0-deleted-object
+// @Accept json
+// @Produce json
+// @Param objectType path string true "object type, e.g. WorkloadInstance"
+// @Param id path int true "ID"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 403 {object} v0.Response "Forbidden"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/deleted-objects/{objectType}/{id}/restore [POST]
+func (h Handler) RestoreDeletedObject(c echo.Context) error {
+	objectType := c.Param("objectType")
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, fmt.Errorf("invalid object ID %s", c.Param("id")), objectType)
+	}
+
+	path, found := apiserver_lib.AuthzObjectPath(objectType)
+	if !found {
+		return apiserver_lib.ResponseStatus404(c, nil, fmt.Errorf("object type %s not found", objectType), objectType)
+	}
+	if permitted, err := h.authorizeDeletedObjectRequest(c, v0.AuthzVerbCreate, path); !permitted {
+		return err
+	}
+
+	// restore object and queue controller notification in a single transaction
+	var object interface{}
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		restored, err := apiserver_lib.RestoreObject(tx, objectType, apiserver_lib.AuthzObjects[path].Model, uint(id))
+		object = restored
+		return err
+	}); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return apiserver_lib.ResponseStatus404(c, nil, fmt.Errorf("deleted object with ID %d not found", id), objectType)
+		case errors.Is(err, apiserver_lib.ErrDeletedObjectRetentionExpired):
+			return apiserver_lib.ResponseStatus404(c, nil, err, objectType)
+		case errors.Is(err, apiserver_lib.ErrDeletedObjectNameConflict):
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		object,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, object, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// authorizeDeletedObjectRequest authorizes requests for deleted objects as
+// requests to the object type's REST path.  Client certificates are only
+// present when authentication is enabled for the API server.
+func (h Handler) authorizeDeletedObjectRequest(c echo.Context, verb string, path string) (bool, error) {
+	if c.Request().TLS == nil {
+		return true, nil
+	}
+
+	return apiserver_lib.AuthorizeRequest(c, h.DB, verb, path)
+}
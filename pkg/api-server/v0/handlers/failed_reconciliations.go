@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	echo "github.com/labstack/echo/v4"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// @Summary gets failed reconciliations.
+// @Description Get the notifications that reconcilers gave up on after
+// @Description exhausting their retry policies, most recent first.
+// @ID get-v0-failed-reconciliations
+// @Accept json
+// @Produce json
+// @Param page query int false "page number"
+// @Param size query int false "page size"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/failed-reconciliations [GET]
+func (h Handler) GetFailedReconciliations(c echo.Context) error {
+	objectType := v0.ObjectTypeFailedReconciliation
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	records, totalCount, err := apiserver_lib.GetFailedReconciliations(h.JS, params)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary retries a failed reconciliation.
+// @Description Republish the notification for a failed reconciliation to its
+// @Description reconciler and remove it from the failed reconciliations.
+// @ID retry-v0-failed-reconciliation
+// @Accept json
+// @Produce json
+// @Param id path int true "ID"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/failed-reconciliations/{id}/retry [POST]
+func (h Handler) RetryFailedReconciliation(c echo.Context) error {
+	objectType := v0.ObjectTypeFailedReconciliation
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, fmt.Errorf("invalid failed reconciliation ID %s", c.Param("id")), objectType)
+	}
+
+	failedReconciliation, err := apiserver_lib.RetryFailedReconciliation(c.Request().Context(), h.JS, id)
+	if errors.Is(err, apiserver_lib.ErrFailedReconciliationNotFound) {
+		return apiserver_lib.ResponseStatus404(c, nil, err, objectType)
+	} else if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, *failedReconciliation, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
@@ -77,26 +77,56 @@ func (h Handler) AddDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		domainNameDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, domainNameDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, domainNameDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all domain name definitions.
 // @Description Get all domain name definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-domainNameDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "domain name definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-definitions [GET]
 func (h Handler) GetDomainNameDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeDomainNameDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -107,17 +137,44 @@ func (h Handler) GetDomainNameDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.DomainNameDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.DomainNameDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.DomainNameDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.DomainNameDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -132,6 +189,7 @@ func (h Handler) GetDomainNameDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-definitions/{id} [GET]
@@ -151,6 +209,7 @@ func (h Handler) GetDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, domainNameDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -165,9 +224,12 @@ func (h Handler) GetDomainNameDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param domainNameDefinition body api_v0.DomainNameDefinition true "DomainNameDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-definitions/{id} [PATCH]
 func (h Handler) UpdateDomainNameDefinition(c echo.Context) error {
@@ -181,6 +243,11 @@ func (h Handler) UpdateDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingDomainNameDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingDomainNameDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -192,16 +259,34 @@ func (h Handler) UpdateDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingDomainNameDefinition).Updates(updatedDomainNameDefinition); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedDomainNameDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingDomainNameDefinition.ResourceVersion)
+	result := h.DB.Model(&existingDomainNameDefinition).Where("resource_version = ?", existingDomainNameDefinition.ResourceVersion).Updates(updatedDomainNameDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingDomainNameDefinition.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingDomainNameDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingDomainNameDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingDomainNameDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -217,9 +302,12 @@ func (h Handler) UpdateDomainNameDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param domainNameDefinition body api_v0.DomainNameDefinition true "DomainNameDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-definitions/{id} [PUT]
 func (h Handler) ReplaceDomainNameDefinition(c echo.Context) error {
@@ -233,6 +321,11 @@ func (h Handler) ReplaceDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingDomainNameDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingDomainNameDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -249,11 +342,16 @@ func (h Handler) ReplaceDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedDomainNameDefinition.ID = existingDomainNameDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedDomainNameDefinition); result.Error != nil {
+	updatedDomainNameDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingDomainNameDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingDomainNameDefinition.ResourceVersion).Save(&updatedDomainNameDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingDomainNameDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingDomainNameDefinition, domainNameDefinitionID); result.Error != nil {
@@ -263,11 +361,24 @@ func (h Handler) ReplaceDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingDomainNameDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingDomainNameDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingDomainNameDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -277,7 +388,10 @@ func (h Handler) ReplaceDomainNameDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -299,9 +413,60 @@ func (h Handler) DeleteDomainNameDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&domainNameDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, domainNameDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*domainNameDefinition.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", domainNameDefinition.ResourceVersion).Delete(&domainNameDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(domainNameDefinition.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, domainNameDefinition.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		domainNameDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, domainNameDefinition, objectType)
@@ -369,22 +534,44 @@ func (h Handler) AddDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&domainNameInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&domainNameInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*domainNameInstance.Reconciled {
-		notifPayload, err := domainNameInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*domainNameInstance.Reconciled {
+			notifPayload, err := domainNameInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.DomainNameInstanceCreateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.DomainNameInstanceCreateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		domainNameInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, domainNameInstance, objectType)
@@ -392,21 +579,39 @@ func (h Handler) AddDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, domainNameInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all domain name instances.
 // @Description Get all domain name instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-domainNameInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "domain name instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-instances [GET]
 func (h Handler) GetDomainNameInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeDomainNameInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -417,17 +622,44 @@ func (h Handler) GetDomainNameInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.DomainNameInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.DomainNameInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.DomainNameInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.DomainNameInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -442,6 +674,7 @@ func (h Handler) GetDomainNameInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-instances/{id} [GET]
@@ -461,6 +694,7 @@ func (h Handler) GetDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, domainNameInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -475,9 +709,12 @@ func (h Handler) GetDomainNameInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param domainNameInstance body api_v0.DomainNameInstance true "DomainNameInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-instances/{id} [PATCH]
 func (h Handler) UpdateDomainNameInstance(c echo.Context) error {
@@ -491,6 +728,11 @@ func (h Handler) UpdateDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingDomainNameInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingDomainNameInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -502,22 +744,67 @@ func (h Handler) UpdateDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingDomainNameInstance).Updates(updatedDomainNameInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedDomainNameInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingDomainNameInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingDomainNameInstance).Where("resource_version = ?", existingDomainNameInstance.ResourceVersion).Updates(updatedDomainNameInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingDomainNameInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingDomainNameInstance.Reconciled {
-		notifPayload, err := existingDomainNameInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedDomainNameInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingDomainNameInstance,
+				*existingDomainNameInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingDomainNameInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedDomainNameInstance) {
+			notifPayload, err := existingDomainNameInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.DomainNameInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.DomainNameInstanceUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingDomainNameInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingDomainNameInstance, objectType)
@@ -525,6 +812,7 @@ func (h Handler) UpdateDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingDomainNameInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -540,9 +828,12 @@ func (h Handler) UpdateDomainNameInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param domainNameInstance body api_v0.DomainNameInstance true "DomainNameInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/domain-name-instances/{id} [PUT]
 func (h Handler) ReplaceDomainNameInstance(c echo.Context) error {
@@ -556,6 +847,11 @@ func (h Handler) ReplaceDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingDomainNameInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingDomainNameInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -572,11 +868,16 @@ func (h Handler) ReplaceDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedDomainNameInstance.ID = existingDomainNameInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedDomainNameInstance); result.Error != nil {
+	updatedDomainNameInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingDomainNameInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingDomainNameInstance.ResourceVersion).Save(&updatedDomainNameInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingDomainNameInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingDomainNameInstance, domainNameInstanceID); result.Error != nil {
@@ -586,11 +887,24 @@ func (h Handler) ReplaceDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingDomainNameInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingDomainNameInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingDomainNameInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -600,7 +914,10 @@ func (h Handler) ReplaceDomainNameInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -616,6 +933,17 @@ func (h Handler) DeleteDomainNameInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, domainNameInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -624,23 +952,77 @@ func (h Handler) DeleteDomainNameInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledDomainNameInstance := api_v0.DomainNameInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(domainNameInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&domainNameInstance).Updates(scheduledDomainNameInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := domainNameInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*domainNameInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledDomainNameInstance.Finalizers = domainNameInstance.Finalizers
+				scheduledDomainNameInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&domainNameInstance).Where("resource_version = ?", domainNameInstance.ResourceVersion).Updates(scheduledDomainNameInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(domainNameInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := domainNameInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.DomainNameInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.DomainNameInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			domainNameInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if domainNameInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -649,11 +1031,38 @@ func (h Handler) DeleteDomainNameInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*domainNameInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(domainNameInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&domainNameInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", domainNameInstance.ResourceVersion).Delete(&domainNameInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(domainNameInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, domainNameInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				domainNameInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -723,22 +1132,44 @@ func (h Handler) AddGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&gatewayDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&gatewayDefinition); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*gatewayDefinition.Reconciled {
-		notifPayload, err := gatewayDefinition.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*gatewayDefinition.Reconciled {
+			notifPayload, err := gatewayDefinition.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.GatewayDefinitionCreateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.GatewayDefinitionCreateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		gatewayDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, gatewayDefinition, objectType)
@@ -746,21 +1177,39 @@ func (h Handler) AddGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all gateway definitions.
 // @Description Get all gateway definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-gatewayDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "gateway definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-definitions [GET]
 func (h Handler) GetGatewayDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeGatewayDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -771,17 +1220,44 @@ func (h Handler) GetGatewayDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.GatewayDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.GatewayDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.GatewayDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.GatewayDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -796,6 +1272,7 @@ func (h Handler) GetGatewayDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-definitions/{id} [GET]
@@ -815,6 +1292,7 @@ func (h Handler) GetGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -829,9 +1307,12 @@ func (h Handler) GetGatewayDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayDefinition body api_v0.GatewayDefinition true "GatewayDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-definitions/{id} [PATCH]
 func (h Handler) UpdateGatewayDefinition(c echo.Context) error {
@@ -845,6 +1326,11 @@ func (h Handler) UpdateGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -856,22 +1342,67 @@ func (h Handler) UpdateGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingGatewayDefinition).Updates(updatedGatewayDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedGatewayDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayDefinition.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingGatewayDefinition).Where("resource_version = ?", existingGatewayDefinition.ResourceVersion).Updates(updatedGatewayDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingGatewayDefinition.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingGatewayDefinition.Reconciled {
-		notifPayload, err := existingGatewayDefinition.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedGatewayDefinition.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingGatewayDefinition,
+				*existingGatewayDefinition.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingGatewayDefinition.Reconciled && apiserver_lib.ReconciliationRequired(updatedGatewayDefinition) {
+			notifPayload, err := existingGatewayDefinition.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.GatewayDefinitionUpdateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.GatewayDefinitionUpdateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayDefinition, objectType)
@@ -879,6 +1410,7 @@ func (h Handler) UpdateGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -894,9 +1426,12 @@ func (h Handler) UpdateGatewayDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayDefinition body api_v0.GatewayDefinition true "GatewayDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-definitions/{id} [PUT]
 func (h Handler) ReplaceGatewayDefinition(c echo.Context) error {
@@ -910,6 +1445,11 @@ func (h Handler) ReplaceGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -926,11 +1466,16 @@ func (h Handler) ReplaceGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedGatewayDefinition.ID = existingGatewayDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedGatewayDefinition); result.Error != nil {
+	updatedGatewayDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingGatewayDefinition.ResourceVersion).Save(&updatedGatewayDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingGatewayDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingGatewayDefinition, gatewayDefinitionID); result.Error != nil {
@@ -940,11 +1485,24 @@ func (h Handler) ReplaceGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -954,7 +1512,10 @@ func (h Handler) ReplaceGatewayDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -976,6 +1537,17 @@ func (h Handler) DeleteGatewayDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, gatewayDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -984,23 +1556,77 @@ func (h Handler) DeleteGatewayDefinition(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledGatewayDefinition := api_v0.GatewayDefinition{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(gatewayDefinition.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&gatewayDefinition).Updates(scheduledGatewayDefinition); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := gatewayDefinition.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*gatewayDefinition.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledGatewayDefinition.Finalizers = gatewayDefinition.Finalizers
+				scheduledGatewayDefinition.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&gatewayDefinition).Where("resource_version = ?", gatewayDefinition.ResourceVersion).Updates(scheduledGatewayDefinition)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(gatewayDefinition.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := gatewayDefinition.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.GatewayDefinitionDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.GatewayDefinitionDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			gatewayDefinition,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if gatewayDefinition.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -1009,11 +1635,38 @@ func (h Handler) DeleteGatewayDefinition(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*gatewayDefinition.ID,
 			)), objectType)
-		} else {
+		} else if len(gatewayDefinition.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&gatewayDefinition); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", gatewayDefinition.ResourceVersion).Delete(&gatewayDefinition)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(gatewayDefinition.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, gatewayDefinition.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				gatewayDefinition,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -1073,26 +1726,56 @@ func (h Handler) AddGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		gatewayHttpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, gatewayHttpPort, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayHttpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all gateway http ports.
 // @Description Get all gateway http ports from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-gatewayHttpPorts
 // @Accept json
 // @Produce json
 // @Param name query string false "gateway http port search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-http-ports [GET]
 func (h Handler) GetGatewayHttpPorts(c echo.Context) error {
 	objectType := api_v0.ObjectTypeGatewayHttpPort
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -1103,17 +1786,44 @@ func (h Handler) GetGatewayHttpPorts(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.GatewayHttpPort{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.GatewayHttpPort{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.GatewayHttpPort{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.GatewayHttpPort{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -1128,6 +1838,7 @@ func (h Handler) GetGatewayHttpPorts(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-http-ports/{id} [GET]
@@ -1147,6 +1858,7 @@ func (h Handler) GetGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayHttpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1161,9 +1873,12 @@ func (h Handler) GetGatewayHttpPort(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayHttpPort body api_v0.GatewayHttpPort true "GatewayHttpPort object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-http-ports/{id} [PATCH]
 func (h Handler) UpdateGatewayHttpPort(c echo.Context) error {
@@ -1177,6 +1892,11 @@ func (h Handler) UpdateGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayHttpPort.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayHttpPort); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1188,16 +1908,34 @@ func (h Handler) UpdateGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingGatewayHttpPort).Updates(updatedGatewayHttpPort); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedGatewayHttpPort.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayHttpPort.ResourceVersion)
+	result := h.DB.Model(&existingGatewayHttpPort).Where("resource_version = ?", existingGatewayHttpPort.ResourceVersion).Updates(updatedGatewayHttpPort)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingGatewayHttpPort.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayHttpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayHttpPort, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayHttpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1213,9 +1951,12 @@ func (h Handler) UpdateGatewayHttpPort(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayHttpPort body api_v0.GatewayHttpPort true "GatewayHttpPort object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-http-ports/{id} [PUT]
 func (h Handler) ReplaceGatewayHttpPort(c echo.Context) error {
@@ -1229,6 +1970,11 @@ func (h Handler) ReplaceGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayHttpPort.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayHttpPort); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1245,11 +1991,16 @@ func (h Handler) ReplaceGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedGatewayHttpPort.ID = existingGatewayHttpPort.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedGatewayHttpPort); result.Error != nil {
+	updatedGatewayHttpPort.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayHttpPort.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingGatewayHttpPort.ResourceVersion).Save(&updatedGatewayHttpPort)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingGatewayHttpPort.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingGatewayHttpPort, gatewayHttpPortID); result.Error != nil {
@@ -1259,11 +2010,24 @@ func (h Handler) ReplaceGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayHttpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayHttpPort, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayHttpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1273,7 +2037,10 @@ func (h Handler) ReplaceGatewayHttpPort(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -1289,9 +2056,60 @@ func (h Handler) DeleteGatewayHttpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&gatewayHttpPort); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, gatewayHttpPort.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*gatewayHttpPort.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", gatewayHttpPort.ResourceVersion).Delete(&gatewayHttpPort)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(gatewayHttpPort.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, gatewayHttpPort.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		gatewayHttpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, gatewayHttpPort, objectType)
@@ -1359,22 +2177,44 @@ func (h Handler) AddGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&gatewayInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&gatewayInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*gatewayInstance.Reconciled {
-		notifPayload, err := gatewayInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*gatewayInstance.Reconciled {
+			notifPayload, err := gatewayInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.GatewayInstanceCreateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.GatewayInstanceCreateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		gatewayInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, gatewayInstance, objectType)
@@ -1382,21 +2222,39 @@ func (h Handler) AddGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all gateway instances.
 // @Description Get all gateway instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-gatewayInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "gateway instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-instances [GET]
 func (h Handler) GetGatewayInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeGatewayInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -1407,17 +2265,44 @@ func (h Handler) GetGatewayInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.GatewayInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.GatewayInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.GatewayInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.GatewayInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -1432,6 +2317,7 @@ func (h Handler) GetGatewayInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-instances/{id} [GET]
@@ -1451,6 +2337,7 @@ func (h Handler) GetGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1465,9 +2352,12 @@ func (h Handler) GetGatewayInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayInstance body api_v0.GatewayInstance true "GatewayInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-instances/{id} [PATCH]
 func (h Handler) UpdateGatewayInstance(c echo.Context) error {
@@ -1481,6 +2371,11 @@ func (h Handler) UpdateGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1492,22 +2387,67 @@ func (h Handler) UpdateGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingGatewayInstance).Updates(updatedGatewayInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedGatewayInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingGatewayInstance).Where("resource_version = ?", existingGatewayInstance.ResourceVersion).Updates(updatedGatewayInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingGatewayInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingGatewayInstance.Reconciled {
-		notifPayload, err := existingGatewayInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedGatewayInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingGatewayInstance,
+				*existingGatewayInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingGatewayInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedGatewayInstance) {
+			notifPayload, err := existingGatewayInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.GatewayInstanceUpdateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.GatewayInstanceUpdateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayInstance, objectType)
@@ -1515,6 +2455,7 @@ func (h Handler) UpdateGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1530,9 +2471,12 @@ func (h Handler) UpdateGatewayInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayInstance body api_v0.GatewayInstance true "GatewayInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-instances/{id} [PUT]
 func (h Handler) ReplaceGatewayInstance(c echo.Context) error {
@@ -1546,6 +2490,11 @@ func (h Handler) ReplaceGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1562,11 +2511,16 @@ func (h Handler) ReplaceGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedGatewayInstance.ID = existingGatewayInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedGatewayInstance); result.Error != nil {
+	updatedGatewayInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingGatewayInstance.ResourceVersion).Save(&updatedGatewayInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingGatewayInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingGatewayInstance, gatewayInstanceID); result.Error != nil {
@@ -1576,11 +2530,24 @@ func (h Handler) ReplaceGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1590,7 +2557,10 @@ func (h Handler) ReplaceGatewayInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -1606,6 +2576,17 @@ func (h Handler) DeleteGatewayInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, gatewayInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -1614,23 +2595,77 @@ func (h Handler) DeleteGatewayInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledGatewayInstance := api_v0.GatewayInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(gatewayInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&gatewayInstance).Updates(scheduledGatewayInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := gatewayInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*gatewayInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledGatewayInstance.Finalizers = gatewayInstance.Finalizers
+				scheduledGatewayInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&gatewayInstance).Where("resource_version = ?", gatewayInstance.ResourceVersion).Updates(scheduledGatewayInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(gatewayInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := gatewayInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.GatewayInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.GatewayInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			gatewayInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if gatewayInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -1639,11 +2674,38 @@ func (h Handler) DeleteGatewayInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*gatewayInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(gatewayInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&gatewayInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", gatewayInstance.ResourceVersion).Delete(&gatewayInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(gatewayInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, gatewayInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				gatewayInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -1703,26 +2765,56 @@ func (h Handler) AddGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		gatewayTcpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, gatewayTcpPort, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayTcpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all gateway tcp ports.
 // @Description Get all gateway tcp ports from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-gatewayTcpPorts
 // @Accept json
 // @Produce json
 // @Param name query string false "gateway tcp port search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-tcp-ports [GET]
 func (h Handler) GetGatewayTcpPorts(c echo.Context) error {
 	objectType := api_v0.ObjectTypeGatewayTcpPort
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -1733,17 +2825,44 @@ func (h Handler) GetGatewayTcpPorts(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.GatewayTcpPort{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.GatewayTcpPort{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.GatewayTcpPort{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.GatewayTcpPort{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -1758,6 +2877,7 @@ func (h Handler) GetGatewayTcpPorts(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-tcp-ports/{id} [GET]
@@ -1777,6 +2897,7 @@ func (h Handler) GetGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, gatewayTcpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1791,9 +2912,12 @@ func (h Handler) GetGatewayTcpPort(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayTcpPort body api_v0.GatewayTcpPort true "GatewayTcpPort object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-tcp-ports/{id} [PATCH]
 func (h Handler) UpdateGatewayTcpPort(c echo.Context) error {
@@ -1807,6 +2931,11 @@ func (h Handler) UpdateGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayTcpPort.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayTcpPort); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1818,16 +2947,34 @@ func (h Handler) UpdateGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingGatewayTcpPort).Updates(updatedGatewayTcpPort); result.Error != nil {
+	// update object in database if it has not changed since it was read
+	updatedGatewayTcpPort.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayTcpPort.ResourceVersion)
+	result := h.DB.Model(&existingGatewayTcpPort).Where("resource_version = ?", existingGatewayTcpPort.ResourceVersion).Updates(updatedGatewayTcpPort)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingGatewayTcpPort.ResourceVersion), objectType)
+	}
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayTcpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayTcpPort, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayTcpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1843,9 +2990,12 @@ func (h Handler) UpdateGatewayTcpPort(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param gatewayTcpPort body api_v0.GatewayTcpPort true "GatewayTcpPort object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/gateway-tcp-ports/{id} [PUT]
 func (h Handler) ReplaceGatewayTcpPort(c echo.Context) error {
@@ -1859,6 +3009,11 @@ func (h Handler) ReplaceGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingGatewayTcpPort.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingGatewayTcpPort); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -1875,11 +3030,16 @@ func (h Handler) ReplaceGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedGatewayTcpPort.ID = existingGatewayTcpPort.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedGatewayTcpPort); result.Error != nil {
+	updatedGatewayTcpPort.ResourceVersion = apiserver_lib.NextResourceVersion(existingGatewayTcpPort.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingGatewayTcpPort.ResourceVersion).Save(&updatedGatewayTcpPort)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingGatewayTcpPort.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingGatewayTcpPort, gatewayTcpPortID); result.Error != nil {
@@ -1889,11 +3049,24 @@ func (h Handler) ReplaceGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingGatewayTcpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingGatewayTcpPort, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingGatewayTcpPort.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -1903,7 +3076,10 @@ func (h Handler) ReplaceGatewayTcpPort(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -1919,9 +3095,60 @@ func (h Handler) DeleteGatewayTcpPort(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
-	// delete object
-	if result := h.DB.Delete(&gatewayTcpPort); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, gatewayTcpPort.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
+	// delete object if it has not changed since it was read along with
+	// its dependents in a single transaction - objects that aren't
+	// reconciled can't wait for their dependents to be deleted first
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		// dependents deleted with the object share its deletion time so
+		// that they can be restored with it
+		tx = apiserver_lib.DeletionSession(tx, time.Now().UTC())
+
+		if _, err := apiserver_lib.DeleteDependents(
+			tx,
+			objectType,
+			*gatewayTcpPort.ID,
+			propagation,
+		); err != nil {
+			return err
+		}
+		result := tx.Where("resource_version = ?", gatewayTcpPort.ResourceVersion).Delete(&gatewayTcpPort)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(gatewayTcpPort.ResourceVersion)
+		}
+
+		// notify owners waiting for their dependents to be deleted
+		return apiserver_lib.ReleaseOwners(tx, gatewayTcpPort.GetOwnerReferences())
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventDeleted,
+		objectType,
+		"v0",
+		gatewayTcpPort,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, gatewayTcpPort, objectType)
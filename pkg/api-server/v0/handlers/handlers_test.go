@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	"github.com/threeport/threeport/pkg/api-server/v0/versions"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// testJetStream records the messages published to JetStream by handlers.
+type testJetStream struct {
+	nats.JetStreamContext
+	msgs []*nats.Msg
+}
+
+// PublishMsg records a published message.
+func (js *testJetStream) PublishMsg(msg *nats.Msg, opts ...nats.PubOpt) (*nats.PubAck, error) {
+	js.msgs = append(js.msgs, msg)
+	return &nats.PubAck{}, nil
+}
+
+// newMockTestDB returns a database backed by a mock that expects the queries
+// made by a test.
+func newMockTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+
+	return db, mock
+}
+
+// TestUpdateResourceVersion tests that updates are rejected with a conflict
+// when the client's resource version is stale or the object is changed
+// between being read and updated, and that successful updates increment the
+// resource version.
+func TestUpdateResourceVersion(t *testing.T) {
+	versions.AddRoleVersions()
+
+	testCases := []struct {
+		name    string
+		ifMatch string
+		// rows affected by the update, -1 if the object isn't updated
+		updated int64
+		status  int
+		etag    string
+	}{
+		{
+			name:    "no resource version provided",
+			updated: 1,
+			status:  http.StatusOK,
+			etag:    `"4"`,
+		},
+		{
+			name:    "current resource version",
+			ifMatch: `"3"`,
+			updated: 1,
+			status:  http.StatusOK,
+			etag:    `"4"`,
+		},
+		{
+			name:    "stale resource version",
+			ifMatch: `"2"`,
+			updated: -1,
+			status:  http.StatusConflict,
+		},
+		{
+			name:    "object changed after it was read",
+			ifMatch: `"3"`,
+			updated: 0,
+			status:  http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockTestDB(t)
+
+			mock.ExpectQuery(`SELECT \* FROM "v0_roles"`).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "name", "rules", "resource_version"}).
+					AddRow(7, "reader", `[]`, 3),
+			)
+			if tc.updated >= 0 {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "v0_roles" SET .* WHERE resource_version = \$\d+`).
+					WillReturnResult(sqlmock.NewResult(0, tc.updated))
+				mock.ExpectCommit()
+			}
+
+			request := httptest.NewRequest(http.MethodPatch, "/v0/roles/7", strings.NewReader(`{"MaxTierCriticality":2}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.ifMatch != "" {
+				request.Header.Set(apiserver_lib.HeaderIfMatch, tc.ifMatch)
+			}
+			recorder := httptest.NewRecorder()
+			c := echo.New().NewContext(request, recorder)
+			c.SetPath(v0.PathRoles + "/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("7")
+
+			js := &testJetStream{}
+			h := Handler{DB: db, JS: js}
+			require.NoError(t, h.UpdateRole(c))
+
+			assert.Equal(t, tc.status, recorder.Code, recorder.Body.String())
+			assert.Equal(t, tc.etag, recorder.Header().Get(apiserver_lib.HeaderETag))
+			if tc.status == http.StatusOK {
+				assert.Len(t, js.msgs, 1, "watch event should be published")
+			} else {
+				assert.Contains(t, recorder.Body.String(), apiserver_lib.ErrMsgResourceVersionConflict)
+				assert.Empty(t, js.msgs)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
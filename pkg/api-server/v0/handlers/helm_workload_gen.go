@@ -72,22 +72,44 @@ func (h Handler) AddHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&helmWorkloadDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&helmWorkloadDefinition); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*helmWorkloadDefinition.Reconciled {
-		notifPayload, err := helmWorkloadDefinition.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*helmWorkloadDefinition.Reconciled {
+			notifPayload, err := helmWorkloadDefinition.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.HelmWorkloadDefinitionCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.HelmWorkloadDefinitionCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		helmWorkloadDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, helmWorkloadDefinition, objectType)
@@ -95,21 +117,39 @@ func (h Handler) AddHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, helmWorkloadDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all helm workload definitions.
 // @Description Get all helm workload definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-helmWorkloadDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "helm workload definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-definitions [GET]
 func (h Handler) GetHelmWorkloadDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeHelmWorkloadDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -120,17 +160,44 @@ func (h Handler) GetHelmWorkloadDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.HelmWorkloadDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.HelmWorkloadDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.HelmWorkloadDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.HelmWorkloadDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -145,6 +212,7 @@ func (h Handler) GetHelmWorkloadDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-definitions/{id} [GET]
@@ -164,6 +232,7 @@ func (h Handler) GetHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, helmWorkloadDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -178,9 +247,12 @@ func (h Handler) GetHelmWorkloadDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param helmWorkloadDefinition body api_v0.HelmWorkloadDefinition true "HelmWorkloadDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-definitions/{id} [PATCH]
 func (h Handler) UpdateHelmWorkloadDefinition(c echo.Context) error {
@@ -194,6 +266,11 @@ func (h Handler) UpdateHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingHelmWorkloadDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingHelmWorkloadDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -205,22 +282,67 @@ func (h Handler) UpdateHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingHelmWorkloadDefinition).Updates(updatedHelmWorkloadDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedHelmWorkloadDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingHelmWorkloadDefinition.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingHelmWorkloadDefinition).Where("resource_version = ?", existingHelmWorkloadDefinition.ResourceVersion).Updates(updatedHelmWorkloadDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingHelmWorkloadDefinition.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingHelmWorkloadDefinition.Reconciled {
-		notifPayload, err := existingHelmWorkloadDefinition.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedHelmWorkloadDefinition.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingHelmWorkloadDefinition,
+				*existingHelmWorkloadDefinition.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingHelmWorkloadDefinition.Reconciled && apiserver_lib.ReconciliationRequired(updatedHelmWorkloadDefinition) {
+			notifPayload, err := existingHelmWorkloadDefinition.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.HelmWorkloadDefinitionUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.HelmWorkloadDefinitionUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingHelmWorkloadDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingHelmWorkloadDefinition, objectType)
@@ -228,6 +350,7 @@ func (h Handler) UpdateHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingHelmWorkloadDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -243,9 +366,12 @@ func (h Handler) UpdateHelmWorkloadDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param helmWorkloadDefinition body api_v0.HelmWorkloadDefinition true "HelmWorkloadDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-definitions/{id} [PUT]
 func (h Handler) ReplaceHelmWorkloadDefinition(c echo.Context) error {
@@ -259,6 +385,11 @@ func (h Handler) ReplaceHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingHelmWorkloadDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingHelmWorkloadDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -275,11 +406,16 @@ func (h Handler) ReplaceHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedHelmWorkloadDefinition.ID = existingHelmWorkloadDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedHelmWorkloadDefinition); result.Error != nil {
+	updatedHelmWorkloadDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingHelmWorkloadDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingHelmWorkloadDefinition.ResourceVersion).Save(&updatedHelmWorkloadDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingHelmWorkloadDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingHelmWorkloadDefinition, helmWorkloadDefinitionID); result.Error != nil {
@@ -289,11 +425,24 @@ func (h Handler) ReplaceHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingHelmWorkloadDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingHelmWorkloadDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingHelmWorkloadDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -303,7 +452,10 @@ func (h Handler) ReplaceHelmWorkloadDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -325,6 +477,17 @@ func (h Handler) DeleteHelmWorkloadDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, helmWorkloadDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -333,23 +496,77 @@ func (h Handler) DeleteHelmWorkloadDefinition(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledHelmWorkloadDefinition := api_v0.HelmWorkloadDefinition{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(helmWorkloadDefinition.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&helmWorkloadDefinition).Updates(scheduledHelmWorkloadDefinition); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := helmWorkloadDefinition.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*helmWorkloadDefinition.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledHelmWorkloadDefinition.Finalizers = helmWorkloadDefinition.Finalizers
+				scheduledHelmWorkloadDefinition.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&helmWorkloadDefinition).Where("resource_version = ?", helmWorkloadDefinition.ResourceVersion).Updates(scheduledHelmWorkloadDefinition)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(helmWorkloadDefinition.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := helmWorkloadDefinition.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.HelmWorkloadDefinitionDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.HelmWorkloadDefinitionDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			helmWorkloadDefinition,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if helmWorkloadDefinition.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -358,11 +575,38 @@ func (h Handler) DeleteHelmWorkloadDefinition(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*helmWorkloadDefinition.ID,
 			)), objectType)
-		} else {
+		} else if len(helmWorkloadDefinition.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&helmWorkloadDefinition); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", helmWorkloadDefinition.ResourceVersion).Delete(&helmWorkloadDefinition)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(helmWorkloadDefinition.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, helmWorkloadDefinition.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				helmWorkloadDefinition,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -432,22 +676,44 @@ func (h Handler) AddHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&helmWorkloadInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&helmWorkloadInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*helmWorkloadInstance.Reconciled {
-		notifPayload, err := helmWorkloadInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*helmWorkloadInstance.Reconciled {
+			notifPayload, err := helmWorkloadInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.HelmWorkloadInstanceCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.HelmWorkloadInstanceCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		helmWorkloadInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, helmWorkloadInstance, objectType)
@@ -455,21 +721,39 @@ func (h Handler) AddHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, helmWorkloadInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all helm workload instances.
 // @Description Get all helm workload instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-helmWorkloadInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "helm workload instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-instances [GET]
 func (h Handler) GetHelmWorkloadInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeHelmWorkloadInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -480,17 +764,44 @@ func (h Handler) GetHelmWorkloadInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.HelmWorkloadInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.HelmWorkloadInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.HelmWorkloadInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.HelmWorkloadInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -505,6 +816,7 @@ func (h Handler) GetHelmWorkloadInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-instances/{id} [GET]
@@ -524,6 +836,7 @@ func (h Handler) GetHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, helmWorkloadInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -538,9 +851,12 @@ func (h Handler) GetHelmWorkloadInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param helmWorkloadInstance body api_v0.HelmWorkloadInstance true "HelmWorkloadInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-instances/{id} [PATCH]
 func (h Handler) UpdateHelmWorkloadInstance(c echo.Context) error {
@@ -554,6 +870,11 @@ func (h Handler) UpdateHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingHelmWorkloadInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingHelmWorkloadInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -565,22 +886,67 @@ func (h Handler) UpdateHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingHelmWorkloadInstance).Updates(updatedHelmWorkloadInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedHelmWorkloadInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingHelmWorkloadInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingHelmWorkloadInstance).Where("resource_version = ?", existingHelmWorkloadInstance.ResourceVersion).Updates(updatedHelmWorkloadInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingHelmWorkloadInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingHelmWorkloadInstance.Reconciled {
-		notifPayload, err := existingHelmWorkloadInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedHelmWorkloadInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingHelmWorkloadInstance,
+				*existingHelmWorkloadInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingHelmWorkloadInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedHelmWorkloadInstance) {
+			notifPayload, err := existingHelmWorkloadInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.HelmWorkloadInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.HelmWorkloadInstanceUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingHelmWorkloadInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingHelmWorkloadInstance, objectType)
@@ -588,6 +954,7 @@ func (h Handler) UpdateHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingHelmWorkloadInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -603,9 +970,12 @@ func (h Handler) UpdateHelmWorkloadInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param helmWorkloadInstance body api_v0.HelmWorkloadInstance true "HelmWorkloadInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/helm-workload-instances/{id} [PUT]
 func (h Handler) ReplaceHelmWorkloadInstance(c echo.Context) error {
@@ -619,6 +989,11 @@ func (h Handler) ReplaceHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingHelmWorkloadInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingHelmWorkloadInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -635,11 +1010,16 @@ func (h Handler) ReplaceHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedHelmWorkloadInstance.ID = existingHelmWorkloadInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedHelmWorkloadInstance); result.Error != nil {
+	updatedHelmWorkloadInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingHelmWorkloadInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingHelmWorkloadInstance.ResourceVersion).Save(&updatedHelmWorkloadInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingHelmWorkloadInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingHelmWorkloadInstance, helmWorkloadInstanceID); result.Error != nil {
@@ -649,11 +1029,24 @@ func (h Handler) ReplaceHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingHelmWorkloadInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingHelmWorkloadInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingHelmWorkloadInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -663,7 +1056,10 @@ func (h Handler) ReplaceHelmWorkloadInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -679,6 +1075,17 @@ func (h Handler) DeleteHelmWorkloadInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, helmWorkloadInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -687,23 +1094,77 @@ func (h Handler) DeleteHelmWorkloadInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledHelmWorkloadInstance := api_v0.HelmWorkloadInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(helmWorkloadInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&helmWorkloadInstance).Updates(scheduledHelmWorkloadInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := helmWorkloadInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*helmWorkloadInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledHelmWorkloadInstance.Finalizers = helmWorkloadInstance.Finalizers
+				scheduledHelmWorkloadInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&helmWorkloadInstance).Where("resource_version = ?", helmWorkloadInstance.ResourceVersion).Updates(scheduledHelmWorkloadInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(helmWorkloadInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := helmWorkloadInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.HelmWorkloadInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.HelmWorkloadInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			helmWorkloadInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if helmWorkloadInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -712,11 +1173,38 @@ func (h Handler) DeleteHelmWorkloadInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*helmWorkloadInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(helmWorkloadInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&helmWorkloadInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", helmWorkloadInstance.ResourceVersion).Delete(&helmWorkloadInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(helmWorkloadInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, helmWorkloadInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				helmWorkloadInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
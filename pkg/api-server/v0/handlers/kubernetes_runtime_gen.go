@@ -72,22 +72,44 @@ func (h Handler) AddKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&kubernetesRuntimeDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&kubernetesRuntimeDefinition); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*kubernetesRuntimeDefinition.Reconciled {
-		notifPayload, err := kubernetesRuntimeDefinition.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*kubernetesRuntimeDefinition.Reconciled {
+			notifPayload, err := kubernetesRuntimeDefinition.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.KubernetesRuntimeDefinitionCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.KubernetesRuntimeDefinitionCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		kubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, kubernetesRuntimeDefinition, objectType)
@@ -95,21 +117,39 @@ func (h Handler) AddKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, kubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all kubernetes runtime definitions.
 // @Description Get all kubernetes runtime definitions from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-kubernetesRuntimeDefinitions
 // @Accept json
 // @Produce json
 // @Param name query string false "kubernetes runtime definition search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-definitions [GET]
 func (h Handler) GetKubernetesRuntimeDefinitions(c echo.Context) error {
 	objectType := api_v0.ObjectTypeKubernetesRuntimeDefinition
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -120,17 +160,44 @@ func (h Handler) GetKubernetesRuntimeDefinitions(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.KubernetesRuntimeDefinition{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.KubernetesRuntimeDefinition{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.KubernetesRuntimeDefinition{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.KubernetesRuntimeDefinition{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -145,6 +212,7 @@ func (h Handler) GetKubernetesRuntimeDefinitions(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-definitions/{id} [GET]
@@ -164,6 +232,7 @@ func (h Handler) GetKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, kubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -178,9 +247,12 @@ func (h Handler) GetKubernetesRuntimeDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param kubernetesRuntimeDefinition body api_v0.KubernetesRuntimeDefinition true "KubernetesRuntimeDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-definitions/{id} [PATCH]
 func (h Handler) UpdateKubernetesRuntimeDefinition(c echo.Context) error {
@@ -194,6 +266,11 @@ func (h Handler) UpdateKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingKubernetesRuntimeDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingKubernetesRuntimeDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -205,22 +282,67 @@ func (h Handler) UpdateKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingKubernetesRuntimeDefinition).Updates(updatedKubernetesRuntimeDefinition); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedKubernetesRuntimeDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingKubernetesRuntimeDefinition.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingKubernetesRuntimeDefinition).Where("resource_version = ?", existingKubernetesRuntimeDefinition.ResourceVersion).Updates(updatedKubernetesRuntimeDefinition)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingKubernetesRuntimeDefinition.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingKubernetesRuntimeDefinition.Reconciled {
-		notifPayload, err := existingKubernetesRuntimeDefinition.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedKubernetesRuntimeDefinition.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingKubernetesRuntimeDefinition,
+				*existingKubernetesRuntimeDefinition.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingKubernetesRuntimeDefinition.Reconciled && apiserver_lib.ReconciliationRequired(updatedKubernetesRuntimeDefinition) {
+			notifPayload, err := existingKubernetesRuntimeDefinition.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.KubernetesRuntimeDefinitionUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.KubernetesRuntimeDefinitionUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingKubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingKubernetesRuntimeDefinition, objectType)
@@ -228,6 +350,7 @@ func (h Handler) UpdateKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingKubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -243,9 +366,12 @@ func (h Handler) UpdateKubernetesRuntimeDefinition(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param kubernetesRuntimeDefinition body api_v0.KubernetesRuntimeDefinition true "KubernetesRuntimeDefinition object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-definitions/{id} [PUT]
 func (h Handler) ReplaceKubernetesRuntimeDefinition(c echo.Context) error {
@@ -259,6 +385,11 @@ func (h Handler) ReplaceKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingKubernetesRuntimeDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingKubernetesRuntimeDefinition); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -275,11 +406,16 @@ func (h Handler) ReplaceKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedKubernetesRuntimeDefinition.ID = existingKubernetesRuntimeDefinition.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedKubernetesRuntimeDefinition); result.Error != nil {
+	updatedKubernetesRuntimeDefinition.ResourceVersion = apiserver_lib.NextResourceVersion(existingKubernetesRuntimeDefinition.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingKubernetesRuntimeDefinition.ResourceVersion).Save(&updatedKubernetesRuntimeDefinition)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingKubernetesRuntimeDefinition.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingKubernetesRuntimeDefinition, kubernetesRuntimeDefinitionID); result.Error != nil {
@@ -289,11 +425,24 @@ func (h Handler) ReplaceKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingKubernetesRuntimeDefinition,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingKubernetesRuntimeDefinition, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingKubernetesRuntimeDefinition.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -303,7 +452,10 @@ func (h Handler) ReplaceKubernetesRuntimeDefinition(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -325,6 +477,17 @@ func (h Handler) DeleteKubernetesRuntimeDefinition(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, kubernetesRuntimeDefinition.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -333,23 +496,77 @@ func (h Handler) DeleteKubernetesRuntimeDefinition(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledKubernetesRuntimeDefinition := api_v0.KubernetesRuntimeDefinition{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(kubernetesRuntimeDefinition.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&kubernetesRuntimeDefinition).Updates(scheduledKubernetesRuntimeDefinition); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := kubernetesRuntimeDefinition.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*kubernetesRuntimeDefinition.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledKubernetesRuntimeDefinition.Finalizers = kubernetesRuntimeDefinition.Finalizers
+				scheduledKubernetesRuntimeDefinition.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&kubernetesRuntimeDefinition).Where("resource_version = ?", kubernetesRuntimeDefinition.ResourceVersion).Updates(scheduledKubernetesRuntimeDefinition)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(kubernetesRuntimeDefinition.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := kubernetesRuntimeDefinition.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.KubernetesRuntimeDefinitionDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.KubernetesRuntimeDefinitionDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			kubernetesRuntimeDefinition,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if kubernetesRuntimeDefinition.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -358,11 +575,38 @@ func (h Handler) DeleteKubernetesRuntimeDefinition(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*kubernetesRuntimeDefinition.ID,
 			)), objectType)
-		} else {
+		} else if len(kubernetesRuntimeDefinition.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&kubernetesRuntimeDefinition); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", kubernetesRuntimeDefinition.ResourceVersion).Delete(&kubernetesRuntimeDefinition)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(kubernetesRuntimeDefinition.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, kubernetesRuntimeDefinition.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				kubernetesRuntimeDefinition,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
@@ -432,22 +676,44 @@ func (h Handler) AddKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus409(c, nil, errors.New("object with provided name already exists"), objectType)
 	}
 
-	// persist to DB
-	if result := h.DB.Create(&kubernetesRuntimeInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// persist to DB and queue controller notification in a single transaction
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Create(&kubernetesRuntimeInstance); result.Error != nil {
+			return result.Error
+		}
 
-	// notify controller if reconciliation is required
-	if !*kubernetesRuntimeInstance.Reconciled {
-		notifPayload, err := kubernetesRuntimeInstance.NotificationPayload(
-			notifications.NotificationOperationCreated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// queue controller notification if reconciliation is required
+		if !*kubernetesRuntimeInstance.Reconciled {
+			notifPayload, err := kubernetesRuntimeInstance.NotificationPayload(
+				notifications.NotificationOperationCreated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.KubernetesRuntimeInstanceCreateSubject, *notifPayload)
 		}
-		h.JS.Publish(notif.KubernetesRuntimeInstanceCreateSubject, *notifPayload)
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+		}
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventCreated,
+		objectType,
+		"v0",
+		kubernetesRuntimeInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, kubernetesRuntimeInstance, objectType)
@@ -455,21 +721,39 @@ func (h Handler) AddKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, kubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus201(c, *response)
 }
 
 // @Summary gets all kubernetes runtime instances.
 // @Description Get all kubernetes runtime instances from the Threeport database.
+// @Description Filter by field value with Field[op]=value where op is one of
+// @Description eq, ne, gt, gte, lt, lte, like, in or notin, e.g. CreatedAt[gt]=2024-01-01T00:00:00Z.
 // @ID get-v0-kubernetesRuntimeInstances
 // @Accept json
 // @Produce json
 // @Param name query string false "kubernetes runtime instance search by name"
+// @Param labelSelector query string false "select objects by label, e.g. team=payments,tier!=dev"
+// @Param sort query string false "comma-separated fields to sort by, prefixed with - for descending order, e.g. -CreatedAt,Name"
+// @Param fields query string false "comma-separated fields to return, e.g. ID,Name"
+// @Param continue query string false "continue token from the Meta of the previous page to fetch the next page"
+// @Param skipCount query bool false "skip counting the total number of objects"
+// @Param watch query bool false "stream changes as server-sent events instead of returning a list"
+// @Param since query int false "resume a watch following the event with this marker"
 // @Success 200 {object} v0.Response "OK"
 // @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 410 {object} v0.Response "Gone"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-instances [GET]
 func (h Handler) GetKubernetesRuntimeInstances(c echo.Context) error {
 	objectType := api_v0.ObjectTypeKubernetesRuntimeInstance
+
+	// check for a watch request
+	watch, since, err := c.(*apiserver_lib.CustomContext).GetWatchParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
 	if err != nil {
 		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
@@ -480,17 +764,44 @@ func (h Handler) GetKubernetesRuntimeInstances(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
 
-	var totalCount int64
-	if result := h.DB.Model(&api_v0.KubernetesRuntimeInstance{}).Where(&filter).Count(&totalCount); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	labelSelector, err := c.(*apiserver_lib.CustomContext).GetLabelSelector()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	queryOptions, err := c.(*apiserver_lib.CustomContext).GetListQueryOptions(h.DB, &api_v0.KubernetesRuntimeInstance{})
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	// stream changes to objects that match the filters if watching
+	if watch {
+		return apiserver_lib.StreamWatchEvents(c, h.JS, objectType, "v0", since, apiserver_lib.NewWatchFilter(h.DB, &filter, labelSelector, queryOptions))
+	}
+
+	cursorParams, err := c.(*apiserver_lib.CustomContext).GetCursorParams(queryOptions)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	totalCount := int64(apiserver_lib.TotalCountSkipped)
+	if !cursorParams.SkipCount {
+		if result := h.DB.Model(&api_v0.KubernetesRuntimeInstance{}).Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope()).Count(&totalCount); result.Error != nil {
+			return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+		}
 	}
 
 	records := &[]api_v0.KubernetesRuntimeInstance{}
-	if result := h.DB.Order("ID asc").Where(&filter).Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+	if result := h.DB.Where(&filter).Scopes(apiserver_lib.LabelSelectorScope(labelSelector), queryOptions.FilterScope(), queryOptions.SortScope(), queryOptions.FieldsScope(), cursorParams.PageScope(params)).Find(records); result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
 	}
 
-	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	meta := apiserver_lib.CreateMeta(params, totalCount)
+	if meta.Continue, err = cursorParams.NextContinueToken(records, params); err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(meta, *records, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
 	}
@@ -505,6 +816,7 @@ func (h Handler) GetKubernetesRuntimeInstances(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-instances/{id} [GET]
@@ -524,6 +836,7 @@ func (h Handler) GetKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, kubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -538,9 +851,12 @@ func (h Handler) GetKubernetesRuntimeInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param kubernetesRuntimeInstance body api_v0.KubernetesRuntimeInstance true "KubernetesRuntimeInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-instances/{id} [PATCH]
 func (h Handler) UpdateKubernetesRuntimeInstance(c echo.Context) error {
@@ -554,6 +870,11 @@ func (h Handler) UpdateKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingKubernetesRuntimeInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingKubernetesRuntimeInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -565,22 +886,67 @@ func (h Handler) UpdateKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
-	// update object in database
-	if result := h.DB.Model(&existingKubernetesRuntimeInstance).Updates(updatedKubernetesRuntimeInstance); result.Error != nil {
-		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-	}
+	// update object in database if it has not changed since it was read
+	// and queue controller notification in a single transaction
+	updatedKubernetesRuntimeInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingKubernetesRuntimeInstance.ResourceVersion)
+	if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&existingKubernetesRuntimeInstance).Where("resource_version = ?", existingKubernetesRuntimeInstance.ResourceVersion).Updates(updatedKubernetesRuntimeInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apiserver_lib.ResourceVersionConflictErr(existingKubernetesRuntimeInstance.ResourceVersion)
+		}
 
-	// notify controller if reconciliation is required
-	if !*existingKubernetesRuntimeInstance.Reconciled {
-		notifPayload, err := existingKubernetesRuntimeInstance.NotificationPayload(
-			notifications.NotificationOperationUpdated,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+		// delete the object if its deletion was waiting for the finalizers
+		// that have been removed
+		if updatedKubernetesRuntimeInstance.Finalizers != nil {
+			deleted, err := apiserver_lib.DeleteFinalized(
+				tx,
+				&existingKubernetesRuntimeInstance,
+				*existingKubernetesRuntimeInstance.ID,
+			)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				return nil
+			}
+		}
+
+		// queue controller notification if reconciliation is required
+		// unless the update only changes the object's status, e.g. its conditions
+		if !*existingKubernetesRuntimeInstance.Reconciled && apiserver_lib.ReconciliationRequired(updatedKubernetesRuntimeInstance) {
+			notifPayload, err := existingKubernetesRuntimeInstance.NotificationPayload(
+				notifications.NotificationOperationUpdated,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.KubernetesRuntimeInstanceUpdateSubject, *notifPayload)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+			return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.KubernetesRuntimeInstanceUpdateSubject, *notifPayload)
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+	apiserver_lib.SignalOutboxRelay()
+
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingKubernetesRuntimeInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 	}
 
 	response, err := apiserver_lib.CreateResponse(nil, existingKubernetesRuntimeInstance, objectType)
@@ -588,6 +954,7 @@ func (h Handler) UpdateKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingKubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -603,9 +970,12 @@ func (h Handler) UpdateKubernetesRuntimeInstance(c echo.Context) error {
 // @Produce json
 // @Param id path int true "ID"
 // @Param kubernetesRuntimeInstance body api_v0.KubernetesRuntimeInstance true "KubernetesRuntimeInstance object"
+// @Param If-Match header string false "resource version the update is based on"
 // @Success 200 {object} v0.Response "OK"
+// @Header 200 {string} ETag "resource version"
 // @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
+// @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
 // @Router /v0/kubernetes-runtime-instances/{id} [PUT]
 func (h Handler) ReplaceKubernetesRuntimeInstance(c echo.Context) error {
@@ -619,6 +989,11 @@ func (h Handler) ReplaceKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, existingKubernetesRuntimeInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
 	// check for empty payload, invalid or unsupported fields, optional associations, etc.
 	if id, err := apiserver_lib.PayloadCheck(c, false, true, objectType, existingKubernetesRuntimeInstance); err != nil {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
@@ -635,11 +1010,16 @@ func (h Handler) ReplaceKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatusErr(id, c, nil, errors.New(err.Error()), objectType)
 	}
 
-	// persist provided data
+	// persist provided data if the object has not changed since it was read
 	updatedKubernetesRuntimeInstance.ID = existingKubernetesRuntimeInstance.ID
-	if result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Omit("CreatedAt", "DeletedAt").Save(&updatedKubernetesRuntimeInstance); result.Error != nil {
+	updatedKubernetesRuntimeInstance.ResourceVersion = apiserver_lib.NextResourceVersion(existingKubernetesRuntimeInstance.ResourceVersion)
+	result := h.DB.Session(&gorm.Session{FullSaveAssociations: false}).Select("*").Omit("CreatedAt", "DeletedAt").Where("resource_version = ?", existingKubernetesRuntimeInstance.ResourceVersion).Save(&updatedKubernetesRuntimeInstance)
+	if result.Error != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
+	if result.RowsAffected == 0 {
+		return apiserver_lib.ResponseStatus409(c, nil, apiserver_lib.ResourceVersionConflictErr(existingKubernetesRuntimeInstance.ResourceVersion), objectType)
+	}
 
 	// reload updated data from DB
 	if result := h.DB.First(&existingKubernetesRuntimeInstance, kubernetesRuntimeInstanceID); result.Error != nil {
@@ -649,11 +1029,24 @@ func (h Handler) ReplaceKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// notify watch clients
+	if err := apiserver_lib.PublishWatchEvent(
+		c.Request().Context(),
+		h.JS,
+		apiserver_lib.WatchEventUpdated,
+		objectType,
+		"v0",
+		existingKubernetesRuntimeInstance,
+	); err != nil {
+		c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+	}
+
 	response, err := apiserver_lib.CreateResponse(nil, existingKubernetesRuntimeInstance, objectType)
 	if err != nil {
 		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 	}
 
+	apiserver_lib.SetETag(c, existingKubernetesRuntimeInstance.ResourceVersion)
 	return apiserver_lib.ResponseStatus200(c, *response)
 }
 
@@ -663,7 +1056,10 @@ func (h Handler) ReplaceKubernetesRuntimeInstance(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID"
+// @Param If-Match header string false "resource version the deletion is based on"
+// @Param propagationPolicy query string false "how dependents are deleted: Foreground, Background (default) or Orphan"
 // @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
 // @Failure 404 {object} v0.Response "Not Found"
 // @Failure 409 {object} v0.Response "Conflict"
 // @Failure 500 {object} v0.Response "Internal Server Error"
@@ -679,6 +1075,17 @@ func (h Handler) DeleteKubernetesRuntimeInstance(c echo.Context) error {
 		return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
 	}
 
+	// check resource version provided by client, if any
+	if err := apiserver_lib.CheckIfMatch(c, kubernetesRuntimeInstance.ResourceVersion); err != nil {
+		return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+	}
+
+	// get the policy for deleting the object's dependents
+	propagation, err := apiserver_lib.GetDeletionPropagation(c)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, err, objectType)
+	}
+
 	// schedule for deletion if not already scheduled
 	// if scheduled and reconciled, delete object from DB
 	// if scheduled but not reconciled, return 409 (controller is working on it)
@@ -687,23 +1094,77 @@ func (h Handler) DeleteKubernetesRuntimeInstance(c echo.Context) error {
 		reconciled := false
 		timestamp := time.Now().UTC()
 		scheduledKubernetesRuntimeInstance := api_v0.KubernetesRuntimeInstance{
+			Common: api_v0.Common{ResourceVersion: apiserver_lib.NextResourceVersion(kubernetesRuntimeInstance.ResourceVersion)},
 			Reconciliation: api_v0.Reconciliation{
 				DeletionScheduled: &timestamp,
 				Reconciled:        &reconciled,
-			}}
-		if result := h.DB.Model(&kubernetesRuntimeInstance).Updates(scheduledKubernetesRuntimeInstance); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			},
 		}
-		// notify controller
-		notifPayload, err := kubernetesRuntimeInstance.NotificationPayload(
-			notifications.NotificationOperationDeleted,
-			false,
-			time.Now().Unix(),
-		)
-		if err != nil {
+		// schedule deletion, delete dependents and queue controller
+		// notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			// dependents deleted with the object share the time its deletion
+			// was scheduled so that they can be restored with it
+			tx = apiserver_lib.DeletionSession(tx, timestamp)
+
+			// the object waits for its dependents to be deleted first when
+			// deleted in the foreground
+			waitForDependents, err := apiserver_lib.DeleteDependents(
+				tx,
+				objectType,
+				*kubernetesRuntimeInstance.ID,
+				propagation,
+			)
+			if err != nil {
+				return err
+			}
+			if waitForDependents {
+				scheduledKubernetesRuntimeInstance.Finalizers = kubernetesRuntimeInstance.Finalizers
+				scheduledKubernetesRuntimeInstance.AddFinalizer(api_v0.FinalizerForegroundDeletion)
+			}
+
+			result := tx.Model(&kubernetesRuntimeInstance).Where("resource_version = ?", kubernetesRuntimeInstance.ResourceVersion).Updates(scheduledKubernetesRuntimeInstance)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return apiserver_lib.ResourceVersionConflictErr(kubernetesRuntimeInstance.ResourceVersion)
+			}
+
+			// the controller is notified once the object's dependents have
+			// been deleted
+			if waitForDependents {
+				return nil
+			}
+
+			// queue controller notification
+			notifPayload, err := kubernetesRuntimeInstance.NotificationPayload(
+				notifications.NotificationOperationDeleted,
+				false,
+				time.Now().Unix(),
+			)
+			if err != nil {
+				return err
+			}
+			return apiserver_lib.AddOutboxNotification(tx, notif.KubernetesRuntimeInstanceDeleteSubject, *notifPayload)
+		}); err != nil {
+			if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+				return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+			}
 			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
-		h.JS.Publish(notif.KubernetesRuntimeInstanceDeleteSubject, *notifPayload)
+		apiserver_lib.SignalOutboxRelay()
+		// notify watch clients
+		if err := apiserver_lib.PublishWatchEvent(
+			c.Request().Context(),
+			h.JS,
+			apiserver_lib.WatchEventUpdated,
+			objectType,
+			"v0",
+			kubernetesRuntimeInstance,
+		); err != nil {
+			c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
+		}
 	} else {
 		if kubernetesRuntimeInstance.DeletionConfirmed == nil {
 			// if deletion scheduled but not reconciled, return 409 - deletion
@@ -712,11 +1173,38 @@ func (h Handler) DeleteKubernetesRuntimeInstance(c echo.Context) error {
 				"object with ID %d already being deleted",
 				*kubernetesRuntimeInstance.ID,
 			)), objectType)
-		} else {
+		} else if len(kubernetesRuntimeInstance.GetFinalizers()) == 0 {
 			// object scheduled for deletion and confirmed - it can be deleted
-			// from DB
-			if result := h.DB.Delete(&kubernetesRuntimeInstance); result.Error != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
+			// from DB once it has no finalizers, otherwise it is deleted when
+			// the last finalizer is removed
+			if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+				result := tx.Where("resource_version = ?", kubernetesRuntimeInstance.ResourceVersion).Delete(&kubernetesRuntimeInstance)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return apiserver_lib.ResourceVersionConflictErr(kubernetesRuntimeInstance.ResourceVersion)
+				}
+
+				// notify owners waiting for their dependents to be deleted
+				return apiserver_lib.ReleaseOwners(tx, kubernetesRuntimeInstance.GetOwnerReferences())
+			}); err != nil {
+				if errors.Is(err, apiserver_lib.ErrResourceVersionConflict) {
+					return apiserver_lib.ResponseStatus409(c, nil, err, objectType)
+				}
+				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+			}
+			apiserver_lib.SignalOutboxRelay()
+			// notify watch clients
+			if err := apiserver_lib.PublishWatchEvent(
+				c.Request().Context(),
+				h.JS,
+				apiserver_lib.WatchEventDeleted,
+				objectType,
+				"v0",
+				kubernetesRuntimeInstance,
+			); err != nil {
+				c.Logger().Errorf("failed to publish watch event for %s: %v", objectType, err)
 			}
 		}
 	}
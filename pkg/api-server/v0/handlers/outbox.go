@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	echo "github.com/labstack/echo/v4"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// @Summary gets undelivered controller notifications.
+// @Description Get the controller notifications in the outbox that have not
+// @Description yet been published to NATS, oldest first.
+// @ID get-v0-outbox-notifications-undelivered
+// @Accept json
+// @Produce json
+// @Param page query int false "page number"
+// @Param size query int false "page size"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/outbox-notifications-undelivered [GET]
+func (h Handler) GetOutboxNotificationsUndelivered(c echo.Context) error {
+	objectType := v0.ObjectTypeOutboxNotification
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	var totalCount int64
+	if result := h.DB.Model(&v0.OutboxNotification{}).Where("delivered = ?", false).Count(&totalCount); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	records := &[]v0.OutboxNotification{}
+	if result := h.DB.Where("delivered = ?", false).Order("id asc").Limit(params.Size).Offset((params.Page - 1) * params.Size).Find(records); result.Error != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, result.Error, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), *records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	echo "github.com/labstack/echo/v4"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// @Summary gets reconcile locks.
+// @Description Get the locks held by controllers on the objects they are
+// @Description reconciling.
+// @ID get-v0-reconcile-locks
+// @Accept json
+// @Produce json
+// @Param page query int false "page number"
+// @Param size query int false "page size"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/reconcile-locks [GET]
+func (h Handler) GetReconcileLocks(c echo.Context) error {
+	objectType := v0.ObjectTypeReconcileLock
+	params, err := c.(*apiserver_lib.CustomContext).GetPaginationParams()
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, &params, err, objectType)
+	}
+
+	records, totalCount, err := apiserver_lib.GetReconcileLocks(h.JS, params)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(apiserver_lib.CreateMeta(params, totalCount), records, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, &params, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
+
+// @Summary forcibly releases a reconcile lock.
+// @Description Delete the lock held by a reconciler on an object so that the
+// @Description object can be reconciled again.  A controller that is still
+// @Description reconciling the object loses its lease on the lock.
+// @ID delete-v0-reconcile-lock
+// @Accept json
+// @Produce json
+// @Param reconcilerName path string true "reconciler name, e.g. WorkloadInstanceReconciler"
+// @Param objectId path int true "object ID"
+// @Success 200 {object} v0.Response "OK"
+// @Failure 400 {object} v0.Response "Bad Request"
+// @Failure 404 {object} v0.Response "Not Found"
+// @Failure 500 {object} v0.Response "Internal Server Error"
+// @Router /v0/reconcile-locks/{reconcilerName}/{objectId} [DELETE]
+func (h Handler) DeleteReconcileLock(c echo.Context) error {
+	objectType := v0.ObjectTypeReconcileLock
+	objectID, err := strconv.ParseUint(c.Param("objectId"), 10, 0)
+	if err != nil {
+		return apiserver_lib.ResponseStatus400(c, nil, fmt.Errorf("invalid object ID %s", c.Param("objectId")), objectType)
+	}
+
+	reconcileLock, err := apiserver_lib.ReleaseReconcileLock(h.JS, c.Param("reconcilerName"), uint(objectID))
+	if errors.Is(err, apiserver_lib.ErrReconcileLockNotFound) {
+		return apiserver_lib.ResponseStatus404(c, nil, err, objectType)
+	} else if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	response, err := apiserver_lib.CreateResponse(nil, *reconcileLock, objectType)
+	if err != nil {
+		return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+	}
+
+	return apiserver_lib.ResponseStatus200(c, *response)
+}
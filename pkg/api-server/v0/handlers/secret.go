@@ -89,11 +89,6 @@ func (h Handler) CustomAddSecretDefinition(next echo.HandlerFunc) echo.HandlerFu
 		// set to nil in the secretDefinition.BeforeCreate() method
 		data := secretDefinition.Data
 
-		// persist to DB
-		if result := h.DB.Create(&secretDefinition); result.Error != nil {
-			return apiserver_lib.ResponseStatus500(c, nil, result.Error, objectType)
-		}
-
 		// encrypt sensitive values
 		var encryptionKey = os.Getenv("ENCRYPTION_KEY")
 		if encryptionKey == "" {
@@ -112,26 +107,38 @@ func (h Handler) CustomAddSecretDefinition(next echo.HandlerFunc) echo.HandlerFu
 			return fmt.Errorf("failed to encrypt secret data")
 		}
 
-		// marshal back to json and update secretDefinition
+		// marshal back to json
 		marshaledJson, err := json.Marshal(encryptedDataMap)
 		if err != nil {
 			return fmt.Errorf("failed to marshal encrypted secret data")
 		}
 		encryptedJson := datatypes.JSON(marshaledJson)
-		secretDefinition.Data = &encryptedJson
-
-		// notify controller if reconciliation is required
-		if !*secretDefinition.Reconciled {
-			notifPayload, err := secretDefinition.NotificationPayload(
-				notifications.NotificationOperationCreated,
-				false,
-				time.Now().Unix(),
-			)
-			if err != nil {
-				return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
+
+		// persist to DB and queue controller notification in a single transaction
+		if err := h.DB.WithContext(c.Request().Context()).Transaction(func(tx *gorm.DB) error {
+			if result := tx.Create(&secretDefinition); result.Error != nil {
+				return result.Error
 			}
-			h.JS.Publish(notif.SecretDefinitionCreateSubject, *notifPayload)
+			secretDefinition.Data = &encryptedJson
+
+			// queue controller notification if reconciliation is required
+			if !*secretDefinition.Reconciled {
+				notifPayload, err := secretDefinition.NotificationPayload(
+					notifications.NotificationOperationCreated,
+					false,
+					time.Now().Unix(),
+				)
+				if err != nil {
+					return err
+				}
+				return apiserver_lib.AddOutboxNotification(tx, notif.SecretDefinitionCreateSubject, *notifPayload)
+			}
+
+			return nil
+		}); err != nil {
+			return apiserver_lib.ResponseStatus500(c, nil, err, objectType)
 		}
+		apiserver_lib.SignalOutboxRelay()
 
 		response, err := apiserver_lib.CreateResponse(nil, secretDefinition, objectType)
 		if err != nil {
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// AuditRecordCustomRoutes includes custom routes for AuditRecord handlers.
+func AuditRecordCustomRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathAuditRecordsSearch, h.GetAuditRecordsSearch)
+}
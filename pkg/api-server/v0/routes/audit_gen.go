@@ -0,0 +1,21 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package routes
+
+import (
+	echo "github.com/labstack/echo/v4"
+	handlers "github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// AuditRecordRoutes sets up all routes for the AuditRecord handlers.
+func AuditRecordRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathAuditRecordVersions, h.GetAuditRecordVersions)
+
+	e.POST(v0.PathAuditRecords, h.AddAuditRecord, h.AddAuditRecordMiddleware()...)
+	e.GET(v0.PathAuditRecords, h.GetAuditRecords, h.GetAuditRecordMiddleware()...)
+	e.GET(v0.PathAuditRecords+"/:id", h.GetAuditRecord, h.GetAuditRecordMiddleware()...)
+	e.PATCH(v0.PathAuditRecords+"/:id", h.UpdateAuditRecord, h.PatchAuditRecordMiddleware()...)
+	e.PUT(v0.PathAuditRecords+"/:id", h.ReplaceAuditRecord, h.PutAuditRecordMiddleware()...)
+	e.DELETE(v0.PathAuditRecords+"/:id", h.DeleteAuditRecord, h.DeleteAuditRecordMiddleware()...)
+}
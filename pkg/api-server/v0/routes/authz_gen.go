@@ -0,0 +1,33 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package routes
+
+import (
+	echo "github.com/labstack/echo/v4"
+	handlers "github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// RoleRoutes sets up all routes for the Role handlers.
+func RoleRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathRoleVersions, h.GetRoleVersions)
+
+	e.POST(v0.PathRoles, h.AddRole)
+	e.GET(v0.PathRoles, h.GetRoles)
+	e.GET(v0.PathRoles+"/:id", h.GetRole)
+	e.PATCH(v0.PathRoles+"/:id", h.UpdateRole)
+	e.PUT(v0.PathRoles+"/:id", h.ReplaceRole)
+	e.DELETE(v0.PathRoles+"/:id", h.DeleteRole)
+}
+
+// RoleBindingRoutes sets up all routes for the RoleBinding handlers.
+func RoleBindingRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathRoleBindingVersions, h.GetRoleBindingVersions)
+
+	e.POST(v0.PathRoleBindings, h.AddRoleBinding, h.AddRoleBindingMiddleware()...)
+	e.GET(v0.PathRoleBindings, h.GetRoleBindings, h.GetRoleBindingMiddleware()...)
+	e.GET(v0.PathRoleBindings+"/:id", h.GetRoleBinding, h.GetRoleBindingMiddleware()...)
+	e.PATCH(v0.PathRoleBindings+"/:id", h.UpdateRoleBinding, h.PatchRoleBindingMiddleware()...)
+	e.PUT(v0.PathRoleBindings+"/:id", h.ReplaceRoleBinding, h.PutRoleBindingMiddleware()...)
+	e.DELETE(v0.PathRoleBindings+"/:id", h.DeleteRoleBinding, h.DeleteRoleBindingMiddleware()...)
+}
@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	"github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// BatchCustomRoutes includes custom routes for batch operations.
+func BatchCustomRoutes(e *echo.Echo, h *handlers.Handler) {
+	// each operation in a batch is authorized as it is executed
+	apiserver_lib.AddAuthzDelegatedPath(v0.PathBatch)
+	e.POST(v0.PathBatch, h.ExecuteBatch(batchRouter(e)))
+}
+
+// batchRouter returns a function that creates routers for the operations in a
+// batch.  Each router serves the object routes with the same validation,
+// auditing and authorization as the API server.
+func batchRouter(e *echo.Echo) handlers.BatchRouter {
+	return func(h *handlers.Handler) *echo.Echo {
+		router := echo.New()
+		router.Validator = e.Validator
+
+		router.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				cc := &apiserver_lib.CustomContext{Context: c}
+				return next(cc)
+			}
+		})
+		router.Use(apiserver_lib.AuditMiddleware(h.DB))
+
+		// client certificates are only present when authentication is
+		// enabled for the API server
+		authorize := apiserver_lib.AuthorizationMiddleware(h.DB)
+		router.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			authorized := authorize(next)
+			return func(c echo.Context) error {
+				if c.Request().TLS == nil {
+					return next(c)
+				}
+				return authorized(c)
+			}
+		})
+
+		AddRoutes(router, h)
+
+		return router
+	}
+}
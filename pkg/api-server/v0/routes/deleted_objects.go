@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	"github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// DeletedObjectCustomRoutes includes custom routes for listing and restoring
+// soft-deleted objects.
+func DeletedObjectCustomRoutes(e *echo.Echo, h *handlers.Handler) {
+	deletedObjectsPath := v0.PathDeletedObjects + "/:objectType"
+	restorePath := deletedObjectsPath + "/:id/restore"
+
+	// requests are authorized against the object type being listed or
+	// restored
+	apiserver_lib.AddAuthzDelegatedPath(deletedObjectsPath)
+	apiserver_lib.AddAuthzDelegatedPath(restorePath)
+
+	e.GET(deletedObjectsPath, h.GetDeletedObjects)
+	e.POST(restorePath, h.RestoreDeletedObject)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// FailedReconciliationCustomRoutes includes custom routes for listing and
+// retrying failed reconciliations.
+func FailedReconciliationCustomRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathFailedReconciliations, h.GetFailedReconciliations)
+	e.POST(v0.PathFailedReconciliations+"/:id/retry", h.RetryFailedReconciliation)
+}
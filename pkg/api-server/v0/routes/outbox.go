@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// OutboxCustomRoutes includes custom routes for the outbox of controller
+// notifications.
+func OutboxCustomRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathOutboxNotificationsUndelivered, h.GetOutboxNotificationsUndelivered)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// ReconcileLockCustomRoutes includes custom routes for inspecting and
+// forcibly releasing reconcile locks.
+func ReconcileLockCustomRoutes(e *echo.Echo, h *handlers.Handler) {
+	e.GET(v0.PathReconcileLocks, h.GetReconcileLocks)
+	e.DELETE(v0.PathReconcileLocks+"/:reconcilerName/:objectId", h.DeleteReconcileLock)
+}
@@ -10,4 +10,10 @@ func AddCustomRoutes(e *echo.Echo, h *handlers.Handler) {
 	WorkloadResourceDefinitionSetRoutes(e, h)
 	WorkloadEventCustomRoutes(e, h)
 	EventsCustomRoutes(e, h)
+	AuditRecordCustomRoutes(e, h)
+	BatchCustomRoutes(e, h)
+	OutboxCustomRoutes(e, h)
+	DeletedObjectCustomRoutes(e, h)
+	FailedReconciliationCustomRoutes(e, h)
+	ReconcileLockCustomRoutes(e, h)
 }
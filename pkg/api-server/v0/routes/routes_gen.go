@@ -10,6 +10,7 @@ import (
 // AddRoutes adds routes for all objects of a particular API version.
 func AddRoutes(e *echo.Echo, h *handlers.Handler) {
 	AttachedObjectReferenceRoutes(e, h)
+	AuditRecordRoutes(e, h)
 	AwsAccountRoutes(e, h)
 	AwsEksKubernetesRuntimeDefinitionRoutes(e, h)
 	AwsEksKubernetesRuntimeInstanceRoutes(e, h)
@@ -44,6 +45,8 @@ func AddRoutes(e *echo.Echo, h *handlers.Handler) {
 	ObservabilityStackDefinitionRoutes(e, h)
 	ObservabilityStackInstanceRoutes(e, h)
 	ProfileRoutes(e, h)
+	RoleRoutes(e, h)
+	RoleBindingRoutes(e, h)
 	SecretDefinitionRoutes(e, h)
 	SecretInstanceRoutes(e, h)
 	TerraformDefinitionRoutes(e, h)
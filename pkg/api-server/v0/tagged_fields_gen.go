@@ -6,6 +6,7 @@ import apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 
 var (
 	AttachedObjectReferenceTaggedFields           = make(map[string]*apiserver_lib.FieldsByTag)
+	AuditRecordTaggedFields                       = make(map[string]*apiserver_lib.FieldsByTag)
 	AwsAccountTaggedFields                        = make(map[string]*apiserver_lib.FieldsByTag)
 	AwsEksKubernetesRuntimeDefinitionTaggedFields = make(map[string]*apiserver_lib.FieldsByTag)
 	AwsEksKubernetesRuntimeInstanceTaggedFields   = make(map[string]*apiserver_lib.FieldsByTag)
@@ -43,6 +44,8 @@ var (
 	ObservabilityStackDefinitionTaggedFields      = make(map[string]*apiserver_lib.FieldsByTag)
 	ObservabilityStackInstanceTaggedFields        = make(map[string]*apiserver_lib.FieldsByTag)
 	ProfileTaggedFields                           = make(map[string]*apiserver_lib.FieldsByTag)
+	RoleTaggedFields                              = make(map[string]*apiserver_lib.FieldsByTag)
+	RoleBindingTaggedFields                       = make(map[string]*apiserver_lib.FieldsByTag)
 	SecretDefinitionTaggedFields                  = make(map[string]*apiserver_lib.FieldsByTag)
 	SecretInstanceTaggedFields                    = make(map[string]*apiserver_lib.FieldsByTag)
 	TerraformDefinitionTaggedFields               = make(map[string]*apiserver_lib.FieldsByTag)
@@ -39,6 +39,13 @@ func AddProfileVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathProfiles,
+		versionObj.Object,
+		new(api_v0.Profile),
+	)
 }
 
 // AddTierVersions adds field validation info and adds it
@@ -71,4 +78,11 @@ func AddTierVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathTiers,
+		versionObj.Object,
+		new(api_v0.Tier),
+	)
 }
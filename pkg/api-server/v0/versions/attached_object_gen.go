@@ -39,4 +39,11 @@ func AddAttachedObjectReferenceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAttachedObjectReferences,
+		versionObj.Object,
+		new(api_v0.AttachedObjectReference),
+	)
 }
@@ -0,0 +1,49 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package versions
+
+import (
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
+	api_v0 "github.com/threeport/threeport/pkg/api/v0"
+	"reflect"
+)
+
+// AddAuditRecordVersions adds field validation info and adds it
+// to the REST API versions.
+func AddAuditRecordVersions() {
+	apiserver_v0.AuditRecordTaggedFields[apiserver_lib.TagNameValidate] = &apiserver_lib.FieldsByTag{
+		Optional:             []string{},
+		OptionalAssociations: []string{},
+		Required:             []string{},
+		TagName:              apiserver_lib.TagNameValidate,
+	}
+
+	// parse struct and populate the FieldsByTag object
+	apiserver_lib.ParseStruct(
+		apiserver_lib.TagNameValidate,
+		reflect.ValueOf(new(api_v0.AuditRecord)),
+		"",
+		apiserver_lib.Translate,
+		apiserver_v0.AuditRecordTaggedFields,
+	)
+
+	// create a version object which contains the object name and versions
+	versionObj := apiserver_lib.VersionObject{
+		Object:  string(api_v0.ObjectTypeAuditRecord),
+		Version: "v0",
+	}
+
+	// add the object tagged fields to the global tagged fields map
+	apiserver_lib.ObjectTaggedFields[versionObj] = apiserver_v0.AuditRecordTaggedFields[apiserver_lib.TagNameValidate]
+
+	// add the object tagged fields to the rest API version
+	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAuditRecords,
+		versionObj.Object,
+		new(api_v0.AuditRecord),
+	)
+}
@@ -3,6 +3,7 @@
 package versions
 
 import (
+	notif "github.com/threeport/threeport/internal/aws/notif"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
@@ -39,6 +40,13 @@ func AddAwsAccountVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsAccounts,
+		versionObj.Object,
+		new(api_v0.AwsAccount),
+	)
 }
 
 // AddAwsEksKubernetesRuntimeDefinitionVersions adds field validation info and adds it
@@ -71,6 +79,13 @@ func AddAwsEksKubernetesRuntimeDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsEksKubernetesRuntimeDefinitions,
+		versionObj.Object,
+		new(api_v0.AwsEksKubernetesRuntimeDefinition),
+	)
 }
 
 // AddAwsEksKubernetesRuntimeInstanceVersions adds field validation info and adds it
@@ -103,6 +118,22 @@ func AddAwsEksKubernetesRuntimeInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsEksKubernetesRuntimeInstances,
+		versionObj.Object,
+		new(api_v0.AwsEksKubernetesRuntimeInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.AwsEksKubernetesRuntimeInstanceCreateSubject,
+		notif.AwsEksKubernetesRuntimeInstanceDeleteSubject,
+	)
 }
 
 // AddAwsObjectStorageBucketDefinitionVersions adds field validation info and adds it
@@ -135,6 +166,13 @@ func AddAwsObjectStorageBucketDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsObjectStorageBucketDefinitions,
+		versionObj.Object,
+		new(api_v0.AwsObjectStorageBucketDefinition),
+	)
 }
 
 // AddAwsObjectStorageBucketInstanceVersions adds field validation info and adds it
@@ -167,6 +205,22 @@ func AddAwsObjectStorageBucketInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsObjectStorageBucketInstances,
+		versionObj.Object,
+		new(api_v0.AwsObjectStorageBucketInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.AwsObjectStorageBucketInstanceCreateSubject,
+		notif.AwsObjectStorageBucketInstanceDeleteSubject,
+	)
 }
 
 // AddAwsRelationalDatabaseDefinitionVersions adds field validation info and adds it
@@ -199,6 +253,13 @@ func AddAwsRelationalDatabaseDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsRelationalDatabaseDefinitions,
+		versionObj.Object,
+		new(api_v0.AwsRelationalDatabaseDefinition),
+	)
 }
 
 // AddAwsRelationalDatabaseInstanceVersions adds field validation info and adds it
@@ -231,4 +292,20 @@ func AddAwsRelationalDatabaseInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathAwsRelationalDatabaseInstances,
+		versionObj.Object,
+		new(api_v0.AwsRelationalDatabaseInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.AwsRelationalDatabaseInstanceCreateSubject,
+		notif.AwsRelationalDatabaseInstanceDeleteSubject,
+	)
 }
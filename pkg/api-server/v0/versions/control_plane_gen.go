@@ -3,6 +3,7 @@
 package versions
 
 import (
+	notif "github.com/threeport/threeport/internal/control-plane/notif"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
@@ -39,6 +40,22 @@ func AddControlPlaneDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathControlPlaneDefinitions,
+		versionObj.Object,
+		new(api_v0.ControlPlaneDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.ControlPlaneDefinitionCreateSubject,
+		notif.ControlPlaneDefinitionDeleteSubject,
+	)
 }
 
 // AddControlPlaneInstanceVersions adds field validation info and adds it
@@ -71,4 +88,20 @@ func AddControlPlaneInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathControlPlaneInstances,
+		versionObj.Object,
+		new(api_v0.ControlPlaneInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.ControlPlaneInstanceCreateSubject,
+		notif.ControlPlaneInstanceDeleteSubject,
+	)
 }
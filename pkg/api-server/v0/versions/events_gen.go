@@ -39,4 +39,11 @@ func AddEventVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathEvents,
+		versionObj.Object,
+		new(api_v0.Event),
+	)
 }
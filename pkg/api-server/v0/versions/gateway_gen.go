@@ -3,6 +3,7 @@
 package versions
 
 import (
+	notif "github.com/threeport/threeport/internal/gateway/notif"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
@@ -39,6 +40,13 @@ func AddDomainNameDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathDomainNameDefinitions,
+		versionObj.Object,
+		new(api_v0.DomainNameDefinition),
+	)
 }
 
 // AddDomainNameInstanceVersions adds field validation info and adds it
@@ -71,6 +79,22 @@ func AddDomainNameInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathDomainNameInstances,
+		versionObj.Object,
+		new(api_v0.DomainNameInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.DomainNameInstanceCreateSubject,
+		notif.DomainNameInstanceDeleteSubject,
+	)
 }
 
 // AddGatewayDefinitionVersions adds field validation info and adds it
@@ -103,6 +127,22 @@ func AddGatewayDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathGatewayDefinitions,
+		versionObj.Object,
+		new(api_v0.GatewayDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.GatewayDefinitionCreateSubject,
+		notif.GatewayDefinitionDeleteSubject,
+	)
 }
 
 // AddGatewayHttpPortVersions adds field validation info and adds it
@@ -135,6 +175,13 @@ func AddGatewayHttpPortVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathGatewayHttpPorts,
+		versionObj.Object,
+		new(api_v0.GatewayHttpPort),
+	)
 }
 
 // AddGatewayInstanceVersions adds field validation info and adds it
@@ -167,6 +214,22 @@ func AddGatewayInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathGatewayInstances,
+		versionObj.Object,
+		new(api_v0.GatewayInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.GatewayInstanceCreateSubject,
+		notif.GatewayInstanceDeleteSubject,
+	)
 }
 
 // AddGatewayTcpPortVersions adds field validation info and adds it
@@ -199,4 +262,11 @@ func AddGatewayTcpPortVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathGatewayTcpPorts,
+		versionObj.Object,
+		new(api_v0.GatewayTcpPort),
+	)
 }
@@ -3,6 +3,7 @@
 package versions
 
 import (
+	notif "github.com/threeport/threeport/internal/kubernetes-runtime/notif"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
@@ -39,6 +40,22 @@ func AddKubernetesRuntimeDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathKubernetesRuntimeDefinitions,
+		versionObj.Object,
+		new(api_v0.KubernetesRuntimeDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.KubernetesRuntimeDefinitionCreateSubject,
+		notif.KubernetesRuntimeDefinitionDeleteSubject,
+	)
 }
 
 // AddKubernetesRuntimeInstanceVersions adds field validation info and adds it
@@ -71,4 +88,20 @@ func AddKubernetesRuntimeInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathKubernetesRuntimeInstances,
+		versionObj.Object,
+		new(api_v0.KubernetesRuntimeInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.KubernetesRuntimeInstanceCreateSubject,
+		notif.KubernetesRuntimeInstanceDeleteSubject,
+	)
 }
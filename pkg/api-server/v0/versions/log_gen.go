@@ -39,6 +39,13 @@ func AddLogBackendVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathLogBackends,
+		versionObj.Object,
+		new(api_v0.LogBackend),
+	)
 }
 
 // AddLogStorageDefinitionVersions adds field validation info and adds it
@@ -71,6 +78,13 @@ func AddLogStorageDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathLogStorageDefinitions,
+		versionObj.Object,
+		new(api_v0.LogStorageDefinition),
+	)
 }
 
 // AddLogStorageInstanceVersions adds field validation info and adds it
@@ -103,4 +117,11 @@ func AddLogStorageInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathLogStorageInstances,
+		versionObj.Object,
+		new(api_v0.LogStorageInstance),
+	)
 }
@@ -39,6 +39,13 @@ func AddModuleApiVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathModuleApis,
+		versionObj.Object,
+		new(api_v0.ModuleApi),
+	)
 }
 
 // AddModuleApiRouteVersions adds field validation info and adds it
@@ -71,4 +78,11 @@ func AddModuleApiRouteVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathModuleApiRoutes,
+		versionObj.Object,
+		new(api_v0.ModuleApiRoute),
+	)
 }
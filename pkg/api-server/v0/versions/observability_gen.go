@@ -3,6 +3,7 @@
 package versions
 
 import (
+	notif "github.com/threeport/threeport/internal/observability/notif"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
@@ -39,6 +40,22 @@ func AddLoggingDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathLoggingDefinitions,
+		versionObj.Object,
+		new(api_v0.LoggingDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.LoggingDefinitionCreateSubject,
+		notif.LoggingDefinitionDeleteSubject,
+	)
 }
 
 // AddLoggingInstanceVersions adds field validation info and adds it
@@ -71,6 +88,22 @@ func AddLoggingInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathLoggingInstances,
+		versionObj.Object,
+		new(api_v0.LoggingInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.LoggingInstanceCreateSubject,
+		notif.LoggingInstanceDeleteSubject,
+	)
 }
 
 // AddMetricsDefinitionVersions adds field validation info and adds it
@@ -103,6 +136,22 @@ func AddMetricsDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathMetricsDefinitions,
+		versionObj.Object,
+		new(api_v0.MetricsDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.MetricsDefinitionCreateSubject,
+		notif.MetricsDefinitionDeleteSubject,
+	)
 }
 
 // AddMetricsInstanceVersions adds field validation info and adds it
@@ -135,6 +184,22 @@ func AddMetricsInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathMetricsInstances,
+		versionObj.Object,
+		new(api_v0.MetricsInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.MetricsInstanceCreateSubject,
+		notif.MetricsInstanceDeleteSubject,
+	)
 }
 
 // AddObservabilityDashboardDefinitionVersions adds field validation info and adds it
@@ -167,6 +232,22 @@ func AddObservabilityDashboardDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathObservabilityDashboardDefinitions,
+		versionObj.Object,
+		new(api_v0.ObservabilityDashboardDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.ObservabilityDashboardDefinitionCreateSubject,
+		notif.ObservabilityDashboardDefinitionDeleteSubject,
+	)
 }
 
 // AddObservabilityDashboardInstanceVersions adds field validation info and adds it
@@ -199,6 +280,22 @@ func AddObservabilityDashboardInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathObservabilityDashboardInstances,
+		versionObj.Object,
+		new(api_v0.ObservabilityDashboardInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.ObservabilityDashboardInstanceCreateSubject,
+		notif.ObservabilityDashboardInstanceDeleteSubject,
+	)
 }
 
 // AddObservabilityStackDefinitionVersions adds field validation info and adds it
@@ -231,6 +328,22 @@ func AddObservabilityStackDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathObservabilityStackDefinitions,
+		versionObj.Object,
+		new(api_v0.ObservabilityStackDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.ObservabilityStackDefinitionCreateSubject,
+		notif.ObservabilityStackDefinitionDeleteSubject,
+	)
 }
 
 // AddObservabilityStackInstanceVersions adds field validation info and adds it
@@ -263,4 +376,20 @@ func AddObservabilityStackInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathObservabilityStackInstances,
+		versionObj.Object,
+		new(api_v0.ObservabilityStackInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.ObservabilityStackInstanceCreateSubject,
+		notif.ObservabilityStackInstanceDeleteSubject,
+	)
 }
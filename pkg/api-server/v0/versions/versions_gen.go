@@ -4,6 +4,7 @@ package versions
 
 func AddVersions() {
 	AddAttachedObjectReferenceVersions()
+	AddAuditRecordVersions()
 	AddAwsAccountVersions()
 	AddAwsEksKubernetesRuntimeDefinitionVersions()
 	AddAwsEksKubernetesRuntimeInstanceVersions()
@@ -38,6 +39,8 @@ func AddVersions() {
 	AddObservabilityStackDefinitionVersions()
 	AddObservabilityStackInstanceVersions()
 	AddProfileVersions()
+	AddRoleVersions()
+	AddRoleBindingVersions()
 	AddSecretDefinitionVersions()
 	AddSecretInstanceVersions()
 	AddTerraformDefinitionVersions()
@@ -3,6 +3,7 @@
 package versions
 
 import (
+	notif "github.com/threeport/threeport/internal/workload/notif"
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 	apiserver_v0 "github.com/threeport/threeport/pkg/api-server/v0"
 	api_v0 "github.com/threeport/threeport/pkg/api/v0"
@@ -39,6 +40,22 @@ func AddWorkloadDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathWorkloadDefinitions,
+		versionObj.Object,
+		new(api_v0.WorkloadDefinition),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.WorkloadDefinitionCreateSubject,
+		notif.WorkloadDefinitionDeleteSubject,
+	)
 }
 
 // AddWorkloadEventVersions adds field validation info and adds it
@@ -71,6 +88,13 @@ func AddWorkloadEventVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathWorkloadEvents,
+		versionObj.Object,
+		new(api_v0.WorkloadEvent),
+	)
 }
 
 // AddWorkloadInstanceVersions adds field validation info and adds it
@@ -103,6 +127,22 @@ func AddWorkloadInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathWorkloadInstances,
+		versionObj.Object,
+		new(api_v0.WorkloadInstance),
+	)
+
+	// register the object's controller notification subjects so that
+	// restored objects and objects deleted with their owners are
+	// reconciled
+	apiserver_lib.AddReconciledObject(
+		versionObj.Object,
+		notif.WorkloadInstanceCreateSubject,
+		notif.WorkloadInstanceDeleteSubject,
+	)
 }
 
 // AddWorkloadResourceDefinitionVersions adds field validation info and adds it
@@ -135,6 +175,13 @@ func AddWorkloadResourceDefinitionVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathWorkloadResourceDefinitions,
+		versionObj.Object,
+		new(api_v0.WorkloadResourceDefinition),
+	)
 }
 
 // AddWorkloadResourceInstanceVersions adds field validation info and adds it
@@ -167,4 +214,11 @@ func AddWorkloadResourceInstanceVersions() {
 
 	// add the object tagged fields to the rest API version
 	apiserver_lib.AddObjectVersion(versionObj)
+
+	// register the object's REST path for request authorization
+	apiserver_lib.AddAuthzObject(
+		api_v0.PathWorkloadResourceInstances,
+		versionObj.Object,
+		new(api_v0.WorkloadResourceInstance),
+	)
 }
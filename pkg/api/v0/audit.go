@@ -0,0 +1,44 @@
+package v0
+
+import "gorm.io/datatypes"
+
+const (
+	PathAuditRecordsSearch = "/v0/audit-records-search"
+
+	// AuditRecordQueryParamStart and AuditRecordQueryParamEnd are the query
+	// parameters for the beginning and end of the time range for audit record
+	// searches.  They are formatted as RFC3339 timestamps.
+	AuditRecordQueryParamStart = "start"
+	AuditRecordQueryParamEnd   = "end"
+)
+
+// AuditRecord is a record of a request to the API that created, updated or
+// deleted an object.  Audit records are written by the API server and cannot
+// be changed by clients.
+type AuditRecord struct {
+	Common `swaggerignore:"true" mapstructure:",squash"`
+
+	// The identity of the client that made the request.  This is the common
+	// name of the client certificate used to authenticate to the API.  It is
+	// empty if authentication is not enabled.
+	Subject *string `json:"Subject,omitempty" query:"subject" validate:"optional"`
+
+	// The HTTP method of the request, e.g. POST.
+	Method *string `json:"Method,omitempty" query:"method" gorm:"not null" validate:"required"`
+
+	// The path the request was made to, e.g. /v0/workload-instances/5.
+	Route *string `json:"Route,omitempty" query:"route" gorm:"not null" validate:"required"`
+
+	// The type of object the request was made for, e.g. WorkloadInstance.
+	ObjectType *string `json:"ObjectType,omitempty" query:"objecttype" validate:"optional"`
+
+	// The unique ID of the object the request was made for.
+	ObjectID *uint `json:"ObjectID,omitempty" query:"objectid" validate:"optional"`
+
+	// The changes made to the object by the request.  Each changed field maps
+	// to an object with the Before and After values of the field.
+	Diff *datatypes.JSON `json:"Diff,omitempty" validate:"optional"`
+
+	// The HTTP status code of the response to the request.
+	ResponseCode *int `json:"ResponseCode,omitempty" query:"responsecode" validate:"optional"`
+}
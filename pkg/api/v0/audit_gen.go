@@ -0,0 +1,70 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package v0
+
+import (
+	"encoding/json"
+	"fmt"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+)
+
+const (
+	ObjectTypeAuditRecord string = "AuditRecord"
+
+	PathAuditRecordVersions = "/audit-records/versions"
+	PathAuditRecords        = "/v0/audit-records"
+)
+
+// NotificationPayload returns the notification payload that is delivered to the
+// controller when a change is made.  It includes the object as presented by the
+// client when the change was made.
+func (ar *AuditRecord) NotificationPayload(
+	operation notifications.NotificationOperation,
+	requeue bool,
+	creationTime int64,
+) (*[]byte, error) {
+	notif := notifications.Notification{
+		CreationTime:  &creationTime,
+		Object:        ar,
+		ObjectVersion: ar.GetVersion(),
+		Operation:     operation,
+	}
+
+	payload, err := json.Marshal(notif)
+	if err != nil {
+		return &payload, fmt.Errorf("failed to marshal notification payload %+v: %w", ar, err)
+	}
+
+	return &payload, nil
+}
+
+// DecodeNotifObject takes the threeport object in the form of a
+// map[string]interface and returns the typed object by marshalling into JSON
+// and then unmarshalling into the typed object.  We are not using the
+// mapstructure library here as that requires custom decode hooks to manage
+// fields with non-native go types.
+func (ar *AuditRecord) DecodeNotifObject(object interface{}) error {
+	jsonObject, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object map from consumed notification message: %w", err)
+	}
+	if err := json.Unmarshal(jsonObject, &ar); err != nil {
+		return fmt.Errorf("failed to unmarshal json object to typed object: %w", err)
+	}
+	return nil
+}
+
+// GetId returns the unique ID for the object.
+func (ar *AuditRecord) GetId() uint {
+	return *ar.ID
+}
+
+// Type returns the object type.
+func (ar *AuditRecord) GetType() string {
+	return "AuditRecord"
+}
+
+// Version returns the version of the API object.
+func (ar *AuditRecord) GetVersion() string {
+	return "v0"
+}
@@ -0,0 +1,102 @@
+package v0
+
+import (
+	"strings"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	AuthzVerbRead   = "read"
+	AuthzVerbCreate = "create"
+	AuthzVerbUpdate = "update"
+	AuthzVerbDelete = "delete"
+
+	// AuthzAll may be used in place of a verb or object type in a role rule
+	// to grant permission for all verbs or object types.
+	AuthzAll = "*"
+
+	// AuthzSystemSubjectPrefix prefixes the client certificate subject of
+	// each threeport control plane component, e.g.
+	// threeport-system:workload-controller.
+	AuthzSystemSubjectPrefix = "threeport-system:"
+
+	// AuthzAdminSubject is the client certificate subject of the admin client
+	// created when a control plane is installed.
+	AuthzAdminSubject = "threeport-admin"
+
+	// AuthzLegacySubject is the client certificate subject of the control
+	// plane components and admin client of control planes installed before
+	// each was issued a certificate with its own subject.  It is bound to the
+	// admin role so that they remain authorized until their certificates are
+	// re-issued.
+	AuthzLegacySubject = "localhost"
+
+	// AuthzAdminRoleName is the name of the role created when the API is
+	// installed that permits all operations.  It is bound to the threeport
+	// control plane components and the admin client.
+	AuthzAdminRoleName = "threeport-admin"
+)
+
+// AuthzSystemSubject returns the client certificate subject for a threeport
+// control plane component, e.g. threeport-system:agent.
+func AuthzSystemSubject(component string) string {
+	return AuthzSystemSubjectPrefix + component
+}
+
+// AuthzReservedSubject returns true if the subject is reserved for the
+// threeport control plane components and the admin client.  Client
+// certificates are not issued for reserved subjects and roles can only be
+// bound to them when the API is installed.
+func AuthzReservedSubject(subject string) bool {
+	return strings.HasPrefix(subject, AuthzSystemSubjectPrefix) ||
+		subject == AuthzAdminSubject ||
+		subject == AuthzLegacySubject
+}
+
+// Role is a set of permissions that may be granted to API clients with a role
+// binding.
+type Role struct {
+	Common `swaggerignore:"true" mapstructure:",squash"`
+
+	// The unique name of a role.  Names of deleted roles may be reused.
+	Name *string `json:"Name,omitempty" query:"name" gorm:"not null;uniqueIndex:,where:deleted_at IS NULL" validate:"required"`
+
+	// The rules that determine which verbs the role permits for which object
+	// types.
+	Rules *datatypes.JSONSlice[RoleRule] `json:"Rules,omitempty" gorm:"not null" validate:"required"`
+
+	// The highest tier criticality for which the role permits objects to be
+	// created, updated or deleted.  Objects associated with a tier of greater
+	// criticality may only be read.  If not set, writes are not restricted by
+	// tier.
+	MaxTierCriticality *int `json:"MaxTierCriticality,omitempty" query:"maxtiercriticality" validate:"optional"`
+}
+
+// RoleRule permits a set of verbs for a set of object types.
+type RoleRule struct {
+	// The verbs that are permitted: read, create, update, delete or '*'.
+	Verbs []string `json:"Verbs" yaml:"Verbs"`
+
+	// The object types the verbs are permitted for, e.g. WorkloadInstance, or
+	// '*' for all object types.
+	ObjectTypes []string `json:"ObjectTypes" yaml:"ObjectTypes"`
+}
+
+// RoleBinding grants the permissions of a role to an API client.
+type RoleBinding struct {
+	Common `swaggerignore:"true" mapstructure:",squash"`
+
+	// The unique name of a role binding.  Names of deleted role bindings may
+	// be reused.
+	Name *string `json:"Name,omitempty" query:"name" gorm:"not null;uniqueIndex:,where:deleted_at IS NULL" validate:"required"`
+
+	// The subject that is granted the role.  This is the common name of the
+	// client certificate used to authenticate to the API.  A subject ending
+	// in '*' grants the role to all subjects with the preceding prefix, e.g.
+	// threeport-system:* for all control plane components.
+	Subject *string `json:"Subject,omitempty" query:"subject" gorm:"not null" validate:"required"`
+
+	// The role granted to the subject.
+	RoleID *uint `json:"RoleID,omitempty" query:"roleid" gorm:"not null" validate:"required"`
+}
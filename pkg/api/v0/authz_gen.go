@@ -0,0 +1,127 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package v0
+
+import (
+	"encoding/json"
+	"fmt"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+)
+
+const (
+	ObjectTypeRole        string = "Role"
+	ObjectTypeRoleBinding string = "RoleBinding"
+
+	PathRoleVersions        = "/roles/versions"
+	PathRoles               = "/v0/roles"
+	PathRoleBindingVersions = "/role-bindings/versions"
+	PathRoleBindings        = "/v0/role-bindings"
+)
+
+// NotificationPayload returns the notification payload that is delivered to the
+// controller when a change is made.  It includes the object as presented by the
+// client when the change was made.
+func (r *Role) NotificationPayload(
+	operation notifications.NotificationOperation,
+	requeue bool,
+	creationTime int64,
+) (*[]byte, error) {
+	notif := notifications.Notification{
+		CreationTime:  &creationTime,
+		Object:        r,
+		ObjectVersion: r.GetVersion(),
+		Operation:     operation,
+	}
+
+	payload, err := json.Marshal(notif)
+	if err != nil {
+		return &payload, fmt.Errorf("failed to marshal notification payload %+v: %w", r, err)
+	}
+
+	return &payload, nil
+}
+
+// DecodeNotifObject takes the threeport object in the form of a
+// map[string]interface and returns the typed object by marshalling into JSON
+// and then unmarshalling into the typed object.  We are not using the
+// mapstructure library here as that requires custom decode hooks to manage
+// fields with non-native go types.
+func (r *Role) DecodeNotifObject(object interface{}) error {
+	jsonObject, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object map from consumed notification message: %w", err)
+	}
+	if err := json.Unmarshal(jsonObject, &r); err != nil {
+		return fmt.Errorf("failed to unmarshal json object to typed object: %w", err)
+	}
+	return nil
+}
+
+// GetId returns the unique ID for the object.
+func (r *Role) GetId() uint {
+	return *r.ID
+}
+
+// Type returns the object type.
+func (r *Role) GetType() string {
+	return "Role"
+}
+
+// Version returns the version of the API object.
+func (r *Role) GetVersion() string {
+	return "v0"
+}
+
+// NotificationPayload returns the notification payload that is delivered to the
+// controller when a change is made.  It includes the object as presented by the
+// client when the change was made.
+func (rb *RoleBinding) NotificationPayload(
+	operation notifications.NotificationOperation,
+	requeue bool,
+	creationTime int64,
+) (*[]byte, error) {
+	notif := notifications.Notification{
+		CreationTime:  &creationTime,
+		Object:        rb,
+		ObjectVersion: rb.GetVersion(),
+		Operation:     operation,
+	}
+
+	payload, err := json.Marshal(notif)
+	if err != nil {
+		return &payload, fmt.Errorf("failed to marshal notification payload %+v: %w", rb, err)
+	}
+
+	return &payload, nil
+}
+
+// DecodeNotifObject takes the threeport object in the form of a
+// map[string]interface and returns the typed object by marshalling into JSON
+// and then unmarshalling into the typed object.  We are not using the
+// mapstructure library here as that requires custom decode hooks to manage
+// fields with non-native go types.
+func (rb *RoleBinding) DecodeNotifObject(object interface{}) error {
+	jsonObject, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object map from consumed notification message: %w", err)
+	}
+	if err := json.Unmarshal(jsonObject, &rb); err != nil {
+		return fmt.Errorf("failed to unmarshal json object to typed object: %w", err)
+	}
+	return nil
+}
+
+// GetId returns the unique ID for the object.
+func (rb *RoleBinding) GetId() uint {
+	return *rb.ID
+}
+
+// Type returns the object type.
+func (rb *RoleBinding) GetType() string {
+	return "RoleBinding"
+}
+
+// Version returns the version of the API object.
+func (rb *RoleBinding) GetVersion() string {
+	return "v0"
+}
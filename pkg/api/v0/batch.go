@@ -0,0 +1,74 @@
+package v0
+
+import "encoding/json"
+
+const (
+	ObjectTypeBatch string = "Batch"
+
+	PathBatch = "/v0/batch"
+
+	BatchOperationCreate = "create"
+	BatchOperationUpdate = "update"
+	BatchOperationDelete = "delete"
+
+	// The maximum number of operations in a single batch.
+	MaxBatchOperations = 100
+)
+
+// Batch is an ordered list of operations that create, update and delete
+// objects of any type.  The operations are executed in a single database
+// transaction so that either all changes are made or none of them are.
+// Notifications to controllers are only sent once the transaction is
+// committed.
+type Batch struct {
+	Operations []BatchOperation `json:"Operations" validate:"required"`
+}
+
+// BatchOperation is a single change to an object in a batch.  Later
+// operations in the batch can reference the object returned by an earlier
+// operation with the earlier operation's Ref.  In the Object payload, any
+// string value of the form ${<ref>.<field>} is replaced with that field of the
+// referenced object, e.g. "WorkloadDefinitionID": "${definition.ID}".
+type BatchOperation struct {
+	// A name for the operation that later operations use to reference the
+	// object it returns, e.g. definition.
+	Ref string `json:"Ref,omitempty" validate:"optional"`
+
+	// The change to make: create, update or delete.
+	Operation string `json:"Operation" validate:"required"`
+
+	// The REST path for the object type, e.g. /v0/workload-definitions.
+	Path string `json:"Path" validate:"required"`
+
+	// The ID of the object to update or delete.
+	ObjectID *uint `json:"ObjectID,omitempty" validate:"optional"`
+
+	// The Ref of an earlier operation in the batch whose object is updated
+	// or deleted.  Used instead of ObjectID for objects created in the same
+	// batch.
+	ObjectRef string `json:"ObjectRef,omitempty" validate:"optional"`
+
+	// The object fields to create or update.  Not used for deletes.
+	Object json.RawMessage `json:"Object,omitempty" validate:"optional"`
+}
+
+// BatchResult is the outcome of a single operation in a batch.
+type BatchResult struct {
+	// The Ref of the operation, if provided.
+	Ref string `json:"Ref,omitempty"`
+
+	// The change that was made.
+	Operation string `json:"Operation"`
+
+	// The REST path the operation was executed against.
+	Path string `json:"Path"`
+
+	// The type of object that was changed.
+	ObjectType string `json:"ObjectType"`
+
+	// The HTTP status code returned for the operation.
+	StatusCode int `json:"StatusCode"`
+
+	// The object as it exists following the operation.
+	Object json.RawMessage `json:"Object,omitempty"`
+}
@@ -3,6 +3,7 @@ package v0
 import (
 	"time"
 
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -12,6 +13,35 @@ type Common struct {
 	CreatedAt *time.Time      `json:"CreatedAt,omitempty"`
 	UpdatedAt *time.Time      `json:"UpdatedAt,omitempty"`
 	DeletedAt *gorm.DeletedAt `json:"DeletedAt,omitempty" gorm:"index"`
+
+	// Incremented each time the object is changed.  It is returned in the ETag
+	// header and may be provided in the If-Match header when updating or
+	// deleting an object so that changes based on a stale copy are rejected.
+	ResourceVersion *uint64 `json:"ResourceVersion,omitempty" gorm:"not null;default:1"`
+
+	// Arbitrary key-value pairs used to organize objects.  Objects can be
+	// selected by their labels with the labelSelector query parameter, e.g.
+	// ?labelSelector=team=payments,tier!=dev
+	Labels *datatypes.JSONMap `json:"Labels,omitempty" validate:"optional"`
+
+	// Arbitrary key-value pairs used to store non-identifying information
+	// about objects.  Objects cannot be selected by their annotations.
+	Annotations *datatypes.JSONMap `json:"Annotations,omitempty" validate:"optional"`
+
+	// References to the objects that own this object.  An object is deleted
+	// when its owners are deleted unless the owners are deleted with the
+	// Orphan propagation policy.
+	OwnerReferences *datatypes.JSONSlice[OwnerReference] `json:"OwnerReferences,omitempty" validate:"optional"`
+}
+
+// GetResourceVersion returns the object's resource version or zero if it has
+// not been set.
+func (c *Common) GetResourceVersion() uint64 {
+	if c.ResourceVersion == nil {
+		return 0
+	}
+
+	return *c.ResourceVersion
 }
 
 // Reconciliation includes the fields for reconciled objects.  These are
@@ -49,4 +79,19 @@ type Reconciliation struct {
 	// situation where future reconciliation could be descructive such as
 	// spinning up more infrastructure when there is a unresolved problem.
 	InterruptReconciliation *bool `json:"InterruptReconciliation,omitempty" query:"interruptreconciliation" gorm:"default:false" validate:"optional"`
+
+	// Indicates the controller found that the resources managed for the object
+	// no longer match the object's desired state when the object was last
+	// resynced and the drift was not corrected.
+	Drifted *bool `json:"Drifted,omitempty" query:"drifted" gorm:"default:false" validate:"optional"`
+
+	// Observations of the object's state set by its controller, e.g. whether
+	// it has been reconciled and, if not, why.  Updates that only change
+	// conditions do not trigger reconciliation.
+	Conditions *datatypes.JSONSlice[Condition] `json:"Conditions,omitempty" validate:"optional"`
+
+	// Finalizers prevent a deleted object from being removed from the
+	// database until each has been removed, e.g. by a controller that must
+	// clean up before the object is gone.
+	Finalizers *datatypes.JSONSlice[string] `json:"Finalizers,omitempty" validate:"optional"`
 }
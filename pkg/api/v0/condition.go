@@ -0,0 +1,120 @@
+package v0
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	ConditionStatusTrue    ConditionStatus = "True"
+	ConditionStatusFalse   ConditionStatus = "False"
+	ConditionStatusUnknown ConditionStatus = "Unknown"
+)
+
+// The types of condition set by controllers on all reconciled objects.
+// Controllers may set additional condition types for the objects they
+// reconcile.
+const (
+	// Reconciled is true when the object's controller has brought the
+	// managed resources to the object's desired state.  When false, the
+	// reason and message explain why reconciliation has not completed.
+	ConditionTypeReconciled = "Reconciled"
+
+	// Drifted is true when the resources managed for the object no longer
+	// match its desired state and the drift was not corrected.
+	ConditionTypeDrifted = "Drifted"
+)
+
+// Condition is an observation of an aspect of a reconciled object's state.
+type Condition struct {
+	// The aspect of the object's state the condition describes, e.g.
+	// Reconciled.
+	Type string `json:"Type" yaml:"Type"`
+
+	// Whether the condition holds: True, False or Unknown.
+	Status ConditionStatus `json:"Status" yaml:"Status"`
+
+	// A short, CamelCase reason for the condition's last transition.
+	Reason string `json:"Reason,omitempty" yaml:"Reason,omitempty"`
+
+	// A human readable explanation of the condition.
+	Message string `json:"Message,omitempty" yaml:"Message,omitempty"`
+
+	// The resource version of the object that the controller was reconciling
+	// when the condition last changed.
+	ObservedGeneration uint64 `json:"ObservedGeneration,omitempty" yaml:"ObservedGeneration,omitempty"`
+
+	// The last time the condition's status changed.
+	LastTransitionTime time.Time `json:"LastTransitionTime" yaml:"LastTransitionTime"`
+}
+
+// GetConditions returns the conditions set on a reconciled object.
+func (r *Reconciliation) GetConditions() []Condition {
+	if r.Conditions == nil {
+		return nil
+	}
+
+	return *r.Conditions
+}
+
+// FindCondition returns the condition of the given type or nil if it has not
+// been set.
+func (r *Reconciliation) FindCondition(conditionType string) *Condition {
+	conditions := r.GetConditions()
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// SetCondition adds a condition or replaces the existing condition of the
+// same type.  The last transition time is only updated when the condition's
+// status changes and is set to the current time if not provided.  A condition
+// with the same status, reason and message as the existing one is not
+// changed so that repeated observations don't cause updates.  It returns true
+// if the conditions were changed.
+func (r *Reconciliation) SetCondition(condition Condition) bool {
+	conditions := r.GetConditions()
+	for i, existing := range conditions {
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		} else if condition.LastTransitionTime.IsZero() {
+			condition.LastTransitionTime = time.Now().UTC()
+		}
+		if existing.Status == condition.Status &&
+			existing.Reason == condition.Reason &&
+			existing.Message == condition.Message {
+			return false
+		}
+		updated := make([]Condition, len(conditions))
+		copy(updated, conditions)
+		updated[i] = condition
+		r.Conditions = conditionSlice(updated)
+		return true
+	}
+
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = time.Now().UTC()
+	}
+	updated := make([]Condition, len(conditions), len(conditions)+1)
+	copy(updated, conditions)
+	r.Conditions = conditionSlice(append(updated, condition))
+
+	return true
+}
+
+// conditionSlice returns conditions in the type stored in the database.
+func conditionSlice(conditions []Condition) *datatypes.JSONSlice[Condition] {
+	slice := datatypes.JSONSlice[Condition](conditions)
+	return &slice
+}
@@ -0,0 +1,128 @@
+package v0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSetCondition tests that conditions are added and replaced by type and
+// that the last transition time only changes with the condition's status.
+func TestSetCondition(t *testing.T) {
+	transitioned := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	reconciled := Condition{
+		Type:               ConditionTypeReconciled,
+		Status:             ConditionStatusTrue,
+		Reason:             "Created",
+		LastTransitionTime: transitioned,
+	}
+	drifted := Condition{
+		Type:               ConditionTypeDrifted,
+		Status:             ConditionStatusFalse,
+		Reason:             "NoDrift",
+		LastTransitionTime: transitioned,
+	}
+
+	testCases := []struct {
+		name       string
+		existing   []Condition
+		condition  Condition
+		changed    bool
+		conditions []Condition
+		// true if the changed condition should have a new transition time
+		transition bool
+	}{
+		{
+			name:       "add to no conditions",
+			condition:  reconciled,
+			changed:    true,
+			conditions: []Condition{reconciled},
+		},
+		{
+			name:       "add another type",
+			existing:   []Condition{reconciled},
+			condition:  drifted,
+			changed:    true,
+			conditions: []Condition{reconciled, drifted},
+		},
+		{
+			name:     "same observation",
+			existing: []Condition{reconciled},
+			condition: Condition{
+				Type:               ConditionTypeReconciled,
+				Status:             ConditionStatusTrue,
+				Reason:             "Created",
+				ObservedGeneration: 4,
+			},
+			changed:    false,
+			conditions: []Condition{reconciled},
+		},
+		{
+			name:     "same status with new reason keeps transition time",
+			existing: []Condition{reconciled, drifted},
+			condition: Condition{
+				Type:   ConditionTypeReconciled,
+				Status: ConditionStatusTrue,
+				Reason: "Updated",
+			},
+			changed: true,
+			conditions: []Condition{
+				{
+					Type:               ConditionTypeReconciled,
+					Status:             ConditionStatusTrue,
+					Reason:             "Updated",
+					LastTransitionTime: transitioned,
+				},
+				drifted,
+			},
+		},
+		{
+			name:     "status change sets transition time",
+			existing: []Condition{reconciled},
+			condition: Condition{
+				Type:    ConditionTypeReconciled,
+				Status:  ConditionStatusFalse,
+				Reason:  "Failed",
+				Message: "failed to reconcile",
+			},
+			changed: true,
+			conditions: []Condition{
+				{
+					Type:    ConditionTypeReconciled,
+					Status:  ConditionStatusFalse,
+					Reason:  "Failed",
+					Message: "failed to reconcile",
+				},
+			},
+			transition: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reconciliation := Reconciliation{}
+			if tc.existing != nil {
+				reconciliation.Conditions = conditionSlice(append([]Condition{}, tc.existing...))
+			}
+			existing := reconciliation.GetConditions()
+
+			before := time.Now().UTC()
+			changed := reconciliation.SetCondition(tc.condition)
+			assert.Equal(t, tc.changed, changed)
+
+			conditions := reconciliation.GetConditions()
+			require.Len(t, conditions, len(tc.conditions))
+			if tc.transition {
+				changedCondition := reconciliation.FindCondition(tc.condition.Type)
+				assert.False(t, changedCondition.LastTransitionTime.Before(before))
+				changedCondition.LastTransitionTime = time.Time{}
+			}
+			assert.Equal(t, tc.conditions, conditions)
+
+			// the existing conditions are never modified in place
+			assert.Equal(t, tc.existing, []Condition(existing))
+		})
+	}
+}
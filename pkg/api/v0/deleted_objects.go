@@ -0,0 +1,21 @@
+package v0
+
+const (
+	ObjectTypeDeletedObject string = "DeletedObject"
+
+	// PathDeletedObjects is the REST path for soft-deleted objects.  Deleted
+	// objects of a type are listed at /v0/deleted-objects/<object type> and a
+	// deleted object is restored with a POST to
+	// /v0/deleted-objects/<object type>/<id>/restore.
+	PathDeletedObjects = "/v0/deleted-objects"
+)
+
+// DeletedObject includes the fields that soft-deleted objects of any type
+// have in common.  The DeletedAt field is set for objects that have been
+// deleted.
+type DeletedObject struct {
+	Common `mapstructure:",squash"`
+
+	// The name of the object if the object type has names.
+	Name *string `json:"Name,omitempty"`
+}
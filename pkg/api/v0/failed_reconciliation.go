@@ -0,0 +1,54 @@
+package v0
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	ObjectTypeFailedReconciliation string = "FailedReconciliation"
+
+	// PathFailedReconciliations is the REST path for failed reconciliations.
+	// A failed reconciliation is retried with a POST to
+	// /v0/failed-reconciliations/<id>/retry.
+	PathFailedReconciliations = "/v0/failed-reconciliations"
+)
+
+// FailedReconciliation is a notification that a reconciler gave up on after
+// exhausting the attempts allowed by its retry policy.  Failed
+// reconciliations are kept in the NATS dead-letter stream until they are
+// retried or expire.
+type FailedReconciliation struct {
+	// The sequence number of the failed reconciliation in the dead-letter
+	// stream.
+	ID *uint64 `json:"ID,omitempty"`
+
+	// The name of the reconciler that failed to reconcile the object.
+	ReconcilerName *string `json:"ReconcilerName,omitempty"`
+
+	// The NATS subject the notification was delivered on.  The notification
+	// is republished to this subject when retried.
+	Subject *string `json:"Subject,omitempty"`
+
+	// The type of the object that failed to reconcile.
+	ObjectType *string `json:"ObjectType,omitempty"`
+
+	// The version of the object that failed to reconcile.
+	ObjectVersion *string `json:"ObjectVersion,omitempty"`
+
+	// The unique ID of the object that failed to reconcile.
+	ObjectID *uint `json:"ObjectID,omitempty"`
+
+	// The number of failed attempts to reconcile the object.
+	Attempts *int `json:"Attempts,omitempty"`
+
+	// The error returned by the last attempt to reconcile the object.
+	LastError *string `json:"LastError,omitempty"`
+
+	// The time the reconciler gave up on the notification.
+	FailedAt *time.Time `json:"FailedAt,omitempty"`
+
+	// The notification that failed to reconcile.
+	Notification *datatypes.JSON `json:"Notification,omitempty"`
+}
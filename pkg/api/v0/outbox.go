@@ -0,0 +1,55 @@
+package v0
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	ObjectTypeOutboxNotification string = "OutboxNotification"
+
+	PathOutboxNotificationsUndelivered = "/v0/outbox-notifications-undelivered"
+)
+
+// OutboxNotification is a notification to a controller that is written in the
+// same database transaction as the change to the object it concerns.  The API
+// server publishes outbox notifications to NATS and retries until they are
+// delivered so that changes to objects are not lost if NATS is unavailable.
+type OutboxNotification struct {
+	Common `swaggerignore:"true" mapstructure:",squash"`
+
+	// The NATS subject the notification is published to.
+	Subject *string `json:"Subject,omitempty" gorm:"not null"`
+
+	// The notification sent to the controller.
+	Payload *datatypes.JSON `json:"Payload,omitempty" gorm:"not null"`
+
+	// The trace context of the request that made the change.  It is sent in
+	// the notification's message headers so the controller continues the
+	// trace.
+	TraceContext *datatypes.JSON `json:"TraceContext,omitempty"`
+
+	// True once the notification has been published.
+	Delivered *bool `json:"Delivered,omitempty" gorm:"not null;default:false;index:idx_v0_outbox_notifications_due,priority:1"`
+
+	// The time the notification was published.
+	DeliveredAt *time.Time `json:"DeliveredAt,omitempty"`
+
+	// The number of times publishing the notification has been attempted.
+	Attempts *int `json:"Attempts,omitempty" gorm:"not null;default:0"`
+
+	// The error returned by the most recent failed attempt to publish the
+	// notification.
+	LastError *string `json:"LastError,omitempty"`
+
+	// The earliest time the next attempt to publish the notification will be
+	// made.
+	NextAttemptAt *time.Time `json:"NextAttemptAt,omitempty" gorm:"index:idx_v0_outbox_notifications_due,priority:2"`
+}
+
+// TableName sets the name of the table for the OutboxNotification objects in
+// the database.
+func (OutboxNotification) TableName() string {
+	return "v0_outbox_notifications"
+}
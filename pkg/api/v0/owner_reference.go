@@ -0,0 +1,154 @@
+package v0
+
+import (
+	"gorm.io/datatypes"
+)
+
+// DeletionPropagation determines what happens to the objects owned by an
+// object when it is deleted.
+type DeletionPropagation string
+
+const (
+	// Foreground deletes an object's dependents first.  The object is
+	// scheduled for deletion but its controller is not notified until all of
+	// its dependents have been deleted.
+	DeletionPropagationForeground DeletionPropagation = "Foreground"
+
+	// Background schedules an object's dependents for deletion at the same
+	// time as the object.  This is the default.
+	DeletionPropagationBackground DeletionPropagation = "Background"
+
+	// Orphan removes the owner reference from an object's dependents so they
+	// are not deleted with the object.
+	DeletionPropagationOrphan DeletionPropagation = "Orphan"
+)
+
+// FinalizerForegroundDeletion is added to an object that is being deleted in
+// the foreground.  It is removed once the object's dependents have been
+// deleted.
+const FinalizerForegroundDeletion = "threeport.io/foreground-deletion"
+
+// OwnerReference identifies the object that owns another object.  An object
+// with owner references is deleted when all of its owners have been deleted.
+type OwnerReference struct {
+	// The type of the owner, e.g. MetricsInstance.
+	ObjectType string `json:"ObjectType" yaml:"ObjectType"`
+
+	// The version of the owner's API object.
+	Version string `json:"Version,omitempty" yaml:"Version,omitempty"`
+
+	// The unique ID of the owner.
+	ID uint `json:"ID" yaml:"ID"`
+}
+
+// GetOwnerReferences returns the references to an object's owners.
+func (c *Common) GetOwnerReferences() []OwnerReference {
+	if c.OwnerReferences == nil {
+		return nil
+	}
+
+	return *c.OwnerReferences
+}
+
+// HasOwnerReference returns true if the object is owned by the object with
+// the given type and ID.
+func (c *Common) HasOwnerReference(objectType string, id uint) bool {
+	for _, ownerReference := range c.GetOwnerReferences() {
+		if ownerReference.ObjectType == objectType && ownerReference.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddOwnerReference adds a reference to an owner of the object.  It returns
+// true if the owner references were changed.
+func (c *Common) AddOwnerReference(ownerReference OwnerReference) bool {
+	if c.HasOwnerReference(ownerReference.ObjectType, ownerReference.ID) {
+		return false
+	}
+
+	ownerReferences := append(
+		append([]OwnerReference{}, c.GetOwnerReferences()...),
+		ownerReference,
+	)
+	slice := datatypes.JSONSlice[OwnerReference](ownerReferences)
+	c.OwnerReferences = &slice
+
+	return true
+}
+
+// RemoveOwnerReference removes the reference to the owner with the given
+// type and ID.  It returns true if the owner references were changed.
+func (c *Common) RemoveOwnerReference(objectType string, id uint) bool {
+	if !c.HasOwnerReference(objectType, id) {
+		return false
+	}
+
+	ownerReferences := []OwnerReference{}
+	for _, ownerReference := range c.GetOwnerReferences() {
+		if ownerReference.ObjectType == objectType && ownerReference.ID == id {
+			continue
+		}
+		ownerReferences = append(ownerReferences, ownerReference)
+	}
+	slice := datatypes.JSONSlice[OwnerReference](ownerReferences)
+	c.OwnerReferences = &slice
+
+	return true
+}
+
+// GetFinalizers returns the finalizers that must be removed before a deleted
+// object is removed from the database.
+func (r *Reconciliation) GetFinalizers() []string {
+	if r.Finalizers == nil {
+		return nil
+	}
+
+	return *r.Finalizers
+}
+
+// HasFinalizer returns true if the object has the given finalizer.
+func (r *Reconciliation) HasFinalizer(finalizer string) bool {
+	for _, existing := range r.GetFinalizers() {
+		if existing == finalizer {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddFinalizer adds a finalizer to the object.  It returns true if the
+// finalizers were changed.
+func (r *Reconciliation) AddFinalizer(finalizer string) bool {
+	if r.HasFinalizer(finalizer) {
+		return false
+	}
+
+	finalizers := append(append([]string{}, r.GetFinalizers()...), finalizer)
+	slice := datatypes.JSONSlice[string](finalizers)
+	r.Finalizers = &slice
+
+	return true
+}
+
+// RemoveFinalizer removes a finalizer from the object.  It returns true if
+// the finalizers were changed.
+func (r *Reconciliation) RemoveFinalizer(finalizer string) bool {
+	if !r.HasFinalizer(finalizer) {
+		return false
+	}
+
+	finalizers := []string{}
+	for _, existing := range r.GetFinalizers() {
+		if existing != finalizer {
+			finalizers = append(finalizers, existing)
+		}
+	}
+	slice := datatypes.JSONSlice[string](finalizers)
+	r.Finalizers = &slice
+
+	return true
+}
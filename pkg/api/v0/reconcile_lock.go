@@ -0,0 +1,54 @@
+package v0
+
+import "time"
+
+const (
+	ObjectTypeReconcileLock string = "ReconcileLock"
+
+	// PathReconcileLocks is the REST path for reconcile locks.  A lock is
+	// forcibly released with a DELETE to
+	// /v0/reconcile-locks/<reconciler name>/<object id>.
+	PathReconcileLocks = "/v0/reconcile-locks"
+
+	// ReconcileLockBucketSuffix is the suffix of the names of the NATS
+	// key-value buckets that controllers keep reconcile locks in.
+	ReconcileLockBucketSuffix = "Lock"
+
+	// ReconcileLockLeaseDuration is how long a reconcile lock is held without
+	// being renewed.  Controllers renew the locks they hold while reconciling
+	// and a lock that has not been renewed within this duration is stale and
+	// may be taken over by another controller.
+	ReconcileLockLeaseDuration = time.Minute
+)
+
+// ReconcileLock is a lock held by a controller on an object while the object
+// is reconciled so that no other controller reconciles it at the same time.
+type ReconcileLock struct {
+	// The NATS key-value bucket the lock is kept in.
+	Bucket *string `json:"Bucket,omitempty"`
+
+	// The key of the lock in the bucket.
+	Key *string `json:"Key,omitempty"`
+
+	// The name of the reconciler that holds the lock.
+	ReconcilerName *string `json:"ReconcilerName,omitempty"`
+
+	// The unique ID of the locked object.
+	ObjectID *uint `json:"ObjectID,omitempty"`
+
+	// The unique ID of the controller instance that holds the lock.
+	ControllerID *string `json:"ControllerID,omitempty"`
+
+	// The time the lock was acquired.
+	AcquiredAt *time.Time `json:"AcquiredAt,omitempty"`
+
+	// The time the lock's lease was last renewed.
+	RenewedAt *time.Time `json:"RenewedAt,omitempty"`
+}
+
+// Stale returns true if the lock's lease has not been renewed within the
+// lease duration.  Locks that do not record when they were renewed are never
+// stale and are only released when they expire from the bucket.
+func (l *ReconcileLock) Stale() bool {
+	return l.RenewedAt != nil && time.Since(*l.RenewedAt) > ReconcileLockLeaseDuration
+}
@@ -12,6 +12,11 @@ func (AttachedObjectReference) TableName() string {
 	return "v0_attached_object_references"
 }
 
+// TableName sets the name of the table for the AuditRecord objects in the database.
+func (AuditRecord) TableName() string {
+	return "v0_audit_records"
+}
+
 // TableName sets the name of the table for the AwsAccount objects in the database.
 func (AwsAccount) TableName() string {
 	return "v0_aws_accounts"
@@ -197,6 +202,16 @@ func (Profile) TableName() string {
 	return "v0_profiles"
 }
 
+// TableName sets the name of the table for the Role objects in the database.
+func (Role) TableName() string {
+	return "v0_roles"
+}
+
+// TableName sets the name of the table for the RoleBinding objects in the database.
+func (RoleBinding) TableName() string {
+	return "v0_role_bindings"
+}
+
 // TableName sets the name of the table for the SecretDefinition objects in the database.
 func (SecretDefinition) TableName() string {
 	return "v0_secret_definitions"
@@ -184,3 +184,27 @@ func GetPEMEncoding(cert []byte, encodingType string) (pemEncodingString string)
 
 	return pemEncoding.String()
 }
+
+// LoadCA parses a PEM encoded CA certificate and private key so they may be
+// used to generate certificates.
+func LoadCA(caPem []byte, caPrivateKeyPem []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	caBlock, _ := pem.Decode(caPem)
+	if caBlock == nil || caBlock.Type != "CERTIFICATE" {
+		return nil, nil, fmt.Errorf("failed to decode CA certificate PEM")
+	}
+	caConfig, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	keyBlock, _ := pem.Decode(caPrivateKeyPem)
+	if keyBlock == nil || keyBlock.Type != "RSA PRIVATE KEY" {
+		return nil, nil, fmt.Errorf("failed to decode CA private key PEM")
+	}
+	caPrivateKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA private key: %w", err)
+	}
+
+	return caConfig, caPrivateKey, nil
+}
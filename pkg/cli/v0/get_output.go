@@ -0,0 +1,379 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ghodss_yaml "github.com/ghodss/yaml"
+	"github.com/iancoleman/strcase"
+	"k8s.io/client-go/util/jsonpath"
+
+	encryption "github.com/threeport/threeport/pkg/encryption/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+const (
+	// GetOutputUsage is the help text for the output flag on `tptctl get`
+	// commands.
+	GetOutputUsage = "Optional. Output format. One of: json|yaml|wide|name|jsonpath=...|custom-columns=..."
+
+	// jsonpathOutputPrefix is the prefix for jsonpath output formats, e.g.
+	// jsonpath={.items[*].Name}
+	jsonpathOutputPrefix = "jsonpath="
+
+	// customColumnsOutputPrefix is the prefix for custom column output
+	// formats, e.g. custom-columns=NAME:.Name,ID:.ID
+	customColumnsOutputPrefix = "custom-columns="
+
+	// maxWideColumnWidth is the maximum number of characters displayed for a
+	// string field in wide output.
+	maxWideColumnWidth = 40
+)
+
+// customColumn is a single column in custom-columns output.
+type customColumn struct {
+	Header string
+	Path   *jsonpath.JSONPath
+}
+
+// ValidateGetOutput returns an error if the output format for a `tptctl get`
+// command is not supported.  An empty output format is valid and indicates
+// the default tabular output for the object.
+func ValidateGetOutput(output string) error {
+	switch {
+	case output == "", output == "json", output == "yaml", output == "wide", output == "name":
+		return nil
+	case strings.HasPrefix(output, jsonpathOutputPrefix):
+		_, err := parseJsonpath("output", strings.TrimPrefix(output, jsonpathOutputPrefix))
+		return err
+	case strings.HasPrefix(output, customColumnsOutputPrefix):
+		_, err := parseCustomColumns(strings.TrimPrefix(output, customColumnsOutputPrefix))
+		return err
+	}
+
+	return fmt.Errorf("unsupported output format %q - must be one of: json|yaml|wide|name|jsonpath=...|custom-columns=...", output)
+}
+
+// OutputGetObjects writes API objects to stdout in the requested output
+// format.  The objectLists are slices of API objects that are combined in the
+// output and the kind is the object kind used for name output, e.g.
+// workload-instance.
+func OutputGetObjects(output, kind string, objectLists ...interface{}) error {
+	if err := ValidateGetOutput(output); err != nil {
+		return err
+	}
+
+	objects, objectType, err := flattenObjectLists(objectLists)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case output == "json":
+		objectsJson, err := json.MarshalIndent(objects, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s objects into JSON: %w", kind, err)
+		}
+		fmt.Println(string(objectsJson))
+	case output == "yaml":
+		// marshal to JSON then convert to YAML - this results in field
+		// names with correct capitalization vs marshalling directly to YAML
+		objectsJson, err := json.Marshal(objects)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s objects into JSON: %w", kind, err)
+		}
+		objectsYaml, err := ghodss_yaml.JSONToYAML(objectsJson)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s objects JSON to YAML: %w", kind, err)
+		}
+		fmt.Print(string(objectsYaml))
+	case output == "name":
+		for _, object := range objects {
+			fmt.Printf("%s/%s\n", kind, stringFieldValue(reflect.ValueOf(object).Elem(), "Name"))
+		}
+	case output == "wide":
+		return outputWide(objects, objectType)
+	case strings.HasPrefix(output, jsonpathOutputPrefix):
+		return outputJsonpath(objects, strings.TrimPrefix(output, jsonpathOutputPrefix))
+	case strings.HasPrefix(output, customColumnsOutputPrefix):
+		return outputCustomColumns(objects, strings.TrimPrefix(output, customColumnsOutputPrefix))
+	}
+
+	return nil
+}
+
+// flattenObjectLists combines slices of API objects into a single list of
+// pointers to those objects with encrypted values redacted.  It also returns
+// the struct type of the objects.
+func flattenObjectLists(objectLists []interface{}) ([]interface{}, reflect.Type, error) {
+	objects := []interface{}{}
+	var objectType reflect.Type
+	for _, objectList := range objectLists {
+		listVal := reflect.ValueOf(objectList)
+		if listVal.Kind() == reflect.Ptr {
+			listVal = listVal.Elem()
+		}
+		if listVal.Kind() != reflect.Slice {
+			return nil, nil, fmt.Errorf("expected slice of objects, got %s", listVal.Kind())
+		}
+
+		elemType := listVal.Type().Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if objectType == nil {
+			objectType = elemType
+		}
+
+		for i := 0; i < listVal.Len(); i++ {
+			objectVal := listVal.Index(i)
+			if objectVal.Kind() != reflect.Ptr {
+				objectVal = objectVal.Addr()
+			}
+			if objectVal.IsNil() {
+				continue
+			}
+			objects = append(objects, encryption.RedactEncryptedValues(objectVal.Interface()))
+		}
+	}
+
+	if objectType == nil || objectType.Kind() != reflect.Struct {
+		return nil, nil, errors.New("no object type could be determined for output")
+	}
+
+	return objects, objectType, nil
+}
+
+// outputWide writes a table with the name of each object along with the value
+// of each of its scalar fields.
+func outputWide(objects []interface{}, objectType reflect.Type) error {
+	// fields on embedded structs may be shadowed by the object's own fields
+	// so each field name is only included once
+	var fieldNames []string
+	for _, fieldName := range wideFieldNames(objectType) {
+		if !util.StringSliceContains(fieldNames, fieldName, true) {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+	_, hasCreatedAt := objectType.FieldByName("CreatedAt")
+
+	headers := []string{"NAME"}
+	for _, fieldName := range fieldNames {
+		headers = append(headers, strcase.ToScreamingDelimited(fieldName, ' ', "", true))
+	}
+	if hasCreatedAt {
+		headers = append(headers, "AGE")
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(headers, "\t "))
+	for _, object := range objects {
+		objectVal := reflect.ValueOf(object).Elem()
+		values := []string{stringFieldValue(objectVal, "Name")}
+		for _, fieldName := range fieldNames {
+			values = append(values, stringFieldValue(objectVal, fieldName))
+		}
+		if hasCreatedAt {
+			age := "<none>"
+			if createdAt, ok := objectVal.FieldByName("CreatedAt").Interface().(*time.Time); ok && createdAt != nil {
+				age = util.GetAge(createdAt).String()
+			}
+			values = append(values, age)
+		}
+		fmt.Fprintln(writer, strings.Join(values, "\t "))
+	}
+	writer.Flush()
+
+	return nil
+}
+
+// wideFieldNames returns the names of the scalar fields on an object type,
+// including those on embedded structs, excluding the Name field and any
+// encrypted fields.
+func wideFieldNames(objectType reflect.Type) []string {
+	var fieldNames []string
+	for i := 0; i < objectType.NumField(); i++ {
+		field := objectType.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			fieldNames = append(fieldNames, wideFieldNames(field.Type)...)
+			continue
+		}
+		if !field.IsExported() || field.Name == "Name" || field.Tag.Get("encrypt") == "true" {
+			continue
+		}
+		if field.Type.Kind() != reflect.Ptr {
+			continue
+		}
+		switch field.Type.Elem().Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			fieldNames = append(fieldNames, field.Name)
+		case reflect.Struct:
+			if field.Type.Elem() == reflect.TypeOf(time.Time{}) {
+				fieldNames = append(fieldNames, field.Name)
+			}
+		}
+	}
+
+	return fieldNames
+}
+
+// stringFieldValue returns the value of a pointer field on an object as a
+// single-line string suitable for tabular output.
+func stringFieldValue(objectVal reflect.Value, fieldName string) string {
+	fieldVal := objectVal.FieldByName(fieldName)
+	if !util.IsNonNilPtr(fieldVal) {
+		return "<none>"
+	}
+
+	var value string
+	switch v := fieldVal.Interface().(type) {
+	case *time.Time:
+		value = v.Format(time.RFC3339)
+	default:
+		value = fmt.Sprint(fieldVal.Elem().Interface())
+	}
+
+	value = strings.Join(strings.Fields(value), " ")
+	if len(value) > maxWideColumnWidth {
+		value = value[:maxWideColumnWidth-3] + "..."
+	}
+
+	return value
+}
+
+// outputJsonpath writes the result of a jsonpath template evaluated against
+// the list of objects.  The objects are available to the template as items,
+// e.g. {.items[*].Name}
+func outputJsonpath(objects []interface{}, template string) error {
+	jp, err := parseJsonpath("output", template)
+	if err != nil {
+		return err
+	}
+
+	data, err := objectsToUnstructured(objects)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, map[string]interface{}{"items": data}); err != nil {
+		return fmt.Errorf("failed to execute jsonpath template %s: %w", template, err)
+	}
+	fmt.Println(buf.String())
+
+	return nil
+}
+
+// outputCustomColumns writes a table with the user-defined columns where each
+// column value is the result of a jsonpath expression evaluated against each
+// object.
+func outputCustomColumns(objects []interface{}, spec string) error {
+	columns, err := parseCustomColumns(spec)
+	if err != nil {
+		return err
+	}
+
+	data, err := objectsToUnstructured(objects)
+	if err != nil {
+		return err
+	}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.Header
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 4, 4, 4, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(headers, "\t "))
+	for _, object := range data {
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			results, err := column.Path.FindResults(object)
+			if err != nil {
+				return fmt.Errorf("failed to evaluate column %s: %w", column.Header, err)
+			}
+			var columnValues []string
+			for _, result := range results {
+				for _, value := range result {
+					columnValues = append(columnValues, fmt.Sprint(value.Interface()))
+				}
+			}
+			if len(columnValues) == 0 {
+				values[i] = "<none>"
+			} else {
+				values[i] = strings.Join(columnValues, ",")
+			}
+		}
+		fmt.Fprintln(writer, strings.Join(values, "\t "))
+	}
+	writer.Flush()
+
+	return nil
+}
+
+// parseCustomColumns parses a custom columns spec, e.g.
+// NAME:.Name,RUNTIME:.KubernetesRuntimeInstanceID
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	if spec == "" {
+		return nil, errors.New("custom-columns format requires at least one column, e.g. custom-columns=NAME:.Name")
+	}
+
+	var columns []customColumn
+	for _, columnSpec := range strings.Split(spec, ",") {
+		header, path, found := strings.Cut(columnSpec, ":")
+		if !found || header == "" || path == "" {
+			return nil, fmt.Errorf("invalid custom column %q - must be in the form HEADER:.Field", columnSpec)
+		}
+		jp, err := parseJsonpath(header, path)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, customColumn{Header: header, Path: jp})
+	}
+
+	return columns, nil
+}
+
+// parseJsonpath parses a jsonpath template.  A template that is not wrapped
+// in braces is treated as a single expression, e.g. .Name becomes {.Name}.
+func parseJsonpath(name, template string) (*jsonpath.JSONPath, error) {
+	if template == "" {
+		return nil, errors.New("jsonpath template must not be empty")
+	}
+	if !strings.HasPrefix(template, "{") {
+		template = fmt.Sprintf("{%s}", template)
+	}
+
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(template); err != nil {
+		return nil, fmt.Errorf("failed to parse jsonpath template %s: %w", template, err)
+	}
+
+	return jp, nil
+}
+
+// objectsToUnstructured converts API objects into generic JSON values so that
+// jsonpath expressions can reference fields by their JSON names.
+func objectsToUnstructured(objects []interface{}) ([]interface{}, error) {
+	objectsJson, err := json.Marshal(objects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal objects into JSON: %w", err)
+	}
+
+	var data []interface{}
+	if err := json.Unmarshal(objectsJson, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal objects JSON: %w", err)
+	}
+
+	return data, nil
+}
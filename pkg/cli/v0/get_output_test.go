@@ -0,0 +1,206 @@
+package v0
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// newGetOutputTestAPI starts a server that returns workload instances in
+// pages of the requested size and returns its address along with a pointer
+// to the number of pages requested.
+func newGetOutputTestAPI(t *testing.T, count int) (string, *int) {
+	var workloadInstances []v0.WorkloadInstance
+	for i := 1; i <= count; i++ {
+		workloadInstances = append(workloadInstances, v0.WorkloadInstance{
+			Common:   v0.Common{ID: util.Ptr(uint(i))},
+			Instance: v0.Instance{Name: util.Ptr(fmt.Sprintf("web-%d", i))},
+		})
+	}
+
+	requests := 0
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		size, err := strconv.Atoi(r.URL.Query().Get(apiserver_lib.QueryParamSize))
+		require.NoError(t, err)
+		page, err := strconv.Atoi(r.URL.Query().Get(apiserver_lib.QueryParamPage))
+		require.NoError(t, err)
+		start := (page - 1) * size
+		if token := r.URL.Query().Get(apiserver_lib.QueryParamContinue); token != "" {
+			start, err = strconv.Atoi(token)
+			require.NoError(t, err)
+		}
+		end := start + size
+		if end > len(workloadInstances) {
+			end = len(workloadInstances)
+		}
+
+		response := apiserver_lib.Response{
+			Meta: apiserver_lib.Meta{PageRequestParams: apiserver_lib.PageRequestParams{Size: size}},
+			Data: []apiserver_lib.Object{},
+		}
+		// like the API, a continue token is returned with every full page
+		if end-start == size {
+			response.Meta.Continue = strconv.Itoa(end)
+		}
+		for i := start; i < end; i++ {
+			response.Data = append(response.Data, workloadInstances[i])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(apiServer.Close)
+
+	return strings.TrimPrefix(apiServer.URL, "http://"), &requests
+}
+
+// captureStdout returns what is written to stdout while running the
+// function.
+func captureStdout(t *testing.T, run func() error) string {
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	runErr := run()
+	os.Stdout = stdout
+	require.NoError(t, writer.Close())
+	require.NoError(t, runErr)
+
+	output, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return string(output)
+}
+
+// TestOutputGetObjectsPaginated tests that every page of objects fetched for
+// a get command is included in each output format.
+func TestOutputGetObjectsPaginated(t *testing.T) {
+	testCases := []struct {
+		name   string
+		count  int
+		output string
+		// the pages requested and the lines of output expected
+		pages int
+		lines []string
+	}{
+		{
+			name:   "name output",
+			count:  5,
+			output: "name",
+			pages:  3,
+			lines: []string{
+				"workload-instance/web-1",
+				"workload-instance/web-2",
+				"workload-instance/web-3",
+				"workload-instance/web-4",
+				"workload-instance/web-5",
+			},
+		},
+		{
+			name:   "final page full",
+			count:  4,
+			output: "name",
+			pages:  3,
+			lines: []string{
+				"workload-instance/web-1",
+				"workload-instance/web-2",
+				"workload-instance/web-3",
+				"workload-instance/web-4",
+			},
+		},
+		{
+			name:   "jsonpath output",
+			count:  3,
+			output: "jsonpath={.items[*].Name}",
+			pages:  2,
+			lines:  []string{"web-1 web-2 web-3"},
+		},
+		{
+			name:   "custom columns output",
+			count:  3,
+			output: "custom-columns=NAME:.Name,ID:.ID",
+			pages:  2,
+			lines: []string{
+				"NAME      ID",
+				"web-1     1",
+				"web-2     2",
+				"web-3     3",
+			},
+		},
+		{
+			name:   "no objects",
+			count:  0,
+			output: "name",
+			pages:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiEndpoint, requests := newGetOutputTestAPI(t, tc.count)
+
+			workloadInstances, err := client.GetWorkloadInstancesWithOptions(
+				http.DefaultClient,
+				apiEndpoint,
+				client_lib.WithPageSize(2),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.pages, *requests)
+
+			output := captureStdout(t, func() error {
+				return OutputGetObjects(tc.output, "workload-instance", workloadInstances)
+			})
+
+			var lines []string
+			for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					lines = append(lines, line)
+				}
+			}
+			assert.Equal(t, tc.lines, lines)
+		})
+	}
+}
+
+// TestOutputGetObjectsJson tests that objects from every list are combined
+// into a single JSON array.
+func TestOutputGetObjectsJson(t *testing.T) {
+	apiEndpoint, _ := newGetOutputTestAPI(t, 3)
+	workloadInstances, err := client.GetWorkloadInstancesWithOptions(
+		http.DefaultClient,
+		apiEndpoint,
+		client_lib.WithPageSize(2),
+	)
+	require.NoError(t, err)
+	otherWorkloadInstances := []v0.WorkloadInstance{
+		{Instance: v0.Instance{Name: util.Ptr("api")}},
+	}
+
+	output := captureStdout(t, func() error {
+		return OutputGetObjects("json", "workload-instance", workloadInstances, &otherWorkloadInstances)
+	})
+
+	var objects []v0.WorkloadInstance
+	require.NoError(t, json.Unmarshal([]byte(output), &objects))
+	var names []string
+	for _, object := range objects {
+		names = append(names, *object.Name)
+	}
+	assert.Equal(t, []string{"web-1", "web-2", "web-3", "api"}, names)
+}
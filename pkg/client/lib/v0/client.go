@@ -32,7 +32,7 @@ func GetHTTPClient(
 	if !authEnabled {
 		return &http.Client{
 			Transport: &CustomTransport{
-				CustomRoundTripper: Chain(nil),
+				CustomRoundTripper: Chain(nil, Trace()),
 				IsTlsEnabled:       false,
 			},
 		}, nil
@@ -105,13 +105,14 @@ func GetHTTPClient(
 
 	if sessionToken != "" {
 		customTransport = &CustomTransport{
-			CustomRoundTripper: Chain(tlsTransport),
+			CustomRoundTripper: Chain(tlsTransport, Trace()),
 			IsTlsEnabled:       true,
 		}
 	} else {
 		customTransport = &CustomTransport{
 			CustomRoundTripper: Chain(
 				tlsTransport,
+				Trace(),
 				AddHeader("Authorization", fmt.Sprintf("Bearer %s", sessionToken))),
 			IsTlsEnabled: true,
 		}
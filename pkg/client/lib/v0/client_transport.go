@@ -1,14 +1,26 @@
 package v0
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
 
 // Custom transport is a struct that holds custom round trippers and any associated info
 type CustomTransport struct {
 	CustomRoundTripper http.RoundTripper
 	IsTlsEnabled       bool
+
+	// TraceContext is the context of the trace that requests made without a
+	// span in their own context are made part of.
+	TraceContext context.Context
 }
 
 func (ct CustomTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if ct.TraceContext != nil {
+		req = req.WithContext(tracing.WithParent(req.Context(), ct.TraceContext))
+	}
 	return ct.CustomRoundTripper.RoundTrip(req)
 }
 
@@ -0,0 +1,141 @@
+package v0
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+)
+
+// ListOption sets a query parameter for a request to a threeport API list
+// endpoint.
+type ListOption func(query url.Values)
+
+// WithSort orders the returned objects by the provided fields.  Prefix a
+// field with - to sort in descending order, e.g. WithSort("-CreatedAt", "Name").
+func WithSort(fields ...string) ListOption {
+	return func(query url.Values) {
+		query.Set(apiserver_lib.QueryParamSort, strings.Join(fields, ","))
+	}
+}
+
+// WithFields limits the fields returned for each object to those provided.
+// The object's ID is always returned.
+func WithFields(fields ...string) ListOption {
+	return func(query url.Values) {
+		query.Set(apiserver_lib.QueryParamFields, strings.Join(fields, ","))
+	}
+}
+
+// WithFilter returns only objects with a field value that satisfies the
+// operator, e.g. WithFilter("CreatedAt", apiserver_lib.FilterOpGreaterThan,
+// "2024-01-01T00:00:00Z").  Multiple values may be provided for the in and
+// notin operators.
+func WithFilter(field string, operator apiserver_lib.FilterOperator, values ...string) ListOption {
+	return func(query url.Values) {
+		query.Add(fmt.Sprintf("%s[%s]", field, operator), strings.Join(values, ","))
+	}
+}
+
+// WithLabelSelector returns only objects with labels that satisfy the
+// selector, e.g. team=payments,tier!=dev.
+func WithLabelSelector(labelSelector string) ListOption {
+	return func(query url.Values) {
+		if labelSelector != "" {
+			query.Set(apiserver_lib.QueryParamLabelSelector, labelSelector)
+		}
+	}
+}
+
+// WithPageSize sets the number of objects returned in each page.
+func WithPageSize(size int) ListOption {
+	return func(query url.Values) {
+		query.Set(apiserver_lib.QueryParamSize, strconv.Itoa(size))
+	}
+}
+
+// WithPage requests a page by number.  It is ignored by the API if a continue
+// token is also provided.
+func WithPage(page int) ListOption {
+	return func(query url.Values) {
+		query.Set(apiserver_lib.QueryParamPage, strconv.Itoa(page))
+	}
+}
+
+// WithContinue requests the page that follows the one the continue token was
+// returned with.
+func WithContinue(token string) ListOption {
+	return func(query url.Values) {
+		if token != "" {
+			query.Set(apiserver_lib.QueryParamContinue, token)
+		}
+	}
+}
+
+// WithSkipCount skips counting the total number of objects, which is slow
+// for large collections.
+func WithSkipCount() ListOption {
+	return func(query url.Values) {
+		query.Set(apiserver_lib.QueryParamSkipCount, "true")
+	}
+}
+
+// ListQueryString returns the query string for a request to a list endpoint
+// with the provided options.
+func ListQueryString(options ...ListOption) string {
+	query := url.Values{}
+	for _, option := range options {
+		option(query)
+	}
+
+	return query.Encode()
+}
+
+// GetAllPages calls a threeport API list endpoint and fetches every page of
+// objects, returning the data from all pages.  The url is the API address and
+// object path, e.g. apiAddr + v0.PathWorkloadInstances.  Pages are fetched
+// with continue tokens when the API provides them and by page number
+// otherwise, e.g. when sorting by a field that doesn't support continue
+// tokens.
+func GetAllPages(
+	apiClient *http.Client,
+	url string,
+	options ...ListOption,
+) ([]apiserver_lib.Object, error) {
+	var data []apiserver_lib.Object
+
+	page := 1
+	continueToken := ""
+	for {
+		pageOptions := append(
+			append([]ListOption{}, options...),
+			WithSkipCount(),
+			WithPage(page),
+			WithContinue(continueToken),
+		)
+		response, err := GetResponse(
+			apiClient,
+			fmt.Sprintf("%s?%s", url, ListQueryString(pageOptions...)),
+			http.MethodGet,
+			new(bytes.Buffer),
+			map[string]string{},
+			http.StatusOK,
+		)
+		if err != nil {
+			return data, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+		}
+		data = append(data, response.Data...)
+
+		// the final page is not full
+		if response.Meta.Size <= 0 || len(response.Data) < response.Meta.Size {
+			return data, nil
+		}
+
+		continueToken = response.Meta.Continue
+		page++
+	}
+}
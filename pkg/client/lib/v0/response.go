@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 )
@@ -16,6 +17,16 @@ var ErrUnauthorized = errors.New("unauthorized")
 var ErrForbidden = errors.New("forbidden")
 var ErrConflict = errors.New("conflict")
 
+// ErrWatchExpired is returned when a watch is resumed from a marker for an
+// event that is no longer retained by the API.  The client must list the
+// objects again and start a new watch without a marker.
+var ErrWatchExpired = errors.New("watch expired")
+
+// ErrResourceVersionConflict is returned when an update or delete is rejected
+// because the object was changed after the client read it.  It wraps
+// ErrConflict.  Callers can get the latest version of the object and retry.
+var ErrResourceVersionConflict = fmt.Errorf("%w: resource version", ErrConflict)
+
 // GetResponse calls the threeport API and returns a response.
 func GetResponse(
 	client *http.Client,
@@ -26,21 +37,7 @@ func GetResponse(
 	expectedStatusCode int,
 ) (*apiserver_lib.Response, error) {
 
-	urlScheme := "http://"
-
-	// check if TLS is configured
-	tlsConfigured := false
-	if transport, ok := client.Transport.(*CustomTransport); ok {
-		tlsConfigured = transport.IsTlsEnabled
-	}
-
-	// update url if TLS is configured
-	if tlsConfigured {
-		urlScheme = "https://"
-	}
-	url = urlScheme + url
-
-	req, err := http.NewRequest(httpMethod, url, reqBody)
+	req, err := http.NewRequest(httpMethod, apiURL(client, url), reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build request to threeport API: %w", err)
 	}
@@ -80,6 +77,9 @@ func GetResponse(
 		case http.StatusForbidden:
 			return nil, fmt.Errorf("%w: %s", ErrForbidden, response.Status.Error)
 		case http.StatusConflict:
+			if strings.HasPrefix(response.Status.Error, apiserver_lib.ErrMsgResourceVersionConflict) {
+				return nil, fmt.Errorf("%w: %s", ErrResourceVersionConflict, response.Status.Error)
+			}
 			return nil, fmt.Errorf("%w: %s", ErrConflict, response.Status.Error)
 		default:
 			return nil, fmt.Errorf(
@@ -108,3 +108,22 @@ func GetResponse(
 
 	return &response, nil
 }
+
+// apiURL adds the scheme to a threeport API URL based on whether the client
+// is configured for TLS.
+func apiURL(client *http.Client, url string) string {
+	urlScheme := "http://"
+
+	// check if TLS is configured
+	tlsConfigured := false
+	if transport, ok := client.Transport.(*CustomTransport); ok {
+		tlsConfigured = transport.IsTlsEnabled
+	}
+
+	// update url if TLS is configured
+	if tlsConfigured {
+		urlScheme = "https://"
+	}
+
+	return urlScheme + url
+}
@@ -0,0 +1,47 @@
+package v0
+
+import (
+	"context"
+	"net/http"
+
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
+
+// Trace creates a client span for each request to the threeport API and
+// propagates the trace context in the request headers.
+func Trace() Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return tracing.NewTransport(rt)
+	}
+}
+
+// WithTraceContext returns a copy of the client that makes its requests part
+// of the trace in ctx.  It is used to trace the API calls made while
+// handling a single operation, e.g. a reconciliation, since the client
+// functions do not take a context.  Clients that do not use a CustomTransport
+// are returned unchanged.
+func WithTraceContext(client *http.Client, ctx context.Context) *http.Client {
+	transport, ok := client.Transport.(*CustomTransport)
+	if !ok {
+		return client
+	}
+
+	tracedTransport := *transport
+	tracedTransport.TraceContext = ctx
+	tracedClient := *client
+	tracedClient.Transport = &tracedTransport
+
+	return &tracedClient
+}
+
+// TraceContext returns the trace context set on a client with
+// WithTraceContext.  A background context is returned if none was set.
+func TraceContext(client *http.Client) context.Context {
+	if client != nil {
+		if transport, ok := client.Transport.(*CustomTransport); ok && transport.TraceContext != nil {
+			return transport.TraceContext
+		}
+	}
+
+	return context.Background()
+}
@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
 )
 
 const GoClientDebug = "ThreeportGoClientDebug"
@@ -30,3 +32,15 @@ func ReplaceAssociatedObjectsWithNil(obj interface{}) (err error) {
 	}
 	return nil
 }
+
+// IfMatchHeader returns the request header used to make an update or delete
+// conditional on the object's resource version.  If the resource version is
+// not known, no header is returned and the change is unconditional.
+func IfMatchHeader(resourceVersion *uint64) map[string]string {
+	header := map[string]string{}
+	if resourceVersion != nil {
+		header[apiserver_lib.HeaderIfMatch] = apiserver_lib.ETag(resourceVersion)
+	}
+
+	return header
+}
@@ -0,0 +1,108 @@
+package v0
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+)
+
+// Watch calls a threeport API list endpoint in watch mode and calls
+// handleEvent with each change to objects of that type.  The url is the API
+// address and object path, e.g. apiAddr + v0.PathWorkloadInstances.  If since
+// is non-zero, the watch resumes after the event with that marker.  Watch
+// blocks until the context is cancelled, the API closes the connection or
+// handleEvent returns an error.  To resume a watch that was interrupted, call
+// Watch again with the marker of the last event that was handled.  If the
+// events following that marker are no longer retained, ErrWatchExpired is
+// returned and the objects must be listed again before starting a new watch.
+func Watch(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	since uint64,
+	handleEvent func(event *apiserver_lib.WatchEvent) error,
+) error {
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+	url = fmt.Sprintf("%s%s%s=true", url, separator, apiserver_lib.QueryParamWatch)
+	if since != 0 {
+		url = fmt.Sprintf("%s&%s=%d", url, apiserver_lib.QueryParamSince, since)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL(client, url), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build watch request to threeport API: %w", err)
+	}
+	req.Header.Add("Accept", "text/event-stream")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute watch call to threeport API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var response apiserver_lib.Response
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body from threeport API: %w", err)
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return fmt.Errorf("failed to unmarshal response body from threeport API: %w", err)
+		}
+		if resp.StatusCode == http.StatusGone {
+			return fmt.Errorf("%w: %s", ErrWatchExpired, response.Status.Error)
+		}
+		return fmt.Errorf(
+			"API returned status: %d, %s: %s",
+			response.Status.Code,
+			response.Status.Message,
+			response.Status.Error,
+		)
+	}
+
+	// read server-sent events - each event's data is a JSON watch event and
+	// events are separated by blank lines
+	reader := bufio.NewReader(resp.Body)
+	var data strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if err == io.EOF {
+				return fmt.Errorf("watch connection closed by threeport API")
+			}
+			return fmt.Errorf("failed to read watch event from threeport API: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			var event apiserver_lib.WatchEvent
+			if err := json.Unmarshal([]byte(data.String()), &event); err != nil {
+				return fmt.Errorf("failed to unmarshal watch event from threeport API: %w", err)
+			}
+			data.Reset()
+			if err := handleEvent(&event); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+		// comments used as heartbeats and the event ID and type fields are
+		// ignored since the same info is included in the event data
+	}
+}
@@ -14,34 +14,8 @@ import (
 )
 
 // GetProfiles fetches all profiles.
-// TODO: implement pagination
 func GetProfiles(apiClient *http.Client, apiAddr string) (*[]v0.Profile, error) {
-	var profiles []v0.Profile
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathProfiles),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &profiles, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &profiles, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&profiles); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &profiles, nil
+	return GetProfilesWithOptions(apiClient, apiAddr)
 }
 
 // GetProfileByID fetches a profile by ID.
@@ -104,6 +78,34 @@ func GetProfilesByQueryString(apiClient *http.Client, apiAddr string, queryStrin
 	return &profiles, nil
 }
 
+// GetProfilesWithOptions fetches all profiles using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetProfilesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.Profile, error) {
+	var profiles []v0.Profile
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathProfiles),
+		options...,
+	)
+	if err != nil {
+		return &profiles, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &profiles, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&profiles); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &profiles, nil
+}
+
 // GetProfileByName fetches a profile by name.
 func GetProfileByName(apiClient *http.Client, apiAddr, name string) (*v0.Profile, error) {
 	var profiles []v0.Profile
@@ -185,6 +187,7 @@ func UpdateProfile(apiClient *http.Client, apiAddr string, profile *v0.Profile)
 	payloadProfile.ID = nil
 	payloadProfile.CreatedAt = nil
 	payloadProfile.UpdatedAt = nil
+	payloadProfile.ResourceVersion = nil
 
 	jsonProfile, err := util.MarshalObject(payloadProfile)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateProfile(apiClient *http.Client, apiAddr string, profile *v0.Profile)
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathProfiles, profileID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonProfile),
-		map[string]string{},
+		client_lib.IfMatchHeader(profile.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateProfile(apiClient *http.Client, apiAddr string, profile *v0.Profile)
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	profile.ResourceVersion = payloadProfile.ResourceVersion
+
 	payloadProfile.ID = &profileID
 	return &payloadProfile, nil
 }
@@ -249,34 +256,8 @@ func DeleteProfile(apiClient *http.Client, apiAddr string, id uint) (*v0.Profile
 }
 
 // GetTiers fetches all tiers.
-// TODO: implement pagination
 func GetTiers(apiClient *http.Client, apiAddr string) (*[]v0.Tier, error) {
-	var tiers []v0.Tier
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathTiers),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &tiers, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &tiers, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&tiers); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &tiers, nil
+	return GetTiersWithOptions(apiClient, apiAddr)
 }
 
 // GetTierByID fetches a tier by ID.
@@ -339,6 +320,34 @@ func GetTiersByQueryString(apiClient *http.Client, apiAddr string, queryString s
 	return &tiers, nil
 }
 
+// GetTiersWithOptions fetches all tiers using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetTiersWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.Tier, error) {
+	var tiers []v0.Tier
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathTiers),
+		options...,
+	)
+	if err != nil {
+		return &tiers, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &tiers, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&tiers); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &tiers, nil
+}
+
 // GetTierByName fetches a tier by name.
 func GetTierByName(apiClient *http.Client, apiAddr, name string) (*v0.Tier, error) {
 	var tiers []v0.Tier
@@ -420,6 +429,7 @@ func UpdateTier(apiClient *http.Client, apiAddr string, tier *v0.Tier) (*v0.Tier
 	payloadTier.ID = nil
 	payloadTier.CreatedAt = nil
 	payloadTier.UpdatedAt = nil
+	payloadTier.ResourceVersion = nil
 
 	jsonTier, err := util.MarshalObject(payloadTier)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateTier(apiClient *http.Client, apiAddr string, tier *v0.Tier) (*v0.Tier
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathTiers, tierID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonTier),
-		map[string]string{},
+		client_lib.IfMatchHeader(tier.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateTier(apiClient *http.Client, apiAddr string, tier *v0.Tier) (*v0.Tier
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	tier.ResourceVersion = payloadTier.ResourceVersion
+
 	payloadTier.ID = &tierID
 	return &payloadTier, nil
 }
@@ -14,34 +14,8 @@ import (
 )
 
 // GetAttachedObjectReferences fetches all attached object references.
-// TODO: implement pagination
 func GetAttachedObjectReferences(apiClient *http.Client, apiAddr string) (*[]v0.AttachedObjectReference, error) {
-	var attachedObjectReferences []v0.AttachedObjectReference
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAttachedObjectReferences),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &attachedObjectReferences, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &attachedObjectReferences, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&attachedObjectReferences); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &attachedObjectReferences, nil
+	return GetAttachedObjectReferencesWithOptions(apiClient, apiAddr)
 }
 
 // GetAttachedObjectReferenceByID fetches a attached object reference by ID.
@@ -104,6 +78,34 @@ func GetAttachedObjectReferencesByQueryString(apiClient *http.Client, apiAddr st
 	return &attachedObjectReferences, nil
 }
 
+// GetAttachedObjectReferencesWithOptions fetches all attached object references using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAttachedObjectReferencesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AttachedObjectReference, error) {
+	var attachedObjectReferences []v0.AttachedObjectReference
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAttachedObjectReferences),
+		options...,
+	)
+	if err != nil {
+		return &attachedObjectReferences, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &attachedObjectReferences, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&attachedObjectReferences); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &attachedObjectReferences, nil
+}
+
 // GetAttachedObjectReferenceByName fetches a attached object reference by name.
 func GetAttachedObjectReferenceByName(apiClient *http.Client, apiAddr, name string) (*v0.AttachedObjectReference, error) {
 	var attachedObjectReferences []v0.AttachedObjectReference
@@ -185,6 +187,7 @@ func UpdateAttachedObjectReference(apiClient *http.Client, apiAddr string, attac
 	payloadAttachedObjectReference.ID = nil
 	payloadAttachedObjectReference.CreatedAt = nil
 	payloadAttachedObjectReference.UpdatedAt = nil
+	payloadAttachedObjectReference.ResourceVersion = nil
 
 	jsonAttachedObjectReference, err := util.MarshalObject(payloadAttachedObjectReference)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateAttachedObjectReference(apiClient *http.Client, apiAddr string, attac
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAttachedObjectReferences, attachedObjectReferenceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAttachedObjectReference),
-		map[string]string{},
+		client_lib.IfMatchHeader(attachedObjectReference.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateAttachedObjectReference(apiClient *http.Client, apiAddr string, attac
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	attachedObjectReference.ResourceVersion = payloadAttachedObjectReference.ResourceVersion
+
 	payloadAttachedObjectReference.ID = &attachedObjectReferenceID
 	return &payloadAttachedObjectReference, nil
 }
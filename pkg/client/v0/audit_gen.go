@@ -0,0 +1,256 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
+)
+
+// GetAuditRecords fetches all audit records.
+func GetAuditRecords(apiClient *http.Client, apiAddr string) (*[]v0.AuditRecord, error) {
+	return GetAuditRecordsWithOptions(apiClient, apiAddr)
+}
+
+// GetAuditRecordByID fetches a audit record by ID.
+func GetAuditRecordByID(apiClient *http.Client, apiAddr string, id uint) (*v0.AuditRecord, error) {
+	var auditRecord v0.AuditRecord
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAuditRecords, id),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &auditRecord, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &auditRecord, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&auditRecord); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &auditRecord, nil
+}
+
+// GetAuditRecordsByQueryString fetches audit records by provided query string.
+func GetAuditRecordsByQueryString(apiClient *http.Client, apiAddr string, queryString string) (*[]v0.AuditRecord, error) {
+	var auditRecords []v0.AuditRecord
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?%s", apiAddr, v0.PathAuditRecords, queryString),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &auditRecords, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &auditRecords, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&auditRecords); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &auditRecords, nil
+}
+
+// GetAuditRecordsWithOptions fetches all audit records using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAuditRecordsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AuditRecord, error) {
+	var auditRecords []v0.AuditRecord
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAuditRecords),
+		options...,
+	)
+	if err != nil {
+		return &auditRecords, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &auditRecords, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&auditRecords); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &auditRecords, nil
+}
+
+// GetAuditRecordByName fetches a audit record by name.
+func GetAuditRecordByName(apiClient *http.Client, apiAddr, name string) (*v0.AuditRecord, error) {
+	var auditRecords []v0.AuditRecord
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?name=%s", apiAddr, v0.PathAuditRecords, name),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &v0.AuditRecord{}, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &v0.AuditRecord{}, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&auditRecords); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	switch {
+	case len(auditRecords) < 1:
+		return &v0.AuditRecord{}, errors.New(fmt.Sprintf("no audit record with name %s", name))
+	case len(auditRecords) > 1:
+		return &v0.AuditRecord{}, errors.New(fmt.Sprintf("more than one audit record with name %s returned", name))
+	}
+
+	return &auditRecords[0], nil
+}
+
+// CreateAuditRecord creates a new audit record.
+func CreateAuditRecord(apiClient *http.Client, apiAddr string, auditRecord *v0.AuditRecord) (*v0.AuditRecord, error) {
+	client_lib.ReplaceAssociatedObjectsWithNil(auditRecord)
+	jsonAuditRecord, err := util.MarshalObject(auditRecord)
+	if err != nil {
+		return auditRecord, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAuditRecords),
+		http.MethodPost,
+		bytes.NewBuffer(jsonAuditRecord),
+		map[string]string{},
+		http.StatusCreated,
+	)
+	if err != nil {
+		return auditRecord, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return auditRecord, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&auditRecord); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return auditRecord, nil
+}
+
+// UpdateAuditRecord updates a audit record.
+func UpdateAuditRecord(apiClient *http.Client, apiAddr string, auditRecord *v0.AuditRecord) (*v0.AuditRecord, error) {
+	client_lib.ReplaceAssociatedObjectsWithNil(auditRecord)
+	// capture the object ID, make a copy of the object, then remove fields that
+	// cannot be updated in the API
+	auditRecordID := *auditRecord.ID
+	payloadAuditRecord := *auditRecord
+	payloadAuditRecord.ID = nil
+	payloadAuditRecord.CreatedAt = nil
+	payloadAuditRecord.UpdatedAt = nil
+	payloadAuditRecord.ResourceVersion = nil
+
+	jsonAuditRecord, err := util.MarshalObject(payloadAuditRecord)
+	if err != nil {
+		return auditRecord, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAuditRecords, auditRecordID),
+		http.MethodPatch,
+		bytes.NewBuffer(jsonAuditRecord),
+		client_lib.IfMatchHeader(auditRecord.ResourceVersion),
+		http.StatusOK,
+	)
+	if err != nil {
+		return auditRecord, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return auditRecord, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payloadAuditRecord); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	auditRecord.ResourceVersion = payloadAuditRecord.ResourceVersion
+
+	payloadAuditRecord.ID = &auditRecordID
+	return &payloadAuditRecord, nil
+}
+
+// DeleteAuditRecord deletes a audit record by ID.
+func DeleteAuditRecord(apiClient *http.Client, apiAddr string, id uint) (*v0.AuditRecord, error) {
+	var auditRecord v0.AuditRecord
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAuditRecords, id),
+		http.MethodDelete,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &auditRecord, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &auditRecord, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&auditRecord); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &auditRecord, nil
+}
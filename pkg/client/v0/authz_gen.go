@@ -0,0 +1,498 @@
+// generated by 'threeport-sdk gen' - do not edit
+
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+	"net/http"
+)
+
+// GetRoles fetches all roles.
+func GetRoles(apiClient *http.Client, apiAddr string) (*[]v0.Role, error) {
+	return GetRolesWithOptions(apiClient, apiAddr)
+}
+
+// GetRoleByID fetches a role by ID.
+func GetRoleByID(apiClient *http.Client, apiAddr string, id uint) (*v0.Role, error) {
+	var role v0.Role
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathRoles, id),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &role, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &role, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&role); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &role, nil
+}
+
+// GetRolesByQueryString fetches roles by provided query string.
+func GetRolesByQueryString(apiClient *http.Client, apiAddr string, queryString string) (*[]v0.Role, error) {
+	var roles []v0.Role
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?%s", apiAddr, v0.PathRoles, queryString),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &roles, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &roles, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roles); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &roles, nil
+}
+
+// GetRolesWithOptions fetches all roles using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetRolesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.Role, error) {
+	var roles []v0.Role
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathRoles),
+		options...,
+	)
+	if err != nil {
+		return &roles, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &roles, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roles); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &roles, nil
+}
+
+// GetRoleByName fetches a role by name.
+func GetRoleByName(apiClient *http.Client, apiAddr, name string) (*v0.Role, error) {
+	var roles []v0.Role
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?name=%s", apiAddr, v0.PathRoles, name),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &v0.Role{}, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &v0.Role{}, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roles); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	switch {
+	case len(roles) < 1:
+		return &v0.Role{}, errors.New(fmt.Sprintf("no role with name %s", name))
+	case len(roles) > 1:
+		return &v0.Role{}, errors.New(fmt.Sprintf("more than one role with name %s returned", name))
+	}
+
+	return &roles[0], nil
+}
+
+// CreateRole creates a new role.
+func CreateRole(apiClient *http.Client, apiAddr string, role *v0.Role) (*v0.Role, error) {
+	client_lib.ReplaceAssociatedObjectsWithNil(role)
+	jsonRole, err := util.MarshalObject(role)
+	if err != nil {
+		return role, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathRoles),
+		http.MethodPost,
+		bytes.NewBuffer(jsonRole),
+		map[string]string{},
+		http.StatusCreated,
+	)
+	if err != nil {
+		return role, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return role, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&role); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return role, nil
+}
+
+// UpdateRole updates a role.
+func UpdateRole(apiClient *http.Client, apiAddr string, role *v0.Role) (*v0.Role, error) {
+	client_lib.ReplaceAssociatedObjectsWithNil(role)
+	// capture the object ID, make a copy of the object, then remove fields that
+	// cannot be updated in the API
+	roleID := *role.ID
+	payloadRole := *role
+	payloadRole.ID = nil
+	payloadRole.CreatedAt = nil
+	payloadRole.UpdatedAt = nil
+	payloadRole.ResourceVersion = nil
+
+	jsonRole, err := util.MarshalObject(payloadRole)
+	if err != nil {
+		return role, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathRoles, roleID),
+		http.MethodPatch,
+		bytes.NewBuffer(jsonRole),
+		client_lib.IfMatchHeader(role.ResourceVersion),
+		http.StatusOK,
+	)
+	if err != nil {
+		return role, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return role, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payloadRole); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	role.ResourceVersion = payloadRole.ResourceVersion
+
+	payloadRole.ID = &roleID
+	return &payloadRole, nil
+}
+
+// DeleteRole deletes a role by ID.
+func DeleteRole(apiClient *http.Client, apiAddr string, id uint) (*v0.Role, error) {
+	var role v0.Role
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathRoles, id),
+		http.MethodDelete,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &role, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &role, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&role); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &role, nil
+}
+
+// GetRoleBindings fetches all role bindings.
+func GetRoleBindings(apiClient *http.Client, apiAddr string) (*[]v0.RoleBinding, error) {
+	return GetRoleBindingsWithOptions(apiClient, apiAddr)
+}
+
+// GetRoleBindingByID fetches a role binding by ID.
+func GetRoleBindingByID(apiClient *http.Client, apiAddr string, id uint) (*v0.RoleBinding, error) {
+	var roleBinding v0.RoleBinding
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathRoleBindings, id),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &roleBinding, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &roleBinding, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roleBinding); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &roleBinding, nil
+}
+
+// GetRoleBindingsByQueryString fetches role bindings by provided query string.
+func GetRoleBindingsByQueryString(apiClient *http.Client, apiAddr string, queryString string) (*[]v0.RoleBinding, error) {
+	var roleBindings []v0.RoleBinding
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?%s", apiAddr, v0.PathRoleBindings, queryString),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &roleBindings, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &roleBindings, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roleBindings); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &roleBindings, nil
+}
+
+// GetRoleBindingsWithOptions fetches all role bindings using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetRoleBindingsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.RoleBinding, error) {
+	var roleBindings []v0.RoleBinding
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathRoleBindings),
+		options...,
+	)
+	if err != nil {
+		return &roleBindings, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &roleBindings, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roleBindings); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &roleBindings, nil
+}
+
+// GetRoleBindingByName fetches a role binding by name.
+func GetRoleBindingByName(apiClient *http.Client, apiAddr, name string) (*v0.RoleBinding, error) {
+	var roleBindings []v0.RoleBinding
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?name=%s", apiAddr, v0.PathRoleBindings, name),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &v0.RoleBinding{}, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &v0.RoleBinding{}, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roleBindings); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	switch {
+	case len(roleBindings) < 1:
+		return &v0.RoleBinding{}, errors.New(fmt.Sprintf("no role binding with name %s", name))
+	case len(roleBindings) > 1:
+		return &v0.RoleBinding{}, errors.New(fmt.Sprintf("more than one role binding with name %s returned", name))
+	}
+
+	return &roleBindings[0], nil
+}
+
+// CreateRoleBinding creates a new role binding.
+func CreateRoleBinding(apiClient *http.Client, apiAddr string, roleBinding *v0.RoleBinding) (*v0.RoleBinding, error) {
+	client_lib.ReplaceAssociatedObjectsWithNil(roleBinding)
+	jsonRoleBinding, err := util.MarshalObject(roleBinding)
+	if err != nil {
+		return roleBinding, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathRoleBindings),
+		http.MethodPost,
+		bytes.NewBuffer(jsonRoleBinding),
+		map[string]string{},
+		http.StatusCreated,
+	)
+	if err != nil {
+		return roleBinding, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return roleBinding, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roleBinding); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return roleBinding, nil
+}
+
+// UpdateRoleBinding updates a role binding.
+func UpdateRoleBinding(apiClient *http.Client, apiAddr string, roleBinding *v0.RoleBinding) (*v0.RoleBinding, error) {
+	client_lib.ReplaceAssociatedObjectsWithNil(roleBinding)
+	// capture the object ID, make a copy of the object, then remove fields that
+	// cannot be updated in the API
+	roleBindingID := *roleBinding.ID
+	payloadRoleBinding := *roleBinding
+	payloadRoleBinding.ID = nil
+	payloadRoleBinding.CreatedAt = nil
+	payloadRoleBinding.UpdatedAt = nil
+	payloadRoleBinding.ResourceVersion = nil
+
+	jsonRoleBinding, err := util.MarshalObject(payloadRoleBinding)
+	if err != nil {
+		return roleBinding, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathRoleBindings, roleBindingID),
+		http.MethodPatch,
+		bytes.NewBuffer(jsonRoleBinding),
+		client_lib.IfMatchHeader(roleBinding.ResourceVersion),
+		http.StatusOK,
+	)
+	if err != nil {
+		return roleBinding, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return roleBinding, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payloadRoleBinding); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	roleBinding.ResourceVersion = payloadRoleBinding.ResourceVersion
+
+	payloadRoleBinding.ID = &roleBindingID
+	return &payloadRoleBinding, nil
+}
+
+// DeleteRoleBinding deletes a role binding by ID.
+func DeleteRoleBinding(apiClient *http.Client, apiAddr string, id uint) (*v0.RoleBinding, error) {
+	var roleBinding v0.RoleBinding
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathRoleBindings, id),
+		http.MethodDelete,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &roleBinding, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &roleBinding, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&roleBinding); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &roleBinding, nil
+}
@@ -14,34 +14,8 @@ import (
 )
 
 // GetAwsAccounts fetches all aws accounts.
-// TODO: implement pagination
 func GetAwsAccounts(apiClient *http.Client, apiAddr string) (*[]v0.AwsAccount, error) {
-	var awsAccounts []v0.AwsAccount
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsAccounts),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsAccounts, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsAccounts, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsAccounts); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsAccounts, nil
+	return GetAwsAccountsWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsAccountByID fetches a aws account by ID.
@@ -104,6 +78,34 @@ func GetAwsAccountsByQueryString(apiClient *http.Client, apiAddr string, querySt
 	return &awsAccounts, nil
 }
 
+// GetAwsAccountsWithOptions fetches all aws accounts using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsAccountsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsAccount, error) {
+	var awsAccounts []v0.AwsAccount
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsAccounts),
+		options...,
+	)
+	if err != nil {
+		return &awsAccounts, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsAccounts, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsAccounts); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsAccounts, nil
+}
+
 // GetAwsAccountByName fetches a aws account by name.
 func GetAwsAccountByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsAccount, error) {
 	var awsAccounts []v0.AwsAccount
@@ -185,6 +187,7 @@ func UpdateAwsAccount(apiClient *http.Client, apiAddr string, awsAccount *v0.Aws
 	payloadAwsAccount.ID = nil
 	payloadAwsAccount.CreatedAt = nil
 	payloadAwsAccount.UpdatedAt = nil
+	payloadAwsAccount.ResourceVersion = nil
 
 	jsonAwsAccount, err := util.MarshalObject(payloadAwsAccount)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateAwsAccount(apiClient *http.Client, apiAddr string, awsAccount *v0.Aws
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsAccounts, awsAccountID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsAccount),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsAccount.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateAwsAccount(apiClient *http.Client, apiAddr string, awsAccount *v0.Aws
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsAccount.ResourceVersion = payloadAwsAccount.ResourceVersion
+
 	payloadAwsAccount.ID = &awsAccountID
 	return &payloadAwsAccount, nil
 }
@@ -249,34 +256,8 @@ func DeleteAwsAccount(apiClient *http.Client, apiAddr string, id uint) (*v0.AwsA
 }
 
 // GetAwsEksKubernetesRuntimeDefinitions fetches all aws eks kubernetes runtime definitions.
-// TODO: implement pagination
 func GetAwsEksKubernetesRuntimeDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.AwsEksKubernetesRuntimeDefinition, error) {
-	var awsEksKubernetesRuntimeDefinitions []v0.AwsEksKubernetesRuntimeDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsEksKubernetesRuntimeDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsEksKubernetesRuntimeDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsEksKubernetesRuntimeDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsEksKubernetesRuntimeDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsEksKubernetesRuntimeDefinitions, nil
+	return GetAwsEksKubernetesRuntimeDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsEksKubernetesRuntimeDefinitionByID fetches a aws eks kubernetes runtime definition by ID.
@@ -339,6 +320,34 @@ func GetAwsEksKubernetesRuntimeDefinitionsByQueryString(apiClient *http.Client,
 	return &awsEksKubernetesRuntimeDefinitions, nil
 }
 
+// GetAwsEksKubernetesRuntimeDefinitionsWithOptions fetches all aws eks kubernetes runtime definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsEksKubernetesRuntimeDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsEksKubernetesRuntimeDefinition, error) {
+	var awsEksKubernetesRuntimeDefinitions []v0.AwsEksKubernetesRuntimeDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsEksKubernetesRuntimeDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &awsEksKubernetesRuntimeDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsEksKubernetesRuntimeDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsEksKubernetesRuntimeDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsEksKubernetesRuntimeDefinitions, nil
+}
+
 // GetAwsEksKubernetesRuntimeDefinitionByName fetches a aws eks kubernetes runtime definition by name.
 func GetAwsEksKubernetesRuntimeDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsEksKubernetesRuntimeDefinition, error) {
 	var awsEksKubernetesRuntimeDefinitions []v0.AwsEksKubernetesRuntimeDefinition
@@ -420,6 +429,7 @@ func UpdateAwsEksKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr str
 	payloadAwsEksKubernetesRuntimeDefinition.ID = nil
 	payloadAwsEksKubernetesRuntimeDefinition.CreatedAt = nil
 	payloadAwsEksKubernetesRuntimeDefinition.UpdatedAt = nil
+	payloadAwsEksKubernetesRuntimeDefinition.ResourceVersion = nil
 
 	jsonAwsEksKubernetesRuntimeDefinition, err := util.MarshalObject(payloadAwsEksKubernetesRuntimeDefinition)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateAwsEksKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr str
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsEksKubernetesRuntimeDefinitions, awsEksKubernetesRuntimeDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsEksKubernetesRuntimeDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsEksKubernetesRuntimeDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateAwsEksKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr str
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsEksKubernetesRuntimeDefinition.ResourceVersion = payloadAwsEksKubernetesRuntimeDefinition.ResourceVersion
+
 	payloadAwsEksKubernetesRuntimeDefinition.ID = &awsEksKubernetesRuntimeDefinitionID
 	return &payloadAwsEksKubernetesRuntimeDefinition, nil
 }
@@ -484,34 +498,8 @@ func DeleteAwsEksKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr str
 }
 
 // GetAwsEksKubernetesRuntimeInstances fetches all aws eks kubernetes runtime instances.
-// TODO: implement pagination
 func GetAwsEksKubernetesRuntimeInstances(apiClient *http.Client, apiAddr string) (*[]v0.AwsEksKubernetesRuntimeInstance, error) {
-	var awsEksKubernetesRuntimeInstances []v0.AwsEksKubernetesRuntimeInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsEksKubernetesRuntimeInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsEksKubernetesRuntimeInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsEksKubernetesRuntimeInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsEksKubernetesRuntimeInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsEksKubernetesRuntimeInstances, nil
+	return GetAwsEksKubernetesRuntimeInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsEksKubernetesRuntimeInstanceByID fetches a aws eks kubernetes runtime instance by ID.
@@ -574,6 +562,34 @@ func GetAwsEksKubernetesRuntimeInstancesByQueryString(apiClient *http.Client, ap
 	return &awsEksKubernetesRuntimeInstances, nil
 }
 
+// GetAwsEksKubernetesRuntimeInstancesWithOptions fetches all aws eks kubernetes runtime instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsEksKubernetesRuntimeInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsEksKubernetesRuntimeInstance, error) {
+	var awsEksKubernetesRuntimeInstances []v0.AwsEksKubernetesRuntimeInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsEksKubernetesRuntimeInstances),
+		options...,
+	)
+	if err != nil {
+		return &awsEksKubernetesRuntimeInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsEksKubernetesRuntimeInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsEksKubernetesRuntimeInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsEksKubernetesRuntimeInstances, nil
+}
+
 // GetAwsEksKubernetesRuntimeInstanceByName fetches a aws eks kubernetes runtime instance by name.
 func GetAwsEksKubernetesRuntimeInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsEksKubernetesRuntimeInstance, error) {
 	var awsEksKubernetesRuntimeInstances []v0.AwsEksKubernetesRuntimeInstance
@@ -655,6 +671,7 @@ func UpdateAwsEksKubernetesRuntimeInstance(apiClient *http.Client, apiAddr strin
 	payloadAwsEksKubernetesRuntimeInstance.ID = nil
 	payloadAwsEksKubernetesRuntimeInstance.CreatedAt = nil
 	payloadAwsEksKubernetesRuntimeInstance.UpdatedAt = nil
+	payloadAwsEksKubernetesRuntimeInstance.ResourceVersion = nil
 
 	jsonAwsEksKubernetesRuntimeInstance, err := util.MarshalObject(payloadAwsEksKubernetesRuntimeInstance)
 	if err != nil {
@@ -666,7 +683,7 @@ func UpdateAwsEksKubernetesRuntimeInstance(apiClient *http.Client, apiAddr strin
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsEksKubernetesRuntimeInstances, awsEksKubernetesRuntimeInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsEksKubernetesRuntimeInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsEksKubernetesRuntimeInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -684,6 +701,10 @@ func UpdateAwsEksKubernetesRuntimeInstance(apiClient *http.Client, apiAddr strin
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsEksKubernetesRuntimeInstance.ResourceVersion = payloadAwsEksKubernetesRuntimeInstance.ResourceVersion
+
 	payloadAwsEksKubernetesRuntimeInstance.ID = &awsEksKubernetesRuntimeInstanceID
 	return &payloadAwsEksKubernetesRuntimeInstance, nil
 }
@@ -719,34 +740,8 @@ func DeleteAwsEksKubernetesRuntimeInstance(apiClient *http.Client, apiAddr strin
 }
 
 // GetAwsObjectStorageBucketDefinitions fetches all aws object storage bucket definitions.
-// TODO: implement pagination
 func GetAwsObjectStorageBucketDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.AwsObjectStorageBucketDefinition, error) {
-	var awsObjectStorageBucketDefinitions []v0.AwsObjectStorageBucketDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsObjectStorageBucketDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsObjectStorageBucketDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsObjectStorageBucketDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsObjectStorageBucketDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsObjectStorageBucketDefinitions, nil
+	return GetAwsObjectStorageBucketDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsObjectStorageBucketDefinitionByID fetches a aws object storage bucket definition by ID.
@@ -809,6 +804,34 @@ func GetAwsObjectStorageBucketDefinitionsByQueryString(apiClient *http.Client, a
 	return &awsObjectStorageBucketDefinitions, nil
 }
 
+// GetAwsObjectStorageBucketDefinitionsWithOptions fetches all aws object storage bucket definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsObjectStorageBucketDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsObjectStorageBucketDefinition, error) {
+	var awsObjectStorageBucketDefinitions []v0.AwsObjectStorageBucketDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsObjectStorageBucketDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &awsObjectStorageBucketDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsObjectStorageBucketDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsObjectStorageBucketDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsObjectStorageBucketDefinitions, nil
+}
+
 // GetAwsObjectStorageBucketDefinitionByName fetches a aws object storage bucket definition by name.
 func GetAwsObjectStorageBucketDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsObjectStorageBucketDefinition, error) {
 	var awsObjectStorageBucketDefinitions []v0.AwsObjectStorageBucketDefinition
@@ -890,6 +913,7 @@ func UpdateAwsObjectStorageBucketDefinition(apiClient *http.Client, apiAddr stri
 	payloadAwsObjectStorageBucketDefinition.ID = nil
 	payloadAwsObjectStorageBucketDefinition.CreatedAt = nil
 	payloadAwsObjectStorageBucketDefinition.UpdatedAt = nil
+	payloadAwsObjectStorageBucketDefinition.ResourceVersion = nil
 
 	jsonAwsObjectStorageBucketDefinition, err := util.MarshalObject(payloadAwsObjectStorageBucketDefinition)
 	if err != nil {
@@ -901,7 +925,7 @@ func UpdateAwsObjectStorageBucketDefinition(apiClient *http.Client, apiAddr stri
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsObjectStorageBucketDefinitions, awsObjectStorageBucketDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsObjectStorageBucketDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsObjectStorageBucketDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -919,6 +943,10 @@ func UpdateAwsObjectStorageBucketDefinition(apiClient *http.Client, apiAddr stri
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsObjectStorageBucketDefinition.ResourceVersion = payloadAwsObjectStorageBucketDefinition.ResourceVersion
+
 	payloadAwsObjectStorageBucketDefinition.ID = &awsObjectStorageBucketDefinitionID
 	return &payloadAwsObjectStorageBucketDefinition, nil
 }
@@ -954,34 +982,8 @@ func DeleteAwsObjectStorageBucketDefinition(apiClient *http.Client, apiAddr stri
 }
 
 // GetAwsObjectStorageBucketInstances fetches all aws object storage bucket instances.
-// TODO: implement pagination
 func GetAwsObjectStorageBucketInstances(apiClient *http.Client, apiAddr string) (*[]v0.AwsObjectStorageBucketInstance, error) {
-	var awsObjectStorageBucketInstances []v0.AwsObjectStorageBucketInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsObjectStorageBucketInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsObjectStorageBucketInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsObjectStorageBucketInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsObjectStorageBucketInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsObjectStorageBucketInstances, nil
+	return GetAwsObjectStorageBucketInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsObjectStorageBucketInstanceByID fetches a aws object storage bucket instance by ID.
@@ -1044,6 +1046,34 @@ func GetAwsObjectStorageBucketInstancesByQueryString(apiClient *http.Client, api
 	return &awsObjectStorageBucketInstances, nil
 }
 
+// GetAwsObjectStorageBucketInstancesWithOptions fetches all aws object storage bucket instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsObjectStorageBucketInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsObjectStorageBucketInstance, error) {
+	var awsObjectStorageBucketInstances []v0.AwsObjectStorageBucketInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsObjectStorageBucketInstances),
+		options...,
+	)
+	if err != nil {
+		return &awsObjectStorageBucketInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsObjectStorageBucketInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsObjectStorageBucketInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsObjectStorageBucketInstances, nil
+}
+
 // GetAwsObjectStorageBucketInstanceByName fetches a aws object storage bucket instance by name.
 func GetAwsObjectStorageBucketInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsObjectStorageBucketInstance, error) {
 	var awsObjectStorageBucketInstances []v0.AwsObjectStorageBucketInstance
@@ -1125,6 +1155,7 @@ func UpdateAwsObjectStorageBucketInstance(apiClient *http.Client, apiAddr string
 	payloadAwsObjectStorageBucketInstance.ID = nil
 	payloadAwsObjectStorageBucketInstance.CreatedAt = nil
 	payloadAwsObjectStorageBucketInstance.UpdatedAt = nil
+	payloadAwsObjectStorageBucketInstance.ResourceVersion = nil
 
 	jsonAwsObjectStorageBucketInstance, err := util.MarshalObject(payloadAwsObjectStorageBucketInstance)
 	if err != nil {
@@ -1136,7 +1167,7 @@ func UpdateAwsObjectStorageBucketInstance(apiClient *http.Client, apiAddr string
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsObjectStorageBucketInstances, awsObjectStorageBucketInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsObjectStorageBucketInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsObjectStorageBucketInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1154,6 +1185,10 @@ func UpdateAwsObjectStorageBucketInstance(apiClient *http.Client, apiAddr string
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsObjectStorageBucketInstance.ResourceVersion = payloadAwsObjectStorageBucketInstance.ResourceVersion
+
 	payloadAwsObjectStorageBucketInstance.ID = &awsObjectStorageBucketInstanceID
 	return &payloadAwsObjectStorageBucketInstance, nil
 }
@@ -1189,34 +1224,8 @@ func DeleteAwsObjectStorageBucketInstance(apiClient *http.Client, apiAddr string
 }
 
 // GetAwsRelationalDatabaseDefinitions fetches all aws relational database definitions.
-// TODO: implement pagination
 func GetAwsRelationalDatabaseDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.AwsRelationalDatabaseDefinition, error) {
-	var awsRelationalDatabaseDefinitions []v0.AwsRelationalDatabaseDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsRelationalDatabaseDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsRelationalDatabaseDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsRelationalDatabaseDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsRelationalDatabaseDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsRelationalDatabaseDefinitions, nil
+	return GetAwsRelationalDatabaseDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsRelationalDatabaseDefinitionByID fetches a aws relational database definition by ID.
@@ -1279,6 +1288,34 @@ func GetAwsRelationalDatabaseDefinitionsByQueryString(apiClient *http.Client, ap
 	return &awsRelationalDatabaseDefinitions, nil
 }
 
+// GetAwsRelationalDatabaseDefinitionsWithOptions fetches all aws relational database definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsRelationalDatabaseDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsRelationalDatabaseDefinition, error) {
+	var awsRelationalDatabaseDefinitions []v0.AwsRelationalDatabaseDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsRelationalDatabaseDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &awsRelationalDatabaseDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsRelationalDatabaseDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsRelationalDatabaseDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsRelationalDatabaseDefinitions, nil
+}
+
 // GetAwsRelationalDatabaseDefinitionByName fetches a aws relational database definition by name.
 func GetAwsRelationalDatabaseDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsRelationalDatabaseDefinition, error) {
 	var awsRelationalDatabaseDefinitions []v0.AwsRelationalDatabaseDefinition
@@ -1360,6 +1397,7 @@ func UpdateAwsRelationalDatabaseDefinition(apiClient *http.Client, apiAddr strin
 	payloadAwsRelationalDatabaseDefinition.ID = nil
 	payloadAwsRelationalDatabaseDefinition.CreatedAt = nil
 	payloadAwsRelationalDatabaseDefinition.UpdatedAt = nil
+	payloadAwsRelationalDatabaseDefinition.ResourceVersion = nil
 
 	jsonAwsRelationalDatabaseDefinition, err := util.MarshalObject(payloadAwsRelationalDatabaseDefinition)
 	if err != nil {
@@ -1371,7 +1409,7 @@ func UpdateAwsRelationalDatabaseDefinition(apiClient *http.Client, apiAddr strin
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsRelationalDatabaseDefinitions, awsRelationalDatabaseDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsRelationalDatabaseDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsRelationalDatabaseDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1389,6 +1427,10 @@ func UpdateAwsRelationalDatabaseDefinition(apiClient *http.Client, apiAddr strin
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsRelationalDatabaseDefinition.ResourceVersion = payloadAwsRelationalDatabaseDefinition.ResourceVersion
+
 	payloadAwsRelationalDatabaseDefinition.ID = &awsRelationalDatabaseDefinitionID
 	return &payloadAwsRelationalDatabaseDefinition, nil
 }
@@ -1424,34 +1466,8 @@ func DeleteAwsRelationalDatabaseDefinition(apiClient *http.Client, apiAddr strin
 }
 
 // GetAwsRelationalDatabaseInstances fetches all aws relational database instances.
-// TODO: implement pagination
 func GetAwsRelationalDatabaseInstances(apiClient *http.Client, apiAddr string) (*[]v0.AwsRelationalDatabaseInstance, error) {
-	var awsRelationalDatabaseInstances []v0.AwsRelationalDatabaseInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsRelationalDatabaseInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &awsRelationalDatabaseInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &awsRelationalDatabaseInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&awsRelationalDatabaseInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &awsRelationalDatabaseInstances, nil
+	return GetAwsRelationalDatabaseInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetAwsRelationalDatabaseInstanceByID fetches a aws relational database instance by ID.
@@ -1514,6 +1530,34 @@ func GetAwsRelationalDatabaseInstancesByQueryString(apiClient *http.Client, apiA
 	return &awsRelationalDatabaseInstances, nil
 }
 
+// GetAwsRelationalDatabaseInstancesWithOptions fetches all aws relational database instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetAwsRelationalDatabaseInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.AwsRelationalDatabaseInstance, error) {
+	var awsRelationalDatabaseInstances []v0.AwsRelationalDatabaseInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathAwsRelationalDatabaseInstances),
+		options...,
+	)
+	if err != nil {
+		return &awsRelationalDatabaseInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &awsRelationalDatabaseInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&awsRelationalDatabaseInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &awsRelationalDatabaseInstances, nil
+}
+
 // GetAwsRelationalDatabaseInstanceByName fetches a aws relational database instance by name.
 func GetAwsRelationalDatabaseInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.AwsRelationalDatabaseInstance, error) {
 	var awsRelationalDatabaseInstances []v0.AwsRelationalDatabaseInstance
@@ -1595,6 +1639,7 @@ func UpdateAwsRelationalDatabaseInstance(apiClient *http.Client, apiAddr string,
 	payloadAwsRelationalDatabaseInstance.ID = nil
 	payloadAwsRelationalDatabaseInstance.CreatedAt = nil
 	payloadAwsRelationalDatabaseInstance.UpdatedAt = nil
+	payloadAwsRelationalDatabaseInstance.ResourceVersion = nil
 
 	jsonAwsRelationalDatabaseInstance, err := util.MarshalObject(payloadAwsRelationalDatabaseInstance)
 	if err != nil {
@@ -1606,7 +1651,7 @@ func UpdateAwsRelationalDatabaseInstance(apiClient *http.Client, apiAddr string,
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathAwsRelationalDatabaseInstances, awsRelationalDatabaseInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonAwsRelationalDatabaseInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(awsRelationalDatabaseInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1624,6 +1669,10 @@ func UpdateAwsRelationalDatabaseInstance(apiClient *http.Client, apiAddr string,
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	awsRelationalDatabaseInstance.ResourceVersion = payloadAwsRelationalDatabaseInstance.ResourceVersion
+
 	payloadAwsRelationalDatabaseInstance.ID = &awsRelationalDatabaseInstanceID
 	return &payloadAwsRelationalDatabaseInstance, nil
 }
@@ -0,0 +1,52 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+)
+
+// ExecuteBatch executes the operations in a batch in a single transaction.
+// If any operation fails, none of the changes are made and an error is
+// returned.
+func ExecuteBatch(
+	apiClient *http.Client,
+	apiAddr string,
+	batch *v0.Batch,
+) (*[]v0.BatchResult, error) {
+	var results []v0.BatchResult
+
+	jsonBatch, err := json.Marshal(batch)
+	if err != nil {
+		return &results, fmt.Errorf("failed to marshal provided object to JSON: %w", err)
+	}
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathBatch),
+		http.MethodPost,
+		bytes.NewBuffer(jsonBatch),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &results, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data)
+	if err != nil {
+		return &results, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &results, nil
+}
@@ -14,34 +14,8 @@ import (
 )
 
 // GetControlPlaneDefinitions fetches all control plane definitions.
-// TODO: implement pagination
 func GetControlPlaneDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.ControlPlaneDefinition, error) {
-	var controlPlaneDefinitions []v0.ControlPlaneDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathControlPlaneDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &controlPlaneDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &controlPlaneDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&controlPlaneDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &controlPlaneDefinitions, nil
+	return GetControlPlaneDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetControlPlaneDefinitionByID fetches a control plane definition by ID.
@@ -104,6 +78,34 @@ func GetControlPlaneDefinitionsByQueryString(apiClient *http.Client, apiAddr str
 	return &controlPlaneDefinitions, nil
 }
 
+// GetControlPlaneDefinitionsWithOptions fetches all control plane definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetControlPlaneDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ControlPlaneDefinition, error) {
+	var controlPlaneDefinitions []v0.ControlPlaneDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathControlPlaneDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &controlPlaneDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &controlPlaneDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&controlPlaneDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &controlPlaneDefinitions, nil
+}
+
 // GetControlPlaneDefinitionByName fetches a control plane definition by name.
 func GetControlPlaneDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.ControlPlaneDefinition, error) {
 	var controlPlaneDefinitions []v0.ControlPlaneDefinition
@@ -185,6 +187,7 @@ func UpdateControlPlaneDefinition(apiClient *http.Client, apiAddr string, contro
 	payloadControlPlaneDefinition.ID = nil
 	payloadControlPlaneDefinition.CreatedAt = nil
 	payloadControlPlaneDefinition.UpdatedAt = nil
+	payloadControlPlaneDefinition.ResourceVersion = nil
 
 	jsonControlPlaneDefinition, err := util.MarshalObject(payloadControlPlaneDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateControlPlaneDefinition(apiClient *http.Client, apiAddr string, contro
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathControlPlaneDefinitions, controlPlaneDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonControlPlaneDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(controlPlaneDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateControlPlaneDefinition(apiClient *http.Client, apiAddr string, contro
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	controlPlaneDefinition.ResourceVersion = payloadControlPlaneDefinition.ResourceVersion
+
 	payloadControlPlaneDefinition.ID = &controlPlaneDefinitionID
 	return &payloadControlPlaneDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteControlPlaneDefinition(apiClient *http.Client, apiAddr string, id uin
 }
 
 // GetControlPlaneInstances fetches all control plane instances.
-// TODO: implement pagination
 func GetControlPlaneInstances(apiClient *http.Client, apiAddr string) (*[]v0.ControlPlaneInstance, error) {
-	var controlPlaneInstances []v0.ControlPlaneInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathControlPlaneInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &controlPlaneInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &controlPlaneInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&controlPlaneInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &controlPlaneInstances, nil
+	return GetControlPlaneInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetControlPlaneInstanceByID fetches a control plane instance by ID.
@@ -339,6 +320,34 @@ func GetControlPlaneInstancesByQueryString(apiClient *http.Client, apiAddr strin
 	return &controlPlaneInstances, nil
 }
 
+// GetControlPlaneInstancesWithOptions fetches all control plane instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetControlPlaneInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ControlPlaneInstance, error) {
+	var controlPlaneInstances []v0.ControlPlaneInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathControlPlaneInstances),
+		options...,
+	)
+	if err != nil {
+		return &controlPlaneInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &controlPlaneInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&controlPlaneInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &controlPlaneInstances, nil
+}
+
 // GetControlPlaneInstanceByName fetches a control plane instance by name.
 func GetControlPlaneInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.ControlPlaneInstance, error) {
 	var controlPlaneInstances []v0.ControlPlaneInstance
@@ -420,6 +429,7 @@ func UpdateControlPlaneInstance(apiClient *http.Client, apiAddr string, controlP
 	payloadControlPlaneInstance.ID = nil
 	payloadControlPlaneInstance.CreatedAt = nil
 	payloadControlPlaneInstance.UpdatedAt = nil
+	payloadControlPlaneInstance.ResourceVersion = nil
 
 	jsonControlPlaneInstance, err := util.MarshalObject(payloadControlPlaneInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateControlPlaneInstance(apiClient *http.Client, apiAddr string, controlP
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathControlPlaneInstances, controlPlaneInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonControlPlaneInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(controlPlaneInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateControlPlaneInstance(apiClient *http.Client, apiAddr string, controlP
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	controlPlaneInstance.ResourceVersion = payloadControlPlaneInstance.ResourceVersion
+
 	payloadControlPlaneInstance.ID = &controlPlaneInstanceID
 	return &payloadControlPlaneInstance, nil
 }
@@ -16,6 +16,11 @@ func DeleteObjectByTypeAndID(apiClient *http.Client, apiAddr string, objectType
 			return fmt.Errorf("failed to delete AttachedObjectReference: %w", err)
 		}
 
+	case "v0.AuditRecord":
+		if _, err := DeleteAuditRecord(apiClient, apiAddr, id); err != nil {
+			return fmt.Errorf("failed to delete AuditRecord: %w", err)
+		}
+
 	case "v0.AwsAccount":
 		if _, err := DeleteAwsAccount(apiClient, apiAddr, id); err != nil {
 			return fmt.Errorf("failed to delete AwsAccount: %w", err)
@@ -186,6 +191,16 @@ func DeleteObjectByTypeAndID(apiClient *http.Client, apiAddr string, objectType
 			return fmt.Errorf("failed to delete Profile: %w", err)
 		}
 
+	case "v0.Role":
+		if _, err := DeleteRole(apiClient, apiAddr, id); err != nil {
+			return fmt.Errorf("failed to delete Role: %w", err)
+		}
+
+	case "v0.RoleBinding":
+		if _, err := DeleteRoleBinding(apiClient, apiAddr, id); err != nil {
+			return fmt.Errorf("failed to delete RoleBinding: %w", err)
+		}
+
 	case "v0.SecretDefinition":
 		if _, err := DeleteSecretDefinition(apiClient, apiAddr, id); err != nil {
 			return fmt.Errorf("failed to delete SecretDefinition: %w", err)
@@ -0,0 +1,77 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+)
+
+// GetDeletedObjects retrieves the soft-deleted objects of an object type that
+// have not yet been purged, e.g. objectType WorkloadInstance.
+func GetDeletedObjects(
+	apiClient *http.Client,
+	apiAddr string,
+	objectType string,
+) (*[]v0.DeletedObject, error) {
+	var deletedObjects []v0.DeletedObject
+
+	objects, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s/%s", apiAddr, v0.PathDeletedObjects, objectType),
+	)
+	if err != nil {
+		return &deletedObjects, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(objects)
+	if err != nil {
+		return &deletedObjects, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&deletedObjects); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &deletedObjects, nil
+}
+
+// RestoreDeletedObject restores a soft-deleted object of an object type by ID.
+func RestoreDeletedObject(
+	apiClient *http.Client,
+	apiAddr string,
+	objectType string,
+	id uint,
+) (*v0.DeletedObject, error) {
+	var restoredObject v0.DeletedObject
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%s/%d/restore", apiAddr, v0.PathDeletedObjects, objectType, id),
+		http.MethodPost,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &restoredObject, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &restoredObject, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&restoredObject); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &restoredObject, nil
+}
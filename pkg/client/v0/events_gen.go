@@ -14,34 +14,8 @@ import (
 )
 
 // GetEvents fetches all events.
-// TODO: implement pagination
 func GetEvents(apiClient *http.Client, apiAddr string) (*[]v0.Event, error) {
-	var events []v0.Event
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathEvents),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &events, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &events, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&events); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &events, nil
+	return GetEventsWithOptions(apiClient, apiAddr)
 }
 
 // GetEventByID fetches a event by ID.
@@ -104,6 +78,34 @@ func GetEventsByQueryString(apiClient *http.Client, apiAddr string, queryString
 	return &events, nil
 }
 
+// GetEventsWithOptions fetches all events using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetEventsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.Event, error) {
+	var events []v0.Event
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathEvents),
+		options...,
+	)
+	if err != nil {
+		return &events, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &events, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&events); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &events, nil
+}
+
 // GetEventByName fetches a event by name.
 func GetEventByName(apiClient *http.Client, apiAddr, name string) (*v0.Event, error) {
 	var events []v0.Event
@@ -185,6 +187,7 @@ func UpdateEvent(apiClient *http.Client, apiAddr string, event *v0.Event) (*v0.E
 	payloadEvent.ID = nil
 	payloadEvent.CreatedAt = nil
 	payloadEvent.UpdatedAt = nil
+	payloadEvent.ResourceVersion = nil
 
 	jsonEvent, err := util.MarshalObject(payloadEvent)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateEvent(apiClient *http.Client, apiAddr string, event *v0.Event) (*v0.E
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathEvents, eventID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonEvent),
-		map[string]string{},
+		client_lib.IfMatchHeader(event.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateEvent(apiClient *http.Client, apiAddr string, event *v0.Event) (*v0.E
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	event.ResourceVersion = payloadEvent.ResourceVersion
+
 	payloadEvent.ID = &eventID
 	return &payloadEvent, nil
 }
@@ -0,0 +1,76 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+)
+
+// GetFailedReconciliations retrieves the notifications that reconcilers gave
+// up on after exhausting their retry policies.
+func GetFailedReconciliations(
+	apiClient *http.Client,
+	apiAddr string,
+) (*[]v0.FailedReconciliation, error) {
+	var failedReconciliations []v0.FailedReconciliation
+
+	objects, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathFailedReconciliations),
+	)
+	if err != nil {
+		return &failedReconciliations, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(objects)
+	if err != nil {
+		return &failedReconciliations, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&failedReconciliations); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &failedReconciliations, nil
+}
+
+// RetryFailedReconciliation republishes the notification for a failed
+// reconciliation by ID so that its reconciler tries again.
+func RetryFailedReconciliation(
+	apiClient *http.Client,
+	apiAddr string,
+	id uint64,
+) (*v0.FailedReconciliation, error) {
+	var failedReconciliation v0.FailedReconciliation
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d/retry", apiAddr, v0.PathFailedReconciliations, id),
+		http.MethodPost,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &failedReconciliation, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &failedReconciliation, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&failedReconciliation); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &failedReconciliation, nil
+}
@@ -14,34 +14,8 @@ import (
 )
 
 // GetDomainNameDefinitions fetches all domain name definitions.
-// TODO: implement pagination
 func GetDomainNameDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.DomainNameDefinition, error) {
-	var domainNameDefinitions []v0.DomainNameDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathDomainNameDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &domainNameDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &domainNameDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&domainNameDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &domainNameDefinitions, nil
+	return GetDomainNameDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetDomainNameDefinitionByID fetches a domain name definition by ID.
@@ -104,6 +78,34 @@ func GetDomainNameDefinitionsByQueryString(apiClient *http.Client, apiAddr strin
 	return &domainNameDefinitions, nil
 }
 
+// GetDomainNameDefinitionsWithOptions fetches all domain name definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetDomainNameDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.DomainNameDefinition, error) {
+	var domainNameDefinitions []v0.DomainNameDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathDomainNameDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &domainNameDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &domainNameDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&domainNameDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &domainNameDefinitions, nil
+}
+
 // GetDomainNameDefinitionByName fetches a domain name definition by name.
 func GetDomainNameDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.DomainNameDefinition, error) {
 	var domainNameDefinitions []v0.DomainNameDefinition
@@ -185,6 +187,7 @@ func UpdateDomainNameDefinition(apiClient *http.Client, apiAddr string, domainNa
 	payloadDomainNameDefinition.ID = nil
 	payloadDomainNameDefinition.CreatedAt = nil
 	payloadDomainNameDefinition.UpdatedAt = nil
+	payloadDomainNameDefinition.ResourceVersion = nil
 
 	jsonDomainNameDefinition, err := util.MarshalObject(payloadDomainNameDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateDomainNameDefinition(apiClient *http.Client, apiAddr string, domainNa
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathDomainNameDefinitions, domainNameDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonDomainNameDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(domainNameDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateDomainNameDefinition(apiClient *http.Client, apiAddr string, domainNa
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	domainNameDefinition.ResourceVersion = payloadDomainNameDefinition.ResourceVersion
+
 	payloadDomainNameDefinition.ID = &domainNameDefinitionID
 	return &payloadDomainNameDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteDomainNameDefinition(apiClient *http.Client, apiAddr string, id uint)
 }
 
 // GetDomainNameInstances fetches all domain name instances.
-// TODO: implement pagination
 func GetDomainNameInstances(apiClient *http.Client, apiAddr string) (*[]v0.DomainNameInstance, error) {
-	var domainNameInstances []v0.DomainNameInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathDomainNameInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &domainNameInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &domainNameInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&domainNameInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &domainNameInstances, nil
+	return GetDomainNameInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetDomainNameInstanceByID fetches a domain name instance by ID.
@@ -339,6 +320,34 @@ func GetDomainNameInstancesByQueryString(apiClient *http.Client, apiAddr string,
 	return &domainNameInstances, nil
 }
 
+// GetDomainNameInstancesWithOptions fetches all domain name instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetDomainNameInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.DomainNameInstance, error) {
+	var domainNameInstances []v0.DomainNameInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathDomainNameInstances),
+		options...,
+	)
+	if err != nil {
+		return &domainNameInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &domainNameInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&domainNameInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &domainNameInstances, nil
+}
+
 // GetDomainNameInstanceByName fetches a domain name instance by name.
 func GetDomainNameInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.DomainNameInstance, error) {
 	var domainNameInstances []v0.DomainNameInstance
@@ -420,6 +429,7 @@ func UpdateDomainNameInstance(apiClient *http.Client, apiAddr string, domainName
 	payloadDomainNameInstance.ID = nil
 	payloadDomainNameInstance.CreatedAt = nil
 	payloadDomainNameInstance.UpdatedAt = nil
+	payloadDomainNameInstance.ResourceVersion = nil
 
 	jsonDomainNameInstance, err := util.MarshalObject(payloadDomainNameInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateDomainNameInstance(apiClient *http.Client, apiAddr string, domainName
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathDomainNameInstances, domainNameInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonDomainNameInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(domainNameInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateDomainNameInstance(apiClient *http.Client, apiAddr string, domainName
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	domainNameInstance.ResourceVersion = payloadDomainNameInstance.ResourceVersion
+
 	payloadDomainNameInstance.ID = &domainNameInstanceID
 	return &payloadDomainNameInstance, nil
 }
@@ -484,34 +498,8 @@ func DeleteDomainNameInstance(apiClient *http.Client, apiAddr string, id uint) (
 }
 
 // GetGatewayDefinitions fetches all gateway definitions.
-// TODO: implement pagination
 func GetGatewayDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.GatewayDefinition, error) {
-	var gatewayDefinitions []v0.GatewayDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &gatewayDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &gatewayDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&gatewayDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &gatewayDefinitions, nil
+	return GetGatewayDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetGatewayDefinitionByID fetches a gateway definition by ID.
@@ -574,6 +562,34 @@ func GetGatewayDefinitionsByQueryString(apiClient *http.Client, apiAddr string,
 	return &gatewayDefinitions, nil
 }
 
+// GetGatewayDefinitionsWithOptions fetches all gateway definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetGatewayDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.GatewayDefinition, error) {
+	var gatewayDefinitions []v0.GatewayDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &gatewayDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &gatewayDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&gatewayDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &gatewayDefinitions, nil
+}
+
 // GetGatewayDefinitionByName fetches a gateway definition by name.
 func GetGatewayDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.GatewayDefinition, error) {
 	var gatewayDefinitions []v0.GatewayDefinition
@@ -655,6 +671,7 @@ func UpdateGatewayDefinition(apiClient *http.Client, apiAddr string, gatewayDefi
 	payloadGatewayDefinition.ID = nil
 	payloadGatewayDefinition.CreatedAt = nil
 	payloadGatewayDefinition.UpdatedAt = nil
+	payloadGatewayDefinition.ResourceVersion = nil
 
 	jsonGatewayDefinition, err := util.MarshalObject(payloadGatewayDefinition)
 	if err != nil {
@@ -666,7 +683,7 @@ func UpdateGatewayDefinition(apiClient *http.Client, apiAddr string, gatewayDefi
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathGatewayDefinitions, gatewayDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonGatewayDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(gatewayDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -684,6 +701,10 @@ func UpdateGatewayDefinition(apiClient *http.Client, apiAddr string, gatewayDefi
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	gatewayDefinition.ResourceVersion = payloadGatewayDefinition.ResourceVersion
+
 	payloadGatewayDefinition.ID = &gatewayDefinitionID
 	return &payloadGatewayDefinition, nil
 }
@@ -719,34 +740,8 @@ func DeleteGatewayDefinition(apiClient *http.Client, apiAddr string, id uint) (*
 }
 
 // GetGatewayHttpPorts fetches all gateway http ports.
-// TODO: implement pagination
 func GetGatewayHttpPorts(apiClient *http.Client, apiAddr string) (*[]v0.GatewayHttpPort, error) {
-	var gatewayHttpPorts []v0.GatewayHttpPort
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayHttpPorts),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &gatewayHttpPorts, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &gatewayHttpPorts, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&gatewayHttpPorts); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &gatewayHttpPorts, nil
+	return GetGatewayHttpPortsWithOptions(apiClient, apiAddr)
 }
 
 // GetGatewayHttpPortByID fetches a gateway http port by ID.
@@ -809,6 +804,34 @@ func GetGatewayHttpPortsByQueryString(apiClient *http.Client, apiAddr string, qu
 	return &gatewayHttpPorts, nil
 }
 
+// GetGatewayHttpPortsWithOptions fetches all gateway http ports using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetGatewayHttpPortsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.GatewayHttpPort, error) {
+	var gatewayHttpPorts []v0.GatewayHttpPort
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayHttpPorts),
+		options...,
+	)
+	if err != nil {
+		return &gatewayHttpPorts, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &gatewayHttpPorts, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&gatewayHttpPorts); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &gatewayHttpPorts, nil
+}
+
 // GetGatewayHttpPortByName fetches a gateway http port by name.
 func GetGatewayHttpPortByName(apiClient *http.Client, apiAddr, name string) (*v0.GatewayHttpPort, error) {
 	var gatewayHttpPorts []v0.GatewayHttpPort
@@ -890,6 +913,7 @@ func UpdateGatewayHttpPort(apiClient *http.Client, apiAddr string, gatewayHttpPo
 	payloadGatewayHttpPort.ID = nil
 	payloadGatewayHttpPort.CreatedAt = nil
 	payloadGatewayHttpPort.UpdatedAt = nil
+	payloadGatewayHttpPort.ResourceVersion = nil
 
 	jsonGatewayHttpPort, err := util.MarshalObject(payloadGatewayHttpPort)
 	if err != nil {
@@ -901,7 +925,7 @@ func UpdateGatewayHttpPort(apiClient *http.Client, apiAddr string, gatewayHttpPo
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathGatewayHttpPorts, gatewayHttpPortID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonGatewayHttpPort),
-		map[string]string{},
+		client_lib.IfMatchHeader(gatewayHttpPort.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -919,6 +943,10 @@ func UpdateGatewayHttpPort(apiClient *http.Client, apiAddr string, gatewayHttpPo
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	gatewayHttpPort.ResourceVersion = payloadGatewayHttpPort.ResourceVersion
+
 	payloadGatewayHttpPort.ID = &gatewayHttpPortID
 	return &payloadGatewayHttpPort, nil
 }
@@ -954,34 +982,8 @@ func DeleteGatewayHttpPort(apiClient *http.Client, apiAddr string, id uint) (*v0
 }
 
 // GetGatewayInstances fetches all gateway instances.
-// TODO: implement pagination
 func GetGatewayInstances(apiClient *http.Client, apiAddr string) (*[]v0.GatewayInstance, error) {
-	var gatewayInstances []v0.GatewayInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &gatewayInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &gatewayInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&gatewayInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &gatewayInstances, nil
+	return GetGatewayInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetGatewayInstanceByID fetches a gateway instance by ID.
@@ -1044,6 +1046,34 @@ func GetGatewayInstancesByQueryString(apiClient *http.Client, apiAddr string, qu
 	return &gatewayInstances, nil
 }
 
+// GetGatewayInstancesWithOptions fetches all gateway instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetGatewayInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.GatewayInstance, error) {
+	var gatewayInstances []v0.GatewayInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayInstances),
+		options...,
+	)
+	if err != nil {
+		return &gatewayInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &gatewayInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&gatewayInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &gatewayInstances, nil
+}
+
 // GetGatewayInstanceByName fetches a gateway instance by name.
 func GetGatewayInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.GatewayInstance, error) {
 	var gatewayInstances []v0.GatewayInstance
@@ -1125,6 +1155,7 @@ func UpdateGatewayInstance(apiClient *http.Client, apiAddr string, gatewayInstan
 	payloadGatewayInstance.ID = nil
 	payloadGatewayInstance.CreatedAt = nil
 	payloadGatewayInstance.UpdatedAt = nil
+	payloadGatewayInstance.ResourceVersion = nil
 
 	jsonGatewayInstance, err := util.MarshalObject(payloadGatewayInstance)
 	if err != nil {
@@ -1136,7 +1167,7 @@ func UpdateGatewayInstance(apiClient *http.Client, apiAddr string, gatewayInstan
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathGatewayInstances, gatewayInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonGatewayInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(gatewayInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1154,6 +1185,10 @@ func UpdateGatewayInstance(apiClient *http.Client, apiAddr string, gatewayInstan
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	gatewayInstance.ResourceVersion = payloadGatewayInstance.ResourceVersion
+
 	payloadGatewayInstance.ID = &gatewayInstanceID
 	return &payloadGatewayInstance, nil
 }
@@ -1189,34 +1224,8 @@ func DeleteGatewayInstance(apiClient *http.Client, apiAddr string, id uint) (*v0
 }
 
 // GetGatewayTcpPorts fetches all gateway tcp ports.
-// TODO: implement pagination
 func GetGatewayTcpPorts(apiClient *http.Client, apiAddr string) (*[]v0.GatewayTcpPort, error) {
-	var gatewayTcpPorts []v0.GatewayTcpPort
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayTcpPorts),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &gatewayTcpPorts, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &gatewayTcpPorts, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&gatewayTcpPorts); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &gatewayTcpPorts, nil
+	return GetGatewayTcpPortsWithOptions(apiClient, apiAddr)
 }
 
 // GetGatewayTcpPortByID fetches a gateway tcp port by ID.
@@ -1279,6 +1288,34 @@ func GetGatewayTcpPortsByQueryString(apiClient *http.Client, apiAddr string, que
 	return &gatewayTcpPorts, nil
 }
 
+// GetGatewayTcpPortsWithOptions fetches all gateway tcp ports using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetGatewayTcpPortsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.GatewayTcpPort, error) {
+	var gatewayTcpPorts []v0.GatewayTcpPort
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathGatewayTcpPorts),
+		options...,
+	)
+	if err != nil {
+		return &gatewayTcpPorts, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &gatewayTcpPorts, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&gatewayTcpPorts); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &gatewayTcpPorts, nil
+}
+
 // GetGatewayTcpPortByName fetches a gateway tcp port by name.
 func GetGatewayTcpPortByName(apiClient *http.Client, apiAddr, name string) (*v0.GatewayTcpPort, error) {
 	var gatewayTcpPorts []v0.GatewayTcpPort
@@ -1360,6 +1397,7 @@ func UpdateGatewayTcpPort(apiClient *http.Client, apiAddr string, gatewayTcpPort
 	payloadGatewayTcpPort.ID = nil
 	payloadGatewayTcpPort.CreatedAt = nil
 	payloadGatewayTcpPort.UpdatedAt = nil
+	payloadGatewayTcpPort.ResourceVersion = nil
 
 	jsonGatewayTcpPort, err := util.MarshalObject(payloadGatewayTcpPort)
 	if err != nil {
@@ -1371,7 +1409,7 @@ func UpdateGatewayTcpPort(apiClient *http.Client, apiAddr string, gatewayTcpPort
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathGatewayTcpPorts, gatewayTcpPortID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonGatewayTcpPort),
-		map[string]string{},
+		client_lib.IfMatchHeader(gatewayTcpPort.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1389,6 +1427,10 @@ func UpdateGatewayTcpPort(apiClient *http.Client, apiAddr string, gatewayTcpPort
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	gatewayTcpPort.ResourceVersion = payloadGatewayTcpPort.ResourceVersion
+
 	payloadGatewayTcpPort.ID = &gatewayTcpPortID
 	return &payloadGatewayTcpPort, nil
 }
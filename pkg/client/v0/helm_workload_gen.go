@@ -14,34 +14,8 @@ import (
 )
 
 // GetHelmWorkloadDefinitions fetches all helm workload definitions.
-// TODO: implement pagination
 func GetHelmWorkloadDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.HelmWorkloadDefinition, error) {
-	var helmWorkloadDefinitions []v0.HelmWorkloadDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathHelmWorkloadDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &helmWorkloadDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &helmWorkloadDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&helmWorkloadDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &helmWorkloadDefinitions, nil
+	return GetHelmWorkloadDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetHelmWorkloadDefinitionByID fetches a helm workload definition by ID.
@@ -104,6 +78,34 @@ func GetHelmWorkloadDefinitionsByQueryString(apiClient *http.Client, apiAddr str
 	return &helmWorkloadDefinitions, nil
 }
 
+// GetHelmWorkloadDefinitionsWithOptions fetches all helm workload definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetHelmWorkloadDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.HelmWorkloadDefinition, error) {
+	var helmWorkloadDefinitions []v0.HelmWorkloadDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathHelmWorkloadDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &helmWorkloadDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &helmWorkloadDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&helmWorkloadDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &helmWorkloadDefinitions, nil
+}
+
 // GetHelmWorkloadDefinitionByName fetches a helm workload definition by name.
 func GetHelmWorkloadDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.HelmWorkloadDefinition, error) {
 	var helmWorkloadDefinitions []v0.HelmWorkloadDefinition
@@ -185,6 +187,7 @@ func UpdateHelmWorkloadDefinition(apiClient *http.Client, apiAddr string, helmWo
 	payloadHelmWorkloadDefinition.ID = nil
 	payloadHelmWorkloadDefinition.CreatedAt = nil
 	payloadHelmWorkloadDefinition.UpdatedAt = nil
+	payloadHelmWorkloadDefinition.ResourceVersion = nil
 
 	jsonHelmWorkloadDefinition, err := util.MarshalObject(payloadHelmWorkloadDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateHelmWorkloadDefinition(apiClient *http.Client, apiAddr string, helmWo
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathHelmWorkloadDefinitions, helmWorkloadDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonHelmWorkloadDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(helmWorkloadDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateHelmWorkloadDefinition(apiClient *http.Client, apiAddr string, helmWo
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	helmWorkloadDefinition.ResourceVersion = payloadHelmWorkloadDefinition.ResourceVersion
+
 	payloadHelmWorkloadDefinition.ID = &helmWorkloadDefinitionID
 	return &payloadHelmWorkloadDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteHelmWorkloadDefinition(apiClient *http.Client, apiAddr string, id uin
 }
 
 // GetHelmWorkloadInstances fetches all helm workload instances.
-// TODO: implement pagination
 func GetHelmWorkloadInstances(apiClient *http.Client, apiAddr string) (*[]v0.HelmWorkloadInstance, error) {
-	var helmWorkloadInstances []v0.HelmWorkloadInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathHelmWorkloadInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &helmWorkloadInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &helmWorkloadInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&helmWorkloadInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &helmWorkloadInstances, nil
+	return GetHelmWorkloadInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetHelmWorkloadInstanceByID fetches a helm workload instance by ID.
@@ -339,6 +320,34 @@ func GetHelmWorkloadInstancesByQueryString(apiClient *http.Client, apiAddr strin
 	return &helmWorkloadInstances, nil
 }
 
+// GetHelmWorkloadInstancesWithOptions fetches all helm workload instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetHelmWorkloadInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.HelmWorkloadInstance, error) {
+	var helmWorkloadInstances []v0.HelmWorkloadInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathHelmWorkloadInstances),
+		options...,
+	)
+	if err != nil {
+		return &helmWorkloadInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &helmWorkloadInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&helmWorkloadInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &helmWorkloadInstances, nil
+}
+
 // GetHelmWorkloadInstanceByName fetches a helm workload instance by name.
 func GetHelmWorkloadInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.HelmWorkloadInstance, error) {
 	var helmWorkloadInstances []v0.HelmWorkloadInstance
@@ -420,6 +429,7 @@ func UpdateHelmWorkloadInstance(apiClient *http.Client, apiAddr string, helmWork
 	payloadHelmWorkloadInstance.ID = nil
 	payloadHelmWorkloadInstance.CreatedAt = nil
 	payloadHelmWorkloadInstance.UpdatedAt = nil
+	payloadHelmWorkloadInstance.ResourceVersion = nil
 
 	jsonHelmWorkloadInstance, err := util.MarshalObject(payloadHelmWorkloadInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateHelmWorkloadInstance(apiClient *http.Client, apiAddr string, helmWork
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathHelmWorkloadInstances, helmWorkloadInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonHelmWorkloadInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(helmWorkloadInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateHelmWorkloadInstance(apiClient *http.Client, apiAddr string, helmWork
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	helmWorkloadInstance.ResourceVersion = payloadHelmWorkloadInstance.ResourceVersion
+
 	payloadHelmWorkloadInstance.ID = &helmWorkloadInstanceID
 	return &payloadHelmWorkloadInstance, nil
 }
@@ -14,34 +14,8 @@ import (
 )
 
 // GetKubernetesRuntimeDefinitions fetches all kubernetes runtime definitions.
-// TODO: implement pagination
 func GetKubernetesRuntimeDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.KubernetesRuntimeDefinition, error) {
-	var kubernetesRuntimeDefinitions []v0.KubernetesRuntimeDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathKubernetesRuntimeDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &kubernetesRuntimeDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &kubernetesRuntimeDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&kubernetesRuntimeDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &kubernetesRuntimeDefinitions, nil
+	return GetKubernetesRuntimeDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetKubernetesRuntimeDefinitionByID fetches a kubernetes runtime definition by ID.
@@ -104,6 +78,34 @@ func GetKubernetesRuntimeDefinitionsByQueryString(apiClient *http.Client, apiAdd
 	return &kubernetesRuntimeDefinitions, nil
 }
 
+// GetKubernetesRuntimeDefinitionsWithOptions fetches all kubernetes runtime definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetKubernetesRuntimeDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.KubernetesRuntimeDefinition, error) {
+	var kubernetesRuntimeDefinitions []v0.KubernetesRuntimeDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathKubernetesRuntimeDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &kubernetesRuntimeDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &kubernetesRuntimeDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&kubernetesRuntimeDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &kubernetesRuntimeDefinitions, nil
+}
+
 // GetKubernetesRuntimeDefinitionByName fetches a kubernetes runtime definition by name.
 func GetKubernetesRuntimeDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.KubernetesRuntimeDefinition, error) {
 	var kubernetesRuntimeDefinitions []v0.KubernetesRuntimeDefinition
@@ -185,6 +187,7 @@ func UpdateKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr string, k
 	payloadKubernetesRuntimeDefinition.ID = nil
 	payloadKubernetesRuntimeDefinition.CreatedAt = nil
 	payloadKubernetesRuntimeDefinition.UpdatedAt = nil
+	payloadKubernetesRuntimeDefinition.ResourceVersion = nil
 
 	jsonKubernetesRuntimeDefinition, err := util.MarshalObject(payloadKubernetesRuntimeDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr string, k
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathKubernetesRuntimeDefinitions, kubernetesRuntimeDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonKubernetesRuntimeDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(kubernetesRuntimeDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr string, k
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	kubernetesRuntimeDefinition.ResourceVersion = payloadKubernetesRuntimeDefinition.ResourceVersion
+
 	payloadKubernetesRuntimeDefinition.ID = &kubernetesRuntimeDefinitionID
 	return &payloadKubernetesRuntimeDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteKubernetesRuntimeDefinition(apiClient *http.Client, apiAddr string, i
 }
 
 // GetKubernetesRuntimeInstances fetches all kubernetes runtime instances.
-// TODO: implement pagination
 func GetKubernetesRuntimeInstances(apiClient *http.Client, apiAddr string) (*[]v0.KubernetesRuntimeInstance, error) {
-	var kubernetesRuntimeInstances []v0.KubernetesRuntimeInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathKubernetesRuntimeInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &kubernetesRuntimeInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &kubernetesRuntimeInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&kubernetesRuntimeInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &kubernetesRuntimeInstances, nil
+	return GetKubernetesRuntimeInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetKubernetesRuntimeInstanceByID fetches a kubernetes runtime instance by ID.
@@ -339,6 +320,34 @@ func GetKubernetesRuntimeInstancesByQueryString(apiClient *http.Client, apiAddr
 	return &kubernetesRuntimeInstances, nil
 }
 
+// GetKubernetesRuntimeInstancesWithOptions fetches all kubernetes runtime instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetKubernetesRuntimeInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.KubernetesRuntimeInstance, error) {
+	var kubernetesRuntimeInstances []v0.KubernetesRuntimeInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathKubernetesRuntimeInstances),
+		options...,
+	)
+	if err != nil {
+		return &kubernetesRuntimeInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &kubernetesRuntimeInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&kubernetesRuntimeInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &kubernetesRuntimeInstances, nil
+}
+
 // GetKubernetesRuntimeInstanceByName fetches a kubernetes runtime instance by name.
 func GetKubernetesRuntimeInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.KubernetesRuntimeInstance, error) {
 	var kubernetesRuntimeInstances []v0.KubernetesRuntimeInstance
@@ -420,6 +429,7 @@ func UpdateKubernetesRuntimeInstance(apiClient *http.Client, apiAddr string, kub
 	payloadKubernetesRuntimeInstance.ID = nil
 	payloadKubernetesRuntimeInstance.CreatedAt = nil
 	payloadKubernetesRuntimeInstance.UpdatedAt = nil
+	payloadKubernetesRuntimeInstance.ResourceVersion = nil
 
 	jsonKubernetesRuntimeInstance, err := util.MarshalObject(payloadKubernetesRuntimeInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateKubernetesRuntimeInstance(apiClient *http.Client, apiAddr string, kub
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathKubernetesRuntimeInstances, kubernetesRuntimeInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonKubernetesRuntimeInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(kubernetesRuntimeInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateKubernetesRuntimeInstance(apiClient *http.Client, apiAddr string, kub
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	kubernetesRuntimeInstance.ResourceVersion = payloadKubernetesRuntimeInstance.ResourceVersion
+
 	payloadKubernetesRuntimeInstance.ID = &kubernetesRuntimeInstanceID
 	return &payloadKubernetesRuntimeInstance, nil
 }
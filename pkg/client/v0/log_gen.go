@@ -14,34 +14,8 @@ import (
 )
 
 // GetLogBackends fetches all log backends.
-// TODO: implement pagination
 func GetLogBackends(apiClient *http.Client, apiAddr string) (*[]v0.LogBackend, error) {
-	var logBackends []v0.LogBackend
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathLogBackends),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &logBackends, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &logBackends, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&logBackends); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &logBackends, nil
+	return GetLogBackendsWithOptions(apiClient, apiAddr)
 }
 
 // GetLogBackendByID fetches a log backend by ID.
@@ -104,6 +78,34 @@ func GetLogBackendsByQueryString(apiClient *http.Client, apiAddr string, querySt
 	return &logBackends, nil
 }
 
+// GetLogBackendsWithOptions fetches all log backends using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetLogBackendsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.LogBackend, error) {
+	var logBackends []v0.LogBackend
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathLogBackends),
+		options...,
+	)
+	if err != nil {
+		return &logBackends, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &logBackends, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&logBackends); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &logBackends, nil
+}
+
 // GetLogBackendByName fetches a log backend by name.
 func GetLogBackendByName(apiClient *http.Client, apiAddr, name string) (*v0.LogBackend, error) {
 	var logBackends []v0.LogBackend
@@ -185,6 +187,7 @@ func UpdateLogBackend(apiClient *http.Client, apiAddr string, logBackend *v0.Log
 	payloadLogBackend.ID = nil
 	payloadLogBackend.CreatedAt = nil
 	payloadLogBackend.UpdatedAt = nil
+	payloadLogBackend.ResourceVersion = nil
 
 	jsonLogBackend, err := util.MarshalObject(payloadLogBackend)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateLogBackend(apiClient *http.Client, apiAddr string, logBackend *v0.Log
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathLogBackends, logBackendID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonLogBackend),
-		map[string]string{},
+		client_lib.IfMatchHeader(logBackend.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateLogBackend(apiClient *http.Client, apiAddr string, logBackend *v0.Log
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	logBackend.ResourceVersion = payloadLogBackend.ResourceVersion
+
 	payloadLogBackend.ID = &logBackendID
 	return &payloadLogBackend, nil
 }
@@ -249,34 +256,8 @@ func DeleteLogBackend(apiClient *http.Client, apiAddr string, id uint) (*v0.LogB
 }
 
 // GetLogStorageDefinitions fetches all log storage definitions.
-// TODO: implement pagination
 func GetLogStorageDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.LogStorageDefinition, error) {
-	var logStorageDefinitions []v0.LogStorageDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathLogStorageDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &logStorageDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &logStorageDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&logStorageDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &logStorageDefinitions, nil
+	return GetLogStorageDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetLogStorageDefinitionByID fetches a log storage definition by ID.
@@ -339,6 +320,34 @@ func GetLogStorageDefinitionsByQueryString(apiClient *http.Client, apiAddr strin
 	return &logStorageDefinitions, nil
 }
 
+// GetLogStorageDefinitionsWithOptions fetches all log storage definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetLogStorageDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.LogStorageDefinition, error) {
+	var logStorageDefinitions []v0.LogStorageDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathLogStorageDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &logStorageDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &logStorageDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&logStorageDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &logStorageDefinitions, nil
+}
+
 // GetLogStorageDefinitionByName fetches a log storage definition by name.
 func GetLogStorageDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.LogStorageDefinition, error) {
 	var logStorageDefinitions []v0.LogStorageDefinition
@@ -420,6 +429,7 @@ func UpdateLogStorageDefinition(apiClient *http.Client, apiAddr string, logStora
 	payloadLogStorageDefinition.ID = nil
 	payloadLogStorageDefinition.CreatedAt = nil
 	payloadLogStorageDefinition.UpdatedAt = nil
+	payloadLogStorageDefinition.ResourceVersion = nil
 
 	jsonLogStorageDefinition, err := util.MarshalObject(payloadLogStorageDefinition)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateLogStorageDefinition(apiClient *http.Client, apiAddr string, logStora
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathLogStorageDefinitions, logStorageDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonLogStorageDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(logStorageDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateLogStorageDefinition(apiClient *http.Client, apiAddr string, logStora
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	logStorageDefinition.ResourceVersion = payloadLogStorageDefinition.ResourceVersion
+
 	payloadLogStorageDefinition.ID = &logStorageDefinitionID
 	return &payloadLogStorageDefinition, nil
 }
@@ -484,34 +498,8 @@ func DeleteLogStorageDefinition(apiClient *http.Client, apiAddr string, id uint)
 }
 
 // GetLogStorageInstances fetches all log storage instances.
-// TODO: implement pagination
 func GetLogStorageInstances(apiClient *http.Client, apiAddr string) (*[]v0.LogStorageInstance, error) {
-	var logStorageInstances []v0.LogStorageInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathLogStorageInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &logStorageInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &logStorageInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&logStorageInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &logStorageInstances, nil
+	return GetLogStorageInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetLogStorageInstanceByID fetches a log storage instance by ID.
@@ -574,6 +562,34 @@ func GetLogStorageInstancesByQueryString(apiClient *http.Client, apiAddr string,
 	return &logStorageInstances, nil
 }
 
+// GetLogStorageInstancesWithOptions fetches all log storage instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetLogStorageInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.LogStorageInstance, error) {
+	var logStorageInstances []v0.LogStorageInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathLogStorageInstances),
+		options...,
+	)
+	if err != nil {
+		return &logStorageInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &logStorageInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&logStorageInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &logStorageInstances, nil
+}
+
 // GetLogStorageInstanceByName fetches a log storage instance by name.
 func GetLogStorageInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.LogStorageInstance, error) {
 	var logStorageInstances []v0.LogStorageInstance
@@ -655,6 +671,7 @@ func UpdateLogStorageInstance(apiClient *http.Client, apiAddr string, logStorage
 	payloadLogStorageInstance.ID = nil
 	payloadLogStorageInstance.CreatedAt = nil
 	payloadLogStorageInstance.UpdatedAt = nil
+	payloadLogStorageInstance.ResourceVersion = nil
 
 	jsonLogStorageInstance, err := util.MarshalObject(payloadLogStorageInstance)
 	if err != nil {
@@ -666,7 +683,7 @@ func UpdateLogStorageInstance(apiClient *http.Client, apiAddr string, logStorage
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathLogStorageInstances, logStorageInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonLogStorageInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(logStorageInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -684,6 +701,10 @@ func UpdateLogStorageInstance(apiClient *http.Client, apiAddr string, logStorage
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	logStorageInstance.ResourceVersion = payloadLogStorageInstance.ResourceVersion
+
 	payloadLogStorageInstance.ID = &logStorageInstanceID
 	return &payloadLogStorageInstance, nil
 }
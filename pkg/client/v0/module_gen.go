@@ -14,34 +14,8 @@ import (
 )
 
 // GetModuleApis fetches all module apis.
-// TODO: implement pagination
 func GetModuleApis(apiClient *http.Client, apiAddr string) (*[]v0.ModuleApi, error) {
-	var moduleApis []v0.ModuleApi
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathModuleApis),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &moduleApis, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &moduleApis, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&moduleApis); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &moduleApis, nil
+	return GetModuleApisWithOptions(apiClient, apiAddr)
 }
 
 // GetModuleApiByID fetches a module api by ID.
@@ -104,6 +78,34 @@ func GetModuleApisByQueryString(apiClient *http.Client, apiAddr string, queryStr
 	return &moduleApis, nil
 }
 
+// GetModuleApisWithOptions fetches all module apis using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetModuleApisWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ModuleApi, error) {
+	var moduleApis []v0.ModuleApi
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathModuleApis),
+		options...,
+	)
+	if err != nil {
+		return &moduleApis, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &moduleApis, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&moduleApis); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &moduleApis, nil
+}
+
 // GetModuleApiByName fetches a module api by name.
 func GetModuleApiByName(apiClient *http.Client, apiAddr, name string) (*v0.ModuleApi, error) {
 	var moduleApis []v0.ModuleApi
@@ -185,6 +187,7 @@ func UpdateModuleApi(apiClient *http.Client, apiAddr string, moduleApi *v0.Modul
 	payloadModuleApi.ID = nil
 	payloadModuleApi.CreatedAt = nil
 	payloadModuleApi.UpdatedAt = nil
+	payloadModuleApi.ResourceVersion = nil
 
 	jsonModuleApi, err := util.MarshalObject(payloadModuleApi)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateModuleApi(apiClient *http.Client, apiAddr string, moduleApi *v0.Modul
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathModuleApis, moduleApiID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonModuleApi),
-		map[string]string{},
+		client_lib.IfMatchHeader(moduleApi.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateModuleApi(apiClient *http.Client, apiAddr string, moduleApi *v0.Modul
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	moduleApi.ResourceVersion = payloadModuleApi.ResourceVersion
+
 	payloadModuleApi.ID = &moduleApiID
 	return &payloadModuleApi, nil
 }
@@ -249,34 +256,8 @@ func DeleteModuleApi(apiClient *http.Client, apiAddr string, id uint) (*v0.Modul
 }
 
 // GetModuleApiRoutes fetches all module api routes.
-// TODO: implement pagination
 func GetModuleApiRoutes(apiClient *http.Client, apiAddr string) (*[]v0.ModuleApiRoute, error) {
-	var moduleApiRoutes []v0.ModuleApiRoute
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathModuleApiRoutes),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &moduleApiRoutes, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &moduleApiRoutes, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&moduleApiRoutes); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &moduleApiRoutes, nil
+	return GetModuleApiRoutesWithOptions(apiClient, apiAddr)
 }
 
 // GetModuleApiRouteByID fetches a module api route by ID.
@@ -339,6 +320,34 @@ func GetModuleApiRoutesByQueryString(apiClient *http.Client, apiAddr string, que
 	return &moduleApiRoutes, nil
 }
 
+// GetModuleApiRoutesWithOptions fetches all module api routes using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetModuleApiRoutesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ModuleApiRoute, error) {
+	var moduleApiRoutes []v0.ModuleApiRoute
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathModuleApiRoutes),
+		options...,
+	)
+	if err != nil {
+		return &moduleApiRoutes, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &moduleApiRoutes, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&moduleApiRoutes); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &moduleApiRoutes, nil
+}
+
 // GetModuleApiRouteByName fetches a module api route by name.
 func GetModuleApiRouteByName(apiClient *http.Client, apiAddr, name string) (*v0.ModuleApiRoute, error) {
 	var moduleApiRoutes []v0.ModuleApiRoute
@@ -420,6 +429,7 @@ func UpdateModuleApiRoute(apiClient *http.Client, apiAddr string, moduleApiRoute
 	payloadModuleApiRoute.ID = nil
 	payloadModuleApiRoute.CreatedAt = nil
 	payloadModuleApiRoute.UpdatedAt = nil
+	payloadModuleApiRoute.ResourceVersion = nil
 
 	jsonModuleApiRoute, err := util.MarshalObject(payloadModuleApiRoute)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateModuleApiRoute(apiClient *http.Client, apiAddr string, moduleApiRoute
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathModuleApiRoutes, moduleApiRouteID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonModuleApiRoute),
-		map[string]string{},
+		client_lib.IfMatchHeader(moduleApiRoute.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateModuleApiRoute(apiClient *http.Client, apiAddr string, moduleApiRoute
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	moduleApiRoute.ResourceVersion = payloadModuleApiRoute.ResourceVersion
+
 	payloadModuleApiRoute.ID = &moduleApiRouteID
 	return &payloadModuleApiRoute, nil
 }
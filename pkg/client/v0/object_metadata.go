@@ -0,0 +1,166 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"gorm.io/datatypes"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+)
+
+// ObjectMetadata contains the fields common to all objects that identify an
+// object and describe it, e.g. its labels and annotations.
+type ObjectMetadata struct {
+	v0.Common
+	Name *string `json:"Name,omitempty"`
+}
+
+// GetObjectsMetadata retrieves the common fields and name of all objects at
+// an API path, e.g. path /v0/workload-definitions.
+func GetObjectsMetadata(
+	apiClient *http.Client,
+	apiAddr string,
+	path string,
+	options ...client_lib.ListOption,
+) (*[]ObjectMetadata, error) {
+	var objectsMetadata []ObjectMetadata
+
+	objects, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, path),
+		options...,
+	)
+	if err != nil {
+		return &objectsMetadata, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(objects)
+	if err != nil {
+		return &objectsMetadata, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&objectsMetadata); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &objectsMetadata, nil
+}
+
+// UpdateObjectMetadata replaces the labels and annotations of the object at
+// an API path with the given ID.
+func UpdateObjectMetadata(
+	apiClient *http.Client,
+	apiAddr string,
+	path string,
+	id uint,
+	labels *datatypes.JSONMap,
+	annotations *datatypes.JSONMap,
+) error {
+	jsonMetadata, err := json.Marshal(map[string]interface{}{
+		"Labels":      labels,
+		"Annotations": annotations,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal object metadata to JSON: %w", err)
+	}
+
+	if _, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, path, id),
+		http.MethodPatch,
+		bytes.NewBuffer(jsonMetadata),
+		map[string]string{},
+		http.StatusOK,
+	); err != nil {
+		return fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	return nil
+}
+
+// GetObjectMetadataByID retrieves the common fields and name of the object at
+// an API path with the given ID.
+func GetObjectMetadataByID(
+	apiClient *http.Client,
+	apiAddr string,
+	path string,
+	id uint,
+) (*ObjectMetadata, error) {
+	var objectMetadata ObjectMetadata
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%d", apiAddr, path, id),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &objectMetadata, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &objectMetadata, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&objectMetadata); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &objectMetadata, nil
+}
+
+// GetObjectByName retrieves the object at an API path with the given name and
+// decodes it into the provided object, e.g. a *v0.WorkloadDefinition or a
+// *map[string]interface{}.  The error wraps client_lib.ErrObjectNotFound if
+// there is no object with the name.
+func GetObjectByName(
+	apiClient *http.Client,
+	apiAddr string,
+	path string,
+	name string,
+	object interface{},
+) error {
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s?name=%s", apiAddr, path, url.QueryEscape(name)),
+		http.MethodGet,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	switch {
+	case len(response.Data) < 1:
+		return fmt.Errorf("%w: no object with name %s at %s", client_lib.ErrObjectNotFound, name, path)
+	case len(response.Data) > 1:
+		return fmt.Errorf("more than one object with name %s at %s returned", name, path)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(object); err != nil {
+		return fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return nil
+}
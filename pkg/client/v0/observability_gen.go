@@ -14,34 +14,8 @@ import (
 )
 
 // GetLoggingDefinitions fetches all logging definitions.
-// TODO: implement pagination
 func GetLoggingDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.LoggingDefinition, error) {
-	var loggingDefinitions []v0.LoggingDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathLoggingDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &loggingDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &loggingDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&loggingDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &loggingDefinitions, nil
+	return GetLoggingDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetLoggingDefinitionByID fetches a logging definition by ID.
@@ -104,6 +78,34 @@ func GetLoggingDefinitionsByQueryString(apiClient *http.Client, apiAddr string,
 	return &loggingDefinitions, nil
 }
 
+// GetLoggingDefinitionsWithOptions fetches all logging definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetLoggingDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.LoggingDefinition, error) {
+	var loggingDefinitions []v0.LoggingDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathLoggingDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &loggingDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &loggingDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&loggingDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &loggingDefinitions, nil
+}
+
 // GetLoggingDefinitionByName fetches a logging definition by name.
 func GetLoggingDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.LoggingDefinition, error) {
 	var loggingDefinitions []v0.LoggingDefinition
@@ -185,6 +187,7 @@ func UpdateLoggingDefinition(apiClient *http.Client, apiAddr string, loggingDefi
 	payloadLoggingDefinition.ID = nil
 	payloadLoggingDefinition.CreatedAt = nil
 	payloadLoggingDefinition.UpdatedAt = nil
+	payloadLoggingDefinition.ResourceVersion = nil
 
 	jsonLoggingDefinition, err := util.MarshalObject(payloadLoggingDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateLoggingDefinition(apiClient *http.Client, apiAddr string, loggingDefi
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathLoggingDefinitions, loggingDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonLoggingDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(loggingDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateLoggingDefinition(apiClient *http.Client, apiAddr string, loggingDefi
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	loggingDefinition.ResourceVersion = payloadLoggingDefinition.ResourceVersion
+
 	payloadLoggingDefinition.ID = &loggingDefinitionID
 	return &payloadLoggingDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteLoggingDefinition(apiClient *http.Client, apiAddr string, id uint) (*
 }
 
 // GetLoggingInstances fetches all logging instances.
-// TODO: implement pagination
 func GetLoggingInstances(apiClient *http.Client, apiAddr string) (*[]v0.LoggingInstance, error) {
-	var loggingInstances []v0.LoggingInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathLoggingInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &loggingInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &loggingInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&loggingInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &loggingInstances, nil
+	return GetLoggingInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetLoggingInstanceByID fetches a logging instance by ID.
@@ -339,6 +320,34 @@ func GetLoggingInstancesByQueryString(apiClient *http.Client, apiAddr string, qu
 	return &loggingInstances, nil
 }
 
+// GetLoggingInstancesWithOptions fetches all logging instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetLoggingInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.LoggingInstance, error) {
+	var loggingInstances []v0.LoggingInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathLoggingInstances),
+		options...,
+	)
+	if err != nil {
+		return &loggingInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &loggingInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&loggingInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &loggingInstances, nil
+}
+
 // GetLoggingInstanceByName fetches a logging instance by name.
 func GetLoggingInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.LoggingInstance, error) {
 	var loggingInstances []v0.LoggingInstance
@@ -420,6 +429,7 @@ func UpdateLoggingInstance(apiClient *http.Client, apiAddr string, loggingInstan
 	payloadLoggingInstance.ID = nil
 	payloadLoggingInstance.CreatedAt = nil
 	payloadLoggingInstance.UpdatedAt = nil
+	payloadLoggingInstance.ResourceVersion = nil
 
 	jsonLoggingInstance, err := util.MarshalObject(payloadLoggingInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateLoggingInstance(apiClient *http.Client, apiAddr string, loggingInstan
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathLoggingInstances, loggingInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonLoggingInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(loggingInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateLoggingInstance(apiClient *http.Client, apiAddr string, loggingInstan
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	loggingInstance.ResourceVersion = payloadLoggingInstance.ResourceVersion
+
 	payloadLoggingInstance.ID = &loggingInstanceID
 	return &payloadLoggingInstance, nil
 }
@@ -484,34 +498,8 @@ func DeleteLoggingInstance(apiClient *http.Client, apiAddr string, id uint) (*v0
 }
 
 // GetMetricsDefinitions fetches all metrics definitions.
-// TODO: implement pagination
 func GetMetricsDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.MetricsDefinition, error) {
-	var metricsDefinitions []v0.MetricsDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathMetricsDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &metricsDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &metricsDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&metricsDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &metricsDefinitions, nil
+	return GetMetricsDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetMetricsDefinitionByID fetches a metrics definition by ID.
@@ -574,6 +562,34 @@ func GetMetricsDefinitionsByQueryString(apiClient *http.Client, apiAddr string,
 	return &metricsDefinitions, nil
 }
 
+// GetMetricsDefinitionsWithOptions fetches all metrics definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetMetricsDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.MetricsDefinition, error) {
+	var metricsDefinitions []v0.MetricsDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathMetricsDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &metricsDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &metricsDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&metricsDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &metricsDefinitions, nil
+}
+
 // GetMetricsDefinitionByName fetches a metrics definition by name.
 func GetMetricsDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.MetricsDefinition, error) {
 	var metricsDefinitions []v0.MetricsDefinition
@@ -655,6 +671,7 @@ func UpdateMetricsDefinition(apiClient *http.Client, apiAddr string, metricsDefi
 	payloadMetricsDefinition.ID = nil
 	payloadMetricsDefinition.CreatedAt = nil
 	payloadMetricsDefinition.UpdatedAt = nil
+	payloadMetricsDefinition.ResourceVersion = nil
 
 	jsonMetricsDefinition, err := util.MarshalObject(payloadMetricsDefinition)
 	if err != nil {
@@ -666,7 +683,7 @@ func UpdateMetricsDefinition(apiClient *http.Client, apiAddr string, metricsDefi
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathMetricsDefinitions, metricsDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonMetricsDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(metricsDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -684,6 +701,10 @@ func UpdateMetricsDefinition(apiClient *http.Client, apiAddr string, metricsDefi
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	metricsDefinition.ResourceVersion = payloadMetricsDefinition.ResourceVersion
+
 	payloadMetricsDefinition.ID = &metricsDefinitionID
 	return &payloadMetricsDefinition, nil
 }
@@ -719,34 +740,8 @@ func DeleteMetricsDefinition(apiClient *http.Client, apiAddr string, id uint) (*
 }
 
 // GetMetricsInstances fetches all metrics instances.
-// TODO: implement pagination
 func GetMetricsInstances(apiClient *http.Client, apiAddr string) (*[]v0.MetricsInstance, error) {
-	var metricsInstances []v0.MetricsInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathMetricsInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &metricsInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &metricsInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&metricsInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &metricsInstances, nil
+	return GetMetricsInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetMetricsInstanceByID fetches a metrics instance by ID.
@@ -809,6 +804,34 @@ func GetMetricsInstancesByQueryString(apiClient *http.Client, apiAddr string, qu
 	return &metricsInstances, nil
 }
 
+// GetMetricsInstancesWithOptions fetches all metrics instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetMetricsInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.MetricsInstance, error) {
+	var metricsInstances []v0.MetricsInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathMetricsInstances),
+		options...,
+	)
+	if err != nil {
+		return &metricsInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &metricsInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&metricsInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &metricsInstances, nil
+}
+
 // GetMetricsInstanceByName fetches a metrics instance by name.
 func GetMetricsInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.MetricsInstance, error) {
 	var metricsInstances []v0.MetricsInstance
@@ -890,6 +913,7 @@ func UpdateMetricsInstance(apiClient *http.Client, apiAddr string, metricsInstan
 	payloadMetricsInstance.ID = nil
 	payloadMetricsInstance.CreatedAt = nil
 	payloadMetricsInstance.UpdatedAt = nil
+	payloadMetricsInstance.ResourceVersion = nil
 
 	jsonMetricsInstance, err := util.MarshalObject(payloadMetricsInstance)
 	if err != nil {
@@ -901,7 +925,7 @@ func UpdateMetricsInstance(apiClient *http.Client, apiAddr string, metricsInstan
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathMetricsInstances, metricsInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonMetricsInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(metricsInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -919,6 +943,10 @@ func UpdateMetricsInstance(apiClient *http.Client, apiAddr string, metricsInstan
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	metricsInstance.ResourceVersion = payloadMetricsInstance.ResourceVersion
+
 	payloadMetricsInstance.ID = &metricsInstanceID
 	return &payloadMetricsInstance, nil
 }
@@ -954,34 +982,8 @@ func DeleteMetricsInstance(apiClient *http.Client, apiAddr string, id uint) (*v0
 }
 
 // GetObservabilityDashboardDefinitions fetches all observability dashboard definitions.
-// TODO: implement pagination
 func GetObservabilityDashboardDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.ObservabilityDashboardDefinition, error) {
-	var observabilityDashboardDefinitions []v0.ObservabilityDashboardDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityDashboardDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &observabilityDashboardDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &observabilityDashboardDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&observabilityDashboardDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &observabilityDashboardDefinitions, nil
+	return GetObservabilityDashboardDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetObservabilityDashboardDefinitionByID fetches a observability dashboard definition by ID.
@@ -1044,6 +1046,34 @@ func GetObservabilityDashboardDefinitionsByQueryString(apiClient *http.Client, a
 	return &observabilityDashboardDefinitions, nil
 }
 
+// GetObservabilityDashboardDefinitionsWithOptions fetches all observability dashboard definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetObservabilityDashboardDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ObservabilityDashboardDefinition, error) {
+	var observabilityDashboardDefinitions []v0.ObservabilityDashboardDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityDashboardDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &observabilityDashboardDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &observabilityDashboardDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&observabilityDashboardDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &observabilityDashboardDefinitions, nil
+}
+
 // GetObservabilityDashboardDefinitionByName fetches a observability dashboard definition by name.
 func GetObservabilityDashboardDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.ObservabilityDashboardDefinition, error) {
 	var observabilityDashboardDefinitions []v0.ObservabilityDashboardDefinition
@@ -1125,6 +1155,7 @@ func UpdateObservabilityDashboardDefinition(apiClient *http.Client, apiAddr stri
 	payloadObservabilityDashboardDefinition.ID = nil
 	payloadObservabilityDashboardDefinition.CreatedAt = nil
 	payloadObservabilityDashboardDefinition.UpdatedAt = nil
+	payloadObservabilityDashboardDefinition.ResourceVersion = nil
 
 	jsonObservabilityDashboardDefinition, err := util.MarshalObject(payloadObservabilityDashboardDefinition)
 	if err != nil {
@@ -1136,7 +1167,7 @@ func UpdateObservabilityDashboardDefinition(apiClient *http.Client, apiAddr stri
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathObservabilityDashboardDefinitions, observabilityDashboardDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonObservabilityDashboardDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(observabilityDashboardDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1154,6 +1185,10 @@ func UpdateObservabilityDashboardDefinition(apiClient *http.Client, apiAddr stri
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	observabilityDashboardDefinition.ResourceVersion = payloadObservabilityDashboardDefinition.ResourceVersion
+
 	payloadObservabilityDashboardDefinition.ID = &observabilityDashboardDefinitionID
 	return &payloadObservabilityDashboardDefinition, nil
 }
@@ -1189,34 +1224,8 @@ func DeleteObservabilityDashboardDefinition(apiClient *http.Client, apiAddr stri
 }
 
 // GetObservabilityDashboardInstances fetches all observability dashboard instances.
-// TODO: implement pagination
 func GetObservabilityDashboardInstances(apiClient *http.Client, apiAddr string) (*[]v0.ObservabilityDashboardInstance, error) {
-	var observabilityDashboardInstances []v0.ObservabilityDashboardInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityDashboardInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &observabilityDashboardInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &observabilityDashboardInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&observabilityDashboardInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &observabilityDashboardInstances, nil
+	return GetObservabilityDashboardInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetObservabilityDashboardInstanceByID fetches a observability dashboard instance by ID.
@@ -1279,6 +1288,34 @@ func GetObservabilityDashboardInstancesByQueryString(apiClient *http.Client, api
 	return &observabilityDashboardInstances, nil
 }
 
+// GetObservabilityDashboardInstancesWithOptions fetches all observability dashboard instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetObservabilityDashboardInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ObservabilityDashboardInstance, error) {
+	var observabilityDashboardInstances []v0.ObservabilityDashboardInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityDashboardInstances),
+		options...,
+	)
+	if err != nil {
+		return &observabilityDashboardInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &observabilityDashboardInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&observabilityDashboardInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &observabilityDashboardInstances, nil
+}
+
 // GetObservabilityDashboardInstanceByName fetches a observability dashboard instance by name.
 func GetObservabilityDashboardInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.ObservabilityDashboardInstance, error) {
 	var observabilityDashboardInstances []v0.ObservabilityDashboardInstance
@@ -1360,6 +1397,7 @@ func UpdateObservabilityDashboardInstance(apiClient *http.Client, apiAddr string
 	payloadObservabilityDashboardInstance.ID = nil
 	payloadObservabilityDashboardInstance.CreatedAt = nil
 	payloadObservabilityDashboardInstance.UpdatedAt = nil
+	payloadObservabilityDashboardInstance.ResourceVersion = nil
 
 	jsonObservabilityDashboardInstance, err := util.MarshalObject(payloadObservabilityDashboardInstance)
 	if err != nil {
@@ -1371,7 +1409,7 @@ func UpdateObservabilityDashboardInstance(apiClient *http.Client, apiAddr string
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathObservabilityDashboardInstances, observabilityDashboardInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonObservabilityDashboardInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(observabilityDashboardInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1389,6 +1427,10 @@ func UpdateObservabilityDashboardInstance(apiClient *http.Client, apiAddr string
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	observabilityDashboardInstance.ResourceVersion = payloadObservabilityDashboardInstance.ResourceVersion
+
 	payloadObservabilityDashboardInstance.ID = &observabilityDashboardInstanceID
 	return &payloadObservabilityDashboardInstance, nil
 }
@@ -1424,34 +1466,8 @@ func DeleteObservabilityDashboardInstance(apiClient *http.Client, apiAddr string
 }
 
 // GetObservabilityStackDefinitions fetches all observability stack definitions.
-// TODO: implement pagination
 func GetObservabilityStackDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.ObservabilityStackDefinition, error) {
-	var observabilityStackDefinitions []v0.ObservabilityStackDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityStackDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &observabilityStackDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &observabilityStackDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&observabilityStackDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &observabilityStackDefinitions, nil
+	return GetObservabilityStackDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetObservabilityStackDefinitionByID fetches a observability stack definition by ID.
@@ -1514,6 +1530,34 @@ func GetObservabilityStackDefinitionsByQueryString(apiClient *http.Client, apiAd
 	return &observabilityStackDefinitions, nil
 }
 
+// GetObservabilityStackDefinitionsWithOptions fetches all observability stack definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetObservabilityStackDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ObservabilityStackDefinition, error) {
+	var observabilityStackDefinitions []v0.ObservabilityStackDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityStackDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &observabilityStackDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &observabilityStackDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&observabilityStackDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &observabilityStackDefinitions, nil
+}
+
 // GetObservabilityStackDefinitionByName fetches a observability stack definition by name.
 func GetObservabilityStackDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.ObservabilityStackDefinition, error) {
 	var observabilityStackDefinitions []v0.ObservabilityStackDefinition
@@ -1595,6 +1639,7 @@ func UpdateObservabilityStackDefinition(apiClient *http.Client, apiAddr string,
 	payloadObservabilityStackDefinition.ID = nil
 	payloadObservabilityStackDefinition.CreatedAt = nil
 	payloadObservabilityStackDefinition.UpdatedAt = nil
+	payloadObservabilityStackDefinition.ResourceVersion = nil
 
 	jsonObservabilityStackDefinition, err := util.MarshalObject(payloadObservabilityStackDefinition)
 	if err != nil {
@@ -1606,7 +1651,7 @@ func UpdateObservabilityStackDefinition(apiClient *http.Client, apiAddr string,
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathObservabilityStackDefinitions, observabilityStackDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonObservabilityStackDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(observabilityStackDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1624,6 +1669,10 @@ func UpdateObservabilityStackDefinition(apiClient *http.Client, apiAddr string,
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	observabilityStackDefinition.ResourceVersion = payloadObservabilityStackDefinition.ResourceVersion
+
 	payloadObservabilityStackDefinition.ID = &observabilityStackDefinitionID
 	return &payloadObservabilityStackDefinition, nil
 }
@@ -1659,34 +1708,8 @@ func DeleteObservabilityStackDefinition(apiClient *http.Client, apiAddr string,
 }
 
 // GetObservabilityStackInstances fetches all observability stack instances.
-// TODO: implement pagination
 func GetObservabilityStackInstances(apiClient *http.Client, apiAddr string) (*[]v0.ObservabilityStackInstance, error) {
-	var observabilityStackInstances []v0.ObservabilityStackInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityStackInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &observabilityStackInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &observabilityStackInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&observabilityStackInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &observabilityStackInstances, nil
+	return GetObservabilityStackInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetObservabilityStackInstanceByID fetches a observability stack instance by ID.
@@ -1749,6 +1772,34 @@ func GetObservabilityStackInstancesByQueryString(apiClient *http.Client, apiAddr
 	return &observabilityStackInstances, nil
 }
 
+// GetObservabilityStackInstancesWithOptions fetches all observability stack instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetObservabilityStackInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.ObservabilityStackInstance, error) {
+	var observabilityStackInstances []v0.ObservabilityStackInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathObservabilityStackInstances),
+		options...,
+	)
+	if err != nil {
+		return &observabilityStackInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &observabilityStackInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&observabilityStackInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &observabilityStackInstances, nil
+}
+
 // GetObservabilityStackInstanceByName fetches a observability stack instance by name.
 func GetObservabilityStackInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.ObservabilityStackInstance, error) {
 	var observabilityStackInstances []v0.ObservabilityStackInstance
@@ -1830,6 +1881,7 @@ func UpdateObservabilityStackInstance(apiClient *http.Client, apiAddr string, ob
 	payloadObservabilityStackInstance.ID = nil
 	payloadObservabilityStackInstance.CreatedAt = nil
 	payloadObservabilityStackInstance.UpdatedAt = nil
+	payloadObservabilityStackInstance.ResourceVersion = nil
 
 	jsonObservabilityStackInstance, err := util.MarshalObject(payloadObservabilityStackInstance)
 	if err != nil {
@@ -1841,7 +1893,7 @@ func UpdateObservabilityStackInstance(apiClient *http.Client, apiAddr string, ob
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathObservabilityStackInstances, observabilityStackInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonObservabilityStackInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(observabilityStackInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1859,6 +1911,10 @@ func UpdateObservabilityStackInstance(apiClient *http.Client, apiAddr string, ob
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	observabilityStackInstance.ResourceVersion = payloadObservabilityStackInstance.ResourceVersion
+
 	payloadObservabilityStackInstance.ID = &observabilityStackInstanceID
 	return &payloadObservabilityStackInstance, nil
 }
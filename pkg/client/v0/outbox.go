@@ -0,0 +1,41 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+)
+
+// GetOutboxNotificationsUndelivered retrieves the controller notifications
+// that have not yet been published by the API server.
+func GetOutboxNotificationsUndelivered(
+	apiClient *http.Client,
+	apiAddr string,
+) (*[]v0.OutboxNotification, error) {
+	var outboxNotifications []v0.OutboxNotification
+
+	objects, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathOutboxNotificationsUndelivered),
+	)
+	if err != nil {
+		return &outboxNotifications, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(objects)
+	if err != nil {
+		return &outboxNotifications, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&outboxNotifications); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &outboxNotifications, nil
+}
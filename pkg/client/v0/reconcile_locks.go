@@ -0,0 +1,78 @@
+package v0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+)
+
+// GetReconcileLocks retrieves the locks held by controllers on the objects
+// they are reconciling.
+func GetReconcileLocks(
+	apiClient *http.Client,
+	apiAddr string,
+) (*[]v0.ReconcileLock, error) {
+	var reconcileLocks []v0.ReconcileLock
+
+	objects, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathReconcileLocks),
+	)
+	if err != nil {
+		return &reconcileLocks, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(objects)
+	if err != nil {
+		return &reconcileLocks, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&reconcileLocks); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &reconcileLocks, nil
+}
+
+// DeleteReconcileLock forcibly releases the lock held by a reconciler on an
+// object, e.g. reconcilerName WorkloadInstanceReconciler.
+func DeleteReconcileLock(
+	apiClient *http.Client,
+	apiAddr string,
+	reconcilerName string,
+	objectID uint,
+) (*v0.ReconcileLock, error) {
+	var reconcileLock v0.ReconcileLock
+
+	response, err := client_lib.GetResponse(
+		apiClient,
+		fmt.Sprintf("%s%s/%s/%d", apiAddr, v0.PathReconcileLocks, url.PathEscape(reconcilerName), objectID),
+		http.MethodDelete,
+		new(bytes.Buffer),
+		map[string]string{},
+		http.StatusOK,
+	)
+	if err != nil {
+		return &reconcileLock, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
+	}
+
+	jsonData, err := json.Marshal(response.Data[0])
+	if err != nil {
+		return &reconcileLock, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&reconcileLock); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &reconcileLock, nil
+}
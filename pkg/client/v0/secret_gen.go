@@ -14,34 +14,8 @@ import (
 )
 
 // GetSecretDefinitions fetches all secret definitions.
-// TODO: implement pagination
 func GetSecretDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.SecretDefinition, error) {
-	var secretDefinitions []v0.SecretDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathSecretDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &secretDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &secretDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&secretDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &secretDefinitions, nil
+	return GetSecretDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetSecretDefinitionByID fetches a secret definition by ID.
@@ -104,6 +78,34 @@ func GetSecretDefinitionsByQueryString(apiClient *http.Client, apiAddr string, q
 	return &secretDefinitions, nil
 }
 
+// GetSecretDefinitionsWithOptions fetches all secret definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetSecretDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.SecretDefinition, error) {
+	var secretDefinitions []v0.SecretDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathSecretDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &secretDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &secretDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&secretDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &secretDefinitions, nil
+}
+
 // GetSecretDefinitionByName fetches a secret definition by name.
 func GetSecretDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.SecretDefinition, error) {
 	var secretDefinitions []v0.SecretDefinition
@@ -185,6 +187,7 @@ func UpdateSecretDefinition(apiClient *http.Client, apiAddr string, secretDefini
 	payloadSecretDefinition.ID = nil
 	payloadSecretDefinition.CreatedAt = nil
 	payloadSecretDefinition.UpdatedAt = nil
+	payloadSecretDefinition.ResourceVersion = nil
 
 	jsonSecretDefinition, err := util.MarshalObject(payloadSecretDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateSecretDefinition(apiClient *http.Client, apiAddr string, secretDefini
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathSecretDefinitions, secretDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonSecretDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(secretDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateSecretDefinition(apiClient *http.Client, apiAddr string, secretDefini
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	secretDefinition.ResourceVersion = payloadSecretDefinition.ResourceVersion
+
 	payloadSecretDefinition.ID = &secretDefinitionID
 	return &payloadSecretDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteSecretDefinition(apiClient *http.Client, apiAddr string, id uint) (*v
 }
 
 // GetSecretInstances fetches all secret instances.
-// TODO: implement pagination
 func GetSecretInstances(apiClient *http.Client, apiAddr string) (*[]v0.SecretInstance, error) {
-	var secretInstances []v0.SecretInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathSecretInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &secretInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &secretInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&secretInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &secretInstances, nil
+	return GetSecretInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetSecretInstanceByID fetches a secret instance by ID.
@@ -339,6 +320,34 @@ func GetSecretInstancesByQueryString(apiClient *http.Client, apiAddr string, que
 	return &secretInstances, nil
 }
 
+// GetSecretInstancesWithOptions fetches all secret instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetSecretInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.SecretInstance, error) {
+	var secretInstances []v0.SecretInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathSecretInstances),
+		options...,
+	)
+	if err != nil {
+		return &secretInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &secretInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&secretInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &secretInstances, nil
+}
+
 // GetSecretInstanceByName fetches a secret instance by name.
 func GetSecretInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.SecretInstance, error) {
 	var secretInstances []v0.SecretInstance
@@ -420,6 +429,7 @@ func UpdateSecretInstance(apiClient *http.Client, apiAddr string, secretInstance
 	payloadSecretInstance.ID = nil
 	payloadSecretInstance.CreatedAt = nil
 	payloadSecretInstance.UpdatedAt = nil
+	payloadSecretInstance.ResourceVersion = nil
 
 	jsonSecretInstance, err := util.MarshalObject(payloadSecretInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateSecretInstance(apiClient *http.Client, apiAddr string, secretInstance
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathSecretInstances, secretInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonSecretInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(secretInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateSecretInstance(apiClient *http.Client, apiAddr string, secretInstance
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	secretInstance.ResourceVersion = payloadSecretInstance.ResourceVersion
+
 	payloadSecretInstance.ID = &secretInstanceID
 	return &payloadSecretInstance, nil
 }
@@ -14,34 +14,8 @@ import (
 )
 
 // GetTerraformDefinitions fetches all terraform definitions.
-// TODO: implement pagination
 func GetTerraformDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.TerraformDefinition, error) {
-	var terraformDefinitions []v0.TerraformDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathTerraformDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &terraformDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &terraformDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&terraformDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &terraformDefinitions, nil
+	return GetTerraformDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetTerraformDefinitionByID fetches a terraform definition by ID.
@@ -104,6 +78,34 @@ func GetTerraformDefinitionsByQueryString(apiClient *http.Client, apiAddr string
 	return &terraformDefinitions, nil
 }
 
+// GetTerraformDefinitionsWithOptions fetches all terraform definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetTerraformDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.TerraformDefinition, error) {
+	var terraformDefinitions []v0.TerraformDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathTerraformDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &terraformDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &terraformDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&terraformDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &terraformDefinitions, nil
+}
+
 // GetTerraformDefinitionByName fetches a terraform definition by name.
 func GetTerraformDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.TerraformDefinition, error) {
 	var terraformDefinitions []v0.TerraformDefinition
@@ -185,6 +187,7 @@ func UpdateTerraformDefinition(apiClient *http.Client, apiAddr string, terraform
 	payloadTerraformDefinition.ID = nil
 	payloadTerraformDefinition.CreatedAt = nil
 	payloadTerraformDefinition.UpdatedAt = nil
+	payloadTerraformDefinition.ResourceVersion = nil
 
 	jsonTerraformDefinition, err := util.MarshalObject(payloadTerraformDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateTerraformDefinition(apiClient *http.Client, apiAddr string, terraform
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathTerraformDefinitions, terraformDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonTerraformDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(terraformDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateTerraformDefinition(apiClient *http.Client, apiAddr string, terraform
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	terraformDefinition.ResourceVersion = payloadTerraformDefinition.ResourceVersion
+
 	payloadTerraformDefinition.ID = &terraformDefinitionID
 	return &payloadTerraformDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteTerraformDefinition(apiClient *http.Client, apiAddr string, id uint)
 }
 
 // GetTerraformInstances fetches all terraform instances.
-// TODO: implement pagination
 func GetTerraformInstances(apiClient *http.Client, apiAddr string) (*[]v0.TerraformInstance, error) {
-	var terraformInstances []v0.TerraformInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathTerraformInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &terraformInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &terraformInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&terraformInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &terraformInstances, nil
+	return GetTerraformInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetTerraformInstanceByID fetches a terraform instance by ID.
@@ -339,6 +320,34 @@ func GetTerraformInstancesByQueryString(apiClient *http.Client, apiAddr string,
 	return &terraformInstances, nil
 }
 
+// GetTerraformInstancesWithOptions fetches all terraform instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetTerraformInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.TerraformInstance, error) {
+	var terraformInstances []v0.TerraformInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathTerraformInstances),
+		options...,
+	)
+	if err != nil {
+		return &terraformInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &terraformInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&terraformInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &terraformInstances, nil
+}
+
 // GetTerraformInstanceByName fetches a terraform instance by name.
 func GetTerraformInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.TerraformInstance, error) {
 	var terraformInstances []v0.TerraformInstance
@@ -420,6 +429,7 @@ func UpdateTerraformInstance(apiClient *http.Client, apiAddr string, terraformIn
 	payloadTerraformInstance.ID = nil
 	payloadTerraformInstance.CreatedAt = nil
 	payloadTerraformInstance.UpdatedAt = nil
+	payloadTerraformInstance.ResourceVersion = nil
 
 	jsonTerraformInstance, err := util.MarshalObject(payloadTerraformInstance)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateTerraformInstance(apiClient *http.Client, apiAddr string, terraformIn
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathTerraformInstances, terraformInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonTerraformInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(terraformInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateTerraformInstance(apiClient *http.Client, apiAddr string, terraformIn
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	terraformInstance.ResourceVersion = payloadTerraformInstance.ResourceVersion
+
 	payloadTerraformInstance.ID = &terraformInstanceID
 	return &payloadTerraformInstance, nil
 }
@@ -14,34 +14,8 @@ import (
 )
 
 // GetWorkloadDefinitions fetches all workload definitions.
-// TODO: implement pagination
 func GetWorkloadDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.WorkloadDefinition, error) {
-	var workloadDefinitions []v0.WorkloadDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &workloadDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &workloadDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&workloadDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &workloadDefinitions, nil
+	return GetWorkloadDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetWorkloadDefinitionByID fetches a workload definition by ID.
@@ -104,6 +78,34 @@ func GetWorkloadDefinitionsByQueryString(apiClient *http.Client, apiAddr string,
 	return &workloadDefinitions, nil
 }
 
+// GetWorkloadDefinitionsWithOptions fetches all workload definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetWorkloadDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.WorkloadDefinition, error) {
+	var workloadDefinitions []v0.WorkloadDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &workloadDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &workloadDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&workloadDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &workloadDefinitions, nil
+}
+
 // GetWorkloadDefinitionByName fetches a workload definition by name.
 func GetWorkloadDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.WorkloadDefinition, error) {
 	var workloadDefinitions []v0.WorkloadDefinition
@@ -185,6 +187,7 @@ func UpdateWorkloadDefinition(apiClient *http.Client, apiAddr string, workloadDe
 	payloadWorkloadDefinition.ID = nil
 	payloadWorkloadDefinition.CreatedAt = nil
 	payloadWorkloadDefinition.UpdatedAt = nil
+	payloadWorkloadDefinition.ResourceVersion = nil
 
 	jsonWorkloadDefinition, err := util.MarshalObject(payloadWorkloadDefinition)
 	if err != nil {
@@ -196,7 +199,7 @@ func UpdateWorkloadDefinition(apiClient *http.Client, apiAddr string, workloadDe
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathWorkloadDefinitions, workloadDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonWorkloadDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(workloadDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -214,6 +217,10 @@ func UpdateWorkloadDefinition(apiClient *http.Client, apiAddr string, workloadDe
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	workloadDefinition.ResourceVersion = payloadWorkloadDefinition.ResourceVersion
+
 	payloadWorkloadDefinition.ID = &workloadDefinitionID
 	return &payloadWorkloadDefinition, nil
 }
@@ -249,34 +256,8 @@ func DeleteWorkloadDefinition(apiClient *http.Client, apiAddr string, id uint) (
 }
 
 // GetWorkloadEvents fetches all workload events.
-// TODO: implement pagination
 func GetWorkloadEvents(apiClient *http.Client, apiAddr string) (*[]v0.WorkloadEvent, error) {
-	var workloadEvents []v0.WorkloadEvent
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadEvents),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &workloadEvents, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &workloadEvents, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&workloadEvents); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &workloadEvents, nil
+	return GetWorkloadEventsWithOptions(apiClient, apiAddr)
 }
 
 // GetWorkloadEventByID fetches a workload event by ID.
@@ -339,6 +320,34 @@ func GetWorkloadEventsByQueryString(apiClient *http.Client, apiAddr string, quer
 	return &workloadEvents, nil
 }
 
+// GetWorkloadEventsWithOptions fetches all workload events using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetWorkloadEventsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.WorkloadEvent, error) {
+	var workloadEvents []v0.WorkloadEvent
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadEvents),
+		options...,
+	)
+	if err != nil {
+		return &workloadEvents, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &workloadEvents, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&workloadEvents); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &workloadEvents, nil
+}
+
 // GetWorkloadEventByName fetches a workload event by name.
 func GetWorkloadEventByName(apiClient *http.Client, apiAddr, name string) (*v0.WorkloadEvent, error) {
 	var workloadEvents []v0.WorkloadEvent
@@ -420,6 +429,7 @@ func UpdateWorkloadEvent(apiClient *http.Client, apiAddr string, workloadEvent *
 	payloadWorkloadEvent.ID = nil
 	payloadWorkloadEvent.CreatedAt = nil
 	payloadWorkloadEvent.UpdatedAt = nil
+	payloadWorkloadEvent.ResourceVersion = nil
 
 	jsonWorkloadEvent, err := util.MarshalObject(payloadWorkloadEvent)
 	if err != nil {
@@ -431,7 +441,7 @@ func UpdateWorkloadEvent(apiClient *http.Client, apiAddr string, workloadEvent *
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathWorkloadEvents, workloadEventID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonWorkloadEvent),
-		map[string]string{},
+		client_lib.IfMatchHeader(workloadEvent.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -449,6 +459,10 @@ func UpdateWorkloadEvent(apiClient *http.Client, apiAddr string, workloadEvent *
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	workloadEvent.ResourceVersion = payloadWorkloadEvent.ResourceVersion
+
 	payloadWorkloadEvent.ID = &workloadEventID
 	return &payloadWorkloadEvent, nil
 }
@@ -484,34 +498,8 @@ func DeleteWorkloadEvent(apiClient *http.Client, apiAddr string, id uint) (*v0.W
 }
 
 // GetWorkloadInstances fetches all workload instances.
-// TODO: implement pagination
 func GetWorkloadInstances(apiClient *http.Client, apiAddr string) (*[]v0.WorkloadInstance, error) {
-	var workloadInstances []v0.WorkloadInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &workloadInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &workloadInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&workloadInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &workloadInstances, nil
+	return GetWorkloadInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetWorkloadInstanceByID fetches a workload instance by ID.
@@ -574,6 +562,34 @@ func GetWorkloadInstancesByQueryString(apiClient *http.Client, apiAddr string, q
 	return &workloadInstances, nil
 }
 
+// GetWorkloadInstancesWithOptions fetches all workload instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetWorkloadInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.WorkloadInstance, error) {
+	var workloadInstances []v0.WorkloadInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadInstances),
+		options...,
+	)
+	if err != nil {
+		return &workloadInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &workloadInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&workloadInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &workloadInstances, nil
+}
+
 // GetWorkloadInstanceByName fetches a workload instance by name.
 func GetWorkloadInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.WorkloadInstance, error) {
 	var workloadInstances []v0.WorkloadInstance
@@ -655,6 +671,7 @@ func UpdateWorkloadInstance(apiClient *http.Client, apiAddr string, workloadInst
 	payloadWorkloadInstance.ID = nil
 	payloadWorkloadInstance.CreatedAt = nil
 	payloadWorkloadInstance.UpdatedAt = nil
+	payloadWorkloadInstance.ResourceVersion = nil
 
 	jsonWorkloadInstance, err := util.MarshalObject(payloadWorkloadInstance)
 	if err != nil {
@@ -666,7 +683,7 @@ func UpdateWorkloadInstance(apiClient *http.Client, apiAddr string, workloadInst
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathWorkloadInstances, workloadInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonWorkloadInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(workloadInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -684,6 +701,10 @@ func UpdateWorkloadInstance(apiClient *http.Client, apiAddr string, workloadInst
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	workloadInstance.ResourceVersion = payloadWorkloadInstance.ResourceVersion
+
 	payloadWorkloadInstance.ID = &workloadInstanceID
 	return &payloadWorkloadInstance, nil
 }
@@ -719,34 +740,8 @@ func DeleteWorkloadInstance(apiClient *http.Client, apiAddr string, id uint) (*v
 }
 
 // GetWorkloadResourceDefinitions fetches all workload resource definitions.
-// TODO: implement pagination
 func GetWorkloadResourceDefinitions(apiClient *http.Client, apiAddr string) (*[]v0.WorkloadResourceDefinition, error) {
-	var workloadResourceDefinitions []v0.WorkloadResourceDefinition
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadResourceDefinitions),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &workloadResourceDefinitions, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &workloadResourceDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&workloadResourceDefinitions); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &workloadResourceDefinitions, nil
+	return GetWorkloadResourceDefinitionsWithOptions(apiClient, apiAddr)
 }
 
 // GetWorkloadResourceDefinitionByID fetches a workload resource definition by ID.
@@ -809,6 +804,34 @@ func GetWorkloadResourceDefinitionsByQueryString(apiClient *http.Client, apiAddr
 	return &workloadResourceDefinitions, nil
 }
 
+// GetWorkloadResourceDefinitionsWithOptions fetches all workload resource definitions using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetWorkloadResourceDefinitionsWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.WorkloadResourceDefinition, error) {
+	var workloadResourceDefinitions []v0.WorkloadResourceDefinition
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadResourceDefinitions),
+		options...,
+	)
+	if err != nil {
+		return &workloadResourceDefinitions, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &workloadResourceDefinitions, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&workloadResourceDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &workloadResourceDefinitions, nil
+}
+
 // GetWorkloadResourceDefinitionByName fetches a workload resource definition by name.
 func GetWorkloadResourceDefinitionByName(apiClient *http.Client, apiAddr, name string) (*v0.WorkloadResourceDefinition, error) {
 	var workloadResourceDefinitions []v0.WorkloadResourceDefinition
@@ -890,6 +913,7 @@ func UpdateWorkloadResourceDefinition(apiClient *http.Client, apiAddr string, wo
 	payloadWorkloadResourceDefinition.ID = nil
 	payloadWorkloadResourceDefinition.CreatedAt = nil
 	payloadWorkloadResourceDefinition.UpdatedAt = nil
+	payloadWorkloadResourceDefinition.ResourceVersion = nil
 
 	jsonWorkloadResourceDefinition, err := util.MarshalObject(payloadWorkloadResourceDefinition)
 	if err != nil {
@@ -901,7 +925,7 @@ func UpdateWorkloadResourceDefinition(apiClient *http.Client, apiAddr string, wo
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathWorkloadResourceDefinitions, workloadResourceDefinitionID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonWorkloadResourceDefinition),
-		map[string]string{},
+		client_lib.IfMatchHeader(workloadResourceDefinition.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -919,6 +943,10 @@ func UpdateWorkloadResourceDefinition(apiClient *http.Client, apiAddr string, wo
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	workloadResourceDefinition.ResourceVersion = payloadWorkloadResourceDefinition.ResourceVersion
+
 	payloadWorkloadResourceDefinition.ID = &workloadResourceDefinitionID
 	return &payloadWorkloadResourceDefinition, nil
 }
@@ -954,34 +982,8 @@ func DeleteWorkloadResourceDefinition(apiClient *http.Client, apiAddr string, id
 }
 
 // GetWorkloadResourceInstances fetches all workload resource instances.
-// TODO: implement pagination
 func GetWorkloadResourceInstances(apiClient *http.Client, apiAddr string) (*[]v0.WorkloadResourceInstance, error) {
-	var workloadResourceInstances []v0.WorkloadResourceInstance
-
-	response, err := client_lib.GetResponse(
-		apiClient,
-		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadResourceInstances),
-		http.MethodGet,
-		new(bytes.Buffer),
-		map[string]string{},
-		http.StatusOK,
-	)
-	if err != nil {
-		return &workloadResourceInstances, fmt.Errorf("call to threeport API returned unexpected response: %w", err)
-	}
-
-	jsonData, err := json.Marshal(response.Data)
-	if err != nil {
-		return &workloadResourceInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
-	}
-
-	decoder := json.NewDecoder(bytes.NewReader(jsonData))
-	decoder.UseNumber()
-	if err := decoder.Decode(&workloadResourceInstances); err != nil {
-		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
-	}
-
-	return &workloadResourceInstances, nil
+	return GetWorkloadResourceInstancesWithOptions(apiClient, apiAddr)
 }
 
 // GetWorkloadResourceInstanceByID fetches a workload resource instance by ID.
@@ -1044,6 +1046,34 @@ func GetWorkloadResourceInstancesByQueryString(apiClient *http.Client, apiAddr s
 	return &workloadResourceInstances, nil
 }
 
+// GetWorkloadResourceInstancesWithOptions fetches all workload resource instances using the provided sorting, field
+// and filter options.  Every page of objects is fetched.
+func GetWorkloadResourceInstancesWithOptions(apiClient *http.Client, apiAddr string, options ...client_lib.ListOption) (*[]v0.WorkloadResourceInstance, error) {
+	var workloadResourceInstances []v0.WorkloadResourceInstance
+
+	data, err := client_lib.GetAllPages(
+		apiClient,
+		fmt.Sprintf("%s%s", apiAddr, v0.PathWorkloadResourceInstances),
+		options...,
+	)
+	if err != nil {
+		return &workloadResourceInstances, err
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return &workloadResourceInstances, fmt.Errorf("failed to marshal response data from threeport API: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&workloadResourceInstances); err != nil {
+		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
+	}
+
+	return &workloadResourceInstances, nil
+}
+
 // GetWorkloadResourceInstanceByName fetches a workload resource instance by name.
 func GetWorkloadResourceInstanceByName(apiClient *http.Client, apiAddr, name string) (*v0.WorkloadResourceInstance, error) {
 	var workloadResourceInstances []v0.WorkloadResourceInstance
@@ -1125,6 +1155,7 @@ func UpdateWorkloadResourceInstance(apiClient *http.Client, apiAddr string, work
 	payloadWorkloadResourceInstance.ID = nil
 	payloadWorkloadResourceInstance.CreatedAt = nil
 	payloadWorkloadResourceInstance.UpdatedAt = nil
+	payloadWorkloadResourceInstance.ResourceVersion = nil
 
 	jsonWorkloadResourceInstance, err := util.MarshalObject(payloadWorkloadResourceInstance)
 	if err != nil {
@@ -1136,7 +1167,7 @@ func UpdateWorkloadResourceInstance(apiClient *http.Client, apiAddr string, work
 		fmt.Sprintf("%s%s/%d", apiAddr, v0.PathWorkloadResourceInstances, workloadResourceInstanceID),
 		http.MethodPatch,
 		bytes.NewBuffer(jsonWorkloadResourceInstance),
-		map[string]string{},
+		client_lib.IfMatchHeader(workloadResourceInstance.ResourceVersion),
 		http.StatusOK,
 	)
 	if err != nil {
@@ -1154,6 +1185,10 @@ func UpdateWorkloadResourceInstance(apiClient *http.Client, apiAddr string, work
 		return nil, fmt.Errorf("failed to decode object in response data from threeport API: %w", err)
 	}
 
+	// keep the provided object's resource version current so it can be
+	// used for subsequent updates
+	workloadResourceInstance.ResourceVersion = payloadWorkloadResourceInstance.ResourceVersion
+
 	payloadWorkloadResourceInstance.ID = &workloadResourceInstanceID
 	return &payloadWorkloadResourceInstance, nil
 }
@@ -0,0 +1,646 @@
+package v0
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	"gorm.io/datatypes"
+
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+const (
+	// ApplySetLabel is the label added to objects created by tptctl apply.
+	// Its value is the name of the apply set the objects belong to.
+	ApplySetLabel = "threeport.io/apply-set"
+
+	// LastAppliedConfigAnnotation is the annotation that stores the config
+	// document an object was last applied from.
+	LastAppliedConfigAnnotation = "threeport.io/last-applied-config"
+
+	// LastAppliedSourceAnnotation is the annotation that stores the path of
+	// the file an object was last applied from.
+	LastAppliedSourceAnnotation = "threeport.io/last-applied-source"
+)
+
+// sensitiveConfigKeys are the config fields with values that are left out
+// when a config document is stored in an annotation, including inline helm
+// values which may contain credentials.
+var sensitiveConfigKeys = map[string]bool{
+	"AccessKeyID":      true,
+	"Data":             true,
+	"DefinitionValues": true,
+	"InstanceValues":   true,
+	"SecretAccessKey":  true,
+	"Values":           true,
+}
+
+// ApplyDocument is a single config document to apply.
+type ApplyDocument struct {
+	// Kind is the document's top-level key, e.g. Workload.
+	Kind string
+
+	// Name is the name of the object the document configures.
+	Name string
+
+	// Source is the path of the file the document was read from.
+	Source string
+
+	// Config is the normalized document with sensitive values left out.  It
+	// is stored in the last applied config annotation and compared with the
+	// previously applied config to find changes.
+	Config []byte
+
+	// sensitive is true if the document sets sensitive values, which are not
+	// included in Config so changes to them can't be detected.
+	sensitive bool
+
+	// config is a pointer to the config type for the kind, e.g.
+	// *WorkloadConfig.
+	config interface{}
+}
+
+// ApplyOperation is the operation performed on a config document when it is
+// applied.
+type ApplyOperation string
+
+const (
+	ApplyOperationCreate    ApplyOperation = "create"
+	ApplyOperationUpdate    ApplyOperation = "update"
+	ApplyOperationReplace   ApplyOperation = "replace"
+	ApplyOperationUnchanged ApplyOperation = "unchanged"
+	ApplyOperationPrune     ApplyOperation = "prune"
+)
+
+// ApplyAction is an operation to perform for a config document.
+type ApplyAction struct {
+	// Operation is the operation to perform.
+	Operation ApplyOperation
+
+	// Document is the config document being applied.  For the prune
+	// operation it is the previously applied document.
+	Document ApplyDocument
+
+	// Applied is the previously applied document for the update, replace,
+	// unchanged and prune operations.
+	Applied *ApplyDocument
+
+	// appliedObjects are the objects created when the document was
+	// previously applied.
+	appliedObjects []appliedObject
+}
+
+// appliedObject is an object created by applying a config document.
+type appliedObject struct {
+	path string
+	id   uint
+}
+
+// ApplySet applies config documents to a Threeport control plane and tracks
+// the objects it creates with the apply set label so that they can be
+// updated or pruned the next time the set is applied.
+type ApplySet struct {
+	// Name is the value of the apply set label on the set's objects.
+	Name string
+
+	// APIClient is the HTTP client used to make requests to the Threeport
+	// API.
+	APIClient *http.Client
+
+	// APIEndpoint is the Threeport API endpoint.
+	APIEndpoint string
+
+	// Sources are the files and directories the documents being applied were
+	// read from.  Only objects last applied from one of these are pruned so
+	// that apply sets with the same name applied from different files don't
+	// prune each other's objects.
+	Sources []string
+
+	// ForceReplace permits objects to be deleted and created again when a
+	// change cannot be made in place.  If false, such changes return
+	// ErrReplaceRequired.
+	ForceReplace bool
+}
+
+// ReadApplyDocuments reads the config documents in a file or, if the path is
+// a directory, in each of the directory's .yaml and .yml files.
+func ReadApplyDocuments(path string) ([]ApplyDocument, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+		}
+		files = []string{}
+		for _, entry := range entries {
+			extension := filepath.Ext(entry.Name())
+			if entry.IsDir() || (extension != ".yaml" && extension != ".yml") {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+
+	var documents []ApplyDocument
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file %s: %w", file, err)
+		}
+		fileDocuments, err := ParseApplyDocuments(content, file)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, fileDocuments...)
+	}
+
+	return documents, nil
+}
+
+// ParseApplyDocuments parses multi-document YAML content read from a source
+// file.  Each document must have a single top-level key that is the kind of
+// config it contains, e.g. Workload or GatewayInstance.
+func ParseApplyDocuments(content []byte, source string) ([]ApplyDocument, error) {
+	if source != "" {
+		absSource, err := filepath.Abs(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path for %s: %w", source, err)
+		}
+		source = absSource
+	}
+
+	var documents []ApplyDocument
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for i := 1; ; i++ {
+		var document yaml.MapSlice
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to unmarshal document %d in %s: %w", i, source, err)
+		}
+		if len(document) == 0 {
+			continue
+		}
+
+		applyDocument, err := parseApplyDocument(document, source, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document %d in %s: %w", i, source, err)
+		}
+		documents = append(documents, *applyDocument)
+	}
+
+	return documents, nil
+}
+
+// Plan returns the actions needed to apply the config documents in
+// dependency order.  Changed documents for kinds that cannot be updated in
+// place are replaced.  Objects in the apply set that were applied from a
+// document in one of the apply set's sources that is no longer present are
+// pruned if prune is true.
+func (a *ApplySet) Plan(documents []ApplyDocument, prune bool) ([]ApplyAction, error) {
+	// each kind and name may only be applied once
+	documentKeys := make(map[string]bool)
+	for _, document := range documents {
+		key := applyDocumentKey(document.Kind, document.Name)
+		if documentKeys[key] {
+			return nil, fmt.Errorf("%s %s is defined more than once", document.Kind, document.Name)
+		}
+		documentKeys[key] = true
+	}
+
+	// get the documents previously applied from the apply set
+	applied, appliedKeys, err := a.getApplied()
+	if err != nil {
+		return nil, err
+	}
+
+	// objects are created in dependency order
+	sortedDocuments := append([]ApplyDocument{}, documents...)
+	sort.SliceStable(sortedDocuments, func(i, j int) bool {
+		return applyKindDepth(sortedDocuments[i].Kind) < applyKindDepth(sortedDocuments[j].Kind)
+	})
+
+	var actions []ApplyAction
+	for _, document := range sortedDocuments {
+		action := ApplyAction{
+			Operation: ApplyOperationCreate,
+			Document:  document,
+		}
+		if previous, ok := applied[applyDocumentKey(document.Kind, document.Name)]; ok {
+			action.Applied = &previous.Document
+			action.appliedObjects = previous.appliedObjects
+			// documents with sensitive values may have changed so they are
+			// updated but not replaced
+			unchanged := bytes.Equal(document.Config, previous.Document.Config)
+			switch {
+			case unchanged && !document.sensitive:
+				action.Operation = ApplyOperationUnchanged
+			case configMethodExists(document, "Update"):
+				action.Operation = ApplyOperationUpdate
+			case unchanged:
+				action.Operation = ApplyOperationUnchanged
+			default:
+				action.Operation = ApplyOperationReplace
+			}
+		}
+		actions = append(actions, action)
+	}
+
+	if !prune {
+		return actions, nil
+	}
+
+	// objects are pruned in reverse dependency order
+	var pruneActions []ApplyAction
+	for _, key := range appliedKeys {
+		if documentKeys[key] {
+			continue
+		}
+		previous := applied[key]
+		if !a.inSources(previous.Document.Source) {
+			continue
+		}
+		pruneActions = append(pruneActions, ApplyAction{
+			Operation:      ApplyOperationPrune,
+			Document:       previous.Document,
+			Applied:        &previous.Document,
+			appliedObjects: previous.appliedObjects,
+		})
+	}
+	sort.SliceStable(pruneActions, func(i, j int) bool {
+		return applyKindDepth(pruneActions[i].Document.Kind) > applyKindDepth(pruneActions[j].Document.Kind)
+	})
+
+	return append(actions, pruneActions...), nil
+}
+
+// Apply performs a single action returned by Plan.
+func (a *ApplySet) Apply(action ApplyAction) error {
+	switch action.Operation {
+	case ApplyOperationCreate:
+		return a.apply(action.Document, "Create")
+	case ApplyOperationUpdate:
+		// changes that cannot be made in place are replaced if permitted
+		err := a.apply(action.Document, "Update")
+		if !errors.Is(err, ErrReplaceRequired) || !a.ForceReplace {
+			return err
+		}
+		return a.replace(action)
+	case ApplyOperationReplace:
+		if !a.ForceReplace {
+			return fmt.Errorf(
+				"%w: %s cannot be updated",
+				ErrReplaceRequired,
+				action.Document.Kind,
+			)
+		}
+		return a.replace(action)
+	case ApplyOperationUnchanged:
+		return nil
+	case ApplyOperationPrune:
+		if _, err := callConfigMethod(action.Document, "Delete", a.APIClient, a.APIEndpoint); err != nil {
+			return fmt.Errorf("failed to delete %s %s: %w", action.Document.Kind, action.Document.Name, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unrecognized apply operation %s", action.Operation)
+	}
+}
+
+// apply calls the Create or Update method for a config document and adds the
+// apply set's label and the last applied annotations to the objects it
+// returns.
+func (a *ApplySet) apply(document ApplyDocument, method string) error {
+	objects, err := callConfigMethod(document, method, a.APIClient, a.APIEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to %s %s %s: %w", strings.ToLower(method), document.Kind, document.Name, err)
+	}
+
+	objectPaths := applyKinds[document.Kind].objectPaths
+	for i, object := range objects {
+		if i >= len(objectPaths) || object.Kind() != reflect.Ptr || object.IsNil() {
+			continue
+		}
+		id := object.Elem().FieldByName("ID")
+		if !id.IsValid() || id.IsNil() {
+			continue
+		}
+		if err := a.labelObject(objectPaths[i], uint(id.Elem().Uint()), document); err != nil {
+			return fmt.Errorf("failed to label %s %s: %w", document.Kind, document.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// replace deletes the objects previously applied for a config document, waits
+// for them to be removed and creates them from the changed document.
+func (a *ApplySet) replace(action ApplyAction) error {
+	if _, err := callConfigMethod(*action.Applied, "Delete", a.APIClient, a.APIEndpoint); err != nil {
+		return fmt.Errorf("failed to delete %s %s for update: %w", action.Document.Kind, action.Document.Name, err)
+	}
+
+	for _, object := range action.appliedObjects {
+		if err := util.Retry(60, 5, func() error {
+			if _, err := client.GetObjectMetadataByID(
+				a.APIClient,
+				a.APIEndpoint,
+				object.path,
+				object.id,
+			); !errors.Is(err, client_lib.ErrObjectNotFound) {
+				return fmt.Errorf("object with ID %d at %s not yet deleted", object.id, object.path)
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to wait for %s %s to be deleted for update: %w", action.Document.Kind, action.Document.Name, err)
+		}
+	}
+
+	return a.apply(action.Document, "Create")
+}
+
+// inSources returns true if a document's source file is one of the apply
+// set's sources or is in one of its source directories.
+func (a *ApplySet) inSources(source string) bool {
+	if source == "" {
+		return false
+	}
+	for _, applySource := range a.Sources {
+		absSource, err := filepath.Abs(applySource)
+		if err != nil {
+			continue
+		}
+		if source == absSource || filepath.Dir(source) == absSource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// labelObject adds the apply set label and last applied annotations to an
+// object, retaining its existing labels and annotations.
+func (a *ApplySet) labelObject(path string, id uint, document ApplyDocument) error {
+	object, err := client.GetObjectMetadataByID(a.APIClient, a.APIEndpoint, path, id)
+	if err != nil {
+		return err
+	}
+
+	labels := datatypes.JSONMap{}
+	if object.Labels != nil {
+		labels = *object.Labels
+	}
+	labels[ApplySetLabel] = a.Name
+
+	annotations := datatypes.JSONMap{}
+	if object.Annotations != nil {
+		annotations = *object.Annotations
+	}
+	annotations[LastAppliedConfigAnnotation] = string(document.Config)
+	annotations[LastAppliedSourceAnnotation] = document.Source
+
+	return client.UpdateObjectMetadata(a.APIClient, a.APIEndpoint, path, id, &labels, &annotations)
+}
+
+// appliedDocument is a document previously applied from an apply set along
+// with the objects created from it.
+type appliedDocument struct {
+	Document       ApplyDocument
+	appliedObjects []appliedObject
+}
+
+// getApplied returns the documents previously applied from the apply set by
+// kind and name along with their keys in the order they were found.
+func (a *ApplySet) getApplied() (map[string]appliedDocument, []string, error) {
+	applied := make(map[string]appliedDocument)
+	var keys []string
+
+	// get the objects with the apply set label from each API path once
+	var paths []string
+	pathFound := make(map[string]bool)
+	for _, kind := range sortedApplyKinds() {
+		for _, path := range applyKinds[kind].objectPaths {
+			if !pathFound[path] {
+				pathFound[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	for _, path := range paths {
+		objects, err := client.GetObjectsMetadata(
+			a.APIClient,
+			a.APIEndpoint,
+			path,
+			client_lib.WithLabelSelector(fmt.Sprintf("%s=%s", ApplySetLabel, a.Name)),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get objects in apply set %s: %w", a.Name, err)
+		}
+
+		for _, object := range *objects {
+			if object.ID == nil || object.Annotations == nil {
+				continue
+			}
+			config, ok := (*object.Annotations)[LastAppliedConfigAnnotation].(string)
+			if !ok {
+				continue
+			}
+			source, _ := (*object.Annotations)[LastAppliedSourceAnnotation].(string)
+
+			var content yaml.MapSlice
+			if err := yaml.Unmarshal([]byte(config), &content); err != nil {
+				return nil, nil, fmt.Errorf("failed to unmarshal last applied config for object with ID %d at %s: %w", *object.ID, path, err)
+			}
+			document, err := parseApplyDocument(content, source, true)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid last applied config for object with ID %d at %s: %w", *object.ID, path, err)
+			}
+
+			key := applyDocumentKey(document.Kind, document.Name)
+			previous, ok := applied[key]
+			if !ok {
+				keys = append(keys, key)
+				previous.Document = *document
+			}
+			previous.appliedObjects = append(previous.appliedObjects, appliedObject{path: path, id: *object.ID})
+			applied[key] = previous
+		}
+	}
+
+	return applied, keys, nil
+}
+
+// parseApplyDocument parses a single config document.  A document read from
+// a last applied config annotation is parsed without its sensitive fields,
+// which may contain digests of their values if it was applied by an earlier
+// version of tptctl.
+func parseApplyDocument(document yaml.MapSlice, source string, lastApplied bool) (*ApplyDocument, error) {
+	if len(document) != 1 {
+		return nil, errors.New("document must have a single top-level key that is the kind of config it contains")
+	}
+	kind, ok := document[0].Key.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid kind %v", document[0].Key)
+	}
+	applyKind, ok := applyKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("unrecognized kind %s - must be one of: %s", kind, strings.Join(sortedApplyKinds(), ", "))
+	}
+
+	configContent, sensitive := removeSensitiveValues(document)
+	configDocument := configContent.(yaml.MapSlice)
+	valuesDocument := document
+	if lastApplied {
+		valuesDocument = configDocument
+	}
+	config, err := yaml.Marshal(configDocument)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s config: %w", kind, err)
+	}
+	values, err := yaml.Marshal(valuesDocument)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s config: %w", kind, err)
+	}
+
+	kindConfig := applyKind.newConfig()
+	if err := yaml.UnmarshalStrict(values, kindConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s config: %w", kind, err)
+	}
+
+	// the values are the config type's only field
+	kindValues := reflect.ValueOf(kindConfig).Elem().Field(0)
+	name := kindValues.FieldByName("Name")
+	if !name.IsValid() || name.IsNil() || name.Elem().String() == "" {
+		return nil, fmt.Errorf("missing required field in %s config: Name", kind)
+	}
+
+	// relative paths in the config are relative to its source file unless
+	// the config sets its own config path
+	for i := 0; i < kindValues.NumField(); i++ {
+		field := kindValues.Field(i)
+		if strings.HasSuffix(kindValues.Type().Field(i).Name, "ConfigPath") &&
+			field.Type() == reflect.TypeOf((*string)(nil)) &&
+			(field.IsNil() || field.Elem().String() == "") {
+			configPath := source
+			field.Set(reflect.ValueOf(&configPath))
+		}
+	}
+
+	return &ApplyDocument{
+		Kind:      kind,
+		Name:      name.Elem().String(),
+		Source:    source,
+		Config:    config,
+		sensitive: sensitive,
+		config:    kindConfig,
+	}, nil
+}
+
+// callConfigMethod calls a method, e.g. Create or Delete, on the values in a
+// config document and returns the objects it returns.
+func callConfigMethod(
+	document ApplyDocument,
+	method string,
+	apiClient *http.Client,
+	apiEndpoint string,
+) ([]reflect.Value, error) {
+	if document.config == nil {
+		return nil, fmt.Errorf("%s %s was not parsed", document.Kind, document.Name)
+	}
+
+	kindMethod := configMethod(document, method)
+	if !kindMethod.IsValid() {
+		return nil, fmt.Errorf("%s does not support %s", document.Kind, strings.ToLower(method))
+	}
+
+	results := kindMethod.Call([]reflect.Value{
+		reflect.ValueOf(apiClient),
+		reflect.ValueOf(apiEndpoint),
+	})
+
+	// the last return value is always an error
+	if err, ok := results[len(results)-1].Interface().(error); ok && err != nil {
+		return nil, err
+	}
+
+	return results[:len(results)-1], nil
+}
+
+// configMethodExists returns true if the values for a config document's kind
+// have a method.
+func configMethodExists(document ApplyDocument, method string) bool {
+	return document.config != nil && configMethod(document, method).IsValid()
+}
+
+// configMethod returns a method on the values in a config document.
+func configMethod(document ApplyDocument, method string) reflect.Value {
+	return reflect.ValueOf(document.config).Elem().Field(0).Addr().MethodByName(method)
+}
+
+// removeSensitiveValues returns a copy of a config document with its
+// sensitive fields removed so that their values are not stored.  It also
+// returns true if any of the sensitive fields are set.
+func removeSensitiveValues(content interface{}) (interface{}, bool) {
+	switch typedContent := content.(type) {
+	case yaml.MapSlice:
+		removed := yaml.MapSlice{}
+		sensitive := false
+		for _, item := range typedContent {
+			if key, ok := item.Key.(string); ok && sensitiveConfigKeys[key] {
+				sensitive = sensitive || item.Value != nil
+				continue
+			}
+			var itemSensitive bool
+			item.Value, itemSensitive = removeSensitiveValues(item.Value)
+			sensitive = sensitive || itemSensitive
+			removed = append(removed, item)
+		}
+		return removed, sensitive
+	case []interface{}:
+		removed := []interface{}{}
+		sensitive := false
+		for _, item := range typedContent {
+			removedItem, itemSensitive := removeSensitiveValues(item)
+			sensitive = sensitive || itemSensitive
+			removed = append(removed, removedItem)
+		}
+		return removed, sensitive
+	default:
+		return content, false
+	}
+}
+
+// applyDocumentKey returns the key that identifies a config document.
+func applyDocumentKey(kind, name string) string {
+	return fmt.Sprintf("%s/%s", kind, name)
+}
+
+// sortedApplyKinds returns the names of all kinds that can be applied in
+// alphabetical order.
+func sortedApplyKinds() []string {
+	var kinds []string
+	for kind := range applyKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
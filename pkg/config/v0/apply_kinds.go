@@ -0,0 +1,249 @@
+package v0
+
+import (
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// applyKind contains the information needed to apply a config of a
+// particular kind, i.e. the top-level key in the config document.
+type applyKind struct {
+	// newConfig returns a pointer to an empty config for the kind.
+	newConfig func() interface{}
+
+	// objectPaths are the API paths for the objects created by the kind's
+	// Create method in the order they are returned.
+	objectPaths []string
+
+	// dependsOn are the kinds that objects of this kind can refer to and
+	// which must be created first.
+	dependsOn []string
+}
+
+// the kinds that create kubernetes runtime instances
+var runtimeKinds = []string{
+	"KubernetesRuntime",
+	"KubernetesRuntimeInstance",
+	"AwsEksKubernetesRuntime",
+	"AwsEksKubernetesRuntimeInstance",
+}
+
+// the kinds that create workload instances
+var workloadKinds = []string{
+	"Workload",
+	"WorkloadInstance",
+	"HelmWorkload",
+	"HelmWorkloadInstance",
+}
+
+// applyKinds contains every config kind that can be applied.
+var applyKinds = map[string]applyKind{
+	"AwsAccount": {
+		newConfig:   func() interface{} { return &AwsAccountConfig{} },
+		objectPaths: []string{v0.PathAwsAccounts},
+		dependsOn:   nil,
+	},
+	"AwsEksKubernetesRuntime": {
+		newConfig:   func() interface{} { return &AwsEksKubernetesRuntimeConfig{} },
+		objectPaths: []string{v0.PathAwsEksKubernetesRuntimeDefinitions, v0.PathAwsEksKubernetesRuntimeInstances},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"AwsEksKubernetesRuntimeDefinition": {
+		newConfig:   func() interface{} { return &AwsEksKubernetesRuntimeDefinitionConfig{} },
+		objectPaths: []string{v0.PathAwsEksKubernetesRuntimeDefinitions},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"AwsEksKubernetesRuntimeInstance": {
+		newConfig:   func() interface{} { return &AwsEksKubernetesRuntimeInstanceConfig{} },
+		objectPaths: []string{v0.PathAwsEksKubernetesRuntimeInstances},
+		dependsOn:   []string{"AwsEksKubernetesRuntimeDefinition"},
+	},
+	"KubernetesRuntime": {
+		newConfig:   func() interface{} { return &KubernetesRuntimeConfig{} },
+		objectPaths: []string{v0.PathKubernetesRuntimeDefinitions, v0.PathKubernetesRuntimeInstances},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"KubernetesRuntimeDefinition": {
+		newConfig:   func() interface{} { return &KubernetesRuntimeDefinitionConfig{} },
+		objectPaths: []string{v0.PathKubernetesRuntimeDefinitions},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"KubernetesRuntimeInstance": {
+		newConfig:   func() interface{} { return &KubernetesRuntimeInstanceConfig{} },
+		objectPaths: []string{v0.PathKubernetesRuntimeInstances},
+		dependsOn:   []string{"KubernetesRuntimeDefinition"},
+	},
+	"ControlPlane": {
+		newConfig:   func() interface{} { return &ControlPlaneConfig{} },
+		objectPaths: []string{v0.PathControlPlaneDefinitions, v0.PathControlPlaneInstances},
+		dependsOn:   kindDependencies(runtimeKinds),
+	},
+	"ControlPlaneDefinition": {
+		newConfig:   func() interface{} { return &ControlPlaneDefinitionConfig{} },
+		objectPaths: []string{v0.PathControlPlaneDefinitions},
+		dependsOn:   nil,
+	},
+	"ControlPlaneInstance": {
+		newConfig:   func() interface{} { return &ControlPlaneInstanceConfig{} },
+		objectPaths: []string{v0.PathControlPlaneInstances},
+		dependsOn:   kindDependencies(runtimeKinds, []string{"ControlPlaneDefinition"}),
+	},
+	"ObservabilityStack": {
+		newConfig:   func() interface{} { return &ObservabilityStackConfig{} },
+		objectPaths: []string{v0.PathObservabilityStackDefinitions, v0.PathObservabilityStackInstances},
+		dependsOn:   kindDependencies(runtimeKinds),
+	},
+	"ObservabilityStackDefinition": {
+		newConfig:   func() interface{} { return &ObservabilityStackDefinitionConfig{} },
+		objectPaths: []string{v0.PathObservabilityStackDefinitions},
+		dependsOn:   nil,
+	},
+	"ObservabilityStackInstance": {
+		newConfig:   func() interface{} { return &ObservabilityStackInstanceConfig{} },
+		objectPaths: []string{v0.PathObservabilityStackInstances},
+		dependsOn:   kindDependencies(runtimeKinds, []string{"ObservabilityStackDefinition"}),
+	},
+	"Terraform": {
+		newConfig:   func() interface{} { return &TerraformConfig{} },
+		objectPaths: []string{v0.PathTerraformDefinitions, v0.PathTerraformInstances},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"TerraformDefinition": {
+		newConfig:   func() interface{} { return &TerraformDefinitionConfig{} },
+		objectPaths: []string{v0.PathTerraformDefinitions},
+		dependsOn:   nil,
+	},
+	"TerraformInstance": {
+		newConfig:   func() interface{} { return &TerraformInstanceConfig{} },
+		objectPaths: []string{v0.PathTerraformInstances},
+		dependsOn:   []string{"AwsAccount", "TerraformDefinition"},
+	},
+	"Workload": {
+		newConfig:   func() interface{} { return &WorkloadConfig{} },
+		objectPaths: []string{v0.PathWorkloadDefinitions, v0.PathWorkloadInstances},
+		dependsOn:   kindDependencies(runtimeKinds, []string{"AwsAccount"}),
+	},
+	"WorkloadDefinition": {
+		newConfig:   func() interface{} { return &WorkloadDefinitionConfig{} },
+		objectPaths: []string{v0.PathWorkloadDefinitions},
+		dependsOn:   nil,
+	},
+	"WorkloadInstance": {
+		newConfig:   func() interface{} { return &WorkloadInstanceConfig{} },
+		objectPaths: []string{v0.PathWorkloadInstances},
+		dependsOn:   kindDependencies(runtimeKinds, []string{"WorkloadDefinition"}),
+	},
+	"HelmWorkload": {
+		newConfig:   func() interface{} { return &HelmWorkloadConfig{} },
+		objectPaths: []string{v0.PathHelmWorkloadDefinitions, v0.PathHelmWorkloadInstances},
+		dependsOn:   kindDependencies(runtimeKinds),
+	},
+	"HelmWorkloadDefinition": {
+		newConfig:   func() interface{} { return &HelmWorkloadDefinitionConfig{} },
+		objectPaths: []string{v0.PathHelmWorkloadDefinitions},
+		dependsOn:   nil,
+	},
+	"HelmWorkloadInstance": {
+		newConfig:   func() interface{} { return &HelmWorkloadInstanceConfig{} },
+		objectPaths: []string{v0.PathHelmWorkloadInstances},
+		dependsOn:   kindDependencies(runtimeKinds, []string{"HelmWorkloadDefinition"}),
+	},
+	"DomainName": {
+		newConfig:   func() interface{} { return &DomainNameConfig{} },
+		objectPaths: []string{v0.PathDomainNameDefinitions, v0.PathDomainNameInstances},
+		dependsOn:   kindDependencies(workloadKinds),
+	},
+	"DomainNameDefinition": {
+		newConfig:   func() interface{} { return &DomainNameDefinitionConfig{} },
+		objectPaths: []string{v0.PathDomainNameDefinitions},
+		dependsOn:   nil,
+	},
+	"DomainNameInstance": {
+		newConfig:   func() interface{} { return &DomainNameInstanceConfig{} },
+		objectPaths: []string{v0.PathDomainNameInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"DomainNameDefinition"}),
+	},
+	"Gateway": {
+		newConfig:   func() interface{} { return &GatewayConfig{} },
+		objectPaths: []string{v0.PathGatewayDefinitions, v0.PathGatewayInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"DomainNameDefinition"}, []string{"DomainName"}),
+	},
+	"GatewayDefinition": {
+		newConfig:   func() interface{} { return &GatewayDefinitionConfig{} },
+		objectPaths: []string{v0.PathGatewayDefinitions},
+		dependsOn:   []string{"DomainNameDefinition", "DomainName"},
+	},
+	"GatewayInstance": {
+		newConfig:   func() interface{} { return &GatewayInstanceConfig{} },
+		objectPaths: []string{v0.PathGatewayInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"GatewayDefinition"}),
+	},
+	"Secret": {
+		newConfig:   func() interface{} { return &SecretConfig{} },
+		objectPaths: []string{v0.PathSecretDefinitions, v0.PathSecretInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"AwsAccount"}),
+	},
+	"SecretDefinition": {
+		newConfig:   func() interface{} { return &SecretDefinitionConfig{} },
+		objectPaths: []string{v0.PathSecretDefinitions},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"SecretInstance": {
+		newConfig:   func() interface{} { return &SecretInstanceConfig{} },
+		objectPaths: []string{v0.PathSecretInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"SecretDefinition"}),
+	},
+	"AwsRelationalDatabase": {
+		newConfig:   func() interface{} { return &AwsRelationalDatabaseConfig{} },
+		objectPaths: []string{v0.PathAwsRelationalDatabaseDefinitions, v0.PathAwsRelationalDatabaseInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"AwsAccount"}),
+	},
+	"AwsRelationalDatabaseDefinition": {
+		newConfig:   func() interface{} { return &AwsRelationalDatabaseDefinitionConfig{} },
+		objectPaths: []string{v0.PathAwsRelationalDatabaseDefinitions},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"AwsRelationalDatabaseInstance": {
+		newConfig:   func() interface{} { return &AwsRelationalDatabaseInstanceConfig{} },
+		objectPaths: []string{v0.PathAwsRelationalDatabaseInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"AwsRelationalDatabaseDefinition"}),
+	},
+	"AwsObjectStorageBucket": {
+		newConfig:   func() interface{} { return &AwsObjectStorageBucketConfig{} },
+		objectPaths: []string{v0.PathAwsObjectStorageBucketDefinitions, v0.PathAwsObjectStorageBucketInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"AwsAccount"}),
+	},
+	"AwsObjectStorageBucketDefinition": {
+		newConfig:   func() interface{} { return &AwsObjectStorageBucketDefinitionConfig{} },
+		objectPaths: []string{v0.PathAwsObjectStorageBucketDefinitions},
+		dependsOn:   []string{"AwsAccount"},
+	},
+	"AwsObjectStorageBucketInstance": {
+		newConfig:   func() interface{} { return &AwsObjectStorageBucketInstanceConfig{} },
+		objectPaths: []string{v0.PathAwsObjectStorageBucketInstances},
+		dependsOn:   kindDependencies(workloadKinds, []string{"AwsObjectStorageBucketDefinition"}),
+	},
+}
+
+// kindDependencies combines lists of kinds into a single list.
+func kindDependencies(kindLists ...[]string) []string {
+	var kinds []string
+	for _, kindList := range kindLists {
+		kinds = append(kinds, kindList...)
+	}
+
+	return kinds
+}
+
+// applyKindDepth returns the length of the longest chain of dependencies for
+// a kind.  Objects are created in order of increasing depth so that the
+// objects they refer to exist first.
+func applyKindDepth(kind string) int {
+	depth := 0
+	for _, dependency := range applyKinds[kind].dependsOn {
+		if d := applyKindDepth(dependency) + 1; d > depth {
+			depth = d
+		}
+	}
+
+	return depth
+}
@@ -0,0 +1,288 @@
+package v0
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// TestParseApplyDocuments tests that multi-document config content is parsed
+// into a document for each kind and name and that invalid documents are
+// rejected.
+func TestParseApplyDocuments(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		kinds   []string
+		names   []string
+		wantErr bool
+	}{
+		{
+			name:    "empty content",
+			content: "",
+		},
+		{
+			name: "multiple documents with empty documents skipped",
+			content: `
+WorkloadDefinition:
+  Name: web
+  YAMLDocument: web.yaml
+---
+---
+WorkloadInstance:
+  Name: web
+  WorkloadDefinition:
+    Name: web
+`,
+			kinds: []string{"WorkloadDefinition", "WorkloadInstance"},
+			names: []string{"web", "web"},
+		},
+		{
+			name:    "unrecognized kind",
+			content: "Unknown:\n  Name: web\n",
+			wantErr: true,
+		},
+		{
+			name:    "more than one kind in a document",
+			content: "WorkloadDefinition:\n  Name: web\nWorkloadInstance:\n  Name: web\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			content: "WorkloadDefinition:\n  YAMLDocument: web.yaml\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			content: "WorkloadDefinition:\n  Name: web\n  Unknown: true\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid YAML",
+			content: "WorkloadDefinition: [",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			documents, err := ParseApplyDocuments([]byte(tc.content), "configs/web.yaml")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, documents, len(tc.kinds))
+			for i, document := range documents {
+				assert.Equal(t, tc.kinds[i], document.Kind)
+				assert.Equal(t, tc.names[i], document.Name)
+				assert.True(t, filepath.IsAbs(document.Source), "source should be an absolute path")
+			}
+		})
+	}
+}
+
+// TestParseApplyDocumentsConfigPath tests that config paths are set to the
+// source file so that relative paths in the config are resolved from it.
+func TestParseApplyDocumentsConfigPath(t *testing.T) {
+	documents, err := ParseApplyDocuments([]byte("WorkloadDefinition:\n  Name: web\n  YAMLDocument: web.yaml\n"), "configs/web.yaml")
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+
+	config, ok := documents[0].config.(*WorkloadDefinitionConfig)
+	require.True(t, ok)
+	require.NotNil(t, config.WorkloadDefinition.WorkloadConfigPath)
+	assert.Equal(t, documents[0].Source, *config.WorkloadDefinition.WorkloadConfigPath)
+}
+
+// TestParseApplyDocumentsExplicitConfigPath tests that a config path set in
+// the config is not replaced by the source file.
+func TestParseApplyDocumentsExplicitConfigPath(t *testing.T) {
+	documents, err := ParseApplyDocuments([]byte("WorkloadDefinition:\n  Name: web\n  YAMLDocument: web.yaml\n  WorkloadConfigPath: /etc/threeport/web.yaml\n"), "configs/web.yaml")
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+
+	config, ok := documents[0].config.(*WorkloadDefinitionConfig)
+	require.True(t, ok)
+	require.NotNil(t, config.WorkloadDefinition.WorkloadConfigPath)
+	assert.Equal(t, "/etc/threeport/web.yaml", *config.WorkloadDefinition.WorkloadConfigPath)
+}
+
+// TestRemoveSensitiveValues tests that sensitive fields are removed wherever
+// they appear in a config document, including the digests stored by earlier
+// versions, and that other values are unchanged.
+func TestRemoveSensitiveValues(t *testing.T) {
+	testCases := []struct {
+		name      string
+		content   interface{}
+		removed   interface{}
+		sensitive bool
+	}{
+		{
+			name:    "scalar",
+			content: "value",
+			removed: "value",
+		},
+		{
+			name: "top-level sensitive values",
+			content: yaml.MapSlice{
+				{Key: "Name", Value: "default"},
+				{Key: "AccessKeyID", Value: "AKIAEXAMPLE"},
+				{Key: "SecretAccessKey", Value: "secret"},
+			},
+			removed: yaml.MapSlice{
+				{Key: "Name", Value: "default"},
+			},
+			sensitive: true,
+		},
+		{
+			name: "nested map value",
+			content: yaml.MapSlice{
+				{Key: "Secret", Value: yaml.MapSlice{
+					{Key: "Name", Value: "db"},
+					{Key: "Data", Value: yaml.MapSlice{{Key: "password", Value: "hunter2"}}},
+				}},
+			},
+			removed: yaml.MapSlice{
+				{Key: "Secret", Value: yaml.MapSlice{
+					{Key: "Name", Value: "db"},
+				}},
+			},
+			sensitive: true,
+		},
+		{
+			name: "inline helm values",
+			content: yaml.MapSlice{
+				{Key: "HelmWorkload", Value: yaml.MapSlice{
+					{Key: "Name", Value: "db"},
+					{Key: "DefinitionValues", Value: "password: hunter2"},
+					{Key: "InstanceValues", Value: "replicas: 2"},
+				}},
+			},
+			removed: yaml.MapSlice{
+				{Key: "HelmWorkload", Value: yaml.MapSlice{
+					{Key: "Name", Value: "db"},
+				}},
+			},
+			sensitive: true,
+		},
+		{
+			name: "sensitive values in a list",
+			content: []interface{}{
+				yaml.MapSlice{{Key: "SecretAccessKey", Value: "secret"}},
+			},
+			removed: []interface{}{
+				yaml.MapSlice{},
+			},
+			sensitive: true,
+		},
+		{
+			name:    "unset sensitive value",
+			content: yaml.MapSlice{{Key: "Name", Value: "db"}, {Key: "Data", Value: nil}},
+			removed: yaml.MapSlice{{Key: "Name", Value: "db"}},
+		},
+		{
+			name: "digest from earlier version",
+			content: yaml.MapSlice{
+				{Key: "Name", Value: "default"},
+				{Key: "AccessKeyID", Value: "sha256:abc"},
+			},
+			removed: yaml.MapSlice{
+				{Key: "Name", Value: "default"},
+			},
+			sensitive: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			removed, sensitive := removeSensitiveValues(tc.content)
+			assert.Equal(t, tc.removed, removed)
+			assert.Equal(t, tc.sensitive, sensitive)
+		})
+	}
+}
+
+// TestParseApplyDocumentsSensitiveValues tests that sensitive values are
+// left out of the config stored in the last applied config annotation but
+// are still used to apply the document.
+func TestParseApplyDocumentsSensitiveValues(t *testing.T) {
+	documents, err := ParseApplyDocuments([]byte(`
+HelmWorkloadDefinition:
+  Name: db
+  Repo: https://charts.bitnami.com/bitnami
+  Chart: postgresql
+  Values: |
+    auth:
+      password: hunter2
+`), "configs/db.yaml")
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+
+	assert.NotContains(t, string(documents[0].Config), "hunter2")
+	assert.True(t, documents[0].sensitive)
+	config, ok := documents[0].config.(*HelmWorkloadDefinitionConfig)
+	require.True(t, ok)
+	require.NotNil(t, config.HelmWorkloadDefinition.Values)
+	assert.Contains(t, *config.HelmWorkloadDefinition.Values, "hunter2")
+}
+
+// TestPlanSensitiveValues tests that previously applied documents with
+// sensitive values are updated since changes to those values can't be
+// detected, and that other unchanged documents are not.
+func TestPlanSensitiveValues(t *testing.T) {
+	testCases := []struct {
+		name      string
+		content   string
+		operation ApplyOperation
+	}{
+		{
+			name:      "unchanged without sensitive values",
+			content:   "AwsAccount:\n  Name: default\n  DefaultRegion: us-east-1\n",
+			operation: ApplyOperationUnchanged,
+		},
+		{
+			name:      "unchanged with sensitive values",
+			content:   "AwsAccount:\n  Name: default\n  DefaultRegion: us-east-1\n  AccessKeyID: AKIAEXAMPLE\n  SecretAccessKey: secret\n",
+			operation: ApplyOperationUpdate,
+		},
+		{
+			name:      "changed",
+			content:   "AwsAccount:\n  Name: default\n  DefaultRegion: us-west-2\n",
+			operation: ApplyOperationUpdate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			documents, err := ParseApplyDocuments([]byte(tc.content), "configs/aws.yaml")
+			require.NoError(t, err)
+
+			applySet := ApplySet{
+				Name:      "test",
+				APIClient: http.DefaultClient,
+				APIEndpoint: newDiffTestAPI(t, map[string]map[string]interface{}{
+					v0.PathAwsAccounts: {
+						"ID":   1,
+						"Name": "default",
+						"Annotations": map[string]interface{}{
+							LastAppliedConfigAnnotation: "AwsAccount:\n  Name: default\n  DefaultRegion: us-east-1\n",
+							LastAppliedSourceAnnotation: documents[0].Source,
+						},
+					},
+				}),
+			}
+			actions, err := applySet.Plan(documents, false)
+			require.NoError(t, err)
+
+			require.Len(t, actions, 1)
+			assert.Equal(t, tc.operation, actions[0].Operation)
+		})
+	}
+}
@@ -198,28 +198,10 @@ func (a *AwsAccountValues) Create(apiClient *http.Client, apiEndpoint string) (*
 		return nil, errors.New("missing required field/s in config - required fields: Name, AccountID")
 	}
 
-	// validate config and credentials properly provided
-	explain := `
-In order to configure an AWS account provide the fields:
-DefaultRegion, AccessKeyID and SecretAccessKey
-OR
-LocalConfig, LocalCredentials and LocalProfile
-`
-	localConfig := false
-	explicitConfig := false
-	if a.LocalConfig != nil && a.LocalCredentials != nil && a.LocalProfile != nil {
-		localConfig = true
-	}
-	if a.DefaultRegion != nil && a.AccessKeyID != nil && a.SecretAccessKey != nil {
-		explicitConfig = true
-	}
-	switch {
-	case localConfig && explicitConfig:
-		msg := fmt.Sprintf("local and explicit configurations provided %s", explain)
-		return nil, errors.New(msg)
-	case !localConfig && !explicitConfig:
-		msg := fmt.Sprintf("neither local nor explicit configurations provided %s", explain)
-		return nil, errors.New(msg)
+	// get region and credentials for AWS account
+	region, accessKeyID, secretAccessKey, err := a.getRegionAndCredentials()
+	if err != nil {
+		return nil, err
 	}
 
 	// validate that no other default AWS account exists
@@ -236,45 +218,65 @@ LocalConfig, LocalCredentials and LocalProfile
 		}
 	}
 
-	// establish default region from explicit declaration in config or AWS config file
-	var region string
-	if a.DefaultRegion == nil {
-		awsConfig, err := ini.Load(*a.LocalConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load aws config: %w", err)
-		}
-		if awsConfig.Section(*a.LocalProfile).HasKey("region") {
-			region = awsConfig.Section(*a.LocalProfile).Key("region").String()
-		} else {
-			return nil, errors.New(
-				fmt.Sprintf("profile %s not found in aws config %s", *a.LocalProfile, *a.LocalConfig),
-			)
-		}
-	} else {
-		region = *a.DefaultRegion
+	// construct AWS account object
+	awsAccount := v0.AwsAccount{
+		Name:            a.Name,
+		DefaultAccount:  a.DefaultAccount,
+		DefaultRegion:   &region,
+		AccountID:       a.AccountID,
+		AccessKeyID:     &accessKeyID,
+		SecretAccessKey: &secretAccessKey,
+		RoleArn:         a.RoleArn,
 	}
 
-	// retrieve access key ID and secret access key if needed
-	var accessKeyID string
-	var secretAccessKey string
-	if a.AccessKeyID == nil && a.SecretAccessKey == nil {
-		awsCredentials, err := ini.Load(*a.LocalCredentials)
+	// create AWS account
+	createdAwsAccount, err := client.CreateAwsAccount(apiClient, apiEndpoint, &awsAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aws account in threeport API: %w", err)
+	}
+
+	return createdAwsAccount, nil
+}
+
+// Update updates an AWS account in the Threeport API.
+func (a *AwsAccountValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsAccount, error) {
+	// validate required fields
+	if a.Name == nil || a.AccountID == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, AccountID")
+	}
+
+	// get region and credentials for AWS account
+	region, accessKeyID, secretAccessKey, err := a.getRegionAndCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	// get existing AWS account by name
+	existingAwsAccount, err := client.GetAwsAccountByName(apiClient, apiEndpoint, *a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS account with name %s: %w", *a.Name, err)
+	}
+
+	// validate that no other default AWS account exists
+	if a.DefaultAccount != nil && *a.DefaultAccount {
+		existingAccounts, err := client.GetAwsAccounts(apiClient, apiEndpoint)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load aws credentials: %w", err)
+			return nil, fmt.Errorf("failed to retrieve existing AWS accounts to check default accounts: %w", err)
 		}
-		if awsCredentials.Section(*a.LocalProfile).HasKey("aws_access_key_id") &&
-			awsCredentials.Section(*a.LocalProfile).HasKey("aws_secret_access_key") {
-			accessKeyID = awsCredentials.Section(*a.LocalProfile).Key("aws_access_key_id").String()
-			secretAccessKey = awsCredentials.Section(*a.LocalProfile).Key("aws_secret_access_key").String()
+		for _, existing := range *existingAccounts {
+			if *existing.ID != *existingAwsAccount.ID && existing.DefaultAccount != nil && *existing.DefaultAccount {
+				msg := fmt.Sprintf("cannot designate account as default account - %s is already the default account", *existing.Name)
+				return nil, errors.New(msg)
+			}
 		}
-	} else {
-		accessKeyID = *a.AccessKeyID
-		secretAccessKey = *a.SecretAccessKey
 	}
 
-	// construct AWS account object
+	// construct AWS account object with updated fields
 	awsAccount := v0.AwsAccount{
-		Name:            a.Name,
+		Common: v0.Common{
+			ID:              existingAwsAccount.ID,
+			ResourceVersion: existingAwsAccount.ResourceVersion,
+		},
 		DefaultAccount:  a.DefaultAccount,
 		DefaultRegion:   &region,
 		AccountID:       a.AccountID,
@@ -283,13 +285,13 @@ LocalConfig, LocalCredentials and LocalProfile
 		RoleArn:         a.RoleArn,
 	}
 
-	// create AWS account
-	createdAwsAccount, err := client.CreateAwsAccount(apiClient, apiEndpoint, &awsAccount)
+	// update AWS account
+	updatedAwsAccount, err := client.UpdateAwsAccount(apiClient, apiEndpoint, &awsAccount)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create aws account in threeport API: %w", err)
+		return nil, fmt.Errorf("failed to update aws account in threeport API: %w", err)
 	}
 
-	return createdAwsAccount, nil
+	return updatedAwsAccount, nil
 }
 
 // Describe returns details related to an AWS account.
@@ -340,6 +342,73 @@ func (a *AwsAccountValues) Delete(apiClient *http.Client, apiEndpoint string) (*
 	return deletedAwsAccount, nil
 }
 
+// getRegionAndCredentials returns the default region, access key ID and
+// secret access key for an AWS account from either the explicit values in the
+// config or the local AWS config and credentials files.
+func (a *AwsAccountValues) getRegionAndCredentials() (string, string, string, error) {
+	// validate config and credentials properly provided
+	explain := `
+In order to configure an AWS account provide the fields:
+DefaultRegion, AccessKeyID and SecretAccessKey
+OR
+LocalConfig, LocalCredentials and LocalProfile
+`
+	localConfig := false
+	explicitConfig := false
+	if a.LocalConfig != nil && a.LocalCredentials != nil && a.LocalProfile != nil {
+		localConfig = true
+	}
+	if a.DefaultRegion != nil && a.AccessKeyID != nil && a.SecretAccessKey != nil {
+		explicitConfig = true
+	}
+	switch {
+	case localConfig && explicitConfig:
+		msg := fmt.Sprintf("local and explicit configurations provided %s", explain)
+		return "", "", "", errors.New(msg)
+	case !localConfig && !explicitConfig:
+		msg := fmt.Sprintf("neither local nor explicit configurations provided %s", explain)
+		return "", "", "", errors.New(msg)
+	}
+
+	// establish default region from explicit declaration in config or AWS config file
+	var region string
+	if a.DefaultRegion == nil {
+		awsConfig, err := ini.Load(*a.LocalConfig)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to load aws config: %w", err)
+		}
+		if awsConfig.Section(*a.LocalProfile).HasKey("region") {
+			region = awsConfig.Section(*a.LocalProfile).Key("region").String()
+		} else {
+			return "", "", "", errors.New(
+				fmt.Sprintf("profile %s not found in aws config %s", *a.LocalProfile, *a.LocalConfig),
+			)
+		}
+	} else {
+		region = *a.DefaultRegion
+	}
+
+	// retrieve access key ID and secret access key if needed
+	var accessKeyID string
+	var secretAccessKey string
+	if a.AccessKeyID == nil && a.SecretAccessKey == nil {
+		awsCredentials, err := ini.Load(*a.LocalCredentials)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to load aws credentials: %w", err)
+		}
+		if awsCredentials.Section(*a.LocalProfile).HasKey("aws_access_key_id") &&
+			awsCredentials.Section(*a.LocalProfile).HasKey("aws_secret_access_key") {
+			accessKeyID = awsCredentials.Section(*a.LocalProfile).Key("aws_access_key_id").String()
+			secretAccessKey = awsCredentials.Section(*a.LocalProfile).Key("aws_secret_access_key").String()
+		}
+	} else {
+		accessKeyID = *a.AccessKeyID
+		secretAccessKey = *a.SecretAccessKey
+	}
+
+	return region, accessKeyID, secretAccessKey, nil
+}
+
 // Create creates a AWS EKS kubernetes runtime definition and instance in the Threeport API.
 func (w *AwsEksKubernetesRuntimeValues) Create(
 	apiClient *http.Client,
@@ -364,6 +433,31 @@ func (w *AwsEksKubernetesRuntimeValues) Create(
 	return createdAwsEksKubernetesRuntimeDefinition, createdAwsEksKubernetesRuntimeInstance, nil
 }
 
+// Update updates a AWS EKS kubernetes runtime definition and instance in the
+// Threeport API.
+func (w *AwsEksKubernetesRuntimeValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.AwsEksKubernetesRuntimeDefinition, *v0.AwsEksKubernetesRuntimeInstance, error) {
+
+	// get operations
+	operations, updatedAwsEksKubernetesRuntimeDefinition, updatedAwsEksKubernetesRuntimeInstance := w.GetOperations(
+		apiClient,
+		apiEndpoint,
+	)
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to execute update operations for AWS EKS kubernetes runtime defined instance with name %s: %w",
+			*w.Name,
+			err,
+		)
+	}
+
+	return updatedAwsEksKubernetesRuntimeDefinition, updatedAwsEksKubernetesRuntimeInstance, nil
+}
+
 // Delete deletes a AWS EKS kubernetes runtime definition and AWS EKS
 // kubernetes runtime instance.
 func (w *AwsEksKubernetesRuntimeValues) Delete(
@@ -443,6 +537,52 @@ func (e *AwsEksKubernetesRuntimeDefinitionValues) Create(apiClient *http.Client,
 	return createdAwsEksKubernetesRuntimeDefinition, nil
 }
 
+// Update updates a AWS EKS kubernetes runtime definition in the Threeport API.
+// Changes apply to AWS EKS kubernetes runtime instances created from the
+// definition after the update.
+func (e *AwsEksKubernetesRuntimeDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsEksKubernetesRuntimeDefinition, error) {
+	// validate required fields
+	if e.Name == nil || e.AwsAccountName == nil || e.ZoneCount == nil ||
+		e.DefaultNodeGroupInstanceType == nil || e.DefaultNodeGroupInitialSize == nil ||
+		e.DefaultNodeGroupMinimumSize == nil || e.DefaultNodeGroupMaximumSize == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, AwsAccountName, ZoneCount, DefaultNodeGroupInstanceType, DefaultNodeGroupInitialSize, DefaultNodeGroupMinimumSize, DefaultNodeGroupMaximumSize")
+	}
+
+	// look up AWS account by name
+	awsAccount, err := client.GetAwsAccountByName(apiClient, apiEndpoint, *e.AwsAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS account with name %s: %w", *e.AwsAccountName, err)
+	}
+
+	// get existing AWS EKS kubernetes runtime definition by name
+	existingAwsEksKubernetesRuntimeDefinition, err := client.GetAwsEksKubernetesRuntimeDefinitionByName(apiClient, apiEndpoint, *e.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS EKS kubernetes runtime definition with name %s: %w", *e.Name, err)
+	}
+
+	// construct AWS EKS kubernetes runtime definition object with updated fields
+	awsEksKubernetesRuntimeDefinition := v0.AwsEksKubernetesRuntimeDefinition{
+		Common: v0.Common{
+			ID:              existingAwsEksKubernetesRuntimeDefinition.ID,
+			ResourceVersion: existingAwsEksKubernetesRuntimeDefinition.ResourceVersion,
+		},
+		AwsAccountID:                 awsAccount.ID,
+		ZoneCount:                    e.ZoneCount,
+		DefaultNodeGroupInstanceType: e.DefaultNodeGroupInstanceType,
+		DefaultNodeGroupInitialSize:  e.DefaultNodeGroupInitialSize,
+		DefaultNodeGroupMinimumSize:  e.DefaultNodeGroupMinimumSize,
+		DefaultNodeGroupMaximumSize:  e.DefaultNodeGroupMaximumSize,
+	}
+
+	// update AWS EKS kubernetes runtime definition
+	updatedAwsEksKubernetesRuntimeDefinition, err := client.UpdateAwsEksKubernetesRuntimeDefinition(apiClient, apiEndpoint, &awsEksKubernetesRuntimeDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update AWS EKS kubernetes runtime definition in threeport API: %w", err)
+	}
+
+	return updatedAwsEksKubernetesRuntimeDefinition, nil
+}
+
 // Describe returns details related to a AWS EKS kubernetes runtime definition.
 func (e *AwsEksKubernetesRuntimeDefinitionValues) Describe(
 	apiClient *http.Client,
@@ -568,6 +708,41 @@ func (e *AwsEksKubernetesRuntimeInstanceValues) Create(apiClient *http.Client, a
 	return createdAwsEksKubernetesRuntimeInstance, nil
 }
 
+// Update updates an AWS EKS kubernetes runtime instance in the threeport API.
+// A running EKS cluster cannot be moved to another region or definition so the
+// update only verifies the instance matches its config.
+func (e *AwsEksKubernetesRuntimeInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsEksKubernetesRuntimeInstance, error) {
+	// validate required fields
+	if e.Name == nil || e.AwsEksKubernetesRuntimeDefinition.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, AwsEksKubernetesRuntimeDefinition.Name")
+	}
+
+	// get existing AWS EKS kubernetes runtime instance by name
+	existingAwsEksKubernetesRuntimeInstance, err := client.GetAwsEksKubernetesRuntimeInstanceByName(apiClient, apiEndpoint, *e.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS EKS kubernetes runtime instance with name %s: %w", *e.Name, err)
+	}
+
+	// look up AWS EKS kubernetes runtime definition by name
+	awsEksKubernetesRuntimeDefinition, err := client.GetAwsEksKubernetesRuntimeDefinitionByName(apiClient, apiEndpoint, *e.AwsEksKubernetesRuntimeDefinition.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS EKS kubernetes runtime definition with name %s: %w", *e.AwsEksKubernetesRuntimeDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"AwsEksKubernetesRuntimeDefinition",
+		existingAwsEksKubernetesRuntimeInstance.AwsEksKubernetesRuntimeDefinitionID,
+		awsEksKubernetesRuntimeDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+	if e.Region != nil && existingAwsEksKubernetesRuntimeInstance.Region != nil &&
+		*e.Region != *existingAwsEksKubernetesRuntimeInstance.Region {
+		return nil, fmt.Errorf("%w: Region changed", ErrReplaceRequired)
+	}
+
+	return existingAwsEksKubernetesRuntimeInstance, nil
+}
+
 // Describe returns details related to a AWS EKS kubernetes runtime instance.
 func (e *AwsEksKubernetesRuntimeInstanceValues) Describe(
 	apiClient *http.Client,
@@ -733,6 +908,48 @@ func (r *AwsRelationalDatabaseValues) Create(apiClient *http.Client, apiEndpoint
 	return createdAwsRelationalDatabaseDefinition, createdAwsRelationalDatabaseInstance, nil
 }
 
+// Update updates an AWS relational database definition and instance in the
+// threeport API.
+func (r *AwsRelationalDatabaseValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsRelationalDatabaseDefinition, *v0.AwsRelationalDatabaseInstance, error) {
+	// validate
+	if r.Name == nil {
+		return nil, nil, errors.New("missing required field: Name")
+	}
+
+	// update the relational database definition
+	awsRelationalDatabaseDefinition := AwsRelationalDatabaseDefinitionValues{
+		Name:               r.Name,
+		AwsAccountName:     r.AwsAccountName,
+		Engine:             r.Engine,
+		EngineVersion:      r.EngineVersion,
+		DatabaseName:       r.DatabaseName,
+		DatabasePort:       r.DatabasePort,
+		BackupDays:         r.BackupDays,
+		MachineSize:        r.MachineSize,
+		StorageGb:          r.StorageGb,
+		WorkloadSecretName: r.WorkloadSecretName,
+	}
+	updatedAwsRelationalDatabaseDefinition, err := awsRelationalDatabaseDefinition.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update AWS relational database definition: %w", err)
+	}
+
+	// update the relational database instance
+	awsRelationalDatabaseInstance := AwsRelationalDatabaseInstanceValues{
+		Name: r.Name,
+		AwsRelationalDatabaseDefinition: &AwsRelationalDatabaseDefinitionValues{
+			Name: r.Name,
+		},
+		WorkloadInstance: r.WorkloadInstance,
+	}
+	updatedAwsRelationalDatabaseInstance, err := awsRelationalDatabaseInstance.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update AWS relational database instance: %w", err)
+	}
+
+	return updatedAwsRelationalDatabaseDefinition, updatedAwsRelationalDatabaseInstance, nil
+}
+
 // Delete deletes an AWS relational database definition and instance from
 // the Threeport API.
 func (r *AwsRelationalDatabaseValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.AwsRelationalDatabaseDefinition, *v0.AwsRelationalDatabaseInstance, error) {
@@ -846,6 +1063,54 @@ func (r *AwsRelationalDatabaseDefinitionValues) Create(apiClient *http.Client, a
 	return createdAwsRelationalDatabaseDefinition, nil
 }
 
+// Update updates an AWS relational database definition in the threeport API.
+// Changes apply to AWS relational database instances created from the
+// definition after the update.
+func (r *AwsRelationalDatabaseDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsRelationalDatabaseDefinition, error) {
+	// validate required fields
+	if r.Name == nil || r.Engine == nil || r.EngineVersion == nil || r.DatabaseName == nil ||
+		r.DatabasePort == nil || r.MachineSize == nil || r.StorageGb == nil ||
+		r.WorkloadSecretName == nil || r.AwsAccountName == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, Engine, EngineVersion, DatabaseName, DatabasePort, MachineSize, StorageGb, WorkloadSecretName, AwsAccountName")
+	}
+
+	// look up AWS account by name
+	awsAccount, err := client.GetAwsAccountByName(apiClient, apiEndpoint, *r.AwsAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS account with name %s: %w", *r.AwsAccountName, err)
+	}
+
+	// get existing AWS relational database definition by name
+	existingAwsRelationalDatabaseDefinition, err := client.GetAwsRelationalDatabaseDefinitionByName(apiClient, apiEndpoint, *r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS relational database definition by name %s: %w", *r.Name, err)
+	}
+
+	// construct AWS relational database definition object with updated fields
+	awsRelationalDatabaseDefinition := v0.AwsRelationalDatabaseDefinition{
+		Common: v0.Common{
+			ID:              existingAwsRelationalDatabaseDefinition.ID,
+			ResourceVersion: existingAwsRelationalDatabaseDefinition.ResourceVersion,
+		},
+		Engine:             r.Engine,
+		EngineVersion:      r.EngineVersion,
+		DatabaseName:       r.DatabaseName,
+		DatabasePort:       r.DatabasePort,
+		MachineSize:        r.MachineSize,
+		StorageGb:          r.StorageGb,
+		WorkloadSecretName: r.WorkloadSecretName,
+		AwsAccountID:       awsAccount.ID,
+	}
+
+	// update AWS relational database definition
+	updatedAwsRelationalDatabaseDefinition, err := client.UpdateAwsRelationalDatabaseDefinition(apiClient, apiEndpoint, &awsRelationalDatabaseDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update AWS relational database definition in threeport API: %w", err)
+	}
+
+	return updatedAwsRelationalDatabaseDefinition, nil
+}
+
 // Delete deletes an AWS relational database definition from the threeport API.
 func (r *AwsRelationalDatabaseDefinitionValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.AwsRelationalDatabaseDefinition, error) {
 	// validate
@@ -913,6 +1178,58 @@ func (r *AwsRelationalDatabaseInstanceValues) Create(apiClient *http.Client, api
 	return createdAwsRelationalDatabaseInstance, nil
 }
 
+// Update updates an AWS relational database instance in the threeport API.
+// The database instance cannot be moved to another definition or workload
+// instance so the update only verifies the instance matches its config.
+func (r *AwsRelationalDatabaseInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsRelationalDatabaseInstance, error) {
+	// validate required fields
+	if r.Name == nil || r.AwsRelationalDatabaseDefinition.Name == nil || r.WorkloadInstance.Name == nil {
+		return nil, errors.New("missing required fields in config - required fields: Name, AwsRelationalDatabaseDefinition.Name, WorkloadInstance.Name")
+	}
+
+	// get existing AWS relational database instance by name
+	existingAwsRelationalDatabaseInstance, err := client.GetAwsRelationalDatabaseInstanceByName(apiClient, apiEndpoint, *r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS relational database instance by name %s: %w", *r.Name, err)
+	}
+
+	// get AWS relational database definition by name
+	awsRelationalDatabaseDefinition, err := client.GetAwsRelationalDatabaseDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*r.AwsRelationalDatabaseDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS relational database definition by name %s: %w", *r.AwsRelationalDatabaseDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"AwsRelationalDatabaseDefinition",
+		existingAwsRelationalDatabaseInstance.AwsRelationalDatabaseDefinitionID,
+		awsRelationalDatabaseDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get workload instance by name
+	workloadInstance, err := client.GetWorkloadInstanceByName(
+		apiClient,
+		apiEndpoint,
+		*r.WorkloadInstance.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed find workload instance by name %s: %w", *r.WorkloadInstance.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"WorkloadInstance",
+		existingAwsRelationalDatabaseInstance.WorkloadInstanceID,
+		workloadInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	return existingAwsRelationalDatabaseInstance, nil
+}
+
 // Delete deletes an AWS relational database instance from the threeport API.
 func (r *AwsRelationalDatabaseInstanceValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.AwsRelationalDatabaseInstance, error) {
 	// validate
@@ -972,6 +1289,43 @@ func (o *AwsObjectStorageBucketValues) Create(apiClient *http.Client, apiEndpoin
 	return createdAwsObjectStorageBucketDefinition, createdAwsObjectStorageBucketInstance, nil
 }
 
+// Update updates an AWS object storage bucket definition and instance in the
+// threeport API.
+func (o *AwsObjectStorageBucketValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsObjectStorageBucketDefinition, *v0.AwsObjectStorageBucketInstance, error) {
+	// validate
+	if o.Name == nil {
+		return nil, nil, errors.New("missing required field: Name")
+	}
+
+	// update the object storage bucket definition
+	awsObjectStorageBucketDefinition := AwsObjectStorageBucketDefinitionValues{
+		Name:                       o.Name,
+		AwsAccountName:             o.AwsAccountName,
+		PublicReadAccess:           o.PublicReadAccess,
+		WorkloadServiceAccountName: o.WorkloadServiceAccountName,
+		WorkloadBucketEnvVar:       o.WorkloadBucketEnvVar,
+	}
+	updatedAwsObjectStorageBucketDefinition, err := awsObjectStorageBucketDefinition.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update AWS object storage bucket definition: %w", err)
+	}
+
+	// update the object storage bucket instance
+	awsObjectStorageBucketInstance := AwsObjectStorageBucketInstanceValues{
+		Name: o.Name,
+		AwsObjectStorageBucketDefinition: &AwsObjectStorageBucketDefinitionValues{
+			Name: o.Name,
+		},
+		WorkloadInstance: o.WorkloadInstance,
+	}
+	updatedAwsObjectStorageBucketInstance, err := awsObjectStorageBucketInstance.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update AWS object storage bucket instance: %w", err)
+	}
+
+	return updatedAwsObjectStorageBucketDefinition, updatedAwsObjectStorageBucketInstance, nil
+}
+
 // Delete deletes an AWS object storage bucket definition and instance from the
 // threeport API.
 func (o *AwsObjectStorageBucketValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.AwsObjectStorageBucketDefinition, *v0.AwsObjectStorageBucketInstance, error) {
@@ -1079,6 +1433,48 @@ func (o *AwsObjectStorageBucketDefinitionValues) Create(apiClient *http.Client,
 	return createdAwsObjectStorageBucketDefinition, nil
 }
 
+// Update updates an AWS object storage bucket definition in the threeport API.
+// Changes apply to AWS object storage bucket instances created from the
+// definition after the update.
+func (o *AwsObjectStorageBucketDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsObjectStorageBucketDefinition, error) {
+	// validate required fields
+	if o.Name == nil || o.WorkloadServiceAccountName == nil || o.WorkloadBucketEnvVar == nil || o.AwsAccountName == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, WorkloadServiceAccountName, WorkloadBucketEnvVar, AwsAccountName")
+	}
+
+	// look up AWS account by name
+	awsAccount, err := client.GetAwsAccountByName(apiClient, apiEndpoint, *o.AwsAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS account with name %s: %w", *o.AwsAccountName, err)
+	}
+
+	// get existing AWS object storage bucket definition by name
+	existingAwsObjectStorageBucketDefinition, err := client.GetAwsObjectStorageBucketDefinitionByName(apiClient, apiEndpoint, *o.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS object storage bucket definition by name %s: %w", *o.Name, err)
+	}
+
+	// construct AWS object storage bucket definition object with updated fields
+	awsObjectStorageBucketDefinition := v0.AwsObjectStorageBucketDefinition{
+		Common: v0.Common{
+			ID:              existingAwsObjectStorageBucketDefinition.ID,
+			ResourceVersion: existingAwsObjectStorageBucketDefinition.ResourceVersion,
+		},
+		PublicReadAccess:           o.PublicReadAccess,
+		WorkloadServiceAccountName: o.WorkloadServiceAccountName,
+		WorkloadBucketEnvVar:       o.WorkloadBucketEnvVar,
+		AwsAccountID:               awsAccount.ID,
+	}
+
+	// update AWS object storage bucket definition
+	updatedAwsObjectStorageBucketDefinition, err := client.UpdateAwsObjectStorageBucketDefinition(apiClient, apiEndpoint, &awsObjectStorageBucketDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update AWS object storage bucket definition in threeport API: %w", err)
+	}
+
+	return updatedAwsObjectStorageBucketDefinition, nil
+}
+
 // Describe returns details related to an AWS object storage bucket definition.
 func (e *AwsObjectStorageBucketDefinitionValues) Describe(apiClient *http.Client, apiEndpoint string) (*status.AwsObjectStorageBucketDefinitionStatusDetail, error) {
 	// validate
@@ -1176,6 +1572,58 @@ func (o *AwsObjectStorageBucketInstanceValues) Create(apiClient *http.Client, ap
 	return createdAwsObjectStorageBucketInstance, nil
 }
 
+// Update updates an AWS object storage bucket instance in the threeport API.
+// The bucket cannot be moved to another definition or workload instance so the
+// update only verifies the instance matches its config.
+func (o *AwsObjectStorageBucketInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.AwsObjectStorageBucketInstance, error) {
+	// validate required fields
+	if o.Name == nil || o.AwsObjectStorageBucketDefinition.Name == nil || o.WorkloadInstance.Name == nil {
+		return nil, errors.New("missing required fields in config - required fields: Name, AwsObjectStorageBucketDefinition.Name, WorkloadInstance.Name")
+	}
+
+	// get existing AWS object storage bucket instance by name
+	existingAwsObjectStorageBucketInstance, err := client.GetAwsObjectStorageBucketInstanceByName(apiClient, apiEndpoint, *o.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS object storage bucket instance by name %s: %w", *o.Name, err)
+	}
+
+	// get AWS object storage bucket definition by name
+	awsObjectStorageBucketDefinition, err := client.GetAwsObjectStorageBucketDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*o.AwsObjectStorageBucketDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS object storage bucket definition by name %s: %w", *o.AwsObjectStorageBucketDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"AwsObjectStorageBucketDefinition",
+		existingAwsObjectStorageBucketInstance.AwsObjectStorageBucketDefinitionID,
+		awsObjectStorageBucketDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get workload instance by name
+	workloadInstance, err := client.GetWorkloadInstanceByName(
+		apiClient,
+		apiEndpoint,
+		*o.WorkloadInstance.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed find workload instance by name %s: %w", *o.WorkloadInstance.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"WorkloadInstance",
+		existingAwsObjectStorageBucketInstance.WorkloadInstanceID,
+		workloadInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	return existingAwsObjectStorageBucketInstance, nil
+}
+
 // Delete deletes an AWS object storage bucket instance from the threeport API.
 func (o *AwsObjectStorageBucketInstanceValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.AwsObjectStorageBucketInstance, error) {
 	// validate
@@ -1198,8 +1646,8 @@ func (o *AwsObjectStorageBucketInstanceValues) Delete(apiClient *http.Client, ap
 	return deletedAwsObjectStorageBucketInstance, nil
 }
 
-// GetOperations returns a slice of operations used to create or delete an AWS
-// EKS kubernetes runtime.
+// GetOperations returns a slice of operations used to create, update or delete
+// an AWS EKS kubernetes runtime.
 func (e *AwsEksKubernetesRuntimeValues) GetOperations(
 	apiClient *http.Client,
 	apiEndpoint string,
@@ -1238,6 +1686,21 @@ func (e *AwsEksKubernetesRuntimeValues) GetOperations(
 			createdAwsEksKubernetesRuntimeDefinition = *awsEksKubernetesRuntimeDefinition
 			return nil
 		},
+		Update: func() error {
+			awsEksKubernetesRuntimeDefinition, err := awsEksKubernetesRuntimeDefinitionValues.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to update AWS EKS kubernetes runtime definition with name %s: %w",
+					*awsEksKubernetesRuntimeDefinitionValues.Name,
+					err,
+				)
+			}
+			createdAwsEksKubernetesRuntimeDefinition = *awsEksKubernetesRuntimeDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err = awsEksKubernetesRuntimeDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -1276,6 +1739,21 @@ func (e *AwsEksKubernetesRuntimeValues) GetOperations(
 			createdAwsEksKubernetesRuntimeInstance = *awsEksKubernetesRuntimeInstance
 			return nil
 		},
+		Update: func() error {
+			awsEksKubernetesRuntimeInstance, err := awsEksKubernetesRuntimeInstanceValues.Update(
+				apiClient,
+				apiEndpoint,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to update AWS EKS kubernetes runtime instance with name %s: %w",
+					*awsEksKubernetesRuntimeInstanceValues.Name,
+					err,
+				)
+			}
+			createdAwsEksKubernetesRuntimeInstance = *awsEksKubernetesRuntimeInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err = awsEksKubernetesRuntimeInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -87,6 +87,37 @@ func (c *ControlPlaneValues) Create(apiClient *http.Client, apiEndpoint string)
 	return createdControlPlaneDefinition, createdControlPlaneInstance, nil
 }
 
+// Update updates a control plane definition and instance in the Threeport API.
+func (c *ControlPlaneValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.ControlPlaneDefinition, *v0.ControlPlaneInstance, error) {
+	// update the control plane definition
+	controlPlaneDefinition := ControlPlaneDefinitionValues{
+		Name:          c.Name,
+		AuthEnabled:   c.AuthEnabled,
+		OnboardParent: c.OnboardParent,
+	}
+	updatedControlPlaneDefinition, err := controlPlaneDefinition.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update control plane definition: %w", err)
+	}
+
+	// update the control plane instance
+	controlPlaneInstance := ControlPlaneInstanceValues{
+		Name:                      c.Name,
+		Namespace:                 c.Namespace,
+		KubernetesRuntimeInstance: c.KubernetesRuntimeInstance,
+		CustomComponentInfo:       c.CustomComponentInfo,
+		ControlPlaneDefinition: &ControlPlaneDefinitionValues{
+			Name: c.Name,
+		},
+	}
+	updatedControlPlaneInstance, err := controlPlaneInstance.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update control plane instance: %w", err)
+	}
+
+	return updatedControlPlaneDefinition, updatedControlPlaneInstance, nil
+}
+
 // Delete deletes a control plane definition and a control plane instance
 // from the Threeport API.
 func (c *ControlPlaneValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.ControlPlaneDefinition, *v0.ControlPlaneInstance, error) {
@@ -183,6 +214,40 @@ func (cd *ControlPlaneDefinitionValues) Create(apiClient *http.Client, apiEndpoi
 	return createdControlPlaneDefinition, nil
 }
 
+// Update updates a control plane definition in the Threeport API.  Changes
+// apply to control plane instances created from the definition after the
+// update.
+func (cd *ControlPlaneDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.ControlPlaneDefinition, error) {
+	// validate required fields
+	if cd.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name")
+	}
+
+	// get existing control plane definition by name
+	existingControlPlaneDefinition, err := client.GetControlPlaneDefinitionByName(apiClient, apiEndpoint, *cd.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find control plane definition with name %s: %w", *cd.Name, err)
+	}
+
+	// construct control plane definition object with updated fields
+	controlPlaneDefinition := v0.ControlPlaneDefinition{
+		Common: v0.Common{
+			ID:              existingControlPlaneDefinition.ID,
+			ResourceVersion: existingControlPlaneDefinition.ResourceVersion,
+		},
+		AuthEnabled:   cd.AuthEnabled,
+		OnboardParent: cd.OnboardParent,
+	}
+
+	// update control plane definition
+	updatedControlPlaneDefinition, err := client.UpdateControlPlaneDefinition(apiClient, apiEndpoint, &controlPlaneDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update control plane definition in threeport API: %w", err)
+	}
+
+	return updatedControlPlaneDefinition, nil
+}
+
 // Delete deletes a control plane definition from the Threeport API.
 func (cd *ControlPlaneDefinitionValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.ControlPlaneDefinition, error) {
 	// get control plane definition by name
@@ -247,6 +312,62 @@ func (ci *ControlPlaneInstanceValues) Create(apiClient *http.Client, apiEndpoint
 	return createdControlPlaneInstance, nil
 }
 
+// Update updates a control plane instance in the Threeport API.  A running
+// control plane cannot be moved to another namespace, kubernetes runtime
+// instance or definition so the update only verifies the instance matches its
+// config.
+func (ci *ControlPlaneInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.ControlPlaneInstance, error) {
+	// validate required fields
+	if ci.Name == nil || ci.Namespace == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, ControlPlaneInstance.Namespace")
+	}
+
+	// get existing control plane instance by name
+	existingControlPlaneInstance, err := client.GetControlPlaneInstanceByName(apiClient, apiEndpoint, *ci.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find control plane instance with name %s: %w", *ci.Name, err)
+	}
+	if existingControlPlaneInstance.Namespace != nil && *existingControlPlaneInstance.Namespace != *ci.Namespace {
+		return nil, fmt.Errorf("%w: Namespace changed", ErrReplaceRequired)
+	}
+
+	// get kubernetes runtime instance API object
+	kubernetesRuntimeInstance, err := SetKubernetesRuntimeInstanceForConfig(
+		ci.KubernetesRuntimeInstance,
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set kubernetes runtime instance: %w", err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		existingControlPlaneInstance.KubernetesRuntimeInstanceID,
+		kubernetesRuntimeInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get control plane definition by name
+	controlPlaneDefinition, err := client.GetControlPlaneDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*ci.ControlPlaneDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find control plane definition with name %s: %w", *ci.ControlPlaneDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"ControlPlaneDefinition",
+		existingControlPlaneInstance.ControlPlaneDefinitionID,
+		controlPlaneDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	return existingControlPlaneInstance, nil
+}
+
 // Delete deletes a control plane instance from the Threeport API.
 func (ci *ControlPlaneInstanceValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.ControlPlaneInstance, error) {
 	// get control plane instance by name
@@ -0,0 +1,680 @@
+package v0
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	ghodss_yaml "github.com/ghodss/yaml"
+
+	helmworkload "github.com/threeport/threeport/internal/helm-workload"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+	client "github.com/threeport/threeport/pkg/client/v0"
+	kube "github.com/threeport/threeport/pkg/kube/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// ObjectDiff is the difference between an object that a config document
+// resolves to and the live object in the Threeport API.
+type ObjectDiff struct {
+	// ObjectType is the type of the object, e.g. WorkloadDefinition.
+	ObjectType string
+
+	// Name is the name of the object.
+	Name string
+
+	// Exists is true if the object is present in the Threeport API.
+	Exists bool
+
+	// Fields are the fields of an existing object that differ from the
+	// config or that can't be compared with the config.
+	Fields []FieldDiff
+}
+
+// Changed returns true if applying the config would create or change the
+// object.
+func (o *ObjectDiff) Changed() bool {
+	if !o.Exists {
+		return true
+	}
+	for _, field := range o.Fields {
+		if !field.Unknown {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unknown returns true if any field of an existing object can't be compared
+// with the config so that applying the config may or may not change it.
+func (o *ObjectDiff) Unknown() bool {
+	for _, field := range o.Fields {
+		if field.Unknown {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FieldDiff is the difference in a single field between the live object and
+// the config.
+type FieldDiff struct {
+	// Field is the name of the field, e.g. YAMLDocument.
+	Field string
+
+	// Live is the value of the field on the live object.
+	Live string
+
+	// Desired is the value of the field resolved from the config.
+	Desired string
+
+	// Unknown is true if the field is set in the config but its live value
+	// can't be compared, e.g. sensitive values that are stored in a secret
+	// store rather than the Threeport API.  Live and Desired are not set for
+	// unknown fields.
+	Unknown bool
+}
+
+// diffObject is the fields of an object as resolved from a config or as
+// found in the Threeport API.  Unknown fields are set in the config but
+// can't be compared with the live object.
+type diffObject struct {
+	exists  bool
+	fields  map[string]string
+	unknown []string
+}
+
+// attachedConfig is a config nested in a workload config, e.g. its domain
+// name or gateway, that produces objects of its own kind.
+type attachedConfig struct {
+	kind   string
+	name   *string
+	values interface{}
+}
+
+// differ resolves config documents to the objects they produce and fetches
+// the live objects to compare them with.
+type differ struct {
+	apiClient   *http.Client
+	apiEndpoint string
+
+	// documents are all the documents being compared so that objects can
+	// be resolved with values from the documents they refer to, e.g. the
+	// values of a helm workload definition in the same set of documents as
+	// its instance.
+	documents []ApplyDocument
+}
+
+// diffKinds contains the functions that compare kinds with fields that are
+// rendered or merged before they are stored in the API.  Other kinds are
+// compared using the config fields with the same name as the object's fields.
+var diffKinds = map[string]func(d *differ, values interface{}) ([]ObjectDiff, error){
+	"Workload": func(d *differ, values interface{}) ([]ObjectDiff, error) {
+		return d.diffWorkload(values.(*WorkloadValues))
+	},
+	"WorkloadDefinition": func(d *differ, values interface{}) ([]ObjectDiff, error) {
+		return d.diffObjects(d.diffWorkloadDefinition(values.(*WorkloadDefinitionValues)))
+	},
+	"WorkloadInstance": func(d *differ, values interface{}) ([]ObjectDiff, error) {
+		return d.diffObjects(d.diffWorkloadInstance(values.(*WorkloadInstanceValues)))
+	},
+	"HelmWorkload": func(d *differ, values interface{}) ([]ObjectDiff, error) {
+		return d.diffHelmWorkload(values.(*HelmWorkloadValues))
+	},
+	"HelmWorkloadDefinition": func(d *differ, values interface{}) ([]ObjectDiff, error) {
+		return d.diffObjects(d.diffHelmWorkloadDefinition(values.(*HelmWorkloadDefinitionValues)))
+	},
+	"HelmWorkloadInstance": func(d *differ, values interface{}) ([]ObjectDiff, error) {
+		return d.diffObjects(d.diffHelmWorkloadInstance(values.(*HelmWorkloadInstanceValues)))
+	},
+}
+
+// Diff returns the differences between the objects that config documents
+// resolve to and the live objects in the Threeport API.  Workload manifests
+// are compared as they are rendered into individual Kubernetes resources and
+// helm workload instances are also compared using the values merged from
+// their definition and instance.  The objects for configs nested in workload
+// and helm workload configs are compared as well.
+func Diff(apiClient *http.Client, apiEndpoint string, documents []ApplyDocument) ([]ObjectDiff, error) {
+	d := differ{
+		apiClient:   apiClient,
+		apiEndpoint: apiEndpoint,
+		documents:   documents,
+	}
+
+	var objectDiffs []ObjectDiff
+	for _, document := range documents {
+		if document.config == nil {
+			return nil, fmt.Errorf("%s %s was not parsed", document.Kind, document.Name)
+		}
+		values := reflect.ValueOf(document.config).Elem().Field(0).Addr().Interface()
+
+		var documentDiffs []ObjectDiff
+		var err error
+		if diffKind, ok := diffKinds[document.Kind]; ok {
+			documentDiffs, err = diffKind(&d, values)
+		} else {
+			documentDiffs, err = d.diffConfigFields(document.Kind, document.Name, values)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s %s: %w", document.Kind, document.Name, err)
+		}
+		objectDiffs = append(objectDiffs, documentDiffs...)
+	}
+
+	return objectDiffs, nil
+}
+
+// diffObjects compares a single resolved object with the live object.
+func (d *differ) diffObjects(objectDiff *ObjectDiff, err error) ([]ObjectDiff, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return []ObjectDiff{*objectDiff}, nil
+}
+
+// compare returns the differences between the fields resolved from a config
+// and the live object.  Only fields resolved from the config are compared.
+func compare(objectType, name string, desired, live diffObject) *ObjectDiff {
+	objectDiff := ObjectDiff{
+		ObjectType: objectType,
+		Name:       name,
+		Exists:     live.exists,
+	}
+	if !live.exists {
+		return &objectDiff
+	}
+
+	var fields []string
+	for field := range desired.fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if desired.fields[field] != live.fields[field] {
+			objectDiff.Fields = append(objectDiff.Fields, FieldDiff{
+				Field:   field,
+				Live:    live.fields[field],
+				Desired: desired.fields[field],
+			})
+		}
+	}
+
+	unknown := append([]string{}, desired.unknown...)
+	sort.Strings(unknown)
+	for _, field := range unknown {
+		objectDiff.Fields = append(objectDiff.Fields, FieldDiff{
+			Field:   field,
+			Unknown: true,
+		})
+	}
+
+	return &objectDiff
+}
+
+// getLive fetches a live object by name.  It returns false if the object
+// doesn't exist.
+func (d *differ) getLive(path, name string, object interface{}) (bool, error) {
+	if err := client.GetObjectByName(d.apiClient, d.apiEndpoint, path, name, object); err != nil {
+		if errors.Is(err, client_lib.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// diffConfigFields compares the scalar fields in a config's values with the
+// fields of the same name on the objects the config's kind produces.
+// Sensitive fields that are set in the config are reported as unknown on the
+// first object the kind produces, which holds them, since their live values
+// are encrypted or not persisted in the Threeport API.
+func (d *differ) diffConfigFields(kind, name string, values interface{}) ([]ObjectDiff, error) {
+	objectPaths := applyKinds[kind].objectPaths
+	objectTypes := []string{kind}
+	if len(objectPaths) == 2 {
+		objectTypes = []string{kind + "Definition", kind + "Instance"}
+	}
+
+	var objectDiffs []ObjectDiff
+	for i, objectPath := range objectPaths {
+		var liveObject map[string]interface{}
+		exists, err := d.getLive(objectPath, name, &liveObject)
+		if err != nil {
+			return nil, err
+		}
+
+		desired := diffObject{exists: true, fields: map[string]string{}}
+		live := diffObject{exists: exists, fields: map[string]string{}}
+		valuesValue := reflect.ValueOf(values).Elem()
+		for j := 0; j < valuesValue.NumField(); j++ {
+			field := valuesValue.Type().Field(j).Name
+			value := valuesValue.Field(j)
+			if field == "Name" ||
+				strings.HasSuffix(field, "ConfigPath") ||
+				value.Kind() != reflect.Ptr ||
+				value.IsNil() {
+				continue
+			}
+			if sensitiveConfigKeys[field] {
+				if i == 0 {
+					desired.unknown = append(desired.unknown, field)
+				}
+				continue
+			}
+			switch value.Elem().Kind() {
+			case reflect.String, reflect.Bool, reflect.Int:
+			default:
+				continue
+			}
+			liveValue, ok := liveObject[field]
+			if !ok {
+				continue
+			}
+			desired.fields[field] = fmt.Sprint(value.Elem().Interface())
+			live.fields[field] = fmt.Sprint(liveValue)
+		}
+
+		objectDiffs = append(objectDiffs, *compare(objectTypes[i], name, desired, live))
+	}
+
+	return objectDiffs, nil
+}
+
+// diffAttachedConfigs compares the objects produced by the configs nested in
+// a workload config with the live objects of the same name.
+func (d *differ) diffAttachedConfigs(attachedConfigs []attachedConfig) ([]ObjectDiff, error) {
+	var objectDiffs []ObjectDiff
+	for _, attached := range attachedConfigs {
+		if attached.name == nil {
+			return nil, fmt.Errorf("missing required field in %s config: Name", attached.kind)
+		}
+		attachedDiffs, err := d.diffConfigFields(attached.kind, *attached.name, attached.values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s %s: %w", attached.kind, *attached.name, err)
+		}
+		objectDiffs = append(objectDiffs, attachedDiffs...)
+	}
+
+	return objectDiffs, nil
+}
+
+// diffWorkload compares the workload definition and instance that a workload
+// config resolves to along with the objects for its domain name, gateway,
+// AWS resources and secret.
+func (d *differ) diffWorkload(w *WorkloadValues) ([]ObjectDiff, error) {
+	definitionDiff, err := d.diffWorkloadDefinition(&WorkloadDefinitionValues{
+		Name:               w.Name,
+		YAMLDocument:       w.YAMLDocument,
+		WorkloadConfigPath: w.WorkloadConfigPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instanceDiff, err := d.diffWorkloadInstance(&WorkloadInstanceValues{
+		Name:                      w.Name,
+		KubernetesRuntimeInstance: w.KubernetesRuntimeInstance,
+		WorkloadDefinition: &WorkloadDefinitionValues{
+			Name: w.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var attachedConfigs []attachedConfig
+	if w.DomainName != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"DomainName", w.DomainName.Name, w.DomainName})
+	}
+	if w.Gateway != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"Gateway", w.Gateway.Name, w.Gateway})
+	}
+	if w.AwsRelationalDatabase != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"AwsRelationalDatabase", w.AwsRelationalDatabase.Name, w.AwsRelationalDatabase})
+	}
+	if w.AwsObjectStorageBucket != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"AwsObjectStorageBucket", w.AwsObjectStorageBucket.Name, w.AwsObjectStorageBucket})
+	}
+	if w.Secret != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"Secret", w.Secret.Name, w.Secret})
+	}
+	attachedDiffs, err := d.diffAttachedConfigs(attachedConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]ObjectDiff{*definitionDiff, *instanceDiff}, attachedDiffs...), nil
+}
+
+// diffWorkloadDefinition compares the rendered manifests for a workload
+// definition.
+func (d *differ) diffWorkloadDefinition(wd *WorkloadDefinitionValues) (*ObjectDiff, error) {
+	if wd.Name == nil || wd.YAMLDocument == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, YAMLDocument")
+	}
+
+	// load YAML document relative to the config file
+	configPath, _ := filepath.Split(util.DerefString(wd.WorkloadConfigPath))
+	definitionContent, err := os.ReadFile(path.Join(configPath, *wd.YAMLDocument))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read definition YAMLDocument file with name %s: %w", *wd.YAMLDocument, err)
+	}
+	desiredManifests, err := renderManifests(string(definitionContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to render YAMLDocument %s: %w", *wd.YAMLDocument, err)
+	}
+
+	var workloadDefinition v0.WorkloadDefinition
+	exists, err := d.getLive(v0.PathWorkloadDefinitions, *wd.Name, &workloadDefinition)
+	if err != nil {
+		return nil, err
+	}
+	live := diffObject{exists: exists}
+	if exists {
+		liveManifests, err := renderManifests(util.DerefString(workloadDefinition.YAMLDocument))
+		if err != nil {
+			return nil, fmt.Errorf("failed to render live YAMLDocument: %w", err)
+		}
+		live.fields = map[string]string{"YAMLDocument": liveManifests}
+	}
+
+	return compare(
+		"WorkloadDefinition",
+		*wd.Name,
+		diffObject{exists: true, fields: map[string]string{"YAMLDocument": desiredManifests}},
+		live,
+	), nil
+}
+
+// diffWorkloadInstance compares the kubernetes runtime instance and workload
+// definition for a workload instance.
+func (d *differ) diffWorkloadInstance(wi *WorkloadInstanceValues) (*ObjectDiff, error) {
+	if wi.Name == nil || wi.WorkloadDefinition == nil || wi.WorkloadDefinition.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, WorkloadDefinition.Name")
+	}
+
+	desired := diffObject{exists: true, fields: map[string]string{
+		"KubernetesRuntimeInstance": d.kubernetesRuntimeInstanceName(wi.KubernetesRuntimeInstance),
+		"WorkloadDefinition":        *wi.WorkloadDefinition.Name,
+	}}
+
+	var workloadInstance v0.WorkloadInstance
+	exists, err := d.getLive(v0.PathWorkloadInstances, *wi.Name, &workloadInstance)
+	if err != nil {
+		return nil, err
+	}
+	live := diffObject{exists: exists, fields: map[string]string{}}
+	if exists {
+		kubernetesRuntimeInstance, err := client.GetKubernetesRuntimeInstanceByID(
+			d.apiClient,
+			d.apiEndpoint,
+			*workloadInstance.KubernetesRuntimeInstanceID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kubernetes runtime instance for workload instance: %w", err)
+		}
+		workloadDefinition, err := client.GetWorkloadDefinitionByID(
+			d.apiClient,
+			d.apiEndpoint,
+			*workloadInstance.WorkloadDefinitionID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workload definition for workload instance: %w", err)
+		}
+		live.fields["KubernetesRuntimeInstance"] = *kubernetesRuntimeInstance.Name
+		live.fields["WorkloadDefinition"] = *workloadDefinition.Name
+	}
+
+	return compare("WorkloadInstance", *wi.Name, desired, live), nil
+}
+
+// diffHelmWorkload compares the helm workload definition and instance that a
+// helm workload config resolves to along with the objects for its domain
+// name, gateway and AWS resources.
+func (d *differ) diffHelmWorkload(h *HelmWorkloadValues) ([]ObjectDiff, error) {
+	definitionDiff, err := d.diffHelmWorkloadDefinition(&HelmWorkloadDefinitionValues{
+		Name:                   h.Name,
+		Repo:                   h.Repo,
+		Chart:                  h.Chart,
+		ChartVersion:           h.ChartVersion,
+		Values:                 h.DefinitionValues,
+		ValuesDocument:         h.DefinitionValuesDocument,
+		HelmWorkloadConfigPath: h.HelmWorkloadConfigPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instanceDiff, err := d.diffHelmWorkloadInstance(&HelmWorkloadInstanceValues{
+		Name:                      h.Name,
+		Values:                    h.InstanceValues,
+		ValuesDocument:            h.InstanceValuesDocument,
+		HelmWorkloadConfigPath:    h.HelmWorkloadConfigPath,
+		KubernetesRuntimeInstance: h.KubernetesRuntimeInstance,
+		HelmWorkloadDefinition: &HelmWorkloadDefinitionValues{
+			Name: h.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var attachedConfigs []attachedConfig
+	if h.DomainName != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"DomainName", h.DomainName.Name, h.DomainName})
+	}
+	if h.Gateway != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"Gateway", h.Gateway.Name, h.Gateway})
+	}
+	if h.AwsRelationalDatabase != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"AwsRelationalDatabase", h.AwsRelationalDatabase.Name, h.AwsRelationalDatabase})
+	}
+	if h.AwsObjectStorageBucket != nil {
+		attachedConfigs = append(attachedConfigs, attachedConfig{"AwsObjectStorageBucket", h.AwsObjectStorageBucket.Name, h.AwsObjectStorageBucket})
+	}
+	attachedDiffs, err := d.diffAttachedConfigs(attachedConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]ObjectDiff{*definitionDiff, *instanceDiff}, attachedDiffs...), nil
+}
+
+// diffHelmWorkloadDefinition compares the chart and values for a helm
+// workload definition.
+func (d *differ) diffHelmWorkloadDefinition(h *HelmWorkloadDefinitionValues) (*ObjectDiff, error) {
+	if h.Name == nil || h.Repo == nil || h.Chart == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, Repo, Chart")
+	}
+
+	values, err := GetValuesFromDocumentOrInline(
+		util.DerefString(h.Values),
+		util.DerefString(h.ValuesDocument),
+		util.DerefString(h.HelmWorkloadConfigPath),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get values document from path: %w", err)
+	}
+	desired := diffObject{exists: true, fields: map[string]string{
+		"Repo":           *h.Repo,
+		"Chart":          *h.Chart,
+		"ValuesDocument": util.DerefString(values),
+	}}
+
+	var helmWorkloadDefinition v0.HelmWorkloadDefinition
+	exists, err := d.getLive(v0.PathHelmWorkloadDefinitions, *h.Name, &helmWorkloadDefinition)
+	if err != nil {
+		return nil, err
+	}
+	live := diffObject{exists: exists, fields: map[string]string{
+		"Repo":           util.DerefString(helmWorkloadDefinition.Repo),
+		"Chart":          util.DerefString(helmWorkloadDefinition.Chart),
+		"ValuesDocument": util.DerefString(helmWorkloadDefinition.ValuesDocument),
+	}}
+
+	return compare("HelmWorkloadDefinition", *h.Name, desired, live), nil
+}
+
+// diffHelmWorkloadInstance compares the kubernetes runtime instance, helm
+// workload definition and values for a helm workload instance.  The values
+// merged from the definition and instance that the chart is installed with
+// are compared as the MergedValues field.
+func (d *differ) diffHelmWorkloadInstance(h *HelmWorkloadInstanceValues) (*ObjectDiff, error) {
+	if h.Name == nil || h.HelmWorkloadDefinition == nil || h.HelmWorkloadDefinition.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, HelmWorkloadDefinition.Name")
+	}
+
+	values, err := GetValuesFromDocumentOrInline(
+		util.DerefString(h.Values),
+		util.DerefString(h.ValuesDocument),
+		util.DerefString(h.HelmWorkloadConfigPath),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get helm instance values document from path: %w", err)
+	}
+
+	// get the definition values from the documents being compared if the
+	// definition is included, otherwise from the live definition
+	definitionValues, definitionFound, err := d.helmWorkloadDefinitionValues(*h.HelmWorkloadDefinition.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !definitionFound {
+		var helmWorkloadDefinition v0.HelmWorkloadDefinition
+		if _, err := d.getLive(
+			v0.PathHelmWorkloadDefinitions,
+			*h.HelmWorkloadDefinition.Name,
+			&helmWorkloadDefinition,
+		); err != nil {
+			return nil, err
+		}
+		definitionValues = helmWorkloadDefinition.ValuesDocument
+	}
+	desiredMergedValues, err := helmworkload.MergeHelmValuesPtrs(definitionValues, values)
+	if err != nil {
+		return nil, err
+	}
+	desired := diffObject{exists: true, fields: map[string]string{
+		"KubernetesRuntimeInstance": d.kubernetesRuntimeInstanceName(h.KubernetesRuntimeInstance),
+		"HelmWorkloadDefinition":    *h.HelmWorkloadDefinition.Name,
+		"ValuesDocument":            util.DerefString(values),
+		"MergedValues":              desiredMergedValues,
+	}}
+
+	var helmWorkloadInstance v0.HelmWorkloadInstance
+	exists, err := d.getLive(v0.PathHelmWorkloadInstances, *h.Name, &helmWorkloadInstance)
+	if err != nil {
+		return nil, err
+	}
+	live := diffObject{exists: exists, fields: map[string]string{}}
+	if exists {
+		kubernetesRuntimeInstance, err := client.GetKubernetesRuntimeInstanceByID(
+			d.apiClient,
+			d.apiEndpoint,
+			*helmWorkloadInstance.KubernetesRuntimeInstanceID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kubernetes runtime instance for helm workload instance: %w", err)
+		}
+		helmWorkloadDefinition, err := client.GetHelmWorkloadDefinitionByID(
+			d.apiClient,
+			d.apiEndpoint,
+			*helmWorkloadInstance.HelmWorkloadDefinitionID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get helm workload definition for helm workload instance: %w", err)
+		}
+		liveMergedValues, err := helmworkload.MergeHelmValuesPtrs(
+			helmWorkloadDefinition.ValuesDocument,
+			helmWorkloadInstance.ValuesDocument,
+		)
+		if err != nil {
+			return nil, err
+		}
+		live.fields["KubernetesRuntimeInstance"] = *kubernetesRuntimeInstance.Name
+		live.fields["HelmWorkloadDefinition"] = *helmWorkloadDefinition.Name
+		live.fields["ValuesDocument"] = util.DerefString(helmWorkloadInstance.ValuesDocument)
+		live.fields["MergedValues"] = liveMergedValues
+	}
+
+	return compare("HelmWorkloadInstance", *h.Name, desired, live), nil
+}
+
+// helmWorkloadDefinitionValues returns the values for a helm workload
+// definition from the documents being compared.  It returns false if no
+// document configures the definition.
+func (d *differ) helmWorkloadDefinitionValues(name string) (*string, bool, error) {
+	for _, document := range d.documents {
+		if document.Name != name {
+			continue
+		}
+		switch config := document.config.(type) {
+		case *HelmWorkloadDefinitionConfig:
+			values, err := GetValuesFromDocumentOrInline(
+				util.DerefString(config.HelmWorkloadDefinition.Values),
+				util.DerefString(config.HelmWorkloadDefinition.ValuesDocument),
+				util.DerefString(config.HelmWorkloadDefinition.HelmWorkloadConfigPath),
+			)
+			return values, true, err
+		case *HelmWorkloadConfig:
+			values, err := GetValuesFromDocumentOrInline(
+				util.DerefString(config.HelmWorkload.DefinitionValues),
+				util.DerefString(config.HelmWorkload.DefinitionValuesDocument),
+				util.DerefString(config.HelmWorkload.HelmWorkloadConfigPath),
+			)
+			return values, true, err
+		}
+	}
+
+	return nil, false, nil
+}
+
+// kubernetesRuntimeInstanceName returns the name of the kubernetes runtime
+// instance in a config or, if not set, the default kubernetes runtime
+// instance.
+func (d *differ) kubernetesRuntimeInstanceName(k *KubernetesRuntimeInstanceValues) string {
+	if k != nil && k.Name != nil {
+		return *k.Name
+	}
+
+	kubernetesRuntimeInstance, err := client.GetDefaultKubernetesRuntimeInstance(d.apiClient, d.apiEndpoint)
+	if err != nil {
+		return ""
+	}
+
+	return util.DerefString(kubernetesRuntimeInstance.Name)
+}
+
+// renderManifests renders a multi-document YAML manifest as its individual
+// Kubernetes resources so that manifests are compared regardless of their
+// formatting and field order.
+func renderManifests(yamlDocument string) (string, error) {
+	jsonResources, err := kube.GetJsonResourcesFromYamlDoc(yamlDocument)
+	if err != nil {
+		return "", err
+	}
+
+	var resources []string
+	for _, jsonResource := range jsonResources {
+		resource, err := ghodss_yaml.JSONToYAML(jsonResource)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert resource to YAML: %w", err)
+		}
+		resources = append(resources, string(resource))
+	}
+
+	return strings.Join(resources, "---\n"), nil
+}
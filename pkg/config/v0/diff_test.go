@@ -0,0 +1,154 @@
+package v0
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// newDiffTestAPI starts a server that returns the live objects at each API
+// path, or no objects for paths without a live object, and returns its
+// address.
+func newDiffTestAPI(t *testing.T, liveObjects map[string]map[string]interface{}) string {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := []interface{}{}
+		if liveObject, ok := liveObjects[r.URL.Path]; ok {
+			data = append(data, liveObject)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"Data": data}))
+	}))
+	t.Cleanup(apiServer.Close)
+
+	return strings.TrimPrefix(apiServer.URL, "http://")
+}
+
+// TestDiffConfigFields tests that the scalar fields in a config are compared
+// with the fields of the same name on the objects the config produces, and
+// that sensitive fields are reported as unknown.
+func TestDiffConfigFields(t *testing.T) {
+	testCases := []struct {
+		name        string
+		kind        string
+		values      interface{}
+		liveObjects map[string]map[string]interface{}
+		objectDiffs []ObjectDiff
+	}{
+		{
+			name: "object doesn't exist",
+			kind: "KubernetesRuntimeInstance",
+			values: &KubernetesRuntimeInstanceValues{
+				Name:     util.Ptr("test"),
+				Location: util.Ptr("us-east-1"),
+			},
+			objectDiffs: []ObjectDiff{
+				{ObjectType: "KubernetesRuntimeInstance", Name: "test"},
+			},
+		},
+		{
+			name: "object unchanged",
+			kind: "KubernetesRuntimeInstance",
+			values: &KubernetesRuntimeInstanceValues{
+				Name:     util.Ptr("test"),
+				Location: util.Ptr("us-east-1"),
+			},
+			liveObjects: map[string]map[string]interface{}{
+				v0.PathKubernetesRuntimeInstances: {"Name": "test", "Location": "us-east-1"},
+			},
+			objectDiffs: []ObjectDiff{
+				{ObjectType: "KubernetesRuntimeInstance", Name: "test", Exists: true},
+			},
+		},
+		{
+			name: "definition and instance changed",
+			kind: "KubernetesRuntime",
+			values: &KubernetesRuntimeValues{
+				Name:             util.Ptr("test"),
+				HighAvailability: util.Ptr(true),
+				Location:         util.Ptr("us-west-2"),
+				DefaultRuntime:   util.Ptr(true),
+			},
+			liveObjects: map[string]map[string]interface{}{
+				v0.PathKubernetesRuntimeDefinitions: {"Name": "test", "HighAvailability": false},
+				v0.PathKubernetesRuntimeInstances:   {"Name": "test", "Location": "us-east-1", "DefaultRuntime": true},
+			},
+			objectDiffs: []ObjectDiff{
+				{
+					ObjectType: "KubernetesRuntimeDefinition",
+					Name:       "test",
+					Exists:     true,
+					Fields: []FieldDiff{
+						{Field: "HighAvailability", Live: "false", Desired: "true"},
+					},
+				},
+				{
+					ObjectType: "KubernetesRuntimeInstance",
+					Name:       "test",
+					Exists:     true,
+					Fields: []FieldDiff{
+						{Field: "Location", Live: "us-east-1", Desired: "us-west-2"},
+					},
+				},
+			},
+		},
+		{
+			name: "fields not set in config ignored",
+			kind: "KubernetesRuntimeInstance",
+			values: &KubernetesRuntimeInstanceValues{
+				Name: util.Ptr("test"),
+			},
+			liveObjects: map[string]map[string]interface{}{
+				v0.PathKubernetesRuntimeInstances: {"Name": "test", "Location": "us-east-1"},
+			},
+			objectDiffs: []ObjectDiff{
+				{ObjectType: "KubernetesRuntimeInstance", Name: "test", Exists: true},
+			},
+		},
+		{
+			name: "sensitive fields unknown",
+			kind: "AwsAccount",
+			values: &AwsAccountValues{
+				Name:            util.Ptr("test"),
+				DefaultRegion:   util.Ptr("us-east-1"),
+				AccessKeyID:     util.Ptr("AKIAEXAMPLE"),
+				SecretAccessKey: util.Ptr("secret"),
+			},
+			liveObjects: map[string]map[string]interface{}{
+				v0.PathAwsAccounts: {"Name": "test", "DefaultRegion": "us-east-1", "AccessKeyID": "encrypted"},
+			},
+			objectDiffs: []ObjectDiff{
+				{
+					ObjectType: "AwsAccount",
+					Name:       "test",
+					Exists:     true,
+					Fields: []FieldDiff{
+						{Field: "AccessKeyID", Unknown: true},
+						{Field: "SecretAccessKey", Unknown: true},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := differ{
+				apiClient:   http.DefaultClient,
+				apiEndpoint: newDiffTestAPI(t, tc.liveObjects),
+			}
+
+			objectDiffs, err := d.diffConfigFields(tc.kind, "test", tc.values)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.objectDiffs, objectDiffs)
+		})
+	}
+}
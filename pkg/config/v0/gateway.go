@@ -134,6 +134,20 @@ func (g *GatewayValues) Create(apiClient *http.Client, apiEndpoint string) (*v0.
 	return createdGatewayDefinition, createdGatewayInstance, nil
 }
 
+// Update updates a gateway definition and instance in the Threeport API.
+func (g *GatewayValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.GatewayDefinition, *v0.GatewayInstance, error) {
+
+	// get operations
+	operations, updatedGatewayDefinition, updatedGatewayInstance := g.GetOperations(apiClient, apiEndpoint)
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf("failed to execute update operations for gateway with name %s: %w", *g.Name, err)
+	}
+
+	return updatedGatewayDefinition, updatedGatewayInstance, nil
+}
+
 // Delete deletes a gateway definition and instance from the Threeport API.
 func (g *GatewayValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.GatewayDefinition, *v0.GatewayInstance, error) {
 
@@ -245,6 +259,144 @@ func (g *GatewayDefinitionValues) Create(apiClient *http.Client, apiEndpoint str
 		return nil, fmt.Errorf("failed to validate values for gateway definition with name %s: %w", *g.Name, err)
 	}
 
+	// construct ports and get domain name definition
+	httpPorts, tcpPorts, domainNameDefinition, domainNameUsed, err := g.getPortsAndDomainName(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// construct gateway definition object
+	var gatewayDefinition v0.GatewayDefinition
+	if domainNameUsed {
+		gatewayDefinition = v0.GatewayDefinition{
+			Definition: v0.Definition{
+				Name: g.Name,
+			},
+			HttpPorts:              httpPorts,
+			TcpPorts:               tcpPorts,
+			SubDomain:              g.SubDomain,
+			ServiceName:            g.ServiceName,
+			DomainNameDefinitionID: domainNameDefinition.ID,
+		}
+	} else {
+		gatewayDefinition = v0.GatewayDefinition{
+			Definition: v0.Definition{
+				Name: g.Name,
+			},
+			HttpPorts:   httpPorts,
+			TcpPorts:    tcpPorts,
+			SubDomain:   g.SubDomain,
+			ServiceName: g.ServiceName,
+		}
+	}
+
+	// create gateway definition
+	createdGatewayDefinition, err := client.CreateGatewayDefinition(apiClient, apiEndpoint, &gatewayDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gateway definition with name %s: %w", *g.Name, err)
+	}
+
+	return createdGatewayDefinition, nil
+}
+
+// Update updates a gateway definition and replaces its http and tcp ports.
+func (g *GatewayDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.GatewayDefinition, error) {
+	if err := g.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate values for gateway definition with name %s: %w", *g.Name, err)
+	}
+
+	// construct ports and get domain name definition
+	httpPorts, tcpPorts, domainNameDefinition, domainNameUsed, err := g.getPortsAndDomainName(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// get existing gateway definition by name
+	existingGatewayDefinition, err := client.GetGatewayDefinitionByName(apiClient, apiEndpoint, *g.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find gateway definition with name %s: %w", *g.Name, err)
+	}
+
+	// replace the gateway definition's ports
+	existingHttpPorts, existingTcpPorts, err := client.GetGatewayHttpAndTcpPortsByGatewayDefinitionId(
+		apiClient,
+		apiEndpoint,
+		*existingGatewayDefinition.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ports for gateway definition with name %s: %w", *g.Name, err)
+	}
+	for _, httpPort := range *existingHttpPorts {
+		if _, err := client.DeleteGatewayHttpPort(apiClient, apiEndpoint, *httpPort.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete http port %d for gateway definition with name %s: %w", *httpPort.Port, *g.Name, err)
+		}
+	}
+	for _, tcpPort := range *existingTcpPorts {
+		if _, err := client.DeleteGatewayTcpPort(apiClient, apiEndpoint, *tcpPort.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete tcp port %d for gateway definition with name %s: %w", *tcpPort.Port, *g.Name, err)
+		}
+	}
+	for _, httpPort := range httpPorts {
+		httpPort.GatewayDefinitionID = existingGatewayDefinition.ID
+		if _, err := client.CreateGatewayHttpPort(apiClient, apiEndpoint, httpPort); err != nil {
+			return nil, fmt.Errorf("failed to create http port %d for gateway definition with name %s: %w", *httpPort.Port, *g.Name, err)
+		}
+	}
+	for _, tcpPort := range tcpPorts {
+		tcpPort.GatewayDefinitionID = existingGatewayDefinition.ID
+		if _, err := client.CreateGatewayTcpPort(apiClient, apiEndpoint, tcpPort); err != nil {
+			return nil, fmt.Errorf("failed to create tcp port %d for gateway definition with name %s: %w", *tcpPort.Port, *g.Name, err)
+		}
+	}
+
+	// construct gateway definition object with updated fields
+	reconciled := false
+	gatewayDefinition := v0.GatewayDefinition{
+		Common: v0.Common{
+			ID:              existingGatewayDefinition.ID,
+			ResourceVersion: existingGatewayDefinition.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: &reconciled,
+		},
+		SubDomain:   g.SubDomain,
+		ServiceName: g.ServiceName,
+	}
+	if domainNameUsed {
+		gatewayDefinition.DomainNameDefinitionID = domainNameDefinition.ID
+	}
+
+	// update gateway definition
+	updatedGatewayDefinition, err := client.UpdateGatewayDefinition(apiClient, apiEndpoint, &gatewayDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update gateway definition with name %s: %w", *g.Name, err)
+	}
+
+	return updatedGatewayDefinition, nil
+}
+
+// Delete deletes a gateway definition.
+func (g *GatewayDefinitionValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.GatewayDefinition, error) {
+	// get domain name definition
+	gatewayDefinition, err := client.GetGatewayDefinitionByName(apiClient, apiEndpoint, *g.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find gateway definition with name %s: %w", *g.Name, err)
+	}
+
+	deletedGatewayDefinition, err := client.DeleteGatewayDefinition(apiClient, apiEndpoint, *gatewayDefinition.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete gateway definition with name %s: %w", *g.Name, err)
+	}
+
+	return deletedGatewayDefinition, nil
+}
+
+// getPortsAndDomainName constructs the http and tcp ports for a gateway
+// definition and gets the domain name definition it uses, if any.
+func (g *GatewayDefinitionValues) getPortsAndDomainName(
+	apiClient *http.Client,
+	apiEndpoint string,
+) ([]*v0.GatewayHttpPort, []*v0.GatewayTcpPort, *v0.DomainNameDefinition, bool, error) {
 	// construct list of http ports
 	tlsEnabled := false
 	var httpPorts []*v0.GatewayHttpPort
@@ -256,7 +408,7 @@ func (g *GatewayDefinitionValues) Create(apiClient *http.Client, apiEndpoint str
 
 			// validate port config
 			if err := currentHttpPort.Validate(); err != nil {
-				return nil, fmt.Errorf("failed to validate values for http port %d: %w", *currentHttpPort.Port, err)
+				return nil, nil, nil, false, fmt.Errorf("failed to validate values for http port %d: %w", *currentHttpPort.Port, err)
 			}
 
 			if currentHttpPort.TLSEnabled != nil && *currentHttpPort.TLSEnabled {
@@ -293,63 +445,16 @@ func (g *GatewayDefinitionValues) Create(apiClient *http.Client, apiEndpoint str
 		domainNameUsed = true
 		dnd, err := client.GetDomainNameDefinitionByName(apiClient, apiEndpoint, *g.DomainNameDefinition.Name)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get domain name definition with name %s: %w", *g.DomainNameDefinition.Name, err)
+			return nil, nil, nil, false, fmt.Errorf("failed to get domain name definition with name %s: %w", *g.DomainNameDefinition.Name, err)
 		}
 		domainNameDefinition = dnd
 	} else {
 		if tlsEnabled {
-			return nil, errors.New("cannot use TLSEnabled without a domain name")
-		}
-	}
-
-	// construct gateway definition object
-	var gatewayDefinition v0.GatewayDefinition
-	if domainNameUsed {
-		gatewayDefinition = v0.GatewayDefinition{
-			Definition: v0.Definition{
-				Name: g.Name,
-			},
-			HttpPorts:              httpPorts,
-			TcpPorts:               tcpPorts,
-			SubDomain:              g.SubDomain,
-			ServiceName:            g.ServiceName,
-			DomainNameDefinitionID: domainNameDefinition.ID,
-		}
-	} else {
-		gatewayDefinition = v0.GatewayDefinition{
-			Definition: v0.Definition{
-				Name: g.Name,
-			},
-			HttpPorts:   httpPorts,
-			TcpPorts:    tcpPorts,
-			SubDomain:   g.SubDomain,
-			ServiceName: g.ServiceName,
+			return nil, nil, nil, false, errors.New("cannot use TLSEnabled without a domain name")
 		}
 	}
 
-	// create gateway definition
-	createdGatewayDefinition, err := client.CreateGatewayDefinition(apiClient, apiEndpoint, &gatewayDefinition)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create gateway definition with name %s: %w", *g.Name, err)
-	}
-
-	return createdGatewayDefinition, nil
-}
-
-// Delete deletes a gateway definition.
-func (g *GatewayDefinitionValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.GatewayDefinition, error) {
-	// get domain name definition
-	gatewayDefinition, err := client.GetGatewayDefinitionByName(apiClient, apiEndpoint, *g.Name)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find gateway definition with name %s: %w", *g.Name, err)
-	}
-
-	deletedGatewayDefinition, err := client.DeleteGatewayDefinition(apiClient, apiEndpoint, *gatewayDefinition.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete gateway definition with name %s: %w", *g.Name, err)
-	}
-
-	return deletedGatewayDefinition, nil
+	return httpPorts, tcpPorts, domainNameDefinition, domainNameUsed, nil
 }
 
 // Validate validates the gateway instance values.
@@ -427,6 +532,83 @@ func (g *GatewayInstanceValues) Create(apiClient *http.Client, apiEndpoint strin
 	return createdGatewayInstance, nil
 }
 
+// Update updates a gateway instance.  The gateway instance refers to the same
+// gateway definition, kubernetes runtime instance and workload instance and
+// is reconciled again to apply changes to its gateway definition.
+func (g *GatewayInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.GatewayInstance, error) {
+	// validate required fields
+	if err := g.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate values for gateway instance with name %s: %w", *g.Name, err)
+	}
+
+	// get existing gateway instance by name
+	existingGatewayInstance, err := client.GetGatewayInstanceByName(apiClient, apiEndpoint, *g.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find gateway instance with name %s: %w", *g.Name, err)
+	}
+
+	// get kubernetes runtime instance API object
+	kubernetesRuntimeInstance, err := SetKubernetesRuntimeInstanceForConfig(
+		g.KubernetesRuntimeInstance,
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set kubernetes runtime instance: %w", err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		existingGatewayInstance.KubernetesRuntimeInstanceID,
+		kubernetesRuntimeInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get workload instance
+	workloadInstance, err := client.GetWorkloadInstanceByName(apiClient, apiEndpoint, *g.WorkloadInstance.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload instance with name %s: %w", *g.WorkloadInstance.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"WorkloadInstance",
+		existingGatewayInstance.WorkloadInstanceID,
+		workloadInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get gateway definition
+	gatewayDefinition, err := client.GetGatewayDefinitionByName(apiClient, apiEndpoint, *g.GatewayDefinition.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gateway definition with name %s: %w", *g.GatewayDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"GatewayDefinition",
+		existingGatewayInstance.GatewayDefinitionID,
+		gatewayDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// trigger a reconciliation of the gateway instance
+	reconciled := false
+	gatewayInstance := v0.GatewayInstance{
+		Common: v0.Common{
+			ID:              existingGatewayInstance.ID,
+			ResourceVersion: existingGatewayInstance.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: &reconciled,
+		},
+	}
+	updatedGatewayInstance, err := client.UpdateGatewayInstance(apiClient, apiEndpoint, &gatewayInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update gateway instance with name %s: %w", *g.Name, err)
+	}
+
+	return updatedGatewayInstance, nil
+}
+
 // Describe returns details related to a gateway instance.
 func (k *GatewayInstanceValues) Describe(
 	apiClient *http.Client,
@@ -480,8 +662,8 @@ func (g *GatewayInstanceValues) Delete(apiClient *http.Client, apiEndpoint strin
 	return deletedGatewayInstance, nil
 }
 
-// GetOperations returns a slice of operations used to create or delete a
-// gateway.
+// GetOperations returns a slice of operations used to create, update or
+// delete a gateway.
 func (g *GatewayValues) GetOperations(
 	apiClient *http.Client,
 	apiEndpoint string,
@@ -512,6 +694,14 @@ func (g *GatewayValues) GetOperations(
 			createdGatewayDefinition = *gatewayDefinition
 			return nil
 		},
+		Update: func() error {
+			gatewayDefinition, err := gatewayDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update gateway definition with name %s: %w", *g.Name, err)
+			}
+			createdGatewayDefinition = *gatewayDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err = gatewayDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -540,6 +730,14 @@ func (g *GatewayValues) GetOperations(
 			createdGatewayInstance = *gatewayInstance
 			return nil
 		},
+		Update: func() error {
+			gatewayInstance, err := gatewayInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update gateway instance with name %s: %w", *g.Name, err)
+			}
+			createdGatewayInstance = *gatewayInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err = gatewayInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -573,6 +771,27 @@ func (n *DomainNameValues) Create(
 	return createdDomainNameDefinition, createdDomainNameInstance, nil
 }
 
+// Update updates a domain name definition and instance in the Threeport API.
+func (n *DomainNameValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.DomainNameDefinition, *v0.DomainNameInstance, error) {
+
+	// get operations
+	operations, updatedDomainNameDefinition, updatedDomainNameInstance := n.GetOperations(apiClient, apiEndpoint)
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to execute update operations for domain name defined instance with name %s: %w",
+			*n.Name,
+			err,
+		)
+	}
+
+	return updatedDomainNameDefinition, updatedDomainNameInstance, nil
+}
+
 // Delete deletes a domain name definition and instance from the Threeport API.
 func (n *DomainNameValues) Delete(
 	apiClient *http.Client,
@@ -627,6 +846,39 @@ func (d *DomainNameDefinitionValues) Create(apiClient *http.Client, apiEndpoint
 	return createdDomainNameDefinition, nil
 }
 
+// Update updates a domain name definition in the Threeport API.
+func (d *DomainNameDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.DomainNameDefinition, error) {
+	// validate required fields
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate values for domain name definition with name %s: %w", *d.Name, err)
+	}
+
+	// get existing domain name definition by name
+	existingDomainNameDefinition, err := client.GetDomainNameDefinitionByName(apiClient, apiEndpoint, *d.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find domain name definition with name %s: %w", *d.Name, err)
+	}
+
+	// construct domain name definition object with updated fields
+	domainNameDefinition := v0.DomainNameDefinition{
+		Common: v0.Common{
+			ID:              existingDomainNameDefinition.ID,
+			ResourceVersion: existingDomainNameDefinition.ResourceVersion,
+		},
+		Domain:     d.Domain,
+		Zone:       d.Zone,
+		AdminEmail: d.AdminEmail,
+	}
+
+	// update domain name definition
+	updatedDomainNameDefinition, err := client.UpdateDomainNameDefinition(apiClient, apiEndpoint, &domainNameDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update domain name definition with name %s: %w", *d.Name, err)
+	}
+
+	return updatedDomainNameDefinition, nil
+}
+
 // Validate validates the domain name definition values.
 func (d *DomainNameDefinitionValues) Validate() error {
 
@@ -750,6 +1002,74 @@ func (d *DomainNameInstanceValues) Create(apiClient *http.Client, apiEndpoint st
 	return createdDomainNameInstance, nil
 }
 
+// Update updates a domain name instance in the Threeport API.  The domain
+// name instance refers to the same domain name definition, kubernetes runtime
+// instance and workload instance and is reconciled again to apply changes to
+// its domain name definition.
+func (d *DomainNameInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.DomainNameInstance, error) {
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf(
+			"failed to validate values for domain name instance %s: %w",
+			d.getDomainNameInstanceName(),
+			err,
+		)
+	}
+
+	// get existing domain name instance by name
+	existingDomainNameInstance, err := client.GetDomainNameInstanceByName(apiClient, apiEndpoint, d.getDomainNameInstanceName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find domain name instance %s: %w", d.getDomainNameInstanceName(), err)
+	}
+
+	// get kubernetes runtime instance API object
+	kubernetesRuntimeInstance, err := SetKubernetesRuntimeInstanceForConfig(
+		d.KubernetesRuntimeInstance,
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set kubernetes runtime instance: %w", err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		existingDomainNameInstance.KubernetesRuntimeInstanceID,
+		kubernetesRuntimeInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get domain name definition
+	domainNameDefinition, err := client.GetDomainNameDefinitionByName(apiClient, apiEndpoint, *d.DomainNameDefinition.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain name definition with name %s: %w", *d.DomainNameDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"DomainNameDefinition",
+		existingDomainNameInstance.DomainNameDefinitionID,
+		domainNameDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// trigger a reconciliation of the domain name instance
+	reconciled := false
+	domainNameInstance := v0.DomainNameInstance{
+		Common: v0.Common{
+			ID:              existingDomainNameInstance.ID,
+			ResourceVersion: existingDomainNameInstance.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: &reconciled,
+		},
+	}
+	updatedDomainNameInstance, err := client.UpdateDomainNameInstance(apiClient, apiEndpoint, &domainNameInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update domain name instance %s: %w", d.getDomainNameInstanceName(), err)
+	}
+
+	return updatedDomainNameInstance, nil
+}
+
 // Describe returns details related to a domain name instance.
 func (k *DomainNameInstanceValues) Describe(
 	apiClient *http.Client,
@@ -826,8 +1146,8 @@ func (d *DomainNameInstanceValues) Validate() error {
 	return nil
 }
 
-// GetOperations returns a slice of operations used to create or delete a
-// domain name.
+// GetOperations returns a slice of operations used to create, update or
+// delete a domain name.
 func (n *DomainNameValues) GetOperations(
 	apiClient *http.Client,
 	apiEndpoint string,
@@ -856,6 +1176,14 @@ func (n *DomainNameValues) GetOperations(
 			createdDomainNameDefinition = *domainNameDefinition
 			return nil
 		},
+		Update: func() error {
+			domainNameDefinition, err := domainNameDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update domain name definition %s: %w", *n.Name, err)
+			}
+			createdDomainNameDefinition = *domainNameDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err = domainNameDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -888,6 +1216,18 @@ func (n *DomainNameValues) GetOperations(
 			createdDomainNameInstance = *domainNameInstance
 			return nil
 		},
+		Update: func() error {
+			domainNameInstance, err := domainNameInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to update domain name instance %s: %w",
+					*domainNameInstanceValues.Name,
+					err,
+				)
+			}
+			createdDomainNameInstance = *domainNameInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err = domainNameInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -146,6 +146,46 @@ func (h *HelmWorkloadValues) ValidateCreate() error {
 	return multiError.Error()
 }
 
+// Update updates a helm workload definition and instance in the Threeport API.
+func (h *HelmWorkloadValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.HelmWorkloadDefinition, *v0.HelmWorkloadInstance, error) {
+
+	// validate required fields
+	if err := h.ValidateCreate(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to validate values for helm workload with name %s: %w",
+			*h.Name,
+			err,
+		)
+	}
+
+	// get operations
+	operations, updatedHelmWorkloadDefinition, updatedHelmWorkloadInstance, err := h.GetOperations(
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to get operations for helm workload with name %s: %w",
+			*h.Name,
+			err,
+		)
+	}
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to execute update operations for helm workload defined instance with name %s: %w",
+			*h.Name,
+			err,
+		)
+	}
+
+	return updatedHelmWorkloadDefinition, updatedHelmWorkloadInstance, nil
+}
+
 // Delete deletes a helm workload definition, helm workload instance,
 // domain name definition, domain name instance,
 // gateway definition, and gateway instance from the Threeport API.
@@ -215,6 +255,63 @@ func (h *HelmWorkloadDefinitionValues) Create(
 	return createdHelmWorkloadDefinition, nil
 }
 
+// Update updates a helm workload definition in the Threeport API.  The helm
+// workload controller upgrades the helm releases for the definition's
+// instances.
+func (h *HelmWorkloadDefinitionValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.HelmWorkloadDefinition, error) {
+	// validate required fields
+	if h.Name == nil || h.Repo == nil || h.Chart == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, Repo, Chart")
+	}
+
+	// get existing helm workload definition by name
+	existingHelmWorkloadDefinition, err := client.GetHelmWorkloadDefinitionByName(apiClient, apiEndpoint, *h.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find helm workload definition with name %s: %w", *h.Name, err)
+	}
+
+	// construct helm workload definition object with updated fields
+	reconciled := false
+	helmWorkloadDefinition := v0.HelmWorkloadDefinition{
+		Common: v0.Common{
+			ID:              existingHelmWorkloadDefinition.ID,
+			ResourceVersion: existingHelmWorkloadDefinition.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: &reconciled,
+		},
+		Repo:         h.Repo,
+		Chart:        h.Chart,
+		ChartVersion: h.ChartVersion,
+	}
+
+	// set helm values if present
+	values, err := GetValuesFromDocumentOrInline(
+		util.DerefString(h.Values),
+		util.DerefString(h.ValuesDocument),
+		util.DerefString(h.HelmWorkloadConfigPath),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get values document from path: %w", err)
+	}
+	helmWorkloadDefinition.ValuesDocument = values
+
+	// update helm workload definition
+	updatedHelmWorkloadDefinition, err := client.UpdateHelmWorkloadDefinition(
+		apiClient,
+		apiEndpoint,
+		&helmWorkloadDefinition,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update helm workload definition in threeport API: %w", err)
+	}
+
+	return updatedHelmWorkloadDefinition, nil
+}
+
 // ValidateCreate validates the helm workload definition values before creating a helm
 // workload definition
 func (h *HelmWorkloadDefinitionValues) ValidateCreate() error {
@@ -354,6 +451,97 @@ func (h *HelmWorkloadInstanceValues) Create(
 	return createdHelmWorkloadInstance, nil
 }
 
+// Update updates a helm workload instance in the Threeport API.  The helm
+// workload controller upgrades the helm release with the updated values.
+func (h *HelmWorkloadInstanceValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.HelmWorkloadInstance, error) {
+	// validate required fields
+	if h.Name == nil || h.HelmWorkloadDefinition == nil || h.HelmWorkloadDefinition.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, HelmWorkloadDefinition.Name")
+	}
+
+	// get existing helm workload instance by name
+	existingHelmWorkloadInstance, err := client.GetHelmWorkloadInstanceByName(apiClient, apiEndpoint, *h.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find helm workload instance with name %s: %w", *h.Name, err)
+	}
+
+	// get kubernetes runtime instance API object
+	kubernetesRuntimeInstance, err := SetKubernetesRuntimeInstanceForConfig(
+		h.KubernetesRuntimeInstance,
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set kubernetes runtime instance: %w", err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		existingHelmWorkloadInstance.KubernetesRuntimeInstanceID,
+		kubernetesRuntimeInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get helm workload definition by name
+	helmWorkloadDefinition, err := client.GetHelmWorkloadDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*h.HelmWorkloadDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to find helm workload definition with name %s: %w",
+			*h.HelmWorkloadDefinition.Name,
+			err,
+		)
+	}
+	if err := checkReferenceUnchanged(
+		"HelmWorkloadDefinition",
+		existingHelmWorkloadInstance.HelmWorkloadDefinitionID,
+		helmWorkloadDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// construct helm workload instance object with updated fields
+	reconciled := false
+	helmWorkloadInstance := v0.HelmWorkloadInstance{
+		Common: v0.Common{
+			ID:              existingHelmWorkloadInstance.ID,
+			ResourceVersion: existingHelmWorkloadInstance.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: &reconciled,
+		},
+	}
+
+	// get helm instance values
+	values, err := GetValuesFromDocumentOrInline(
+		util.DerefString(h.Values),
+		util.DerefString(h.ValuesDocument),
+		util.DerefString(h.HelmWorkloadConfigPath),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get helm instance values document from path: %w", err)
+	}
+	helmWorkloadInstance.ValuesDocument = values
+
+	// update helm workload instance
+	updatedHelmWorkloadInstance, err := client.UpdateHelmWorkloadInstance(
+		apiClient,
+		apiEndpoint,
+		&helmWorkloadInstance,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update helm workload instance in threeport API: %w", err)
+	}
+
+	return updatedHelmWorkloadInstance, nil
+}
+
 // ValidateCreate validates the helm workload instance values before creating a helm
 // workload instance
 func (h *HelmWorkloadInstanceValues) ValidateCreate() error {
@@ -484,6 +672,18 @@ func (h *HelmWorkloadValues) GetOperations(
 			createdHelmWorkloadDefinition = *helmWorkloadDefinition
 			return nil
 		},
+		Update: func() error {
+			helmWorkloadDefinition, err := helmWorkloadDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to update helm workload definition with name %s: %w",
+					*h.Name,
+					err,
+				)
+			}
+			createdHelmWorkloadDefinition = *helmWorkloadDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err = helmWorkloadDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -519,6 +719,14 @@ func (h *HelmWorkloadValues) GetOperations(
 			createdHelmWorkloadInstance = *helmWorkloadInstance
 			return nil
 		},
+		Update: func() error {
+			helmWorkloadInstance, err := helmWorkloadInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update helm workload instance with name %s: %w", *h.Name, err)
+			}
+			createdHelmWorkloadInstance = *helmWorkloadInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err = helmWorkloadInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
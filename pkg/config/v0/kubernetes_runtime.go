@@ -94,6 +94,38 @@ func (kr *KubernetesRuntimeValues) Create(apiClient *http.Client, apiEndpoint st
 	return createdKubernetesRuntimeDefinition, createdKubernetesRuntimeInstance, nil
 }
 
+// Update updates a kubernetes runtime definition and instance in the Threeport API.
+func (kr *KubernetesRuntimeValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.KubernetesRuntimeDefinition, *v0.KubernetesRuntimeInstance, error) {
+	// update the kubernetes runtime definition
+	kubernetesRuntimeDefinition := KubernetesRuntimeDefinitionValues{
+		Name:                     kr.Name,
+		InfraProvider:            kr.InfraProvider,
+		InfraProviderAccountName: kr.InfraProviderAccountName,
+		HighAvailability:         kr.HighAvailability,
+	}
+	updatedKubernetesRuntimeDefinition, err := kubernetesRuntimeDefinition.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update kubernetes runtime definition: %w", err)
+	}
+
+	// update the kubernetes runtime instance
+	kubernetesRuntimeInstance := KubernetesRuntimeInstanceValues{
+		Name:                kr.Name,
+		Location:            kr.Location,
+		DefaultRuntime:      kr.DefaultRuntime,
+		ThreeportAgentImage: kr.ThreeportAgentImage,
+		KubernetesRuntimeDefinition: &KubernetesRuntimeDefinitionValues{
+			Name: kr.Name,
+		},
+	}
+	updatedKubernetesRuntimeInstance, err := kubernetesRuntimeInstance.Update(apiClient, apiEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update kubernetes runtime instance: %w", err)
+	}
+
+	return updatedKubernetesRuntimeDefinition, updatedKubernetesRuntimeInstance, nil
+}
+
 // Delete deletes a kubernetes runtime definition and instance from the Threeport API.
 func (kr *KubernetesRuntimeValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.KubernetesRuntimeDefinition, *v0.KubernetesRuntimeInstance, error) {
 	// get kubernetes runtime instance by name
@@ -176,6 +208,43 @@ func (krd *KubernetesRuntimeDefinitionValues) Create(apiClient *http.Client, api
 	return createdKubernetesRuntimeDefinition, nil
 }
 
+// Update updates a kubernetes runtime definition.  The infra provider cannot
+// be changed for an existing kubernetes runtime definition.
+func (krd *KubernetesRuntimeDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.KubernetesRuntimeDefinition, error) {
+	// validate required fields
+	if krd.Name == nil || krd.InfraProvider == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, InfraProvider")
+	}
+
+	// get existing kubernetes runtime definition by name
+	existingKubernetesRuntimeDefinition, err := client.GetKubernetesRuntimeDefinitionByName(apiClient, apiEndpoint, *krd.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find kubernetes definition with name %s: %w", *krd.Name, err)
+	}
+	if existingKubernetesRuntimeDefinition.InfraProvider != nil &&
+		*existingKubernetesRuntimeDefinition.InfraProvider != *krd.InfraProvider {
+		return nil, fmt.Errorf("%w: InfraProvider changed", ErrReplaceRequired)
+	}
+
+	// construct kubernetes runtime definition object with updated fields
+	kubernetesRuntimeDefinition := v0.KubernetesRuntimeDefinition{
+		Common: v0.Common{
+			ID:              existingKubernetesRuntimeDefinition.ID,
+			ResourceVersion: existingKubernetesRuntimeDefinition.ResourceVersion,
+		},
+		HighAvailability:         krd.HighAvailability,
+		InfraProviderAccountName: krd.InfraProviderAccountName,
+	}
+
+	// update kubernetes runtime definition
+	updatedKubernetesRuntimeDefinition, err := client.UpdateKubernetesRuntimeDefinition(apiClient, apiEndpoint, &kubernetesRuntimeDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update kubernetes runtime definition in threeport API: %w", err)
+	}
+
+	return updatedKubernetesRuntimeDefinition, nil
+}
+
 // Describe returns details related to a kubernetes runtime definition.
 func (k *KubernetesRuntimeDefinitionValues) Describe(
 	apiClient *http.Client,
@@ -261,6 +330,57 @@ func (kri *KubernetesRuntimeInstanceValues) Create(apiClient *http.Client, apiEn
 	return createdKubernetesRuntimeInstance, nil
 }
 
+// Update updates a kubernetes runtime instance.  The instance is reconciled
+// again so that changes such as a new threeport agent image are applied to
+// the running kubernetes cluster.
+func (kri *KubernetesRuntimeInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.KubernetesRuntimeInstance, error) {
+	// validate required fields
+	if kri.Name == nil || kri.Location == nil || kri.KubernetesRuntimeDefinition.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, Location, KubernetesRuntimeDefinition.Name")
+	}
+
+	// get existing kubernetes runtime instance by name
+	existingKubernetesRuntimeInstance, err := client.GetKubernetesRuntimeInstanceByName(apiClient, apiEndpoint, *kri.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find kubernetes instance with name %s: %w", *kri.Name, err)
+	}
+
+	// get kubernetes runtime definition by name
+	kubernetesRuntimeDefinition, err := client.GetKubernetesRuntimeDefinitionByName(apiClient, apiEndpoint, *kri.KubernetesRuntimeDefinition.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find kubernetes definition with name %s: %w", *kri.KubernetesRuntimeDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeDefinition",
+		existingKubernetesRuntimeInstance.KubernetesRuntimeDefinitionID,
+		kubernetesRuntimeDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// construct kubernetes runtime instance object with updated fields
+	kubernetesRuntimeInstance := v0.KubernetesRuntimeInstance{
+		Common: v0.Common{
+			ID:              existingKubernetesRuntimeInstance.ID,
+			ResourceVersion: existingKubernetesRuntimeInstance.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: util.Ptr(false),
+		},
+		DefaultRuntime:      kri.DefaultRuntime,
+		Location:            kri.Location,
+		ThreeportAgentImage: kri.ThreeportAgentImage,
+	}
+
+	// update kubernetes runtime instance
+	updatedKubernetesRuntimeInstance, err := client.UpdateKubernetesRuntimeInstance(apiClient, apiEndpoint, &kubernetesRuntimeInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update kubernetes runtime instance in threeport API: %w", err)
+	}
+
+	return updatedKubernetesRuntimeInstance, nil
+}
+
 // Describe returns details related to a kubernetes runtime instance.
 func (k *KubernetesRuntimeInstanceValues) Describe(
 	apiClient *http.Client,
@@ -79,7 +79,8 @@ type ObservabilityStackInstanceValues struct {
 	ObservabilityStackDefinition          *ObservabilityStackDefinitionValues `yaml:"ObservabilityStackDefinition"`
 }
 
-// GetOperations returns the operations for creating or deleting an observability stack.
+// GetOperations returns the operations for creating, updating or deleting an
+// observability stack.
 func (o *ObservabilityStackValues) GetOperations(
 	apiClient *http.Client,
 	apiEndpoint string,
@@ -111,6 +112,14 @@ func (o *ObservabilityStackValues) GetOperations(
 			createdObservabilityStackDefinition = *createdOsd
 			return nil
 		},
+		Update: func() error {
+			updatedOsd, err := observabilityStackDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update observability stack definition with name %s: %w", *o.Name, err)
+			}
+			createdObservabilityStackDefinition = *updatedOsd
+			return nil
+		},
 		Delete: func() error {
 			_, err := observabilityStackDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -146,6 +155,14 @@ func (o *ObservabilityStackValues) GetOperations(
 			createdObservabilityStackInstance = *createdOsi
 			return nil
 		},
+		Update: func() error {
+			updatedOsi, err := observabilityStackInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update observability stack instance with name %s: %w", *o.Name, err)
+			}
+			createdObservabilityStackInstance = *updatedOsi
+			return nil
+		},
 		Delete: func() error {
 			deletedOsi, err := observabilityStackInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -198,6 +215,31 @@ func (o *ObservabilityStackValues) Create(
 	return observabilityStackDefinition, observabilityStackInstance, nil
 }
 
+// Update updates an observability stack definition and instance
+func (o *ObservabilityStackValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.ObservabilityStackDefinition, *v0.ObservabilityStackInstance, error) {
+	// validate observability stack update values
+	if err := o.ValidateCreate(); err != nil {
+		return nil, nil, fmt.Errorf("failed to validate observability stack values: %w", err)
+	}
+
+	// get observability stack operations
+	operations, observabilityStackDefinition, observabilityStackInstance := o.GetOperations(apiClient, apiEndpoint)
+
+	// execute observability stack update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to execute update operations for observability stack defined instance with name %s: %w",
+			*o.Name,
+			err,
+		)
+	}
+
+	return observabilityStackDefinition, observabilityStackInstance, nil
+}
+
 // Delete deletes an observability stack definition and instance
 func (o *ObservabilityStackValues) Delete(
 	apiClient *http.Client,
@@ -347,6 +389,65 @@ func (o *ObservabilityStackDefinitionValues) Create(
 	return createdOsd, nil
 }
 
+// Update updates an observability stack definition.  The helm values are
+// merged into observability stack instances when they are created so changes
+// apply to instances created after the update.
+func (o *ObservabilityStackDefinitionValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.ObservabilityStackDefinition, error) {
+	// validate observability stack update values
+	if err := o.ValidateCreate(); err != nil {
+		return nil, fmt.Errorf("failed to validate observability stack values: %w", err)
+	}
+
+	// get existing observability stack definition
+	existingOsd, err := client.GetObservabilityStackDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*o.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get observability stack definition: %w", err)
+	}
+
+	// get helm values
+	grafana, loki, promtail, kubePrometheusStack, err := getObservabilityHelmValuesDocuments(
+		o.GrafanaHelmValues, o.GrafanaHelmValuesDocument,
+		o.LokiHelmValues, o.LokiHelmValuesDocument,
+		o.PromtailHelmValues, o.PromtailHelmValuesDocument,
+		o.KubePrometheusStackHelmValues, o.KubePrometheusStackHelmValuesDocument,
+		o.ObservabilityConfigPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// construct observability stack definition object with updated fields
+	osd := &v0.ObservabilityStackDefinition{
+		Common: v0.Common{
+			ID:              existingOsd.ID,
+			ResourceVersion: existingOsd.ResourceVersion,
+		},
+		GrafanaHelmValuesDocument:             grafana,
+		LokiHelmValuesDocument:                loki,
+		PromtailHelmValuesDocument:            promtail,
+		KubePrometheusStackHelmValuesDocument: kubePrometheusStack,
+	}
+
+	// update observability stack definition
+	updatedOsd, err := client.UpdateObservabilityStackDefinition(
+		apiClient,
+		apiEndpoint,
+		osd,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update observability stack definition: %w", err)
+	}
+
+	return updatedOsd, nil
+}
+
 // Delete deletes an observability stack definition.
 func (o *ObservabilityStackDefinitionValues) Delete(
 	apiClient *http.Client,
@@ -521,6 +622,98 @@ func (o *ObservabilityStackInstanceValues) Create(
 	return createdOsi, nil
 }
 
+// Update updates an observability stack instance.  The observability
+// components of a running stack are not re-rendered in place, so any change to
+// the instance requires it to be deleted and created again.
+func (o *ObservabilityStackInstanceValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.ObservabilityStackInstance, error) {
+	// validate observability stack update values
+	if err := o.ValidateCreate(); err != nil {
+		return nil, fmt.Errorf("failed to validate observability stack values: %w", err)
+	}
+
+	// get existing observability stack instance
+	existingOsi, err := client.GetObservabilityStackInstanceByName(
+		apiClient,
+		apiEndpoint,
+		*o.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get observability stack instance: %w", err)
+	}
+
+	// get kubernetes runtime instance
+	kri, err := client.GetKubernetesRuntimeInstanceByName(
+		apiClient,
+		apiEndpoint,
+		*o.KubernetesRuntimeInstance.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes runtime instance: %w", err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		existingOsi.KubernetesRuntimeInstanceID,
+		kri.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	osd, err := client.GetObservabilityStackDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*o.ObservabilityStackDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get observability stack definition by name %s: %w",
+			*o.ObservabilityStackDefinition.Name,
+			err,
+		)
+	}
+	if err := checkReferenceUnchanged(
+		"ObservabilityStackDefinition",
+		existingOsi.ObservabilityStackDefinitionID,
+		osd.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// ensure the enabled components are unchanged
+	if o.MetricsEnabled != nil && util.DerefBool(o.MetricsEnabled) != util.DerefBool(existingOsi.MetricsEnabled) {
+		return nil, fmt.Errorf("%w: MetricsEnabled changed", ErrReplaceRequired)
+	}
+	if o.LoggingEnabled != nil && util.DerefBool(o.LoggingEnabled) != util.DerefBool(existingOsi.LoggingEnabled) {
+		return nil, fmt.Errorf("%w: LoggingEnabled changed", ErrReplaceRequired)
+	}
+
+	// ensure the helm values are unchanged
+	grafana, loki, promtail, kubePrometheusStack, err := getObservabilityHelmValuesDocuments(
+		o.GrafanaHelmValues, o.GrafanaHelmValuesDocument,
+		o.LokiHelmValues, o.LokiHelmValuesDocument,
+		o.PromtailHelmValues, o.PromtailHelmValuesDocument,
+		o.KubePrometheusStackHelmValues, o.KubePrometheusStackHelmValuesDocument,
+		o.ObservabilityConfigPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for field, values := range map[string][2]*string{
+		"GrafanaHelmValues":             {grafana, existingOsi.GrafanaHelmValuesDocument},
+		"LokiHelmValues":                {loki, existingOsi.LokiHelmValuesDocument},
+		"PromtailHelmValues":            {promtail, existingOsi.PromtailHelmValuesDocument},
+		"KubePrometheusStackHelmValues": {kubePrometheusStack, existingOsi.KubePrometheusStackHelmValuesDocument},
+	} {
+		if util.DerefString(values[0]) != util.DerefString(values[1]) {
+			return nil, fmt.Errorf("%w: %s changed", ErrReplaceRequired, field)
+		}
+	}
+
+	return existingOsi, nil
+}
+
 // Delete deletes an observability stack instance.
 func (o *ObservabilityStackInstanceValues) Delete(
 	apiClient *http.Client,
@@ -603,3 +796,54 @@ func (o *ObservabilityStackInstanceValues) ValidateDelete() error {
 	}
 	return nil
 }
+
+// getObservabilityHelmValuesDocuments returns the grafana, loki, promtail and
+// kube-prometheus-stack helm values documents from either the inline values or
+// the values documents referenced in the config.
+func getObservabilityHelmValuesDocuments(
+	grafanaInline, grafanaDocument,
+	lokiInline, lokiDocument,
+	promtailInline, promtailDocument,
+	kubePrometheusStackInline, kubePrometheusStackDocument,
+	observabilityConfigPath *string,
+) (*string, *string, *string, *string, error) {
+	configPath := util.DerefString(observabilityConfigPath)
+
+	grafana, err := GetValuesFromDocumentOrInline(
+		util.DerefString(grafanaInline),
+		util.DerefString(grafanaDocument),
+		configPath,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to get grafana values document from path: %w", err)
+	}
+
+	loki, err := GetValuesFromDocumentOrInline(
+		util.DerefString(lokiInline),
+		util.DerefString(lokiDocument),
+		configPath,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to get loki values document from path: %w", err)
+	}
+
+	promtail, err := GetValuesFromDocumentOrInline(
+		util.DerefString(promtailInline),
+		util.DerefString(promtailDocument),
+		configPath,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to get promtail values document from path: %w", err)
+	}
+
+	kubePrometheusStack, err := GetValuesFromDocumentOrInline(
+		util.DerefString(kubePrometheusStackInline),
+		util.DerefString(kubePrometheusStackDocument),
+		configPath,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to get kube-prometheus-stack values document from path: %w", err)
+	}
+
+	return grafana, loki, promtail, kubePrometheusStack, nil
+}
@@ -84,7 +84,36 @@ func (s *SecretValues) Create(
 	return createdSecretDefinition, createdSecretInstance, nil
 }
 
-// GetOperations returns the operations to create and delete a Secret object
+// Update updates a Secret object
+func (s *SecretValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.SecretDefinition, *v0.SecretInstance, error) {
+	// validate required fields
+	if err := s.ValidateCreate(); err != nil {
+		return nil, nil, fmt.Errorf("failed to validate secret values: %w", err)
+	}
+
+	// get operations
+	operations, updatedSecretDefinition, updatedSecretInstance := s.GetOperations(
+		apiClient,
+		apiEndpoint,
+	)
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to update secret defined instance with name %s : %w",
+			*s.Name,
+			err,
+		)
+	}
+
+	return updatedSecretDefinition, updatedSecretInstance, nil
+}
+
+// GetOperations returns the operations to create, update and delete a Secret
+// object
 func (s *SecretValues) GetOperations(
 	apiClient *http.Client,
 	apiEndpoint string,
@@ -109,6 +138,14 @@ func (s *SecretValues) GetOperations(
 			createdSecretDefinition = *secretDefinition
 			return nil
 		},
+		Update: func() error {
+			secretDefinition, err := secretDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update secret definition with name %s: %w", *s.Name, err)
+			}
+			createdSecretDefinition = *secretDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err := secretDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -135,6 +172,14 @@ func (s *SecretValues) GetOperations(
 			createdSecretInstance = *secretInstance
 			return nil
 		},
+		Update: func() error {
+			secretInstance, err := secretInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update secret instance with name %s: %w", *s.Name, err)
+			}
+			createdSecretInstance = *secretInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err := secretInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -211,6 +256,71 @@ func (s *SecretDefinitionValues) Create(
 	return createdSecretDefinition, nil
 }
 
+// Update updates a SecretDefinition object
+func (s *SecretDefinitionValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.SecretDefinition, error) {
+	// validate required fields
+	if err := s.ValidateCreate(); err != nil {
+		return nil, fmt.Errorf("failed to validate secret values: %w", err)
+	}
+
+	// get aws account
+	awsAccount, err := client.GetAwsAccountByName(
+		apiClient,
+		apiEndpoint,
+		*s.AwsAccountName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get aws account by id: %w", err)
+	}
+
+	// get existing secret definition
+	existingSecretDefinition, err := client.GetSecretDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*s.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret definition by name %s: %w", *s.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"AwsAccount",
+		existingSecretDefinition.AwsAccountID,
+		awsAccount.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// marshal json data
+	jsonData, err := json.Marshal(s.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	// update secret definition
+	updatedSecretDefinition, err := client.UpdateSecretDefinition(
+		apiClient,
+		apiEndpoint,
+		&v0.SecretDefinition{
+			Common: v0.Common{
+				ID:              existingSecretDefinition.ID,
+				ResourceVersion: existingSecretDefinition.ResourceVersion,
+			},
+			Reconciliation: v0.Reconciliation{
+				Reconciled: util.Ptr(false),
+			},
+			Data: util.Ptr(datatypes.JSON(jsonData)),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update secret definition: %w", err)
+	}
+
+	return updatedSecretDefinition, nil
+}
+
 // Delete deletes a SecretDefinition object
 func (s *SecretDefinitionValues) Delete(
 	apiClient *http.Client,
@@ -323,6 +433,105 @@ func (s *SecretInstanceValues) Create(
 	return createdSecretInstance, nil
 }
 
+// Update updates a SecretInstance object.  The secret instance must refer to
+// the same secret definition, kubernetes runtime instance and workload
+// instance; changes to the secret data are made on the secret definition.
+func (s *SecretInstanceValues) Update(
+	apiClient *http.Client,
+	apiEndpoint string,
+) (*v0.SecretInstance, error) {
+	// validate required fields
+	if err := s.ValidateCreate(); err != nil {
+		return nil, fmt.Errorf("failed to validate secret values: %w", err)
+	}
+
+	// get existing secret instance
+	existingSecretInstance, err := client.GetSecretInstanceByName(
+		apiClient,
+		apiEndpoint,
+		*s.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret instance by name %s: %w", *s.Name, err)
+	}
+
+	// get kubernetes runtime instance
+	kubernetesRuntimeInstance, err := SetKubernetesRuntimeInstanceForConfig(
+		s.KubernetesRuntimeInstance,
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to set kubernetes runtime instance for secret instance %s: %w",
+			*s.Name,
+			err,
+		)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		existingSecretInstance.KubernetesRuntimeInstanceID,
+		kubernetesRuntimeInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get secret definition
+	secretDefinition, err := client.GetSecretDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*s.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret definition by name %s: %w", *s.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"SecretDefinition",
+		existingSecretInstance.SecretDefinitionID,
+		secretDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get workload instance
+	switch {
+	case s.WorkloadInstance != nil:
+		workloadInstance, err := client.GetWorkloadInstanceByName(
+			apiClient,
+			apiEndpoint,
+			*s.WorkloadInstance.Name,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workload instance by name: %w", err)
+		}
+		if err := checkReferenceUnchanged(
+			"WorkloadInstance",
+			existingSecretInstance.WorkloadInstanceID,
+			workloadInstance.ID,
+		); err != nil {
+			return nil, err
+		}
+	case s.HelmWorkloadInstance != nil:
+		helmWorkloadInstance, err := client.GetHelmWorkloadInstanceByName(
+			apiClient,
+			apiEndpoint,
+			*s.HelmWorkloadInstance.Name,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get helm workload instance by name: %w", err)
+		}
+		if err := checkReferenceUnchanged(
+			"HelmWorkloadInstance",
+			existingSecretInstance.HelmWorkloadInstanceID,
+			helmWorkloadInstance.ID,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return existingSecretInstance, nil
+}
+
 // Delete deletes a SecretInstance object
 func (s *SecretInstanceValues) Delete(
 	apiClient *http.Client,
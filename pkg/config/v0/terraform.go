@@ -76,6 +76,24 @@ func (t *TerraformValues) Create(apiClient *http.Client, apiEndpoint string) (*v
 	return createdTerraformDefinition, createdTerraformInstance, nil
 }
 
+// Update updates a terraform definition and instance in the Threeport API.
+func (t *TerraformValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.TerraformDefinition, *v0.TerraformInstance, error) {
+
+	// get operations
+	operations, updatedTerraformDefinition, updatedTerraformInstance := t.GetOperations(apiClient, apiEndpoint)
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to execute update operations for terraform defined instance with name %s: %w",
+			*t.Name,
+			err,
+		)
+	}
+
+	return updatedTerraformDefinition, updatedTerraformInstance, nil
+}
+
 // Delete deletes a terraform definition, terraform instance,
 // domain name definition, domain name instance,
 // gateway definition, and gateway instance from the Threeport API.
@@ -120,6 +138,73 @@ func (t *TerraformDefinitionValues) Create(apiClient *http.Client, apiEndpoint s
 		return nil, fmt.Errorf("failed to validate values for terraform definition with name %s: %w", *t.Name, err)
 	}
 
+	// load terraform configs
+	concatConfig, err := t.loadConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	// construct terraform definition object
+	terraformDefinition := v0.TerraformDefinition{
+		Definition: v0.Definition{
+			Name: t.Name,
+		},
+		ConfigDir: &concatConfig,
+	}
+
+	// create terraform definition
+	createdTerraformDefinition, err := client.CreateTerraformDefinition(apiClient, apiEndpoint, &terraformDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create terraform definition in threeport API: %w", err)
+	}
+
+	return createdTerraformDefinition, nil
+}
+
+// Update updates a terraform definition in the Threeport API.  Terraform
+// instances derived from the definition apply the updated config.
+func (t *TerraformDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.TerraformDefinition, error) {
+	// validate inputs
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate values for terraform definition with name %s: %w", *t.Name, err)
+	}
+
+	// load terraform configs
+	concatConfig, err := t.loadConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	// get existing terraform definition by name
+	existingTerraformDefinition, err := client.GetTerraformDefinitionByName(apiClient, apiEndpoint, *t.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find terraform definition with name %s: %w", *t.Name, err)
+	}
+
+	// construct terraform definition object with updated fields
+	terraformDefinition := v0.TerraformDefinition{
+		Common: v0.Common{
+			ID:              existingTerraformDefinition.ID,
+			ResourceVersion: existingTerraformDefinition.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: util.Ptr(false),
+		},
+		ConfigDir: &concatConfig,
+	}
+
+	// update terraform definition
+	updatedTerraformDefinition, err := client.UpdateTerraformDefinition(apiClient, apiEndpoint, &terraformDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update terraform definition in threeport API: %w", err)
+	}
+
+	return updatedTerraformDefinition, nil
+}
+
+// loadConfigDir reads all the terraform config files in the config dir and
+// returns them as a single document.
+func (t *TerraformDefinitionValues) loadConfigDir() (string, error) {
 	// build the path to the terraform config dir relative to the user's working
 	// directory
 	configDir := filepath.Dir(*t.TerraformConfigPath)
@@ -138,10 +223,10 @@ func (t *TerraformDefinitionValues) Create(apiClient *http.Client, apiEndpoint s
 
 		return nil
 	}); err != nil {
-		return nil, fmt.Errorf("failed to find terraform config files in provided config dir: %w", err)
+		return "", fmt.Errorf("failed to find terraform config files in provided config dir: %w", err)
 	}
 	if len(terraformConfigFiles) == 0 {
-		return nil, fmt.Errorf("no terraform config files with '.tf' file extension found in provided config dir: %s", *t.ConfigDir)
+		return "", fmt.Errorf("no terraform config files with '.tf' file extension found in provided config dir: %s", *t.ConfigDir)
 	}
 
 	// load terraform configs
@@ -149,27 +234,13 @@ func (t *TerraformDefinitionValues) Create(apiClient *http.Client, apiEndpoint s
 	for _, configFile := range terraformConfigFiles {
 		configContent, err := os.ReadFile(configFile)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read terraform config file with name %s: %w", configFile, err)
+			return "", fmt.Errorf("failed to read terraform config file with name %s: %w", configFile, err)
 		}
 		concatConfig += string(configContent)
 		concatConfig += "\n"
 	}
 
-	// construct terraform definition object
-	terraformDefinition := v0.TerraformDefinition{
-		Definition: v0.Definition{
-			Name: t.Name,
-		},
-		ConfigDir: &concatConfig,
-	}
-
-	// create terraform definition
-	createdTerraformDefinition, err := client.CreateTerraformDefinition(apiClient, apiEndpoint, &terraformDefinition)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create terraform definition in threeport API: %w", err)
-	}
-
-	return createdTerraformDefinition, nil
+	return concatConfig, nil
 }
 
 // Describe returns details related to a terraform definition.
@@ -272,20 +343,11 @@ func (t *TerraformInstanceValues) Create(apiClient *http.Client, apiEndpoint str
 	}
 
 	// add terraform vars if supplied
-	if t.VarsDocument != nil && *t.VarsDocument != "" {
-		// build the path to the terraform config dir relative to the user's working
-		// directory
-		configDir := filepath.Dir(*t.TerraformConfigPath)
-		varsDoc := filepath.Join(configDir, *t.VarsDocument)
-		varsContent, err := os.ReadFile(varsDoc)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read terraform vars file with name %s: %w", *t.VarsDocument, err)
-		}
-
-		// add the terraform vars to the terraform instance object
-		varsContentStr := string(varsContent)
-		terraformInstance.VarsDocument = &varsContentStr
+	varsDocument, err := t.loadVarsDocument()
+	if err != nil {
+		return nil, err
 	}
+	terraformInstance.VarsDocument = varsDocument
 
 	// create terraform instance
 	createdTerraformInstance, err := client.CreateTerraformInstance(apiClient, apiEndpoint, &terraformInstance)
@@ -296,6 +358,100 @@ func (t *TerraformInstanceValues) Create(apiClient *http.Client, apiEndpoint str
 	return createdTerraformInstance, nil
 }
 
+// Update updates a terraform instance in the Threeport API.  The instance is
+// reconciled again to apply the updated terraform vars.
+func (t *TerraformInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.TerraformInstance, error) {
+	// validate inputs
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate values for terraform instance with name %s: %w", *t.Name, err)
+	}
+
+	// get existing terraform instance by name
+	existingTerraformInstance, err := client.GetTerraformInstanceByName(apiClient, apiEndpoint, *t.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find terraform instance with name %s: %w", *t.Name, err)
+	}
+
+	// get terraform definition by name
+	terraformDefinition, err := client.GetTerraformDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*t.TerraformDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find terraform definition with name %s: %w", *t.TerraformDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"TerraformDefinition",
+		existingTerraformInstance.TerraformDefinitionID,
+		terraformDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get AWS Account by name
+	awsAccount, err := client.GetAwsAccountByName(
+		apiClient,
+		apiEndpoint,
+		*t.AwsAccount.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find AWS account with name %s: %w", *t.AwsAccount.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"AwsAccount",
+		existingTerraformInstance.AwsAccountID,
+		awsAccount.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// construct terraform instance object with updated fields
+	terraformInstance := v0.TerraformInstance{
+		Common: v0.Common{
+			ID:              existingTerraformInstance.ID,
+			ResourceVersion: existingTerraformInstance.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: util.Ptr(false),
+		},
+	}
+
+	// add terraform vars if supplied
+	varsDocument, err := t.loadVarsDocument()
+	if err != nil {
+		return nil, err
+	}
+	terraformInstance.VarsDocument = varsDocument
+
+	// update terraform instance
+	updatedTerraformInstance, err := client.UpdateTerraformInstance(apiClient, apiEndpoint, &terraformInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update terraform instance in threeport API: %w", err)
+	}
+
+	return updatedTerraformInstance, nil
+}
+
+// loadVarsDocument reads the terraform vars file if one is supplied.
+func (t *TerraformInstanceValues) loadVarsDocument() (*string, error) {
+	if t.VarsDocument == nil || *t.VarsDocument == "" {
+		return nil, nil
+	}
+
+	// build the path to the terraform config dir relative to the user's working
+	// directory
+	configDir := filepath.Dir(*t.TerraformConfigPath)
+	varsDoc := filepath.Join(configDir, *t.VarsDocument)
+	varsContent, err := os.ReadFile(varsDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terraform vars file with name %s: %w", *t.VarsDocument, err)
+	}
+	varsContentStr := string(varsContent)
+
+	return &varsContentStr, nil
+}
+
 // Describe returns details related to a terraform instance.
 func (k *TerraformInstanceValues) Describe(
 	apiClient *http.Client,
@@ -375,6 +531,14 @@ func (t *TerraformValues) GetOperations(apiClient *http.Client, apiEndpoint stri
 			createdTerraformDefinition = *terraformDefinition
 			return nil
 		},
+		Update: func() error {
+			terraformDefinition, err := terraformDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update terraform definition with name %s: %w", *terraformDefinitionValues.Name, err)
+			}
+			createdTerraformDefinition = *terraformDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err = terraformDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -406,6 +570,14 @@ func (t *TerraformValues) GetOperations(apiClient *http.Client, apiEndpoint stri
 			createdTerraformInstance = *terraformInstance
 			return nil
 		},
+		Update: func() error {
+			terraformInstance, err := terraformInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update terraform instance with name %s: %w", *terraformInstanceValues.Name, err)
+			}
+			createdTerraformInstance = *terraformInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err = terraformInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
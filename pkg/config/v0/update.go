@@ -0,0 +1,23 @@
+package v0
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrReplaceRequired is returned by Update methods when a change to a config
+// cannot be made to the existing objects in place, e.g. when an instance
+// refers to a different definition.  The objects must be deleted and created
+// again to make the change.
+var ErrReplaceRequired = errors.New("change cannot be made in place - delete and create again to apply it")
+
+// checkReferenceUnchanged returns an error that wraps ErrReplaceRequired if
+// the ID of an object referred to by an existing object differs from the one
+// referred to in its config.
+func checkReferenceUnchanged(field string, existingID, configID *uint) error {
+	if configID == nil || (existingID != nil && *existingID == *configID) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s changed", ErrReplaceRequired, field)
+}
@@ -82,6 +82,24 @@ func (w *WorkloadValues) Create(apiClient *http.Client, apiEndpoint string) (*v0
 	return createdWorkloadDefinition, createdWorkloadInstance, nil
 }
 
+// Update updates a workload definition and instance in the Threeport API.
+func (w *WorkloadValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.WorkloadDefinition, *v0.WorkloadInstance, error) {
+
+	// get operations
+	operations, updatedWorkloadDefinition, updatedWorkloadInstance := w.GetOperations(apiClient, apiEndpoint)
+
+	// execute update operations
+	if err := operations.Update(); err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to execute update operations for workload defined instance with name %s: %w",
+			*w.Name,
+			err,
+		)
+	}
+
+	return updatedWorkloadDefinition, updatedWorkloadInstance, nil
+}
+
 // Delete deletes a workload definition, workload instance, domain name definition,
 // domain name instance, gateway definition, and gateway instance from the Threeport API.
 func (w *WorkloadValues) Delete(apiClient *http.Client, apiEndpoint string) (*v0.WorkloadDefinition, *v0.WorkloadInstance, error) {
@@ -136,6 +154,54 @@ func (wd *WorkloadDefinitionValues) Create(apiClient *http.Client, apiEndpoint s
 	return createdWorkloadDefinition, nil
 }
 
+// Update updates a workload definition in the Threeport API.  The workload
+// controller derives new workload resource definitions from the updated
+// YAMLDocument and applies the changes to the definition's instances.
+func (wd *WorkloadDefinitionValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.WorkloadDefinition, error) {
+	// validate required fields
+	if wd.Name == nil || wd.YAMLDocument == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, YAMLDocument")
+	}
+
+	// build the path to the YAML document relative to the user's working directory
+	configPath, _ := filepath.Split(*wd.WorkloadConfigPath)
+	relativeYamlPath := path.Join(configPath, *wd.YAMLDocument)
+
+	// load YAML document
+	definitionContent, err := os.ReadFile(relativeYamlPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read definition YAMLDocument file with name %s: %w", *wd.YAMLDocument, err)
+	}
+	stringContent := string(definitionContent)
+
+	// get existing workload definition by name
+	existingWorkloadDefinition, err := client.GetWorkloadDefinitionByName(apiClient, apiEndpoint, *wd.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workload definition with name %s: %w", *wd.Name, err)
+	}
+
+	// construct workload definition object with updated fields
+	reconciled := false
+	workloadDefinition := v0.WorkloadDefinition{
+		Common: v0.Common{
+			ID:              existingWorkloadDefinition.ID,
+			ResourceVersion: existingWorkloadDefinition.ResourceVersion,
+		},
+		Reconciliation: v0.Reconciliation{
+			Reconciled: &reconciled,
+		},
+		YAMLDocument: &stringContent,
+	}
+
+	// update workload definition
+	updatedWorkloadDefinition, err := client.UpdateWorkloadDefinition(apiClient, apiEndpoint, &workloadDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workload definition in threeport API: %w", err)
+	}
+
+	return updatedWorkloadDefinition, nil
+}
+
 // Describe returns details related to a workload definition.
 func (wd *WorkloadDefinitionValues) Describe(apiClient *http.Client, apiEndpoint string) (*status.WorkloadDefinitionStatusDetail, error) {
 	// validate
@@ -276,6 +342,59 @@ func (wi *WorkloadInstanceValues) Create(apiClient *http.Client, apiEndpoint str
 	return createdWorkloadInstance, nil
 }
 
+// Update updates a workload instance in the Threeport API.  A workload
+// instance has no attributes that can be changed in place so this confirms
+// that it still refers to the same workload definition and kubernetes runtime
+// instance.
+func (wi *WorkloadInstanceValues) Update(apiClient *http.Client, apiEndpoint string) (*v0.WorkloadInstance, error) {
+	// validate required fields
+	if wi.Name == nil || wi.WorkloadDefinition == nil || wi.WorkloadDefinition.Name == nil {
+		return nil, errors.New("missing required field/s in config - required fields: Name, WorkloadDefinition.Name")
+	}
+
+	// get existing workload instance by name
+	workloadInstance, err := client.GetWorkloadInstanceByName(apiClient, apiEndpoint, *wi.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workload instance with name %s: %w", *wi.Name, err)
+	}
+
+	// get kubernetes runtime instance API object
+	kubernetesRuntimeInstance, err := SetKubernetesRuntimeInstanceForConfig(
+		wi.KubernetesRuntimeInstance,
+		apiClient,
+		apiEndpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set kubernetes runtime instance: %w", err)
+	}
+	if err := checkReferenceUnchanged(
+		"KubernetesRuntimeInstance",
+		workloadInstance.KubernetesRuntimeInstanceID,
+		kubernetesRuntimeInstance.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	// get workload definition by name
+	workloadDefinition, err := client.GetWorkloadDefinitionByName(
+		apiClient,
+		apiEndpoint,
+		*wi.WorkloadDefinition.Name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload definition by name %s: %w", *wi.WorkloadDefinition.Name, err)
+	}
+	if err := checkReferenceUnchanged(
+		"WorkloadDefinition",
+		workloadInstance.WorkloadDefinitionID,
+		workloadDefinition.ID,
+	); err != nil {
+		return nil, err
+	}
+
+	return workloadInstance, nil
+}
+
 // Describe returns important failure events related to a workload instance.
 func (wi *WorkloadInstanceValues) Describe(apiClient *http.Client, apiEndpoint string) (*status.WorkloadInstanceStatusDetail, error) {
 	// validate
@@ -334,8 +453,8 @@ func (wi *WorkloadInstanceValues) Delete(apiClient *http.Client, apiEndpoint str
 	return deletedWorkloadInstance, nil
 }
 
-// GetOperations returns a slice of operations used to create or delete a
-// workload.
+// GetOperations returns a slice of operations used to create, update or
+// delete a workload.
 func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint string) (*util.Operations, *v0.WorkloadDefinition, *v0.WorkloadInstance) {
 
 	var err error
@@ -360,6 +479,14 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 			createdWorkloadDefinition = *workloadDefinition
 			return nil
 		},
+		Update: func() error {
+			workloadDefinition, err := workloadDefinitionValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update workload definition with name %s: %w", *w.Name, err)
+			}
+			createdWorkloadDefinition = *workloadDefinition
+			return nil
+		},
 		Delete: func() error {
 			_, err = workloadDefinitionValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -387,6 +514,14 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 			createdWorkloadInstance = *workloadInstance
 			return nil
 		},
+		Update: func() error {
+			workloadInstance, err := workloadInstanceValues.Update(apiClient, apiEndpoint)
+			if err != nil {
+				return fmt.Errorf("failed to update workload instance with name %s: %w", *w.Name, err)
+			}
+			createdWorkloadInstance = *workloadInstance
+			return nil
+		},
 		Delete: func() error {
 			_, err = workloadInstanceValues.Delete(apiClient, apiEndpoint)
 			if err != nil {
@@ -415,6 +550,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, err = domainNameDefinitionValues.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update domain name definition with name %s: %w", *w.DomainName.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, err = domainNameDefinitionValues.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -440,6 +582,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, err = domainNameInstanceValues.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update domain name instance with name %s: %w", *w.DomainName.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, err = domainNameInstanceValues.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -471,6 +620,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, err = gatewayDefinitionValues.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update gateway definition with name %s: %w", *w.Gateway.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, err = gatewayDefinitionValues.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -496,6 +652,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, err = gatewayInstanceValues.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update gateway instance with name %s: %w", *w.Gateway.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, err = gatewayInstanceValues.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -532,6 +695,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, _, err := awsRelationalDatabase.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update aws relational database with name %s: %w", *w.AwsRelationalDatabase.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, _, err = awsRelationalDatabase.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -563,6 +733,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, _, err := awsObjectStorageBucket.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update aws object storage bucket with name %s: %w", *w.AwsObjectStorageBucket.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, _, err := awsObjectStorageBucket.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -591,6 +768,13 @@ func (w *WorkloadValues) GetOperations(apiClient *http.Client, apiEndpoint strin
 				}
 				return nil
 			},
+			Update: func() error {
+				_, _, err := secret.Update(apiClient, apiEndpoint)
+				if err != nil {
+					return fmt.Errorf("failed to update secret defined instance with name %s: %w", *w.Secret.Name, err)
+				}
+				return nil
+			},
 			Delete: func() error {
 				_, _, err := secret.Delete(apiClient, apiEndpoint)
 				if err != nil {
@@ -0,0 +1,288 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// The default minimum number of concurrent reconciliation processes for
+	// a reconciler.
+	DefaultMinConcurrentReconciles = 1
+
+	// The default maximum number of concurrent reconciliation processes for
+	// a reconciler that is scaled by the autoscaler.
+	DefaultMaxConcurrentReconciles = 10
+
+	// The default interval at which the autoscaler scales reconcilers.
+	DefaultAutoscaleInterval = 15 * time.Second
+
+	// The default number of CPU cores a controller may consume before the
+	// autoscaler reduces the number of reconciliation processes.
+	DefaultCPUBudget = 1.0
+
+	// The default number of notifications pending in NATS for each
+	// reconciliation process.  When more notifications than this are pending
+	// per process, the reconciler is scaled up.
+	DefaultPendingPerReconcile = 5
+)
+
+// NewReconcilerFunc returns the reconciler for a new reconciliation process.
+// Each process needs its own NATS subscription since processes unsubscribe
+// when they shut down.  The autoscaler sets the reconciler's Shutdown
+// channel.
+type NewReconcilerFunc func() (*Reconciler, error)
+
+// Autoscaler runs the reconciliation processes for a controller's
+// reconcilers.  At each interval, the number of processes for each reconciler
+// is scaled to the number of notifications pending for the reconciler in
+// NATS within the reconciler's bounds.  When the controller's CPU
+// consumption exceeds the CPU budget, reconcilers are not scaled up and the
+// reconciler with the most processes above its minimum is scaled down so that
+// capacity goes to the reconcilers with the most activity.
+type Autoscaler struct {
+	// The interval at which reconcilers are scaled.  If zero,
+	// DefaultAutoscaleInterval is used.
+	Interval time.Duration
+
+	// The number of CPU cores the controller may consume.  If zero, CPU
+	// consumption does not limit scaling.
+	CPUBudget float64
+
+	// The number of notifications pending in NATS for each reconciliation
+	// process.  If zero, DefaultPendingPerReconcile is used.
+	PendingPerReconcile int
+
+	// Log is the logger used to write logs.
+	Log *logr.Logger
+
+	mutex    sync.Mutex
+	pools    []*reconcilerPool
+	shutdown bool
+
+	// the CPU time consumed by the controller when it was last sampled
+	cpuTime    time.Duration
+	cpuSampled time.Time
+}
+
+// reconcilerPool is the set of reconciliation processes for a reconciler.
+type reconcilerPool struct {
+	config        ReconcilerConfig
+	newReconciler NewReconcilerFunc
+	min           int
+	max           int
+	reconcilers   []*Reconciler
+}
+
+// AddReconciler starts the reconciliation processes for a reconciler and
+// scales them from then on.
+func (a *Autoscaler) AddReconciler(config ReconcilerConfig, newReconciler NewReconcilerFunc) error {
+	pool := &reconcilerPool{
+		config:        config,
+		newReconciler: newReconciler,
+		min:           config.MinConcurrentReconciles,
+		max:           config.MaxConcurrentReconciles,
+	}
+	if pool.min < 1 {
+		pool.min = DefaultMinConcurrentReconciles
+	}
+	if pool.max == 0 {
+		pool.max = config.ConcurrentReconciles
+	}
+	if pool.max < pool.min {
+		pool.max = pool.min
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if err := pool.scale(pool.bound(config.ConcurrentReconciles)); err != nil {
+		return fmt.Errorf("failed to start reconciliation processes for %s: %w", config.Name, err)
+	}
+	a.pools = append(a.pools, pool)
+
+	return nil
+}
+
+// Run scales the reconcilers at each interval until the autoscaler is shut
+// down.
+func (a *Autoscaler) Run() {
+	interval := a.Interval
+	if interval == 0 {
+		interval = DefaultAutoscaleInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	a.mutex.Lock()
+	a.cpuUsage()
+	a.mutex.Unlock()
+
+	for range ticker.C {
+		if !a.autoscale() {
+			return
+		}
+	}
+}
+
+// Shutdown stops scaling and instructs all reconciliation processes to shut
+// down.
+func (a *Autoscaler) Shutdown() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.shutdown = true
+	for _, pool := range a.pools {
+		for _, r := range pool.reconcilers {
+			r.Shutdown <- true
+		}
+		pool.reconcilers = nil
+	}
+}
+
+// autoscale scales each reconciler once.  It returns false if the autoscaler
+// has been shut down.
+func (a *Autoscaler) autoscale() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.shutdown {
+		return false
+	}
+
+	pendingPerReconcile := a.PendingPerReconcile
+	if pendingPerReconcile < 1 {
+		pendingPerReconcile = DefaultPendingPerReconcile
+	}
+
+	cpuUsage, measured := a.cpuUsage()
+	overBudget := measured && a.CPUBudget > 0 && cpuUsage > a.CPUBudget
+	if measured {
+		controllerCPUUsage.Set(cpuUsage)
+	}
+
+	for _, pool := range a.pools {
+		pending, err := pool.pending()
+		if err != nil {
+			a.Log.Error(err, "failed to get pending notifications for reconciler", "reconcilerName", pool.config.Name)
+			continue
+		}
+		pendingNotifications.WithLabelValues(pool.config.Name).Set(float64(pending))
+
+		current := len(pool.reconcilers)
+		desired := pool.bound(int((pending + uint64(pendingPerReconcile) - 1) / uint64(pendingPerReconcile)))
+		switch {
+		case desired > current && !overBudget:
+			a.scale(pool, desired, "pending notifications increased")
+		case desired < current:
+			// scale down one process at a time so that processes aren't
+			// stopped and started as notifications arrive in bursts
+			a.scale(pool, current-1, "pending notifications decreased")
+		}
+	}
+
+	if overBudget {
+		// remove a process from the reconciler with the most processes
+		// above its minimum
+		var largest *reconcilerPool
+		for _, pool := range a.pools {
+			surplus := len(pool.reconcilers) - pool.min
+			if surplus > 0 && (largest == nil || surplus > len(largest.reconcilers)-largest.min) {
+				largest = pool
+			}
+		}
+		if largest != nil {
+			a.scale(largest, len(largest.reconcilers)-1, fmt.Sprintf("CPU usage of %.2f cores exceeds budget", cpuUsage))
+		}
+	}
+
+	return true
+}
+
+// scale changes the number of processes for a reconciler and logs the
+// reason for the change.
+func (a *Autoscaler) scale(pool *reconcilerPool, count int, reason string) {
+	previous := len(pool.reconcilers)
+	if err := pool.scale(count); err != nil {
+		a.Log.Error(err, "failed to scale reconciler", "reconcilerName", pool.config.Name)
+	}
+	a.Log.V(1).Info(
+		"reconciler scaled",
+		"reconcilerName", pool.config.Name,
+		"previousReconciles", previous,
+		"concurrentReconciles", len(pool.reconcilers),
+		"reason", reason,
+	)
+}
+
+// cpuUsage returns the number of CPU cores consumed by the controller since
+// it was last sampled.  It returns false if CPU consumption can't be
+// measured.
+func (a *Autoscaler) cpuUsage() (float64, bool) {
+	cpuTime, ok := processCPUTime()
+	if !ok {
+		return 0, false
+	}
+	now := time.Now()
+	previousTime, previousSampled := a.cpuTime, a.cpuSampled
+	a.cpuTime, a.cpuSampled = cpuTime, now
+	if previousSampled.IsZero() {
+		return 0, false
+	}
+
+	return (cpuTime - previousTime).Seconds() / now.Sub(previousSampled).Seconds(), true
+}
+
+// bound returns the number of processes within the reconciler's bounds.
+func (p *reconcilerPool) bound(count int) int {
+	if count < p.min {
+		return p.min
+	}
+	if count > p.max {
+		return p.max
+	}
+
+	return count
+}
+
+// pending returns the number of notifications for the reconciler that have
+// not yet been delivered to a reconciliation process.
+func (p *reconcilerPool) pending() (uint64, error) {
+	if len(p.reconcilers) == 0 {
+		return 0, fmt.Errorf("no reconciliation processes are running")
+	}
+	info, err := p.reconcilers[0].Sub.ConsumerInfo()
+	if err != nil {
+		return 0, err
+	}
+
+	return info.NumPending, nil
+}
+
+// scale starts or stops processes until the reconciler has the provided
+// number of processes.
+func (p *reconcilerPool) scale(count int) error {
+	defer func() {
+		concurrentReconciles.WithLabelValues(p.config.Name).Set(float64(len(p.reconcilers)))
+	}()
+
+	for len(p.reconcilers) < count {
+		r, err := p.newReconciler()
+		if err != nil {
+			return err
+		}
+		r.Shutdown = make(chan bool, 1)
+		p.reconcilers = append(p.reconcilers, r)
+		go p.config.ReconcileFunc(r)
+	}
+	for len(p.reconcilers) > count {
+		last := len(p.reconcilers) - 1
+		p.reconcilers[last].Shutdown <- true
+		p.reconcilers = p.reconcilers[:last]
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAutoscaleTestJetStream starts an embedded NATS server with a stream for
+// the notifications scaled on and returns a JetStream context for it.
+func newAutoscaleTestJetStream(t *testing.T) nats.JetStreamContext {
+	natsServer, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+	go natsServer.Start()
+	t.Cleanup(natsServer.Shutdown)
+	require.True(t, natsServer.ReadyForConnections(10*time.Second), "NATS server should start")
+
+	nc, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+
+	js, err := nc.JetStream()
+	require.NoError(t, err)
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:     "autoscaleTestStream",
+		Subjects: []string{"autoscaleTest.>"},
+	})
+	require.NoError(t, err)
+
+	return js
+}
+
+// TestAutoscale tests that reconcilers are scaled to the notifications
+// pending for them within their bounds, that they are scaled down one
+// process at a time and that they are not scaled up when the controller
+// exceeds its CPU budget.
+func TestAutoscale(t *testing.T) {
+	testCases := []struct {
+		name      string
+		min       int
+		max       int
+		initial   int
+		pending   int
+		overCPU   bool
+		processes int
+	}{
+		{
+			name:      "scale up to pending notifications",
+			min:       1,
+			max:       10,
+			initial:   1,
+			pending:   12,
+			processes: 3,
+		},
+		{
+			name:      "scale up to maximum",
+			min:       1,
+			max:       4,
+			initial:   1,
+			pending:   100,
+			processes: 4,
+		},
+		{
+			name:      "scale down one process at a time",
+			min:       1,
+			max:       10,
+			initial:   4,
+			pending:   0,
+			processes: 3,
+		},
+		{
+			name:      "no scale down below minimum",
+			min:       2,
+			max:       10,
+			initial:   2,
+			pending:   0,
+			processes: 2,
+		},
+		{
+			name:      "no change when processes match pending notifications",
+			min:       1,
+			max:       10,
+			initial:   2,
+			pending:   10,
+			processes: 2,
+		},
+		{
+			name:      "no scale up when over CPU budget",
+			min:       1,
+			max:       10,
+			initial:   1,
+			pending:   12,
+			overCPU:   true,
+			processes: 1,
+		},
+		{
+			name:      "scale down when over CPU budget",
+			min:       1,
+			max:       10,
+			initial:   3,
+			pending:   15,
+			overCPU:   true,
+			processes: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			js := newAutoscaleTestJetStream(t)
+			for i := 0; i < tc.pending; i++ {
+				_, err := js.Publish("autoscaleTest.created", []byte("{}"))
+				require.NoError(t, err)
+			}
+
+			// reconciliation processes share a durable consumer and run
+			// until they are shut down
+			var running atomic.Int32
+			config := ReconcilerConfig{
+				Name:                    "AutoscaleTestReconciler",
+				ConcurrentReconciles:    tc.initial,
+				MinConcurrentReconciles: tc.min,
+				MaxConcurrentReconciles: tc.max,
+				ReconcileFunc: func(r *Reconciler) {
+					running.Add(1)
+					defer running.Add(-1)
+					<-r.Shutdown
+				},
+			}
+			newReconciler := func() (*Reconciler, error) {
+				sub, err := js.PullSubscribe("autoscaleTest.created", "autoscale-test")
+				if err != nil {
+					return nil, err
+				}
+				return &Reconciler{Name: config.Name, Sub: sub}, nil
+			}
+
+			log := logr.Discard()
+			autoscaler := &Autoscaler{Log: &log}
+			defer autoscaler.Shutdown()
+			require.NoError(t, autoscaler.AddReconciler(config, newReconciler))
+			assert.Eventually(t, func() bool { return running.Load() == int32(tc.initial) }, 5*time.Second, 10*time.Millisecond)
+
+			if tc.overCPU {
+				// sample CPU consumption then consume more than the budget
+				// before scaling
+				autoscaler.CPUBudget = 1e-9
+				autoscaler.cpuUsage()
+				for start := time.Now(); time.Since(start) < 20*time.Millisecond; {
+				}
+			}
+			require.True(t, autoscaler.autoscale())
+
+			assert.Len(t, autoscaler.pools[0].reconcilers, tc.processes)
+			assert.Eventually(t, func() bool { return running.Load() == int32(tc.processes) }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+// TestAutoscalerShutdown tests that all reconciliation processes are shut
+// down and scaling stops when the autoscaler is shut down.
+func TestAutoscalerShutdown(t *testing.T) {
+	var running atomic.Int32
+	config := ReconcilerConfig{
+		Name:                 "AutoscaleTestReconciler",
+		ConcurrentReconciles: 3,
+		ReconcileFunc: func(r *Reconciler) {
+			running.Add(1)
+			defer running.Add(-1)
+			<-r.Shutdown
+		},
+	}
+
+	log := logr.Discard()
+	autoscaler := &Autoscaler{Log: &log}
+	require.NoError(t, autoscaler.AddReconciler(config, func() (*Reconciler, error) {
+		return &Reconciler{Name: config.Name}, nil
+	}))
+	assert.Eventually(t, func() bool { return running.Load() == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	autoscaler.Shutdown()
+	assert.Eventually(t, func() bool { return running.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, autoscaler.autoscale(), "autoscaler should stop scaling once shut down")
+}
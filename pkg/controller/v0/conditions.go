@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"strings"
+
+	"gorm.io/datatypes"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	event "github.com/threeport/threeport/pkg/event/v0"
+)
+
+// The reason given on the Drifted condition when a resync finds that an
+// object that previously drifted matches its desired state.
+const ReasonNoDrift = "NoDrift"
+
+// ConditionedObject is a reconciled object that has status conditions.  All
+// objects that include the Reconciliation fields implement it.
+type ConditionedObject interface {
+	apilib.ReconciledThreeportApiObject
+	GetResourceVersion() uint64
+	GetConditions() []v0.Condition
+	FindCondition(conditionType string) *v0.Condition
+}
+
+// NewCondition returns a condition observed for an object at its current
+// resource version.
+func NewCondition(
+	object ConditionedObject,
+	conditionType string,
+	status v0.ConditionStatus,
+	reason string,
+	message string,
+) v0.Condition {
+	return v0.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: object.GetResourceVersion(),
+	}
+}
+
+// SetConditions returns an object's conditions with the provided conditions
+// set and whether they changed.  The object itself is not modified so that
+// the result can be sent in an update that only includes the changed fields.
+// Objects that don't have conditions return nil and false.
+func SetConditions(
+	object apilib.ReconciledThreeportApiObject,
+	conditions ...v0.Condition,
+) (*datatypes.JSONSlice[v0.Condition], bool) {
+	conditioned, ok := object.(ConditionedObject)
+	if !ok {
+		return nil, false
+	}
+
+	// SetCondition doesn't modify the existing conditions in place
+	reconciliation := v0.Reconciliation{}
+	if existing := conditioned.GetConditions(); existing != nil {
+		existingConditions := datatypes.JSONSlice[v0.Condition](existing)
+		reconciliation.Conditions = &existingConditions
+	}
+
+	changed := false
+	for _, condition := range conditions {
+		if reconciliation.SetCondition(condition) {
+			changed = true
+		}
+	}
+
+	return reconciliation.Conditions, changed
+}
+
+// ReconciledConditions returns an object's conditions after it has been
+// reconciled successfully for the operation with the given reason.
+func ReconciledConditions(
+	object apilib.ReconciledThreeportApiObject,
+	reason string,
+) (*datatypes.JSONSlice[v0.Condition], bool) {
+	conditioned, ok := object.(ConditionedObject)
+	if !ok {
+		return nil, false
+	}
+
+	return SetConditions(object, NewCondition(
+		conditioned,
+		v0.ConditionTypeReconciled,
+		v0.ConditionStatusTrue,
+		reason,
+		"",
+	))
+}
+
+// FailedConditions returns an object's conditions after reconciliation failed
+// with the given reason and error.  The error is used as the condition's
+// message so users can see why the object is not reconciled.
+func FailedConditions(
+	object apilib.ReconciledThreeportApiObject,
+	reason string,
+	reconcileErr error,
+) (*datatypes.JSONSlice[v0.Condition], bool) {
+	conditioned, ok := object.(ConditionedObject)
+	if !ok {
+		return nil, false
+	}
+
+	message := ""
+	if reconcileErr != nil {
+		message = reconcileErr.Error()
+	}
+
+	return SetConditions(object, NewCondition(
+		conditioned,
+		v0.ConditionTypeReconciled,
+		v0.ConditionStatusFalse,
+		reason,
+		message,
+	))
+}
+
+// DriftConditions returns an object's conditions after it was checked for
+// drift.  The Drifted condition is only added once drift has been found.
+func DriftConditions(
+	object apilib.ReconciledThreeportApiObject,
+	drift *Drift,
+) (*datatypes.JSONSlice[v0.Condition], bool) {
+	conditioned, ok := object.(ConditionedObject)
+	if !ok {
+		return nil, false
+	}
+
+	var condition v0.Condition
+	switch {
+	case drift.Uncorrected():
+		condition = NewCondition(
+			conditioned,
+			v0.ConditionTypeDrifted,
+			v0.ConditionStatusTrue,
+			event.ReasonDrifted,
+			strings.Join(drift.Resources, ", "),
+		)
+	case drift.Detected():
+		condition = NewCondition(
+			conditioned,
+			v0.ConditionTypeDrifted,
+			v0.ConditionStatusFalse,
+			event.ReasonDriftCorrected,
+			strings.Join(drift.Resources, ", "),
+		)
+	default:
+		if conditioned.FindCondition(v0.ConditionTypeDrifted) == nil {
+			return nil, false
+		}
+		condition = NewCondition(
+			conditioned,
+			v0.ConditionTypeDrifted,
+			v0.ConditionStatusFalse,
+			ReasonNoDrift,
+			"",
+		)
+	}
+
+	return SetConditions(object, condition)
+}
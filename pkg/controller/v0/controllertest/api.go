@@ -0,0 +1,80 @@
+package controllertest
+
+import (
+	"net/http"
+	"sync"
+
+	validator "github.com/go-playground/validator/v10"
+	echo "github.com/labstack/echo/v4"
+	middleware "github.com/labstack/echo/v4/middleware"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	handlers_v0 "github.com/threeport/threeport/pkg/api-server/v0/handlers"
+	routes_v0 "github.com/threeport/threeport/pkg/api-server/v0/routes"
+	versions_v0 "github.com/threeport/threeport/pkg/api-server/v0/versions"
+)
+
+// addVersions ensures the object versions, which are registered globally, are
+// only added once when more than one environment is started.
+var addVersions sync.Once
+
+// newAPI returns the REST API's routes served the way the rest-api main
+// package serves them without client certificate authentication.
+func newAPI(
+	db *gorm.DB,
+	nc *nats.Conn,
+	js nats.JetStreamContext,
+	logger *zap.Logger,
+) http.Handler {
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+
+	validate := validator.New()
+	validate.RegisterValidation("optional", apiserver_lib.IsOptional)
+	validate.RegisterValidation("association", apiserver_lib.IsAssociation)
+	validate.RegisterValidation("ISO8601date", apiserver_lib.IsISO8601Date)
+	e.Validator = &apiserver_lib.CustomValidator{Validator: validate}
+
+	// middleware
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			cc := &apiserver_lib.CustomContext{Context: c}
+			return next(cc)
+		}
+	})
+	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		LogError:  true,
+		LogMethod: true,
+		LogStatus: true,
+		LogURI:    true,
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			logger.Debug(
+				"request",
+				zap.String("method", v.Method),
+				zap.String("uri", v.URI),
+				zap.Int("status", v.Status),
+				zap.Error(v.Error),
+			)
+			return nil
+		},
+	}))
+	e.Use(middleware.Recover())
+	e.Use(apiserver_lib.AuditMiddleware(db))
+
+	// routes
+	h_v0 := handlers_v0.New(db, nc, js)
+	routes_v0.AddRoutes(e, &h_v0)
+	routes_v0.AddCustomRoutes(e, &h_v0)
+
+	// add version info for queries to /<object>/versions
+	addVersions.Do(func() {
+		apiserver_lib.Versions[0] = "v0"
+		versions_v0.AddVersions()
+	})
+
+	return e
+}
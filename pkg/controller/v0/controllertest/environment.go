@@ -0,0 +1,317 @@
+// Package controllertest runs the Threeport REST API, NATS JetStream and a
+// throwaway database in-process so that reconcile functions can be tested
+// without a Kubernetes cluster.
+package controllertest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	_ "github.com/threeport/threeport/cmd/database-migrator/migrations"
+	restapi_util "github.com/threeport/threeport/cmd/rest-api/util"
+	apiserver_lib "github.com/threeport/threeport/pkg/api-server/lib/v0"
+	database "github.com/threeport/threeport/pkg/api-server/v0/database"
+	tpclient_lib "github.com/threeport/threeport/pkg/client/lib/v0"
+	controller "github.com/threeport/threeport/pkg/controller/v0"
+	encryption "github.com/threeport/threeport/pkg/encryption/v0"
+	log "github.com/threeport/threeport/pkg/log/v0"
+	installer "github.com/threeport/threeport/pkg/threeport-installer/v0"
+)
+
+// The name of the NATS bucket used for object locks by the reconcilers
+// returned from an environment.
+const LockBucketName = "controllertestLock"
+
+// Config is the configuration for a test environment.
+type Config struct {
+	// DatabaseURL is the connection URL for a Postgres-compatible database
+	// to use rather than starting a throwaway CockroachDB server.  The
+	// database schema is migrated but the database is not removed when the
+	// environment is stopped.
+	DatabaseURL string
+
+	// CockroachBinary is the path to the cockroach binary used for the
+	// throwaway database.  If not set, the COCKROACH_BINARY environment
+	// variable is used and, if that is not set, the binary for the version
+	// of CockroachDB installed with Threeport is downloaded.
+	CockroachBinary string
+
+	// Verbose writes logs with v(1).InfoLevel and above.
+	Verbose bool
+}
+
+// Environment is an in-process Threeport control plane for testing
+// reconcilers.  It includes the REST API with the generated routes, an
+// embedded NATS server with JetStream and the API's database.
+type Environment struct {
+	// APIServer is the endpoint to reach the in-process REST API.
+	// format: [hostname]:[port]
+	APIServer string
+
+	// APIClient is the HTTP client used to make requests to the REST API.
+	APIClient *http.Client
+
+	// DB is the database used by the REST API.
+	DB *gorm.DB
+
+	// NATSConn is the connection to the embedded NATS server.
+	NATSConn *nats.Conn
+
+	// JetStreamContext is the context for the embedded NATS server's
+	// JetStream.  The streams for all controllers are added when the
+	// environment is started.
+	JetStreamContext nats.JetStreamContext
+
+	// KeyValue is the NATS key-value store used to lock objects while they
+	// are reconciled.
+	KeyValue nats.KeyValue
+
+	// EncryptionKey is the key used to encrypt and decrypt sensitive fields.
+	EncryptionKey string
+
+	// Log is the logger used by the environment and its reconcilers.
+	Log *logr.Logger
+
+	logger       zap.Logger
+	dbServer     testserver.TestServer
+	natsServer   *server.Server
+	natsStoreDir string
+	apiServer    *httptest.Server
+	cancel       context.CancelFunc
+	shutdownWait sync.WaitGroup
+	running      []*controller.Reconciler
+	runningMutex sync.Mutex
+}
+
+// Start starts a test environment.  The environment should be stopped once
+// the tests that use it are complete.
+//
+//	env, err := controllertest.Start(controllertest.Config{})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(env.Stop)
+func Start(config Config) (*Environment, error) {
+	logger, err := log.NewLogger(config.Verbose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+	reconcilerLog := zapr.NewLogger(&logger)
+
+	env := Environment{
+		Log:    &reconcilerLog,
+		logger: logger,
+	}
+
+	// the API validates sensitive fields using the encryption key from the
+	// environment
+	env.EncryptionKey = os.Getenv("ENCRYPTION_KEY")
+	if env.EncryptionKey == "" {
+		encryptionKey, err := encryption.GenerateKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate encryption key: %w", err)
+		}
+		if err := os.Setenv("ENCRYPTION_KEY", encryptionKey); err != nil {
+			return nil, fmt.Errorf("failed to set encryption key in environment: %w", err)
+		}
+		env.EncryptionKey = encryptionKey
+	}
+
+	if err := env.startDatabase(config); err != nil {
+		env.Stop()
+		return nil, err
+	}
+
+	if err := env.startNATS(); err != nil {
+		env.Stop()
+		return nil, err
+	}
+
+	if err := env.startAPI(); err != nil {
+		env.Stop()
+		return nil, err
+	}
+
+	return &env, nil
+}
+
+// Stop shuts down the reconcilers started with Run, the REST API, the NATS
+// server and the throwaway database.
+func (e *Environment) Stop() {
+	e.runningMutex.Lock()
+	for _, r := range e.running {
+		r.Shutdown <- true
+	}
+	e.running = nil
+	e.runningMutex.Unlock()
+	e.shutdownWait.Wait()
+
+	if e.cancel != nil {
+		e.cancel()
+	}
+	if e.apiServer != nil {
+		e.apiServer.Close()
+	}
+	if e.NATSConn != nil {
+		e.NATSConn.Close()
+	}
+	if e.natsServer != nil {
+		e.natsServer.Shutdown()
+		e.natsServer.WaitForShutdown()
+	}
+	if e.natsStoreDir != "" {
+		os.RemoveAll(e.natsStoreDir)
+	}
+	if e.DB != nil {
+		if sqlDb, err := e.DB.DB(); err == nil {
+			sqlDb.Close()
+		}
+	}
+	if e.dbServer != nil {
+		e.dbServer.Stop()
+	}
+}
+
+// startDatabase starts a throwaway database, unless one is provided, and
+// migrates the database schema.
+func (e *Environment) startDatabase(config Config) error {
+	dsn := config.DatabaseURL
+	if dsn == "" {
+		opts := []testserver.TestServerOpt{
+			testserver.CustomVersionOpt(installer.DatabaseImageTag),
+		}
+		if config.CockroachBinary != "" {
+			opts = append(opts, testserver.CockroachBinaryPathOpt(config.CockroachBinary))
+		}
+		dbServer, err := testserver.NewTestServer(opts...)
+		if err != nil {
+			return fmt.Errorf("failed to start database: %w", err)
+		}
+		e.dbServer = dbServer
+		if err := dbServer.WaitForInit(); err != nil {
+			return fmt.Errorf("failed to wait for database to start: %w", err)
+		}
+		dsn = dbServer.PGURL().String()
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: &database.ZapLogger{Logger: &e.logger},
+		NowFunc: func() time.Time {
+			utc, _ := time.LoadLocation("UTC")
+			return time.Now().In(utc).Truncate(time.Microsecond)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	e.DB = db
+
+	// run the same migrations as the database-migrator
+	sqlDb, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database connection for migrations: %w", err)
+	}
+	if err := goose.SetDialect("postgres"); err != nil {
+		return fmt.Errorf("failed to set goose dialect: %w", err)
+	}
+	goose.SetLogger(goose.NopLogger())
+	goose.SetTableName("threeport_goose_db_version")
+	ctx := context.WithValue(context.TODO(), "gormdb", db)
+	if err := goose.RunContext(ctx, "up", sqlDb, "."); err != nil {
+		return fmt.Errorf("failed to migrate database schema: %w", err)
+	}
+
+	return nil
+}
+
+// startNATS starts an embedded NATS server with JetStream enabled and adds
+// the controller streams the way the REST API does at start up.
+func (e *Environment) startNATS() error {
+	storeDir, err := os.MkdirTemp("", "controllertest-nats")
+	if err != nil {
+		return fmt.Errorf("failed to create JetStream storage directory: %w", err)
+	}
+	e.natsStoreDir = storeDir
+
+	natsServer, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  storeDir,
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create NATS server: %w", err)
+	}
+	e.natsServer = natsServer
+	go natsServer.Start()
+	if !natsServer.ReadyForConnections(10 * time.Second) {
+		return errors.New("timed out waiting for NATS server to start")
+	}
+
+	nc, err := nats.Connect(natsServer.ClientURL())
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS server: %w", err)
+	}
+	e.NATSConn = nc
+
+	js, err := restapi_util.InitJetStream(nc)
+	if err != nil {
+		return fmt.Errorf("failed to initialize nats jet stream: %w", err)
+	}
+	e.JetStreamContext = *js
+
+	kv, err := controller.CreateLockBucketIfNotExists(e.JetStreamContext, &nats.KeyValueConfig{
+		Bucket:      LockBucketName,
+		Description: "contains locks on objects reconciled in tests",
+		TTL:         controller.LockBucketTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create key-value locking bucket: %w", err)
+	}
+	e.KeyValue = kv
+
+	return nil
+}
+
+// startAPI serves the REST API on a local port and starts relaying controller
+// notifications from the outbox.
+func (e *Environment) startAPI() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+
+	e.apiServer = httptest.NewServer(newAPI(e.DB, e.NATSConn, e.JetStreamContext, &e.logger))
+	e.APIServer = e.apiServer.Listener.Addr().String()
+
+	apiClient, err := tpclient_lib.GetHTTPClient(false, "", "", "", "")
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	e.APIClient = apiClient
+
+	outboxRelay := &apiserver_lib.OutboxRelay{
+		DB:     e.DB,
+		JS:     e.JetStreamContext,
+		Logger: &e.logger,
+	}
+	go outboxRelay.Run(ctx)
+
+	return nil
+}
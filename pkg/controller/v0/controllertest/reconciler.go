@@ -0,0 +1,101 @@
+package controllertest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+
+	controller "github.com/threeport/threeport/pkg/controller/v0"
+	event "github.com/threeport/threeport/pkg/event/v0"
+)
+
+// Reconciler returns a reconciler connected to the environment's REST API
+// and NATS server.  It has a pull subscription for the notifications on the
+// reconciler config's subject so that notifications sent by the API can be
+// pulled, but it is not started.  Reconcile functions can be called with it
+// directly:
+//
+//	r, err := env.Reconciler(controller.ReconcilerConfig{
+//		Name:         "WorkloadInstanceReconciler",
+//		NotifSubject: notif.WorkloadInstanceSubject,
+//	})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	_, err = v0WorkloadInstanceCreated(r, workloadInstance, r.Log)
+func (e *Environment) Reconciler(config controller.ReconcilerConfig) (*controller.Reconciler, error) {
+	if config.Name == "" {
+		return nil, errors.New("reconciler config must include a name")
+	}
+	if config.NotifSubject == "" {
+		return nil, errors.New("reconciler config must include a notification subject")
+	}
+
+	// find the controller stream the API sends the notifications to
+	streamName, err := e.JetStreamContext.StreamNameBySubject(config.NotifSubject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stream for subject %s: %w", config.NotifSubject, err)
+	}
+
+	// create JetStream consumer and pull subscription
+	consumer := config.Name + "Consumer"
+	if _, err := e.JetStreamContext.AddConsumer(streamName, &nats.ConsumerConfig{
+		AckPolicy:     nats.AckExplicitPolicy,
+		Durable:       consumer,
+		FilterSubject: config.NotifSubject,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add consumer %s to stream %s: %w", consumer, streamName, err)
+	}
+	sub, err := e.JetStreamContext.PullSubscribe(
+		config.NotifSubject,
+		consumer,
+		nats.BindStream(streamName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull subscription for reconciler notifications: %w", err)
+	}
+
+	return &controller.Reconciler{
+		APIClient:     e.APIClient,
+		APIServer:     e.APIServer,
+		ControllerID:  uuid.New(),
+		EncryptionKey: e.EncryptionKey,
+		EventsRecorder: &event.EventRecorder{
+			APIClient:           e.APIClient,
+			APIServer:           e.APIServer,
+			ReportingController: config.Name,
+		},
+		JetStreamContext: e.JetStreamContext,
+		KeyValue:         e.KeyValue,
+		Log:              e.Log,
+		Name:             config.Name,
+		RetryPolicy:      config.RetryPolicy,
+		Shutdown:         make(chan bool, 1),
+		ShutdownWait:     &e.shutdownWait,
+		Sub:              sub,
+	}, nil
+}
+
+// Run returns a reconciler for the reconciler config and starts its
+// reconcile function so that objects created, updated and deleted through
+// the REST API are reconciled as they would be in a controller.  The
+// reconciler is shut down when the environment is stopped.
+func (e *Environment) Run(config controller.ReconcilerConfig) (*controller.Reconciler, error) {
+	if config.ReconcileFunc == nil {
+		return nil, errors.New("reconciler config must include a reconcile function")
+	}
+
+	r, err := e.Reconciler(config)
+	if err != nil {
+		return nil, err
+	}
+
+	e.runningMutex.Lock()
+	e.running = append(e.running, r)
+	e.runningMutex.Unlock()
+	go config.ReconcileFunc(r)
+
+	return r, nil
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package controller
+
+import "time"
+
+// processCPUTime is not supported on this platform so the CPU budget for
+// reconcilers is not enforced.
+func processCPUTime() (time.Duration, bool) {
+	return 0, false
+}
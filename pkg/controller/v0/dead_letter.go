@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"gorm.io/datatypes"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	event "github.com/threeport/threeport/pkg/event/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// deadLetter moves a notification that has exhausted its retries to the
+// dead-letter stream and terminates its delivery so that it is no longer
+// retried.  The notification remains in the dead-letter stream with the last
+// error until it is retried by a user.
+func (r *Reconciler) deadLetter(
+	object apilib.ReconciledThreeportApiObject,
+	msg *nats.Msg,
+	attempts int,
+	reconcileErr error,
+) error {
+	notification := datatypes.JSON(msg.Data)
+	failedReconciliation := v0.FailedReconciliation{
+		ReconcilerName: util.Ptr(r.Name),
+		Subject:        util.Ptr(msg.Subject),
+		ObjectType:     util.Ptr(object.GetType()),
+		ObjectVersion:  util.Ptr(object.GetVersion()),
+		ObjectID:       util.Ptr(object.GetId()),
+		Attempts:       util.Ptr(attempts),
+		LastError:      util.Ptr(reconcileErr.Error()),
+		FailedAt:       util.Ptr(time.Now().UTC()),
+		Notification:   &notification,
+	}
+	data, err := json.Marshal(failedReconciliation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal failed reconciliation: %w", err)
+	}
+
+	if _, err := r.JetStreamContext.PublishMsg(tracing.NewMsg(
+		r.TraceContext(msg),
+		notifications.DeadLetterSubject(r.Name),
+		data,
+	)); err != nil {
+		return fmt.Errorf("failed to publish failed reconciliation to dead-letter stream: %w", err)
+	}
+
+	r.completeReconcile(msg, ReconcileOutcomeError)
+	if err := msg.Term(); err != nil {
+		r.Log.Error(
+			err, "failed to terminate delivery of dead-lettered notification",
+			"objectType", object.GetType(),
+			"objectVersion", object.GetVersion(),
+			"objectID", object.GetId(),
+		)
+	}
+	r.resetFailedAttempts(object)
+	deadLetterTotal.WithLabelValues(r.Name).Inc()
+
+	r.Log.Error(
+		reconcileErr, "reconciliation retries exhausted - notification moved to dead-letter stream",
+		"objectType", object.GetType(),
+		"objectVersion", object.GetVersion(),
+		"objectID", object.GetId(),
+		"attempts", attempts,
+	)
+	if r.EventsRecorder != nil {
+		if err := r.EventsRecorder.RecordEvent(
+			&v0.Event{
+				Reason: util.Ptr(event.ReasonRetriesExhausted),
+				Note: util.Ptr(fmt.Sprintf(
+					"reconciliation failed after %d attempts and will not be retried until retried with tptctl: %s",
+					attempts,
+					reconcileErr,
+				)),
+				Type: util.Ptr(event.TypeWarning),
+			},
+			object.GetId(),
+			object.GetVersion(),
+			object.GetType(),
+		); err != nil {
+			r.Log.Error(err, "failed to record event for exhausted reconciliation retries")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	event "github.com/threeport/threeport/pkg/event/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// Drift is the difference between the desired state of an object and the
+// actual state of the resources managed for it that a reconciler found when
+// the object was resynced.
+type Drift struct {
+	// The resources that have drifted from their desired state, e.g.
+	// "Deployment web/api".
+	Resources []string
+
+	// Corrected is true if the reconciler restored the desired state of the
+	// resources.
+	Corrected bool
+}
+
+// Add records a resource that has drifted from its desired state.
+func (d *Drift) Add(resource string) {
+	d.Resources = append(d.Resources, resource)
+}
+
+// Detected returns true if any resources have drifted.
+func (d *Drift) Detected() bool {
+	return d != nil && len(d.Resources) > 0
+}
+
+// Uncorrected returns true if resources have drifted and the reconciler did
+// not restore their desired state.
+func (d *Drift) Uncorrected() bool {
+	return d.Detected() && !d.Corrected
+}
+
+// RecordDrift logs and records an event for drift found when an object was
+// resynced.  Drift that was corrected is recorded as a normal event while
+// uncorrected drift is recorded as a warning.
+func (r *Reconciler) RecordDrift(
+	object apilib.ReconciledThreeportApiObject,
+	drift *Drift,
+	log *logr.Logger,
+) {
+	if !drift.Detected() {
+		return
+	}
+	driftTotal.WithLabelValues(r.Name, strconv.FormatBool(drift.Corrected)).Inc()
+
+	resources := strings.Join(drift.Resources, ", ")
+	reason := event.ReasonDrifted
+	eventType := event.TypeWarning
+	note := fmt.Sprintf("resources have drifted from the desired state: %s", resources)
+	if drift.Corrected {
+		reason = event.ReasonDriftCorrected
+		eventType = event.TypeNormal
+		note = fmt.Sprintf("resources that drifted from the desired state were corrected: %s", resources)
+	}
+	log.Info(note, "corrected", drift.Corrected)
+
+	if r.EventsRecorder != nil {
+		if err := r.EventsRecorder.RecordEvent(
+			&v0.Event{
+				Reason: util.Ptr(reason),
+				Note:   util.Ptr(note),
+				Type:   util.Ptr(eventType),
+			},
+			object.GetId(),
+			object.GetVersion(),
+			object.GetType(),
+		); err != nil {
+			log.Error(err, "failed to record event for drift")
+		}
+	}
+}
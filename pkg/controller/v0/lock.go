@@ -1,11 +1,23 @@
 package controller
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/nats-io/nats.go"
+
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
 )
 
+// LockBucketTTL is the TTL of the key-value bucket each controller creates for
+// locks.  Failed reconciliation attempts are also counted in the bucket so
+// the count is forgotten once it expires.
+const LockBucketTTL = 20 * time.Minute
+
 // CreateLockBucketIfNotExists binds to the existing KeyValue store if it has been
 // created.  If not created it will be created and the KeyValue store returned.
 func CreateLockBucketIfNotExists(js nats.KeyValueManager, config *nats.KeyValueConfig) (nats.KeyValue, error) {
@@ -24,3 +36,140 @@ func CreateLockBucketIfNotExists(js nats.KeyValueManager, config *nats.KeyValueC
 
 	return kv, nil
 }
+
+// lockRenewInterval is the interval at which the leases on locks held by
+// reconcilers are renewed.
+const lockRenewInterval = v0.ReconcileLockLeaseDuration / 3
+
+// lockLease is the lease on a lock held by a reconciler.
+type lockLease struct {
+	mutex    sync.Mutex
+	lock     *v0.ReconcileLock
+	revision uint64
+	lost     bool
+	stop     chan bool
+}
+
+// state returns the key-value revision of the lock and whether the lock
+// was lost.
+func (l *lockLease) state() (uint64, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.revision, l.lost
+}
+
+// decodeLock returns the lock recorded in a lock's key-value record.  It
+// returns nil if the record does not contain a lock, e.g. for locks written
+// by controllers that only recorded their controller ID.
+func decodeLock(data []byte) *v0.ReconcileLock {
+	var lock v0.ReconcileLock
+	if err := json.Unmarshal(data, &lock); err != nil || lock.ControllerID == nil {
+		return nil
+	}
+
+	return &lock
+}
+
+// createLock writes a lock to the key-value store and returns its revision.
+// If the object is already locked and the lock is stale, the stale lock is
+// replaced.
+func (r *Reconciler) createLock(lockKey string, lock *v0.ReconcileLock) (uint64, error) {
+	data, err := json.Marshal(lock)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal lock: %w", err)
+	}
+
+	rev, err := r.KeyValue.Create(lockKey, data)
+	if !errors.Is(err, nats.ErrKeyExists) {
+		return rev, err
+	}
+
+	kvEntry, getErr := r.KeyValue.Get(lockKey)
+	if getErr != nil {
+		return 0, err
+	}
+	existingLock := decodeLock(kvEntry.Value())
+	if existingLock == nil || !existingLock.Stale() {
+		return 0, err
+	}
+
+	return r.KeyValue.Update(lockKey, data, kvEntry.Revision())
+}
+
+// startLease starts renewing the lease on a lock until the lock is released.
+func (r *Reconciler) startLease(lockKey string, lock *v0.ReconcileLock, revision uint64) {
+	lease := &lockLease{
+		lock:     lock,
+		revision: revision,
+		stop:     make(chan bool),
+	}
+	r.leases.Store(lockKey, lease)
+
+	go func() {
+		ticker := time.NewTicker(lockRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-lease.stop:
+				return
+			case <-ticker.C:
+				if !r.renewLease(lockKey, lease) {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// stopLease stops renewing the lease on a lock and returns the lease.  It
+// returns nil if the reconciler does not hold a lease on the lock.
+func (r *Reconciler) stopLease(lockKey string) *lockLease {
+	value, ok := r.leases.LoadAndDelete(lockKey)
+	if !ok {
+		return nil
+	}
+	lease := value.(*lockLease)
+	close(lease.stop)
+
+	return lease
+}
+
+// renewLease updates the renewal time of a lock.  It returns false if the
+// lock was released or taken over by another controller in which case the
+// lease is lost and no longer renewed.
+func (r *Reconciler) renewLease(lockKey string, lease *lockLease) bool {
+	lease.mutex.Lock()
+	defer lease.mutex.Unlock()
+
+	lease.lock.RenewedAt = util.Ptr(time.Now().UTC())
+	data, err := json.Marshal(lease.lock)
+	if err != nil {
+		r.Log.Error(err, "failed to marshal lock", "lockKey", lockKey)
+		return true
+	}
+
+	rev, err := r.KeyValue.Update(lockKey, data, lease.revision)
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyExists) {
+			lease.lost = true
+			lockFailureTotal.WithLabelValues(r.Name, LockFailureLeaseLost).Inc()
+			r.Log.Error(
+				err, "lock lease lost - lock was released or taken over while reconciling",
+				"lockKey", lockKey,
+				"bucket", r.KeyValue.Bucket(),
+			)
+			return false
+		}
+		r.Log.Error(
+			err, "failed to renew lock lease",
+			"lockKey", lockKey,
+			"bucket", r.KeyValue.Bucket(),
+		)
+		return true
+	}
+	lease.revision = rev
+
+	return true
+}
@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// Outcomes of a reconciliation used as the outcome metric label.
+	ReconcileOutcomeSuccess  = "success"
+	ReconcileOutcomeError    = "error"
+	ReconcileOutcomeRequeued = "requeued"
+	ReconcileOutcomeInvalid  = "invalid"
+
+	// Reasons a lock could not be acquired or held used as the reason metric
+	// label.
+	LockFailureLocked      = "locked"
+	LockFailureCheckFailed = "check_failed"
+	LockFailureLockFailed  = "lock_failed"
+	LockFailureLeaseLost   = "lease_lost"
+
+	// Results of pulling a message from NATS used as the result metric label.
+	PullResultMessage = "message"
+	PullResultEmpty   = "empty"
+	PullResultError   = "error"
+)
+
+var (
+	reconcileTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "reconcile_total",
+		Help:      "The number of reconciliations by reconciler and outcome.",
+	}, []string{"reconciler", "outcome"})
+
+	reconcileDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "reconcile_duration_seconds",
+		Help:      "The time taken to reconcile an object by reconciler and outcome.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+	}, []string{"reconciler", "outcome"})
+
+	requeueTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "requeue_total",
+		Help:      "The number of notifications requeued by reconciler.",
+	}, []string{"reconciler"})
+
+	requeueDelaySeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "requeue_delay_seconds",
+		Help:      "The delay before requeued notifications are redelivered by reconciler.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	}, []string{"reconciler"})
+
+	lockFailureTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "lock_failure_total",
+		Help:      "The number of times a reconciler could not acquire or hold a lock on an object by reconciler and reason.",
+	}, []string{"reconciler", "reason"})
+
+	natsPullDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "nats_pull_duration_seconds",
+		Help:      "The time taken to pull a notification from NATS by reconciler and result.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+	}, []string{"reconciler", "result"})
+
+	deadLetterTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "dead_letter_total",
+		Help:      "The number of notifications moved to the dead-letter stream after exhausting retries by reconciler.",
+	}, []string{"reconciler"})
+
+	concurrentReconciles = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "concurrent_reconciles",
+		Help:      "The number of concurrent reconciliation processes by reconciler.",
+	}, []string{"reconciler"})
+
+	pendingNotifications = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "pending_notifications",
+		Help:      "The number of notifications pending in NATS by reconciler.",
+	}, []string{"reconciler"})
+
+	resyncTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "resync_total",
+		Help:      "The number of resync notifications sent for reconciled objects by reconciler.",
+	}, []string{"reconciler"})
+
+	driftTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "drift_total",
+		Help:      "The number of times resynced objects were found to have drifted from their desired state by reconciler and whether the drift was corrected.",
+	}, []string{"reconciler", "corrected"})
+
+	shardForwardTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "shard_forward_total",
+		Help:      "The number of notifications forwarded to the controller replica that owns the object by reconciler.",
+	}, []string{"reconciler"})
+
+	shardRebalanceTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "shard_rebalance_total",
+		Help:      "The number of times objects were rebalanced across controller replicas.",
+	})
+
+	shardMembers = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "shard_members",
+		Help:      "The number of controller replicas objects are sharded across.",
+	})
+
+	controllerCPUUsage = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "threeport",
+		Subsystem: "controller",
+		Name:      "cpu_usage_cores",
+		Help:      "The number of CPU cores consumed by the controller as measured by the autoscaler.",
+	})
+)
+
+// reconcileRecord tracks a reconciliation from the time its notification is
+// pulled until it is complete.
+type reconcileRecord struct {
+	start   time.Time
+	outcome string
+	err     error
+	ctx     context.Context
+	span    trace.Span
+}
+
+// startReconcile begins tracking the reconciliation of a notification.
+func (r *Reconciler) startReconcile(msg *nats.Msg) {
+	ctx, span := r.startReconcileSpan(msg)
+	r.reconciles.Store(msg, &reconcileRecord{
+		start: time.Now(),
+		ctx:   ctx,
+		span:  span,
+	})
+}
+
+// RecordReconcileError records that reconciliation of a notification failed.
+// The reconciliation is observed with the error outcome once the notification
+// is requeued and the error counts against the reconciler's retry policy.
+func (r *Reconciler) RecordReconcileError(msg *nats.Msg, err error) {
+	if record, ok := r.reconciles.Load(msg); ok {
+		record.(*reconcileRecord).outcome = ReconcileOutcomeError
+		record.(*reconcileRecord).err = err
+	}
+}
+
+// reconcileError returns the error recorded for the reconciliation of a
+// notification, if any.
+func (r *Reconciler) reconcileError(msg *nats.Msg) error {
+	if record, ok := r.reconciles.Load(msg); ok {
+		return record.(*reconcileRecord).err
+	}
+
+	return nil
+}
+
+// completeReconcile observes the count and duration of a reconciliation.  If
+// an error was recorded for the notification, the error outcome is used.
+func (r *Reconciler) completeReconcile(msg *nats.Msg, outcome string) {
+	value, ok := r.reconciles.LoadAndDelete(msg)
+	if !ok {
+		return
+	}
+	record := value.(*reconcileRecord)
+	if record.outcome != "" {
+		outcome = record.outcome
+	}
+
+	reconcileTotal.WithLabelValues(r.Name, outcome).Inc()
+	reconcileDuration.WithLabelValues(r.Name, outcome).Observe(time.Since(record.start).Seconds())
+	endReconcileSpan(record.span, outcome)
+}
+
+// EndReconcile stops tracking the reconciliation of a notification.  It is
+// deferred for each notification a reconciler pulls so that reconciliations
+// that end without the notification being requeued or the lock being released
+// after success, e.g. when the object no longer exists, are observed with the
+// success outcome, or the error outcome if an error was recorded, rather than
+// tracked indefinitely.
+func (r *Reconciler) EndReconcile(msg *nats.Msg) {
+	r.completeReconcile(msg, ReconcileOutcomeSuccess)
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reconcileCount returns the number of reconciliations observed for a
+// reconciler with an outcome.
+func reconcileCount(t *testing.T, reconcilerName, outcome string) float64 {
+	var metric dto.Metric
+	require.NoError(t, reconcileTotal.WithLabelValues(reconcilerName, outcome).Write(&metric))
+
+	return metric.GetCounter().GetValue()
+}
+
+// TestEndReconcile tests that reconciliations are no longer tracked once
+// their notifications are handled and that reconciliations that weren't
+// already completed are observed once.
+func TestEndReconcile(t *testing.T) {
+	testCases := []struct {
+		name string
+		// the outcome the reconciliation is completed with before it ends,
+		// if any
+		completed string
+		err       error
+		outcome   string
+	}{
+		{
+			name:    "ended without completing",
+			outcome: ReconcileOutcomeSuccess,
+		},
+		{
+			name:    "ended without completing after error",
+			err:     errors.New("connection refused"),
+			outcome: ReconcileOutcomeError,
+		},
+		{
+			name:      "ended after requeue",
+			completed: ReconcileOutcomeRequeued,
+			outcome:   ReconcileOutcomeRequeued,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{Name: "EndReconcileTestReconciler"}
+			msg := &nats.Msg{Subject: "endReconcileTest.created"}
+			before := reconcileCount(t, r.Name, tc.outcome)
+
+			r.startReconcile(msg)
+			if tc.err != nil {
+				r.RecordReconcileError(msg, tc.err)
+			}
+			if tc.completed != "" {
+				r.completeReconcile(msg, tc.completed)
+			}
+			r.EndReconcile(msg)
+			r.EndReconcile(msg)
+
+			_, tracked := r.reconciles.Load(msg)
+			assert.False(t, tracked, "reconciliation should no longer be tracked")
+			assert.Equal(t, before+1, reconcileCount(t, r.Name, tc.outcome))
+		})
+	}
+}
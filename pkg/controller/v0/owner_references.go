@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"gorm.io/datatypes"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+	v0 "github.com/threeport/threeport/pkg/api/v0"
+)
+
+// FinalizedObject is a reconciled object that can have finalizers.  All
+// objects that include the Reconciliation fields implement it.
+type FinalizedObject interface {
+	apilib.ReconciledThreeportApiObject
+	GetFinalizers() []string
+	HasFinalizer(finalizer string) bool
+}
+
+// OwnerReference returns a reference to an object so that the objects a
+// controller creates for it are deleted along with it.
+func OwnerReference(owner apilib.ReconciledThreeportApiObject) v0.OwnerReference {
+	return v0.OwnerReference{
+		ObjectType: owner.GetType(),
+		Version:    owner.GetVersion(),
+		ID:         owner.GetId(),
+	}
+}
+
+// OwnedBy returns the owner references for an object owned by the provided
+// objects.
+func OwnedBy(owners ...apilib.ReconciledThreeportApiObject) *datatypes.JSONSlice[v0.OwnerReference] {
+	common := v0.Common{}
+	for _, owner := range owners {
+		common.AddOwnerReference(OwnerReference(owner))
+	}
+
+	return common.OwnerReferences
+}
+
+// AddFinalizer returns an object's finalizers with the provided finalizer
+// added and whether they changed.  A controller adds a finalizer to an object
+// it must clean up after before the object is removed from the database.
+// The object itself is not modified so that the result can be sent in an
+// update that only includes the changed fields.
+func AddFinalizer(
+	object apilib.ReconciledThreeportApiObject,
+	finalizer string,
+) (*datatypes.JSONSlice[string], bool) {
+	finalized, ok := object.(FinalizedObject)
+	if !ok {
+		return nil, false
+	}
+
+	reconciliation := finalizersOf(finalized)
+	changed := reconciliation.AddFinalizer(finalizer)
+
+	return reconciliation.Finalizers, changed
+}
+
+// RemoveFinalizer returns an object's finalizers with the provided finalizer
+// removed and whether they changed.  A controller removes its finalizer once
+// it has cleaned up after a deleted object and the object is removed from
+// the database when its last finalizer is removed.
+func RemoveFinalizer(
+	object apilib.ReconciledThreeportApiObject,
+	finalizer string,
+) (*datatypes.JSONSlice[string], bool) {
+	finalized, ok := object.(FinalizedObject)
+	if !ok {
+		return nil, false
+	}
+
+	reconciliation := finalizersOf(finalized)
+	changed := reconciliation.RemoveFinalizer(finalizer)
+
+	return reconciliation.Finalizers, changed
+}
+
+// finalizersOf returns the reconciliation fields with an object's finalizers.
+// AddFinalizer and RemoveFinalizer don't modify the existing finalizers in
+// place.
+func finalizersOf(object FinalizedObject) v0.Reconciliation {
+	reconciliation := v0.Reconciliation{}
+	if existing := object.GetFinalizers(); existing != nil {
+		existingFinalizers := datatypes.JSONSlice[string](existing)
+		reconciliation.Finalizers = &existingFinalizers
+	}
+
+	return reconciliation
+}
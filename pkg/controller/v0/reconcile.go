@@ -13,6 +13,7 @@ import (
 
 	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
 	v0 "github.com/threeport/threeport/pkg/api/v0"
+	util "github.com/threeport/threeport/pkg/util/v0"
 )
 
 // ReconcilerConfig contains values needed to start new reconcilers in
@@ -24,16 +25,37 @@ type ReconcilerConfig struct {
 	// The function that will perform object reconciliation.
 	ReconcileFunc func(r *Reconciler)
 
-	// The maximum number of concurrent reconilation process to run.  This
-	// number should be tuned (programatically) to be higher for reconcilers
-	// that are called more often.  The tuning should be based on the length of
-	// queue in NATS with a cap at some reasonable number that will keep CPU
-	// consumption at a reasonable level for each individual controller.  The
-	// proportion of activity among reconcilers within a controller is the key.
+	// The number of concurrent reconcilation processes to start with.  The
+	// Autoscaler tunes the number of processes between
+	// MinConcurrentReconciles and MaxConcurrentReconciles based on the number
+	// of notifications pending in NATS for the reconciler and the CPU
+	// consumption of the controller.
 	ConcurrentReconciles int
 
+	// The minimum number of concurrent reconciliation processes to run.  If
+	// zero, DefaultMinConcurrentReconciles is used.
+	MinConcurrentReconciles int
+
+	// The maximum number of concurrent reconciliation processes to run.  If
+	// zero, the larger of ConcurrentReconciles and MinConcurrentReconciles is
+	// used so that the number of processes is fixed.
+	MaxConcurrentReconciles int
+
 	// The NATS Jetstream subject used for notifications to a reconciler
 	NotifSubject string
+
+	// The policy used to retry failed reconciliations.
+	RetryPolicy RetryPolicy
+
+	// The interval at which objects that have been reconciled are resynced.
+	// If zero, objects are not resynced.
+	ResyncInterval time.Duration
+
+	// The NATS Jetstream subject resync notifications are sent to.
+	ResyncSubject string
+
+	// The function that lists the reconciled objects to resync.
+	ListReconciledFunc ListReconciledFunc
 }
 
 // Reconciler contains the assets needed by reconcilers to recieve subscription
@@ -78,6 +100,22 @@ type Reconciler struct {
 
 	// EventsRecorder is the recorder used to record events.
 	EventsRecorder Recorder
+
+	// RetryPolicy determines how failed reconciliations are retried and when
+	// they are given up on.
+	RetryPolicy RetryPolicy
+
+	// Sharder shards objects across controller replicas.  If set,
+	// notifications for objects owned by another replica are forwarded to
+	// it rather than reconciled.
+	Sharder *Sharder
+
+	// reconciles tracks in-progress reconciliations by notification for
+	// metrics.
+	reconciles sync.Map
+
+	// leases tracks the leases on locks held by the reconciler by lock key.
+	leases sync.Map
 }
 
 // Recorder is an interface for recording events.
@@ -89,17 +127,28 @@ type Recorder interface {
 // PullMessage checks the queue for a message and returns it if there was a
 // message to retrieve.  It fetches only one message at a time and waits 20
 // seconds for a message to become available.  If no message is returned in 20
-// seconds, it returns nil so the reconciler can reconnect to NATS.
+// seconds, it returns nil so the reconciler can reconnect to NATS.  When
+// sharding, a notification for an object owned by another replica is
+// forwarded to that replica and nil is returned.
 func (r *Reconciler) PullMessage() *nats.Msg {
+	pullStart := time.Now()
 	messages, err := r.Sub.Fetch(1, nats.MaxWait(time.Second*20))
 	if err != nil && !errors.Is(err, nats.ErrTimeout) {
+		natsPullDuration.WithLabelValues(r.Name, PullResultError).Observe(time.Since(pullStart).Seconds())
 		r.Log.Error(err, "failed to fetch message from pull subscription")
 		return nil
 	}
 	if len(messages) == 0 {
+		natsPullDuration.WithLabelValues(r.Name, PullResultEmpty).Observe(time.Since(pullStart).Seconds())
 		return nil
 	}
+	natsPullDuration.WithLabelValues(r.Name, PullResultMessage).Observe(time.Since(pullStart).Seconds())
 	msg := messages[0]
+	if r.Sharder != nil && r.Sharder.reroute(r.Name, msg) {
+		r.Log.V(1).Info("notification forwarded to owning controller replica", "msgSubject", msg.Subject)
+		return nil
+	}
+	r.startReconcile(msg)
 	r.Log.V(1).Info("new message received", "msgSubject", msg.Subject)
 	return msg
 }
@@ -110,6 +159,9 @@ func (r *Reconciler) PullMessage() *nats.Msg {
 // requeue after 10 sec.
 func (r *Reconciler) RequeueRaw(msg *nats.Msg) {
 	msg.NakWithDelay(time.Duration(time.Duration(10).Seconds()))
+	requeueTotal.WithLabelValues(r.Name).Inc()
+	requeueDelaySeconds.WithLabelValues(r.Name).Observe(10)
+	r.completeReconcile(msg, ReconcileOutcomeInvalid)
 	r.Log.V(1).Info("raw message requeued",
 		"messageSubject", msg.Subject,
 		"messagePayload", string(msg.Data),
@@ -144,12 +196,32 @@ func (r *Reconciler) UnlockAndRequeue(
 }
 
 // Requeue waits for the delay duration and then sends the notifcation to the
-// NATS server to trigger reconciliation.
+// NATS server to trigger reconciliation.  If an error was recorded for the
+// reconciliation and the reconciler's retry policy has no attempts remaining,
+// the notification is moved to the dead-letter stream instead.
 func (r *Reconciler) Requeue(
 	object apilib.ReconciledThreeportApiObject,
 	requeueDelay int64,
 	msg *nats.Msg,
 ) {
+	if reconcileErr := r.reconcileError(msg); reconcileErr != nil {
+		attempts := r.recordFailedAttempt(object)
+		if r.RetryPolicy.Exhausted(attempts) {
+			err := r.deadLetter(object, msg, attempts, reconcileErr)
+			if err == nil {
+				return
+			}
+			r.Log.Error(
+				err, "failed to move notification to dead-letter stream - requeueing",
+				"objectType", object.GetType(),
+				"objectVersion", object.GetVersion(),
+				"objectID", object.GetId(),
+			)
+		}
+	}
+
+	r.completeReconcile(msg, ReconcileOutcomeRequeued)
+
 	err := msg.NakWithDelay(time.Duration(requeueDelay) * time.Second)
 	if err != nil {
 		r.Log.V(1).Info(
@@ -159,6 +231,8 @@ func (r *Reconciler) Requeue(
 			"objectID", object.GetId(),
 		)
 	} else {
+		requeueTotal.WithLabelValues(r.Name).Inc()
+		requeueDelaySeconds.WithLabelValues(r.Name).Observe(float64(requeueDelay))
 		r.Log.V(1).Info(
 			"requeue notification sent",
 			"reconcilerName", r.Name,
@@ -185,6 +259,7 @@ func (r *Reconciler) CheckLock(object apilib.ReconciledThreeportApiObject) (bool
 	kvEntry, err := r.KeyValue.Get(lockKey)
 	if err != nil {
 		if !errors.Is(err, nats.ErrKeyNotFound) && !errors.Is(err, nats.ErrKeyDeleted) {
+			lockFailureTotal.WithLabelValues(r.Name, LockFailureCheckFailed).Inc()
 			r.Log.Error(
 				err, "failed to get key-value record",
 				"lockKey", lockKey,
@@ -194,6 +269,18 @@ func (r *Reconciler) CheckLock(object apilib.ReconciledThreeportApiObject) (bool
 		}
 	}
 	if kvEntry != nil {
+		if lock := decodeLock(kvEntry.Value()); lock != nil && lock.Stale() {
+			r.Log.Info(
+				"object lock is stale - lock will be taken over",
+				"objectType", object.GetType(),
+				"objectVersion", object.GetVersion(),
+				"objectID", object.GetId(),
+				"holderControllerID", *lock.ControllerID,
+				"renewedAt", *lock.RenewedAt,
+			)
+			return false, true
+		}
+		lockFailureTotal.WithLabelValues(r.Name, LockFailureLocked).Inc()
 		r.Log.V(1).Info(
 			"object is locked - requeue",
 			"objectType", object.GetType(),
@@ -207,12 +294,23 @@ func (r *Reconciler) CheckLock(object apilib.ReconciledThreeportApiObject) (bool
 }
 
 // Lock puts a lock on the given object so that no other reconcilation of this
-// object is attempted until unlocked.  Returns true if successful.
+// object is attempted until unlocked.  The lock records the controller that
+// holds it and its lease is renewed until the lock is released.  A stale lock
+// held by another controller is taken over.  Returns true if successful.
 func (r *Reconciler) Lock(object apilib.ReconciledThreeportApiObject) bool {
 	lockKey := r.lockKey(object.GetId())
 
-	rev, err := r.KeyValue.Create(lockKey, []byte(r.ControllerID.String()))
+	now := time.Now().UTC()
+	lock := v0.ReconcileLock{
+		ReconcilerName: &r.Name,
+		ObjectID:       util.Ptr(object.GetId()),
+		ControllerID:   util.Ptr(r.ControllerID.String()),
+		AcquiredAt:     &now,
+		RenewedAt:      &now,
+	}
+	rev, err := r.createLock(lockKey, &lock)
 	if err != nil {
+		lockFailureTotal.WithLabelValues(r.Name, LockFailureLockFailed).Inc()
 		r.Log.Error(
 			err, "failed to apply lock to object for reconciliation",
 			"lockKey", lockKey,
@@ -227,6 +325,7 @@ func (r *Reconciler) Lock(object apilib.ReconciledThreeportApiObject) bool {
 		"objectVersion", object.GetVersion(),
 		"objectID", object.GetId(),
 	)
+	r.startLease(lockKey, &lock, rev)
 
 	return true
 }
@@ -235,9 +334,29 @@ func (r *Reconciler) Lock(object apilib.ReconciledThreeportApiObject) bool {
 // longer be locked.  Rerturns true if successful.  If the lock fails to be
 // released it will remain locked until the TTL expires in NATS.
 func (r *Reconciler) ReleaseLock(object apilib.ReconciledThreeportApiObject, lockReleased chan bool, msg *nats.Msg, reconcileSuccess bool) bool {
+	if reconcileSuccess {
+		r.completeReconcile(msg, ReconcileOutcomeSuccess)
+		r.resetFailedAttempts(object)
+	}
+
 	lockKey := r.lockKey(object.GetId())
 
-	if err := r.KeyValue.Delete(lockKey); err != nil {
+	// only delete the lock if it is still held by this reconciler, i.e. it
+	// was not forcibly released or taken over while reconciling
+	lockHeld := true
+	var deleteOpts []nats.DeleteOpt
+	if lease := r.stopLease(lockKey); lease != nil {
+		revision, lost := lease.state()
+		lockHeld = !lost
+		deleteOpts = append(deleteOpts, nats.LastRevision(revision))
+	}
+	if !lockHeld {
+		r.Log.Info(
+			"lock lease was lost while reconciling - lock not released",
+			"lockKey", lockKey,
+			"bucket", r.KeyValue.Bucket(),
+		)
+	} else if err := r.KeyValue.Delete(lockKey, deleteOpts...); err != nil {
 		r.Log.Error(
 			err, "failed to delete key-value record",
 			"lockKey", lockKey,
@@ -10,6 +10,9 @@ const (
 // SetRequeueDelay sets the requeue delay.  It will be set to the initial delay
 // value if the first requeue for the object.  It will be set to double the
 // previous delay if not the first, or the max delay if reached.
+//
+// Deprecated: use Reconciler.RequeueDelay which applies the reconciler's
+// retry policy.
 func SetRequeueDelay(creationTime *int64) int64 {
 	var requeueDelay int64
 
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+)
+
+// The default interval at which reconciled objects are resynced for
+// reconcilers that check objects for drift.
+const DefaultResyncInterval = 10 * time.Minute
+
+// ListReconciledFunc returns the objects for a reconciler that have been
+// reconciled so they may be resynced.
+type ListReconciledFunc func(apiClient *http.Client, apiServer string) ([]apilib.ReconciledThreeportApiObject, error)
+
+// Resyncer periodically sends resync notifications for objects that have
+// been reconciled so that reconcilers check the resources they manage for
+// drift from the desired state.  Without a resync, an object is not
+// reconciled again until it is changed in the API.
+//
+// Resyncs happen on interval boundaries in every replica of a controller and
+// each notification has a message ID for the object and interval so that
+// NATS discards the duplicates sent by other replicas.
+type Resyncer struct {
+	// APIServer is the endpoint to reach Threeport REST API.
+	// format: [protocol]://[hostname]:[port]
+	APIServer string
+
+	// APIClient is the HTTP client used to make requests to the Threeport API.
+	APIClient *http.Client
+
+	// JetStreamContext is the context for the NATS persistence layer.
+	JetStreamContext nats.JetStreamContext
+
+	// Log is the logger used to write logs.
+	Log *logr.Logger
+
+	mutex    sync.Mutex
+	stop     chan struct{}
+	shutdown bool
+}
+
+// AddReconciler starts resyncing the reconciler's objects at its resync
+// interval.  Reconcilers without a resync interval are ignored.
+func (s *Resyncer) AddReconciler(config ReconcilerConfig) {
+	if config.ResyncInterval <= 0 || config.ListReconciledFunc == nil || config.ResyncSubject == "" {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shutdown {
+		return
+	}
+	if s.stop == nil {
+		s.stop = make(chan struct{})
+	}
+	go s.run(config, s.stop)
+
+	s.Log.Info(
+		"resync of reconciled objects started",
+		"reconcilerName", config.Name,
+		"resyncInterval", config.ResyncInterval.String(),
+	)
+}
+
+// Shutdown stops resyncing objects.
+func (s *Resyncer) Shutdown() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shutdown {
+		return
+	}
+	s.shutdown = true
+	if s.stop != nil {
+		close(s.stop)
+	}
+}
+
+// run resyncs a reconciler's objects at the start of each interval until the
+// resyncer is shut down.
+func (s *Resyncer) run(config ReconcilerConfig, stop chan struct{}) {
+	for {
+		next := time.Now().Truncate(config.ResyncInterval).Add(config.ResyncInterval)
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := s.resync(config, next); err != nil {
+			s.Log.Error(err, "failed to resync reconciled objects", "reconcilerName", config.Name)
+		}
+	}
+}
+
+// resync sends a resync notification for each of a reconciler's reconciled
+// objects that is not being deleted.
+func (s *Resyncer) resync(config ReconcilerConfig, interval time.Time) error {
+	objects, err := config.ListReconciledFunc(s.APIClient, s.APIServer)
+	if err != nil {
+		return fmt.Errorf("failed to list reconciled objects: %w", err)
+	}
+
+	resynced := 0
+	for _, object := range objects {
+		if object.ScheduledForDeletion() != nil {
+			continue
+		}
+
+		payload, err := object.NotificationPayload(
+			notifications.NotificationOperationResync,
+			false,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			s.Log.Error(
+				err, "failed to build resync notification",
+				"reconcilerName", config.Name,
+				"objectID", object.GetId(),
+			)
+			continue
+		}
+
+		msgID := fmt.Sprintf("%s.%d.resync.%d", config.Name, object.GetId(), interval.Unix())
+		if _, err := s.JetStreamContext.Publish(config.ResyncSubject, *payload, nats.MsgId(msgID)); err != nil {
+			s.Log.Error(
+				err, "failed to send resync notification",
+				"reconcilerName", config.Name,
+				"objectID", object.GetId(),
+			)
+			continue
+		}
+		resynced++
+	}
+	resyncTotal.WithLabelValues(config.Name).Add(float64(resynced))
+
+	s.Log.V(1).Info(
+		"reconciled objects resynced",
+		"reconcilerName", config.Name,
+		"objectCount", resynced,
+	)
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+
+	"github.com/nats-io/nats.go"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+)
+
+// The default factor the requeue delay is multiplied by after each failed
+// attempt.
+const DefaultRetryMultiplier = 2.0
+
+// RetryPolicy determines how failed reconciliations are retried.  The delay
+// between attempts grows exponentially from the initial delay up to the max
+// delay.  The zero value retries indefinitely with the default delays.
+//
+// Failed attempts are counted in the controller's lock bucket and the count
+// expires with the bucket's TTL, so the max delay should be well under the
+// TTL for max attempts to take effect.
+type RetryPolicy struct {
+	// The delay in seconds before the first retry.  If zero,
+	// DefaultInitialRequeueDelay is used.
+	InitialDelay int64
+
+	// The maximum delay in seconds between retries.  If zero,
+	// DefaultMaxRequeueDelay is used.
+	MaxDelay int64
+
+	// The factor the delay is multiplied by after each failed attempt.  If
+	// less than 1, DefaultRetryMultiplier is used.
+	Multiplier float64
+
+	// The fraction of the delay, between 0 and 1, that is randomly added to
+	// or removed from each delay so that objects that failed together are
+	// not retried together.
+	Jitter float64
+
+	// The number of failed attempts after which the notification is moved to
+	// the dead-letter stream.  If zero, reconciliation is retried
+	// indefinitely.
+	MaxAttempts int
+}
+
+// Delay returns the requeue delay in seconds after the provided number of
+// failed attempts.
+func (p *RetryPolicy) Delay(attempts int) int64 {
+	initialDelay := p.InitialDelay
+	if initialDelay < 1 {
+		initialDelay = DefaultInitialRequeueDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay < 1 {
+		maxDelay = DefaultMaxRequeueDelay
+	}
+	if maxDelay < initialDelay {
+		maxDelay = initialDelay
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultRetryMultiplier
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := math.Min(
+		float64(initialDelay)*math.Pow(multiplier, float64(attempts-1)),
+		float64(maxDelay),
+	)
+	if p.Jitter > 0 {
+		delay += delay * math.Min(p.Jitter, 1) * (2*rand.Float64() - 1)
+	}
+
+	return min(max(int64(math.Round(delay)), 1), maxDelay)
+}
+
+// Exhausted returns true if the provided number of failed attempts uses up
+// the attempts allowed by the policy.
+func (p *RetryPolicy) Exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
+
+// RequeueDelay returns the delay in seconds to use if the current
+// reconciliation of the object fails.  It is based on the number of times
+// reconciliation of the object has already failed.
+func (r *Reconciler) RequeueDelay(object apilib.ReconciledThreeportApiObject) int64 {
+	return r.RetryPolicy.Delay(r.failedAttempts(object) + 1)
+}
+
+// attemptsKey constructs the key for the count of failed attempts to
+// reconcile an object.
+func (r *Reconciler) attemptsKey(id uint) string {
+	return fmt.Sprintf("%s.%d.attempts", r.Name, id)
+}
+
+// failedAttempts returns the number of times reconciliation of the object has
+// failed since it last succeeded.
+func (r *Reconciler) failedAttempts(object apilib.ReconciledThreeportApiObject) int {
+	attemptsKey := r.attemptsKey(object.GetId())
+
+	kvEntry, err := r.KeyValue.Get(attemptsKey)
+	if err != nil {
+		if !errors.Is(err, nats.ErrKeyNotFound) && !errors.Is(err, nats.ErrKeyDeleted) {
+			r.Log.Error(
+				err, "failed to get failed reconciliation attempts",
+				"attemptsKey", attemptsKey,
+				"bucket", r.KeyValue.Bucket(),
+			)
+		}
+		return 0
+	}
+
+	attempts, err := strconv.Atoi(string(kvEntry.Value()))
+	if err != nil {
+		r.Log.Error(err, "invalid failed reconciliation attempts value", "attemptsKey", attemptsKey)
+		return 0
+	}
+
+	return attempts
+}
+
+// recordFailedAttempt increments the number of failed attempts to reconcile
+// the object and returns the new count.
+func (r *Reconciler) recordFailedAttempt(object apilib.ReconciledThreeportApiObject) int {
+	attemptsKey := r.attemptsKey(object.GetId())
+
+	attempts := r.failedAttempts(object) + 1
+	if _, err := r.KeyValue.Put(attemptsKey, []byte(strconv.Itoa(attempts))); err != nil {
+		r.Log.Error(
+			err, "failed to record failed reconciliation attempt",
+			"attemptsKey", attemptsKey,
+			"bucket", r.KeyValue.Bucket(),
+		)
+	}
+
+	return attempts
+}
+
+// resetFailedAttempts clears the number of failed attempts to reconcile the
+// object.
+func (r *Reconciler) resetFailedAttempts(object apilib.ReconciledThreeportApiObject) {
+	if r.failedAttempts(object) == 0 {
+		return
+	}
+
+	attemptsKey := r.attemptsKey(object.GetId())
+	if err := r.KeyValue.Delete(attemptsKey); err != nil {
+		r.Log.Error(
+			err, "failed to reset failed reconciliation attempts",
+			"attemptsKey", attemptsKey,
+			"bucket", r.KeyValue.Bucket(),
+		)
+	}
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRetryPolicyDelay tests that the requeue delay grows exponentially from
+// the initial delay up to the max delay.
+func TestRetryPolicyDelay(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   RetryPolicy
+		attempts int
+		delay    int64
+	}{
+		{
+			name:     "zero value first attempt",
+			policy:   RetryPolicy{},
+			attempts: 1,
+			delay:    DefaultInitialRequeueDelay,
+		},
+		{
+			name:     "zero value grows by default multiplier",
+			policy:   RetryPolicy{},
+			attempts: 3,
+			delay:    DefaultInitialRequeueDelay * 4,
+		},
+		{
+			name:     "zero value capped at default max",
+			policy:   RetryPolicy{},
+			attempts: 20,
+			delay:    DefaultMaxRequeueDelay,
+		},
+		{
+			name:     "attempts below one treated as first attempt",
+			policy:   RetryPolicy{InitialDelay: 5},
+			attempts: 0,
+			delay:    5,
+		},
+		{
+			name:     "custom multiplier",
+			policy:   RetryPolicy{InitialDelay: 2, MaxDelay: 100, Multiplier: 3},
+			attempts: 3,
+			delay:    18,
+		},
+		{
+			name:     "multiplier below one uses default",
+			policy:   RetryPolicy{InitialDelay: 2, MaxDelay: 100, Multiplier: 0.5},
+			attempts: 3,
+			delay:    8,
+		},
+		{
+			name:     "max delay below initial delay",
+			policy:   RetryPolicy{InitialDelay: 10, MaxDelay: 5},
+			attempts: 4,
+			delay:    10,
+		},
+		{
+			name:     "large attempt count doesn't overflow",
+			policy:   RetryPolicy{InitialDelay: 1, MaxDelay: 60},
+			attempts: 10000,
+			delay:    60,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.delay, tc.policy.Delay(tc.attempts))
+		})
+	}
+}
+
+// TestRetryPolicyDelayJitter tests that jitter keeps the delay within the
+// jitter fraction of the delay and within the max delay.
+func TestRetryPolicyDelayJitter(t *testing.T) {
+	policy := RetryPolicy{InitialDelay: 10, MaxDelay: 15, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(1)
+		assert.GreaterOrEqual(t, delay, int64(5))
+		assert.LessOrEqual(t, delay, int64(15))
+	}
+}
+
+// TestRetryPolicyExhausted tests that attempts are only exhausted when the
+// policy has max attempts.
+func TestRetryPolicyExhausted(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    RetryPolicy
+		attempts  int
+		exhausted bool
+	}{
+		{
+			name:      "no max attempts",
+			policy:    RetryPolicy{},
+			attempts:  1000,
+			exhausted: false,
+		},
+		{
+			name:      "below max attempts",
+			policy:    RetryPolicy{MaxAttempts: 3},
+			attempts:  2,
+			exhausted: false,
+		},
+		{
+			name:      "at max attempts",
+			policy:    RetryPolicy{MaxAttempts: 3},
+			attempts:  3,
+			exhausted: true,
+		},
+		{
+			name:      "above max attempts",
+			policy:    RetryPolicy{MaxAttempts: 3},
+			attempts:  4,
+			exhausted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exhausted, tc.policy.Exhausted(tc.attempts))
+		})
+	}
+}
@@ -0,0 +1,682 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	// The default interval at which controller replicas renew their
+	// membership and refresh the membership of other replicas.  A replica
+	// that doesn't renew its membership for three intervals is removed and
+	// its objects are rebalanced across the remaining replicas.
+	DefaultShardHeartbeatInterval = 10 * time.Second
+
+	// The number of points each replica has on the hash ring so that objects
+	// are evenly distributed across replicas.
+	shardVirtualNodes = 100
+
+	// The header that records how many times a notification has been
+	// forwarded between replicas.  Replicas may briefly disagree on the owner
+	// of an object while membership changes so a notification is reconciled
+	// by the replica that has it once it has been forwarded maxShardHops
+	// times.
+	shardHopsHeader = "Threeport-Shard-Hops"
+	maxShardHops    = 3
+
+	// The number of notifications pulled at a time when routing.
+	shardRouteBatch = 10
+)
+
+// HashRing assigns keys to members using consistent hashing so that only the
+// keys owned by a member move when members are added or removed.
+type HashRing struct {
+	members []string
+	hashes  []uint32
+	owners  map[uint32]string
+}
+
+// NewHashRing returns a hash ring for the provided members.
+func NewHashRing(members []string) *HashRing {
+	sorted := append([]string{}, members...)
+	sort.Strings(sorted)
+
+	ring := HashRing{
+		members: sorted,
+		owners:  make(map[uint32]string),
+	}
+	for _, member := range sorted {
+		for i := 0; i < shardVirtualNodes; i++ {
+			hash := hashKey(fmt.Sprintf("%s#%d", member, i))
+			// on the rare hash collision, the first member in sorted order
+			// keeps the point so every replica builds the same ring
+			if _, found := ring.owners[hash]; found {
+				continue
+			}
+			ring.owners[hash] = member
+			ring.hashes = append(ring.hashes, hash)
+		}
+	}
+	sort.Slice(ring.hashes, func(i, j int) bool { return ring.hashes[i] < ring.hashes[j] })
+
+	return &ring
+}
+
+// Owner returns the member that owns a key.  It returns an empty string if
+// the ring has no members.
+func (h *HashRing) Owner(key string) string {
+	if len(h.hashes) == 0 {
+		return ""
+	}
+	hash := hashKey(key)
+	i := sort.Search(len(h.hashes), func(i int) bool { return h.hashes[i] >= hash })
+	if i == len(h.hashes) {
+		i = 0
+	}
+
+	return h.owners[h.hashes[i]]
+}
+
+// Members returns the members of the ring in sorted order.
+func (h *HashRing) Members() []string {
+	return h.members
+}
+
+// hashKey returns the position of a key on the hash ring.
+func hashKey(key string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+
+	return hash.Sum32()
+}
+
+// Sharder shards the objects for a controller's reconcilers across the
+// controller's replicas so that each object is reconciled by exactly one
+// replica.  Replicas record their membership in a NATS key-value bucket and
+// object IDs are assigned to members with a hash ring.
+//
+// Notifications from the API are pulled from each reconciler's durable
+// consumer by a router in every replica and forwarded to a subject in the
+// shard stream for the replica that owns the object.  Each replica's
+// reconcilers pull from their own consumer on the shard stream.  When a
+// replica leaves, the notifications that were forwarded to it and not
+// reconciled are forwarded to the new owners.  Replicas that have left are
+// found from the consumers and subjects on the shard stream so that
+// notifications are forwarded even if every replica that saw a replica leave
+// has restarted.
+type Sharder struct {
+	// ControllerID is the unique identifier for this controller replica.
+	ControllerID uuid.UUID
+
+	// JetStreamContext is the context for the NATS persistence layer.
+	JetStreamContext nats.JetStreamContext
+
+	// MemberBucketName is the name of the NATS key-value bucket used to
+	// track controller replicas.
+	MemberBucketName string
+
+	// ShardStreamName is the name of the NATS stream used to forward
+	// notifications to the replica that owns the object.
+	ShardStreamName string
+
+	// NotifStreamName is the name of the NATS stream the API sends
+	// notifications for the controller's objects to.
+	NotifStreamName string
+
+	// HeartbeatInterval is the interval at which membership is renewed and
+	// refreshed.  If zero, DefaultShardHeartbeatInterval is used.
+	HeartbeatInterval time.Duration
+
+	// Log is the logger used to write logs.
+	Log *logr.Logger
+
+	members     nats.KeyValue
+	mutex       sync.RWMutex
+	ring        *HashRing
+	reconcilers []string
+	stop        chan struct{}
+	shutdown    bool
+}
+
+// shardMember is the value recorded for a replica in the member bucket.
+type shardMember struct {
+	RenewedAt time.Time `json:"renewedAt"`
+}
+
+// Start records this replica's membership, creates the shard stream if it
+// doesn't exist and starts renewing membership.
+func (s *Sharder) Start() error {
+	interval := s.heartbeatInterval()
+
+	members, err := CreateLockBucketIfNotExists(s.JetStreamContext, &nats.KeyValueConfig{
+		Bucket:      s.MemberBucketName,
+		Description: "contains the controller replicas objects are sharded across",
+		TTL:         3 * interval,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bind to member bucket %s: %w", s.MemberBucketName, err)
+	}
+	if members == nil {
+		return fmt.Errorf("failed to bind to member bucket %s", s.MemberBucketName)
+	}
+	s.members = members
+
+	if _, err := s.JetStreamContext.StreamInfo(s.ShardStreamName); err != nil {
+		if !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to get shard stream %s: %w", s.ShardStreamName, err)
+		}
+		if _, err := s.JetStreamContext.AddStream(&nats.StreamConfig{
+			Name:      s.ShardStreamName,
+			Subjects:  []string{fmt.Sprintf("%s.>", s.ShardStreamName)},
+			Retention: nats.WorkQueuePolicy,
+		}); err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+			return fmt.Errorf("failed to create shard stream %s: %w", s.ShardStreamName, err)
+		}
+	}
+
+	if err := s.heartbeat(); err != nil {
+		return err
+	}
+	if err := s.refresh(); err != nil {
+		return err
+	}
+
+	s.stop = make(chan struct{})
+	go s.run(interval, s.stop)
+
+	s.Log.Info(
+		"sharding started",
+		"memberBucketName", s.MemberBucketName,
+		"members", s.currentRing().Members(),
+	)
+
+	return nil
+}
+
+// AddReconciler starts routing the notifications for a reconciler from its
+// durable consumer to the replicas that own the objects.
+func (s *Sharder) AddReconciler(config ReconcilerConfig, consumer string) error {
+	sub, err := s.JetStreamContext.PullSubscribe(
+		config.NotifSubject,
+		consumer,
+		nats.BindStream(s.NotifStreamName),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription for routing %s notifications: %w", config.Name, err)
+	}
+
+	s.mutex.Lock()
+	s.reconcilers = append(s.reconcilers, config.Name)
+	stop := s.stop
+	s.mutex.Unlock()
+
+	go s.route(config.Name, sub, stop)
+
+	return nil
+}
+
+// Subscribe returns a pull subscription for a reconciliation process that
+// receives the notifications for objects owned by this replica.
+func (s *Sharder) Subscribe(config ReconcilerConfig) (*nats.Subscription, error) {
+	return s.subscribeShard(config.Name, s.ControllerID.String())
+}
+
+// Owns returns true if this replica owns the object with the provided ID.
+func (s *Sharder) Owns(id uint) bool {
+	return s.Owner(id) == s.ControllerID.String()
+}
+
+// Owner returns the controller ID of the replica that owns the object with
+// the provided ID.
+func (s *Sharder) Owner(id uint) string {
+	owner := s.currentRing().Owner(strconv.FormatUint(uint64(id), 10))
+	if owner == "" {
+		return s.ControllerID.String()
+	}
+
+	return owner
+}
+
+// Shutdown stops routing notifications and removes this replica's
+// membership so that its objects are rebalanced to the remaining replicas.
+// It is safe to call on a nil Sharder.
+func (s *Sharder) Shutdown() {
+	if s == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.shutdown {
+		return
+	}
+	s.shutdown = true
+	if s.stop != nil {
+		close(s.stop)
+	}
+	if s.members != nil {
+		if err := s.members.Delete(s.ControllerID.String()); err != nil {
+			s.Log.Error(err, "failed to remove controller replica from members")
+		}
+	}
+}
+
+// reroute forwards a notification pulled by a reconciliation process to the
+// replica that owns the object if this replica no longer owns it, e.g.
+// when a requeued notification is redelivered after objects were
+// rebalanced.  It returns true if the notification was forwarded.
+func (s *Sharder) reroute(reconcilerName string, msg *nats.Msg) bool {
+	hops := shardHops(msg)
+	if hops >= maxShardHops {
+		return false
+	}
+	id, err := notificationObjectID(msg.Data)
+	if err != nil {
+		return false
+	}
+	owner := s.Owner(id)
+	if owner == s.ControllerID.String() {
+		return false
+	}
+
+	if err := s.forward(reconcilerName, msg, owner, hops+1); err != nil {
+		s.Log.Error(err, "failed to forward notification to owner - reconciling", "owner", owner)
+		return false
+	}
+	if err := msg.Ack(); err != nil {
+		s.Log.Error(err, "failed to acknowledge forwarded notification")
+	}
+
+	return true
+}
+
+// route pulls notifications for a reconciler from its durable consumer and
+// forwards them to the replicas that own the objects until the sharder is
+// shut down.
+func (s *Sharder) route(reconcilerName string, sub *nats.Subscription, stop chan struct{}) {
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		messages, err := sub.Fetch(shardRouteBatch, nats.MaxWait(5*time.Second))
+		if err != nil && !errors.Is(err, nats.ErrTimeout) {
+			s.Log.Error(err, "failed to fetch notifications to route", "reconcilerName", reconcilerName)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for _, msg := range messages {
+			// notifications that can't be decoded are sent to this replica's
+			// reconcilers which requeue them
+			owner := s.ControllerID.String()
+			if id, err := notificationObjectID(msg.Data); err == nil {
+				owner = s.Owner(id)
+			}
+
+			if err := s.forward(reconcilerName, msg, owner, shardHops(msg)); err != nil {
+				s.Log.Error(
+					err, "failed to forward notification to owner",
+					"reconcilerName", reconcilerName,
+					"owner", owner,
+				)
+				msg.Nak()
+				continue
+			}
+			if err := msg.Ack(); err != nil {
+				s.Log.Error(err, "failed to acknowledge routed notification", "reconcilerName", reconcilerName)
+			}
+		}
+	}
+}
+
+// forward sends a notification to the shard subject for a reconciler in the
+// owning replica.
+func (s *Sharder) forward(reconcilerName string, msg *nats.Msg, owner string, hops int) error {
+	forwarded := nats.NewMsg(s.shardSubject(reconcilerName, owner))
+	forwarded.Data = msg.Data
+	for key, values := range msg.Header {
+		// NATS headers such as the message ID used to discard duplicates
+		// apply to the original message only
+		if strings.HasPrefix(key, "Nats-") || key == shardHopsHeader {
+			continue
+		}
+		forwarded.Header[key] = values
+	}
+	forwarded.Header.Set(shardHopsHeader, strconv.Itoa(hops))
+
+	if _, err := s.JetStreamContext.PublishMsg(forwarded); err != nil {
+		return err
+	}
+	if owner != s.ControllerID.String() {
+		shardForwardTotal.WithLabelValues(reconcilerName).Inc()
+	}
+
+	return nil
+}
+
+// run renews membership and refreshes the hash ring at each interval until
+// the sharder is shut down.
+func (s *Sharder) run(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.heartbeat(); err != nil {
+			s.Log.Error(err, "failed to renew controller replica membership")
+		}
+		if err := s.refresh(); err != nil {
+			s.Log.Error(err, "failed to refresh controller replica membership")
+		}
+	}
+}
+
+// heartbeat renews this replica's membership.
+func (s *Sharder) heartbeat() error {
+	member, err := json.Marshal(shardMember{RenewedAt: time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal member: %w", err)
+	}
+	if _, err := s.members.Put(s.ControllerID.String(), member); err != nil {
+		return fmt.Errorf("failed to record controller replica membership: %w", err)
+	}
+
+	return nil
+}
+
+// refresh rebuilds the hash ring when replicas have joined or left and
+// drains the notifications forwarded to replicas that have left.
+func (s *Sharder) refresh() error {
+	keys, err := s.members.Keys()
+	if err != nil && !errors.Is(err, nats.ErrNoKeysFound) {
+		return fmt.Errorf("failed to get controller replica members: %w", err)
+	}
+
+	// this replica always reconciles its own objects even if its
+	// membership could not be renewed
+	self := s.ControllerID.String()
+	found := false
+	for _, key := range keys {
+		if key == self {
+			found = true
+			break
+		}
+	}
+	if !found {
+		keys = append(keys, self)
+	}
+	sort.Strings(keys)
+
+	s.mutex.Lock()
+	previous := s.ring
+	changed := previous == nil || !equalMembers(previous.Members(), keys)
+	if changed {
+		s.ring = NewHashRing(keys)
+	}
+	ring := s.ring
+	s.mutex.Unlock()
+
+	if changed {
+		shardMembers.Set(float64(len(keys)))
+		if previous != nil {
+			shardRebalanceTotal.Inc()
+			s.Log.Info(
+				"controller replicas changed - objects rebalanced",
+				"previousMembers", previous.Members(),
+				"members", keys,
+			)
+		}
+	}
+
+	// the replica that owns a departed replica's ID on the ring forwards the
+	// notifications that were left in its shards - shards are found from
+	// both the consumers and the subjects with notifications on the shard
+	// stream so that notifications are forwarded even if a departed
+	// replica's consumer was removed
+	current := make(map[string]bool)
+	for _, key := range keys {
+		current[key] = true
+	}
+	departed := make(map[string]bool)
+	for consumer := range s.JetStreamContext.ConsumerNames(s.ShardStreamName) {
+		if _, member, ok := parseShardConsumerName(consumer); ok && !current[member] {
+			departed[consumer] = true
+		}
+	}
+	info, err := s.JetStreamContext.StreamInfo(s.ShardStreamName, &nats.StreamInfoRequest{
+		SubjectsFilter: fmt.Sprintf("%s.>", s.ShardStreamName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get shard stream %s subjects: %w", s.ShardStreamName, err)
+	}
+	for subject := range info.State.Subjects {
+		if reconcilerName, member, ok := s.parseShardSubject(subject); ok && !current[member] {
+			departed[shardConsumerName(reconcilerName, member)] = true
+		}
+	}
+	for consumer := range departed {
+		reconcilerName, member, _ := parseShardConsumerName(consumer)
+		if ring.Owner(member) != self {
+			continue
+		}
+		s.drain(reconcilerName, member)
+	}
+
+	return nil
+}
+
+// drain forwards the notifications left in a departed replica's shard for a
+// reconciler to the replicas that now own the objects and removes the
+// replica's shard consumer once all notifications have been forwarded.  The
+// consumer is created again if it no longer exists.  Notifications that were
+// delivered to the departed replica and not acknowledged are forwarded on a
+// later refresh once NATS makes them available again.
+func (s *Sharder) drain(reconcilerName, member string) {
+	consumer := shardConsumerName(reconcilerName, member)
+	sub, err := s.subscribeShard(reconcilerName, member)
+	if err != nil {
+		s.Log.Error(err, "failed to bind to departed replica's shard consumer", "consumer", consumer)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	forwarded := 0
+	for {
+		messages, err := sub.Fetch(shardRouteBatch, nats.MaxWait(time.Second))
+		if err != nil || len(messages) == 0 {
+			break
+		}
+		for _, msg := range messages {
+			owner := s.ControllerID.String()
+			if id, err := notificationObjectID(msg.Data); err == nil {
+				owner = s.Owner(id)
+			}
+			if err := s.forward(reconcilerName, msg, owner, 0); err != nil {
+				s.Log.Error(err, "failed to forward departed replica's notification", "owner", owner)
+				msg.Nak()
+				continue
+			}
+			msg.Ack()
+			forwarded++
+		}
+	}
+
+	info, err := sub.ConsumerInfo()
+	if err != nil || info.NumPending > 0 || info.NumAckPending > 0 {
+		return
+	}
+	if err := s.JetStreamContext.DeleteConsumer(s.ShardStreamName, consumer); err != nil {
+		s.Log.Error(err, "failed to delete departed replica's shard consumer", "consumer", consumer)
+	}
+	s.Log.Info(
+		"departed replica's notifications forwarded",
+		"reconcilerName", reconcilerName,
+		"departedControllerID", member,
+		"notificationCount", forwarded,
+	)
+}
+
+// currentRing returns the hash ring for the current members.
+func (s *Sharder) currentRing() *HashRing {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.ring == nil {
+		return NewHashRing([]string{s.ControllerID.String()})
+	}
+
+	return s.ring
+}
+
+// heartbeatInterval returns the interval at which membership is renewed.
+func (s *Sharder) heartbeatInterval() time.Duration {
+	if s.HeartbeatInterval > 0 {
+		return s.HeartbeatInterval
+	}
+
+	return DefaultShardHeartbeatInterval
+}
+
+// shardSubject returns the subject notifications for a reconciler are
+// forwarded to for a replica.
+func (s *Sharder) shardSubject(reconcilerName, member string) string {
+	return fmt.Sprintf("%s.%s.%s", s.ShardStreamName, reconcilerName, member)
+}
+
+// subscribeShard returns a pull subscription to a replica's shard for a
+// reconciler and creates the replica's shard consumer if it doesn't exist.
+// Shard consumers don't expire so that the notifications left in a shard are
+// forwarded by drain however long a replica has been gone.
+func (s *Sharder) subscribeShard(reconcilerName, member string) (*nats.Subscription, error) {
+	consumer := shardConsumerName(reconcilerName, member)
+	subject := s.shardSubject(reconcilerName, member)
+
+	if _, err := s.JetStreamContext.ConsumerInfo(s.ShardStreamName, consumer); err != nil {
+		if !errors.Is(err, nats.ErrConsumerNotFound) {
+			return nil, fmt.Errorf("failed to get shard consumer %s: %w", consumer, err)
+		}
+		if _, err := s.JetStreamContext.AddConsumer(s.ShardStreamName, &nats.ConsumerConfig{
+			Durable:       consumer,
+			AckPolicy:     nats.AckExplicitPolicy,
+			FilterSubject: subject,
+		}); err != nil && !errors.Is(err, nats.ErrConsumerNameAlreadyInUse) {
+			return nil, fmt.Errorf("failed to create shard consumer %s: %w", consumer, err)
+		}
+	}
+
+	return s.JetStreamContext.PullSubscribe(
+		subject,
+		consumer,
+		nats.Bind(s.ShardStreamName, consumer),
+	)
+}
+
+// parseShardSubject returns the reconciler name and replica controller ID
+// from a subject on the shard stream.  It returns false if the subject is not
+// that of a replica's shard.
+func (s *Sharder) parseShardSubject(subject string) (string, string, bool) {
+	shard, found := strings.CutPrefix(subject, s.ShardStreamName+".")
+	if !found {
+		return "", "", false
+	}
+	separator := strings.LastIndex(shard, ".")
+	if separator < 1 {
+		return "", "", false
+	}
+	member := shard[separator+1:]
+	if _, err := uuid.Parse(member); err != nil {
+		return "", "", false
+	}
+
+	return shard[:separator], member, true
+}
+
+// shardConsumerName returns the name of a replica's shard consumer for a
+// reconciler.
+func shardConsumerName(reconcilerName, member string) string {
+	return fmt.Sprintf("%s-%s", reconcilerName, member)
+}
+
+// parseShardConsumerName returns the reconciler name and replica controller
+// ID from the name of a shard consumer.  It returns false if the name is not
+// that of a shard consumer.
+func parseShardConsumerName(consumer string) (string, string, bool) {
+	// controller IDs are UUIDs which have a fixed length
+	idStart := len(consumer) - len(uuid.Nil.String())
+	if idStart < 2 || consumer[idStart-1] != '-' {
+		return "", "", false
+	}
+	member := consumer[idStart:]
+	if _, err := uuid.Parse(member); err != nil {
+		return "", "", false
+	}
+
+	return consumer[:idStart-1], member, true
+}
+
+// shardHops returns the number of times a notification has been forwarded
+// between replicas.
+func shardHops(msg *nats.Msg) int {
+	if msg.Header == nil {
+		return 0
+	}
+	hops, err := strconv.Atoi(msg.Header.Get(shardHopsHeader))
+	if err != nil {
+		return 0
+	}
+
+	return hops
+}
+
+// notificationObjectID returns the ID of the object in a notification.
+func notificationObjectID(data []byte) (uint, error) {
+	var notif struct {
+		Object struct {
+			ID *uint `json:"ID"`
+		}
+	}
+	if err := json.Unmarshal(data, &notif); err != nil {
+		return 0, fmt.Errorf("failed to decode notification: %w", err)
+	}
+	if notif.Object.ID == nil {
+		return 0, errors.New("notification object has no ID")
+	}
+
+	return *notif.Object.ID, nil
+}
+
+// equalMembers returns true if two sorted lists of members are the same.
+func equalMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHashRingOwner tests that keys are assigned to members of the ring and
+// that every replica builds the same ring regardless of member order.
+func TestHashRingOwner(t *testing.T) {
+	testCases := []struct {
+		name    string
+		members []string
+	}{
+		{
+			name: "no members",
+		},
+		{
+			name:    "single member",
+			members: []string{"a"},
+		},
+		{
+			name:    "several members",
+			members: []string{"c", "a", "b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ring := NewHashRing(tc.members)
+			reversed := make([]string, len(tc.members))
+			for i, member := range tc.members {
+				reversed[len(tc.members)-1-i] = member
+			}
+			reversedRing := NewHashRing(reversed)
+
+			for i := 0; i < 100; i++ {
+				key := fmt.Sprintf("%d", i)
+				owner := ring.Owner(key)
+				if len(tc.members) == 0 {
+					assert.Empty(t, owner)
+					continue
+				}
+				assert.Contains(t, tc.members, owner)
+				assert.Equal(t, owner, reversedRing.Owner(key))
+			}
+		})
+	}
+}
+
+// TestHashRingRebalance tests that only the keys owned by a departed member
+// move to other members.
+func TestHashRingRebalance(t *testing.T) {
+	ring := NewHashRing([]string{"a", "b", "c"})
+	departedRing := NewHashRing([]string{"a", "b"})
+
+	owned := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("%d", i)
+		owner := ring.Owner(key)
+		owned[owner]++
+		if owner != "c" {
+			assert.Equal(t, owner, departedRing.Owner(key), "key %s should not move", key)
+		}
+	}
+
+	// virtual nodes spread keys across all members
+	for _, member := range ring.Members() {
+		assert.Greater(t, owned[member], 100, "member %s should own a share of keys", member)
+	}
+}
+
+// TestParseShardConsumerName tests that the reconciler name and replica are
+// recovered from shard consumer names.
+func TestParseShardConsumerName(t *testing.T) {
+	member := uuid.New().String()
+
+	testCases := []struct {
+		name           string
+		consumer       string
+		reconcilerName string
+		member         string
+		ok             bool
+	}{
+		{
+			name:           "shard consumer",
+			consumer:       shardConsumerName("WorkloadInstanceReconciler", member),
+			reconcilerName: "WorkloadInstanceReconciler",
+			member:         member,
+			ok:             true,
+		},
+		{
+			name:           "reconciler name with hyphens",
+			consumer:       shardConsumerName("custom-reconciler", member),
+			reconcilerName: "custom-reconciler",
+			member:         member,
+			ok:             true,
+		},
+		{
+			name:     "not a shard consumer",
+			consumer: "WorkloadInstanceReconcilerConsumer",
+		},
+		{
+			name:     "no reconciler name",
+			consumer: "-" + member,
+		},
+		{
+			name:     "suffix is not a UUID",
+			consumer: "WorkloadInstanceReconciler-" + "zzzzzzzz-zzzz-zzzz-zzzz-zzzzzzzzzzzz",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reconcilerName, member, ok := parseShardConsumerName(tc.consumer)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.reconcilerName, reconcilerName)
+			assert.Equal(t, tc.member, member)
+		})
+	}
+}
+
+// TestParseShardSubject tests that the reconciler name and replica are
+// recovered from the subjects of shards on the shard stream.
+func TestParseShardSubject(t *testing.T) {
+	member := uuid.New().String()
+	s := &Sharder{ShardStreamName: "shardTestStream"}
+
+	testCases := []struct {
+		name           string
+		subject        string
+		reconcilerName string
+		member         string
+		ok             bool
+	}{
+		{
+			name:           "shard subject",
+			subject:        s.shardSubject("WorkloadInstanceReconciler", member),
+			reconcilerName: "WorkloadInstanceReconciler",
+			member:         member,
+			ok:             true,
+		},
+		{
+			name:    "other stream",
+			subject: "otherStream.WorkloadInstanceReconciler." + member,
+		},
+		{
+			name:    "no reconciler name",
+			subject: "shardTestStream." + member,
+		},
+		{
+			name:    "replica is not a UUID",
+			subject: "shardTestStream.WorkloadInstanceReconciler.replica",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reconcilerName, member, ok := s.parseShardSubject(tc.subject)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.reconcilerName, reconcilerName)
+			assert.Equal(t, tc.member, member)
+		})
+	}
+}
+
+// TestSharderDrain tests that the notifications left in a departed
+// replica's shard are forwarded to the replica that owns the objects whether
+// or not the departed replica's shard consumer still exists, and that the
+// departed replica's shard consumer is removed once drained.
+func TestSharderDrain(t *testing.T) {
+	testCases := []struct {
+		name     string
+		consumer bool
+	}{
+		{
+			name:     "departed replica's consumer exists",
+			consumer: true,
+		},
+		{
+			name: "departed replica's consumer removed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			natsServer, err := server.NewServer(&server.Options{
+				Host:      "127.0.0.1",
+				Port:      server.RANDOM_PORT,
+				JetStream: true,
+				StoreDir:  t.TempDir(),
+				NoLog:     true,
+				NoSigs:    true,
+			})
+			require.NoError(t, err)
+			go natsServer.Start()
+			t.Cleanup(natsServer.Shutdown)
+			require.True(t, natsServer.ReadyForConnections(10*time.Second), "NATS server should start")
+			nc, err := nats.Connect(natsServer.ClientURL())
+			require.NoError(t, err)
+			t.Cleanup(nc.Close)
+			js, err := nc.JetStream()
+			require.NoError(t, err)
+
+			log := logr.Discard()
+			s := &Sharder{
+				ControllerID:      uuid.New(),
+				JetStreamContext:  js,
+				MemberBucketName:  "shardTestMembers",
+				ShardStreamName:   "shardTestStream",
+				HeartbeatInterval: time.Hour,
+				Log:               &log,
+			}
+			t.Cleanup(s.Shutdown)
+
+			// a departed replica left a notification in its shard
+			departed := uuid.New().String()
+			_, err = js.AddStream(&nats.StreamConfig{
+				Name:      s.ShardStreamName,
+				Subjects:  []string{fmt.Sprintf("%s.>", s.ShardStreamName)},
+				Retention: nats.WorkQueuePolicy,
+			})
+			require.NoError(t, err)
+			if tc.consumer {
+				_, err = s.subscribeShard("ShardTestReconciler", departed)
+				require.NoError(t, err)
+			}
+			_, err = js.Publish(s.shardSubject("ShardTestReconciler", departed), []byte(`{"Object":{"ID":7}}`))
+			require.NoError(t, err)
+
+			require.NoError(t, s.Start())
+
+			sub, err := s.Subscribe(ReconcilerConfig{Name: "ShardTestReconciler"})
+			require.NoError(t, err)
+			messages, err := sub.Fetch(1, nats.MaxWait(5*time.Second))
+			require.NoError(t, err)
+			require.Len(t, messages, 1)
+			assert.JSONEq(t, `{"Object":{"ID":7}}`, string(messages[0].Data))
+			assert.Equal(t, "0", messages[0].Header.Get(shardHopsHeader))
+
+			_, err = js.ConsumerInfo(s.ShardStreamName, shardConsumerName("ShardTestReconciler", departed))
+			assert.ErrorIs(t, err, nats.ErrConsumerNotFound)
+		})
+	}
+}
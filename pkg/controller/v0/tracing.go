@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	apilib "github.com/threeport/threeport/pkg/api/lib/v0"
+	notifications "github.com/threeport/threeport/pkg/notifications/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
+)
+
+// startReconcileSpan starts the span for the reconciliation of a
+// notification.  The trace is continued from the trace context in the
+// message headers, i.e. from the API request that triggered the
+// notification.
+func (r *Reconciler) startReconcileSpan(msg *nats.Msg) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(
+		tracing.ExtractMsg(context.Background(), msg),
+		fmt.Sprintf("%s reconcile", r.Name),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			tracing.MessagingSystemNATS,
+			semconv.MessagingDestinationName(msg.Subject),
+			attribute.String("threeport.reconciler", r.Name),
+		),
+	)
+}
+
+// endReconcileSpan ends the span for a reconciliation with its outcome.
+func endReconcileSpan(span trace.Span, outcome string) {
+	if span == nil {
+		return
+	}
+	span.SetAttributes(attribute.String("threeport.reconcile.outcome", outcome))
+	if outcome == ReconcileOutcomeError || outcome == ReconcileOutcomeInvalid {
+		span.SetStatus(codes.Error, fmt.Sprintf("reconciliation %s", outcome))
+	}
+	span.End()
+}
+
+// TraceContext returns the context with the span for the reconciliation of a
+// notification.  Reconcilers use it to trace the operations they perform.  A
+// background context is returned if the notification is not being
+// reconciled.
+func (r *Reconciler) TraceContext(msg *nats.Msg) context.Context {
+	if record, ok := r.reconciles.Load(msg); ok {
+		return record.(*reconcileRecord).ctx
+	}
+
+	return context.Background()
+}
+
+// TraceObject adds the object being reconciled and the operation that
+// triggered the reconciliation to the reconciliation's span.
+func (r *Reconciler) TraceObject(
+	msg *nats.Msg,
+	object apilib.ReconciledThreeportApiObject,
+	operation notifications.NotificationOperation,
+) {
+	trace.SpanFromContext(r.TraceContext(msg)).SetAttributes(
+		attribute.String("threeport.object.type", object.GetType()),
+		attribute.String("threeport.object.version", object.GetVersion()),
+		attribute.Int("threeport.object.id", int(object.GetId())),
+		attribute.String("threeport.operation", string(operation)),
+	)
+}
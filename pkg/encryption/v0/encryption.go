@@ -156,7 +156,7 @@ func RedactEncryptedValues(obj interface{}) interface{} {
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
 		encrypt := field.Tag.Get("encrypt")
-		if encrypt == "true" {
+		if encrypt == "true" && util.IsNonNilPtr(fieldVal) {
 			fieldVal.Elem().SetString("[encrypted value redacted]")
 		}
 	}
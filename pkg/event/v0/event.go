@@ -26,6 +26,15 @@ const (
 	ReasonSuccessfulDelete = "SuccessfulDelete"
 	ReasonFailedDelete     = "FailedDelete"
 
+	ReasonFailedResync = "FailedResync"
+
+	// Event reasons for objects that have drifted from their desired state.
+	ReasonDrifted        = "Drifted"
+	ReasonDriftCorrected = "DriftCorrected"
+
+	// Event reason for reconciliations that have exhausted their retries.
+	ReasonRetriesExhausted = "RetriesExhausted"
+
 	// Default event types
 	TypeNormal  = "Normal"
 	TypeWarning = "Warning"
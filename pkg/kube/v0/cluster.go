@@ -23,8 +23,10 @@ import (
 	"k8s.io/client-go/restmapper"
 
 	v0 "github.com/threeport/threeport/pkg/api/v0"
+	client_lib "github.com/threeport/threeport/pkg/client/lib/v0"
 	client "github.com/threeport/threeport/pkg/client/v0"
 	"github.com/threeport/threeport/pkg/encryption/v0"
+	tracing "github.com/threeport/threeport/pkg/tracing/v0"
 )
 
 // GetInClusterKubeClient creates a kubernetes clientset for an in cluster configuration
@@ -138,6 +140,35 @@ func GetDiscoveryClient(
 	return discoveryClient, nil
 }
 
+// GetClientset returns a new typed clientset for a kubernetes cluster
+// instance.  It is used for API calls that are not available with the dynamic
+// client, such as streaming pod logs.
+func GetClientset(
+	runtime *v0.KubernetesRuntimeInstance,
+	threeportControlPlane bool,
+	threeportAPIClient *http.Client,
+	threeportAPIEndpoint string,
+	encryptionKey string,
+) (*kubernetes.Clientset, error) {
+	restConfig, err := GetRestConfig(
+		runtime,
+		threeportControlPlane,
+		threeportAPIClient,
+		threeportAPIEndpoint,
+		encryptionKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get REST config for kubernetes runtime instance: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube clientset from rest config: %w", err)
+	}
+
+	return clientset, nil
+}
+
 // GetRestConfig takes a kubernetes runtime instance and returns a REST config
 // for the kubernetes API.
 func GetRestConfig(
@@ -242,6 +273,10 @@ func GetRestConfig(
 		return nil, errors.New("did not find certificate, key pair or connection token - have no way to authenticate to kubernetes API")
 	}
 
+	// make kubernetes API calls part of the trace for the threeport API
+	// client's current operation
+	restConfig.Wrap(tracing.WrapTransport(client_lib.TraceContext(threeportAPIClient)))
+
 	return &restConfig, nil
 }
 
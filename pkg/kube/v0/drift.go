@@ -0,0 +1,167 @@
+package v0
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	kubemetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// GetResourceDrift compares the desired state of a Kubernetes resource with
+// the resource in the cluster.  It returns true if the resource no longer
+// exists, otherwise it returns the fields that don't match the desired state.
+// A namespaced resource without a namespace is looked up in the default
+// namespace and the namespace is set on the desired resource so that it may
+// be re-applied.
+func GetResourceDrift(
+	desired *unstructured.Unstructured,
+	defaultNamespace string,
+	kubeClient dynamic.Interface,
+	mapper meta.RESTMapper,
+) (bool, []string, error) {
+	mapping, err := getResourceMapping(desired, mapper)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get REST mapping for kubernetes resource: %w", err)
+	}
+
+	var resourceClient dynamic.ResourceInterface
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if desired.GetNamespace() == "" {
+			desired.SetNamespace(defaultNamespace)
+		}
+		resourceClient = kubeClient.Resource(mapping.Resource).Namespace(desired.GetNamespace())
+	} else {
+		resourceClient = kubeClient.Resource(mapping.Resource)
+	}
+
+	actual, err := resourceClient.Get(context.Background(), desired.GetName(), kubemetav1.GetOptions{})
+	if err != nil {
+		if kubeerr.IsNotFound(err) {
+			return true, nil, nil
+		}
+		return false, nil, fmt.Errorf("failed to get resource from kubernetes API: %w", err)
+	}
+
+	fields, err := DriftedFields(desired, actual)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to compare desired and actual resource: %w", err)
+	}
+
+	return false, fields, nil
+}
+
+// DriftedFields returns the paths of the fields set in a desired Kubernetes
+// resource that have a different value in the actual resource.  Fields that
+// are not set in the desired resource, such as those defaulted by Kubernetes,
+// are ignored along with the resource's status and all metadata other than
+// labels and annotations.
+func DriftedFields(desired, actual *unstructured.Unstructured) ([]string, error) {
+	// round trip both objects through JSON so that numbers are compared as
+	// the same type
+	desiredObject, err := normalizeObject(desired.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize desired resource: %w", err)
+	}
+	actualObject, err := normalizeObject(actual.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize actual resource: %w", err)
+	}
+
+	var drifted []string
+	for field, value := range desiredObject {
+		switch field {
+		case "status":
+			continue
+		case "metadata":
+			desiredMetadata, _ := value.(map[string]interface{})
+			actualMetadata, _ := actualObject[field].(map[string]interface{})
+			for _, metadataField := range []string{"labels", "annotations"} {
+				if desiredValue, found := desiredMetadata[metadataField]; found {
+					drifted = append(drifted, driftedValues(
+						fmt.Sprintf("metadata.%s", metadataField),
+						desiredValue,
+						actualMetadata[metadataField],
+					)...)
+				}
+			}
+		default:
+			drifted = append(drifted, driftedValues(field, value, actualObject[field])...)
+		}
+	}
+	sort.Strings(drifted)
+
+	return drifted, nil
+}
+
+// driftedValues returns the paths of the values in desired that are
+// different in actual.
+func driftedValues(path string, desired, actual interface{}) []string {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{path}
+		}
+		var drifted []string
+		for key, value := range desiredValue {
+			drifted = append(drifted, driftedValues(
+				fmt.Sprintf("%s.%s", path, key),
+				value,
+				actualValue[key],
+			)...)
+		}
+		return drifted
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok || len(actualValue) != len(desiredValue) {
+			return []string{path}
+		}
+		var drifted []string
+		for i := range desiredValue {
+			drifted = append(drifted, driftedValues(
+				fmt.Sprintf("%s[%d]", path, i),
+				desiredValue[i],
+				actualValue[i],
+			)...)
+		}
+		return drifted
+	default:
+		if !reflect.DeepEqual(desired, actual) {
+			return []string{path}
+		}
+	}
+
+	return nil
+}
+
+// normalizeObject returns a copy of an unstructured object as decoded from
+// JSON.
+func normalizeObject(object map[string]interface{}) (map[string]interface{}, error) {
+	objectJson, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(objectJson, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// DriftedResourceName returns the name of a Kubernetes resource used to
+// report drift, e.g. "Deployment web/api".
+func DriftedResourceName(kubeObject *unstructured.Unstructured) string {
+	if kubeObject.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", kubeObject.GetKind(), kubeObject.GetName())
+	}
+
+	return fmt.Sprintf("%s %s/%s", kubeObject.GetKind(), kubeObject.GetNamespace(), kubeObject.GetName())
+}
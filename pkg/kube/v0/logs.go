@@ -0,0 +1,132 @@
+package v0
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	kubemetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	util "github.com/threeport/threeport/pkg/util/v0"
+)
+
+// PodContainer identifies a single container in a pod.
+type PodContainer struct {
+	Namespace     string
+	PodName       string
+	ContainerName string
+}
+
+// GetPodContainers returns the containers for the pods in the provided
+// namespaces that match the label selector.  If a container name is provided,
+// only containers with that name are returned.
+func GetPodContainers(
+	kubeClient dynamic.Interface,
+	namespaces []string,
+	labelSelector string,
+	containerName string,
+) ([]PodContainer, error) {
+	gvr := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "pods",
+	}
+
+	var podContainers []PodContainer
+	for _, namespace := range namespaces {
+		pods, err := kubeClient.Resource(gvr).Namespace(namespace).List(
+			context.TODO(),
+			kubemetav1.ListOptions{LabelSelector: labelSelector},
+		)
+		if err != nil {
+			return podContainers, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+		}
+
+		for _, item := range pods.Items {
+			var pod corev1.Pod
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &pod); err != nil {
+				return podContainers, fmt.Errorf("failed to convert pod %s to typed object: %w", item.GetName(), err)
+			}
+			for _, container := range pod.Spec.Containers {
+				if containerName != "" && container.Name != containerName {
+					continue
+				}
+				podContainers = append(podContainers, PodContainer{
+					Namespace:     pod.Namespace,
+					PodName:       pod.Name,
+					ContainerName: container.Name,
+				})
+			}
+		}
+	}
+
+	return podContainers, nil
+}
+
+// StreamPodLogs streams the logs for each pod container concurrently and
+// writes each line to the writer prefixed with the pod and container name.  It
+// returns once all log streams have ended.
+func StreamPodLogs(
+	clientset kubernetes.Interface,
+	podContainers []PodContainer,
+	logOptions corev1.PodLogOptions,
+	writer io.Writer,
+) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	multiError := util.MultiError{}
+
+	for _, podContainer := range podContainers {
+		wg.Add(1)
+		go func(podContainer PodContainer) {
+			defer wg.Done()
+
+			options := logOptions
+			options.Container = podContainer.ContainerName
+			stream, err := clientset.CoreV1().Pods(podContainer.Namespace).GetLogs(
+				podContainer.PodName,
+				&options,
+			).Stream(context.TODO())
+			if err != nil {
+				mu.Lock()
+				multiError.AppendError(fmt.Errorf(
+					"failed to stream logs for container %s in pod %s: %w",
+					podContainer.ContainerName,
+					podContainer.PodName,
+					err,
+				))
+				mu.Unlock()
+				return
+			}
+			defer stream.Close()
+
+			prefix := fmt.Sprintf("[%s/%s]", podContainer.PodName, podContainer.ContainerName)
+			scanner := bufio.NewScanner(stream)
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				mu.Lock()
+				fmt.Fprintf(writer, "%s %s\n", prefix, scanner.Text())
+				mu.Unlock()
+			}
+			if err := scanner.Err(); err != nil {
+				mu.Lock()
+				multiError.AppendError(fmt.Errorf(
+					"failed to read logs for container %s in pod %s: %w",
+					podContainer.ContainerName,
+					podContainer.PodName,
+					err,
+				))
+				mu.Unlock()
+			}
+		}(podContainer)
+	}
+	wg.Wait()
+
+	return multiError.Error()
+}
@@ -0,0 +1,27 @@
+package v0
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DeadLetterStreamName is the NATS JetStream stream that retains
+	// notifications that reconcilers have given up on so they may be
+	// inspected and retried.
+	DeadLetterStreamName = "deadLetterStream"
+
+	// DeadLetterSubjects includes the NATS subjects for all dead-letter
+	// notifications.
+	DeadLetterSubjects = "deadLetter.>"
+
+	// DeadLetterStreamMaxAge is how long failed reconciliations are retained
+	// in the dead-letter stream.
+	DeadLetterStreamMaxAge = time.Hour * 24 * 7
+)
+
+// DeadLetterSubject returns the NATS subject for notifications that a
+// reconciler has given up on.
+func DeadLetterSubject(reconcilerName string) string {
+	return fmt.Sprintf("deadLetter.%s", reconcilerName)
+}
@@ -14,6 +14,10 @@ const (
 	NotificationOperationCreated = "Created"
 	NotificationOperationUpdated = "Updated"
 	NotificationOperationDeleted = "Deleted"
+
+	// Sent by controllers to periodically resync objects that have already
+	// been reconciled so that drift from the desired state is detected.
+	NotificationOperationResync = "Resync"
 )
 
 // Notification is the message that is sent to NATS to alert a controller that a
@@ -26,6 +30,7 @@ type Notification struct {
 	// * Created
 	// * Updated
 	// * Deleted
+	// * Resync
 	Operation NotificationOperation
 
 	// Tracks the backoff delay last used in a requeue so that it may
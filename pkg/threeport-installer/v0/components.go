@@ -270,6 +270,7 @@ NATS_PORT=4222
 				},
 				"template": map[string]interface{}{
 					"metadata": map[string]interface{}{
+						"annotations": cpi.getMetricsAnnotations(),
 						"labels": map[string]interface{}{
 							"app.kubernetes.io/name": cpi.Opts.RestApiInfo.ServiceResourceName,
 						},
@@ -321,7 +322,7 @@ func (cpi *ControlPlaneInstaller) InstallThreeportAPITLS(
 		serverCertificate, serverPrivateKey, err := auth.GenerateCertificate(
 			authConfig.CAConfig,
 			&authConfig.CAPrivateKey,
-			"localhost",
+			cpi.Opts.RestApiInfo.ServiceResourceName,
 			serverAltName,
 		)
 		if err != nil {
@@ -342,6 +343,60 @@ func (cpi *ControlPlaneInstaller) InstallThreeportAPITLS(
 	return nil
 }
 
+// IssueClientCertificate generates a client certificate and private key for an
+// API client using the threeport API CA stored in the control plane's
+// Kubernetes cluster.  The common name is the subject that role bindings
+// grant roles to.  It returns the PEM encoded certificate and private key along
+// with the PEM encoded CA certificate.
+func IssueClientCertificate(
+	kubeClient dynamic.Interface,
+	mapper *meta.RESTMapper,
+	namespace string,
+	commonName string,
+) (string, string, string, error) {
+	if v0.AuthzReservedSubject(commonName) {
+		return "", "", "", fmt.Errorf("common name %s is reserved for threeport control plane components", commonName)
+	}
+
+	apiCaSecret, err := kube.GetResource(
+		"core",
+		"v1",
+		"Secret",
+		namespace,
+		ThreeportApiCaSecret,
+		kubeClient,
+		*mapper,
+	)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to get threeport API CA secret: %w", err)
+	}
+
+	var caPem, caPrivateKeyPem []byte
+	for key, value := range map[string]*[]byte{"tls.crt": &caPem, "tls.key": &caPrivateKeyPem} {
+		encoded, found, err := unstructured.NestedString(apiCaSecret.Object, "data", key)
+		if err != nil || !found {
+			return "", "", "", fmt.Errorf("failed to find %s in threeport API CA secret", key)
+		}
+		decoded, err := util.Base64Decode(encoded)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to decode %s from threeport API CA secret: %w", key, err)
+		}
+		*value = []byte(decoded)
+	}
+
+	caConfig, caPrivateKey, err := auth.LoadCA(caPem, caPrivateKeyPem)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to load threeport API CA: %w", err)
+	}
+
+	certificate, privateKey, err := auth.GenerateCertificate(caConfig, caPrivateKey, commonName)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to generate client certificate and private key: %w", err)
+	}
+
+	return certificate, privateKey, string(caPem), nil
+}
+
 // InstallThreeportControllers installs the threeport controllers in a
 // Kubernetes cluster.
 func (cpi *ControlPlaneInstaller) InstallThreeportControllers(
@@ -366,7 +421,7 @@ func (cpi *ControlPlaneInstaller) InstallThreeportControllers(
 			certificate, privateKey, err := auth.GenerateCertificate(
 				authConfig.CAConfig,
 				&authConfig.CAPrivateKey,
-				"localhost",
+				v0.AuthzSystemSubject(controller.Name),
 			)
 			if err != nil {
 				return fmt.Errorf("failed to generate client certificate and private key for workload controller: %w", err)
@@ -469,7 +524,7 @@ func (cpi *ControlPlaneInstaller) InstallThreeportAgent(
 		agentCertificate, agentPrivateKey, err := auth.GenerateCertificate(
 			authConfig.CAConfig,
 			&authConfig.CAPrivateKey,
-			"localhost",
+			v0.AuthzSystemSubject(cpi.Opts.AgentInfo.Name),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to generate client certificate and private key for threeport agent: %w", err)
@@ -1882,6 +1937,7 @@ func (cpi *ControlPlaneInstaller) getControllerDeployment(
 				},
 				"template": map[string]interface{}{
 					"metadata": map[string]interface{}{
+						"annotations": cpi.getMetricsAnnotations(),
 						"labels": map[string]interface{}{
 							"app.kubernetes.io/name": deployName,
 						},
@@ -1929,6 +1985,17 @@ func (cpi *ControlPlaneInstaller) getReadinessProbe() map[string]interface{} {
 	return readinessProbe
 }
 
+// getMetricsAnnotations returns the pod annotations that allow Prometheus to
+// scrape metrics from the API server and controllers.  Metrics are served on
+// the same port as the readiness probe.
+func (cpi *ControlPlaneInstaller) getMetricsAnnotations() map[string]interface{} {
+	return map[string]interface{}{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "8081",
+		"prometheus.io/path":   "/metrics",
+	}
+}
+
 func (cpi *ControlPlaneInstaller) getDevEnvironmentVolumes(vols, volMounts []interface{}) ([]interface{}, []interface{}) {
 	codePathVol, codePathVolMount := cpi.getCodePathVols()
 	vols = append(vols, codePathVol)
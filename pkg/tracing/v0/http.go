@@ -0,0 +1,52 @@
+package v0
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTransport returns a round tripper that creates a client span for each
+// request and propagates the trace context to the server in the request
+// headers.
+func NewTransport(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt)
+}
+
+// WrapTransport returns a function that wraps a round tripper so that
+// requests are traced.  Requests made without a span in their context are
+// made part of the trace in parent.  It is used for clients, such as
+// kubernetes clients, whose requests are not made with the caller's context.
+func WrapTransport(parent context.Context) func(http.RoundTripper) http.RoundTripper {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		tracedTransport := NewTransport(rt)
+
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return tracedTransport.RoundTrip(req.WithContext(WithParent(req.Context(), parent)))
+		})
+	}
+}
+
+// WithParent returns ctx with the span from parent when ctx has no span of
+// its own.
+func WithParent(ctx context.Context, parent context.Context) context.Context {
+	if parent == nil || trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	parentSpanContext := trace.SpanContextFromContext(parent)
+	if !parentSpanContext.IsValid() {
+		return ctx
+	}
+
+	return trace.ContextWithSpanContext(ctx, parentSpanContext)
+}
+
+// roundTripperFunc allows a function to be used as a round tripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls the function.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,66 @@
+package v0
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// MessagingSystemNATS is the span attribute for spans that publish or consume
+// NATS messages.
+var MessagingSystemNATS = semconv.MessagingSystemKey.String("nats")
+
+// natsHeaderCarrier adapts NATS message headers for use by the trace
+// context propagator.
+type natsHeaderCarrier nats.Header
+
+// Get returns the value of a header.
+func (c natsHeaderCarrier) Get(key string) string {
+	return nats.Header(c).Get(key)
+}
+
+// Set sets the value of a header.
+func (c natsHeaderCarrier) Set(key string, value string) {
+	nats.Header(c).Set(key, value)
+}
+
+// Keys returns the names of the headers.
+func (c natsHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// InjectMsg writes the trace context from ctx to the headers of a NATS
+// message so that the message's consumer can continue the trace.
+func InjectMsg(ctx context.Context, msg *nats.Msg) {
+	if msg.Header == nil {
+		msg.Header = nats.Header{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, natsHeaderCarrier(msg.Header))
+}
+
+// ExtractMsg returns a copy of ctx with the trace context read from the
+// headers of a NATS message.
+func ExtractMsg(ctx context.Context, msg *nats.Msg) context.Context {
+	if msg.Header == nil {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, natsHeaderCarrier(msg.Header))
+}
+
+// NewMsg returns a NATS message for the subject and data with the trace
+// context from ctx in its headers.
+func NewMsg(ctx context.Context, subject string, data []byte) *nats.Msg {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	InjectMsg(ctx, msg)
+
+	return msg
+}
@@ -34,6 +34,20 @@ func (r *Operations) Create() error {
 	return nil
 }
 
+// Update executes all update operations in the operation stack.  Operations
+// without an update function are skipped.
+func (r *Operations) Update() error {
+	for _, operation := range r.Operations {
+		if operation.Update == nil {
+			continue
+		}
+		if err := operation.Update(); err != nil {
+			return fmt.Errorf("failed to update %s:\n%w", operation.Name, err)
+		}
+	}
+	return nil
+}
+
 // ExecuteUpdateOperations executes all delete operations in the operation stack.
 func (r *Operations) Delete() error {
 	return r.delete(len(r.Operations) - 1)
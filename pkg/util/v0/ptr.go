@@ -13,3 +13,12 @@ func DerefString(s *string) string {
 	}
 	return *s
 }
+
+// DerefBool returns the value of a bool pointer or false if the pointer is
+// nil.
+func DerefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}